@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"github.com/c-bata/go-prompt"
 	"github.com/williamflynt/topolith/pkg/app"
+	"github.com/williamflynt/topolith/pkg/errors"
 	"github.com/williamflynt/topolith/pkg/grammar"
 	"github.com/williamflynt/topolith/pkg/world"
+	"slices"
 	"strings"
+	"unicode"
 )
 
 // executor handles the unparsed input to the CLI.
@@ -17,16 +20,139 @@ func executor(app app.App) prompt.Executor {
 			return
 		}
 
-		p, err := grammar.Parse(input)
-		if err != nil {
-			fmt.Println("invalid input:", err)
-			p.PrintSyntaxTree()
+		if _, err := grammar.Parse(input); err != nil {
+			fmt.Println(errorMessage(err))
 			return
 		}
+		input = splitAssignments(app, input)
 
 		resp := app.Exec(input)
 		fmt.Println(resp)
+
+		// Offer to retry a mistyped ID with one of the suggestions from the response.
+		p, err := grammar.Parse(resp)
+		if err != nil || len(p.Response.Status.Suggestions) == 0 {
+			return
+		}
+		status := p.Response.Status
+		choice := strings.TrimSpace(prompt.Input(
+			fmt.Sprintf("Did you mean %s? Enter one to retry, or nothing to skip: ", strings.Join(status.Suggestions, ", ")),
+			func(d prompt.Document) []prompt.Suggest {
+				suggestions := make([]prompt.Suggest, len(status.Suggestions))
+				for i, s := range status.Suggestions {
+					suggestions[i] = prompt.Suggest{Text: s, Description: fmt.Sprintf("Retry with %s instead of %s", s, status.Missing)}
+				}
+				return prompt.FilterHasPrefix(suggestions, d.TextBeforeCursor(), true)
+			},
+		))
+		if choice == "" {
+			return
+		}
+		executor(app)(replaceId(input, status.Missing, choice))
+	}
+}
+
+// splitAssignments asks which target gets each component and Rel of the Item in a split, and adds the answers to the input.
+// Input that isn't a split, or already assigns, is returned as-is. Unanswered questions go to the first target.
+func splitAssignments(app app.App, input string) string {
+	p, err := grammar.Parse(input)
+	if err != nil || p.InputAttributes.Verb != "split" || len(p.InputAttributes.Assignments) > 0 {
+		return input
+	}
+	w := app.World()
+	ids := w.Resolve(p.InputAttributes.ResourceId)
+	if len(ids) != 1 {
+		return input
+	}
+	assignable, _ := w.Components(ids[0])
+	for _, rel := range append(w.RelFrom(ids[0], true), w.RelTo(ids[0], true)...) {
+		assignable = append(assignable, rel.From.Id, rel.To.Id)
+	}
+	slices.Sort(assignable)
+	assignable = slices.Compact(slices.DeleteFunc(assignable, func(id string) bool { return id == ids[0] }))
+
+	targets := p.InputAttributes.SecondaryIds
+	assignments := make([]string, 0)
+	for _, id := range assignable {
+		choice := strings.TrimSpace(prompt.Input(
+			fmt.Sprintf("Which of %s gets %s? Enter nothing for %s: ", strings.Join(targets, ", "), id, targets[0]),
+			func(d prompt.Document) []prompt.Suggest {
+				suggestions := make([]prompt.Suggest, len(targets))
+				for i, target := range targets {
+					suggestions[i] = prompt.Suggest{Text: target, Description: fmt.Sprintf("Assign %s to %s", id, target)}
+				}
+				return prompt.FilterHasPrefix(suggestions, d.TextBeforeCursor(), true)
+			},
+		))
+		if choice != "" {
+			assignments = append(assignments, fmt.Sprintf(`"%s"="%s"`, id, choice))
+		}
+	}
+	if len(assignments) == 0 {
+		return input
+	}
+	// Flags come last, so the assignments go before them. The rest of the input is kept as-is.
+	at := len(input)
+	for _, span := range fieldSpans(input) {
+		if strings.HasPrefix(input[span[0]:], "--") {
+			at = span[0]
+			break
+		}
+	}
+	assign := "assign " + strings.Join(assignments, " ")
+	if at < len(input) {
+		assign += " "
+	}
+	return strings.TrimRightFunc(input[:at], unicode.IsSpace) + " " + assign + input[at:]
+}
+
+// replaceId replaces each whole, optionally quoted, occurrence of the ID in the input.
+// The rest of the input is kept as-is, including any whitespace inside quoted values.
+func replaceId(input, id, replacement string) string {
+	var b strings.Builder
+	last := 0
+	for _, span := range fieldSpans(input) {
+		if strings.Trim(input[span[0]:span[1]], `"`) == id {
+			b.WriteString(input[last:span[0]])
+			b.WriteString(fmt.Sprintf(`"%s"`, replacement))
+			last = span[1]
+		}
+	}
+	b.WriteString(input[last:])
+	return b.String()
+}
+
+// fieldSpans returns the start and end of each field in the input: a run of text between whitespace, where whitespace inside quotes doesn't count.
+func fieldSpans(input string) [][2]int {
+	spans := make([][2]int, 0)
+	start, quoted := -1, false
+	for i, r := range input {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case unicode.IsSpace(r) && !quoted:
+			if start >= 0 {
+				spans = append(spans, [2]int{start, i})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(input)})
+	}
+	return spans
+}
+
+// errorMessage returns the human-readable part of an error, such as where a parse error happened.
+func errorMessage(err error) string {
+	if te, ok := err.(errors.TopolithError); ok {
+		return fmt.Sprintf("%s: %s", te.Description, te.Message)
 	}
+	return err.Error()
 }
 
 // completer handles the autocompletion for the CLI.
@@ -35,14 +161,50 @@ func completer(app app.App) prompt.Completer {
 		text := d.TextBeforeCursor()
 
 		suggestions := []prompt.Suggest{
-			{Text: ".save", Description: "Store the world"},
-			{Text: ".load", Description: "Load a new world"},
 			{Text: "item", Description: "Manage items"},
 			{Text: "rel", Description: "Manage relationships"},
 			{Text: "world", Description: "Manage the world"},
+			{Text: "world save", Description: "Store the world"},
+			{Text: "world load", Description: "Load a stored world"},
+			{Text: "world list", Description: "List stored worlds"},
+			{Text: "world new", Description: "Start a new, empty world"},
+			{Text: "world set", Description: "Set the world name, description or theme"},
+			{Text: "world open", Description: "Open another world alongside this one"},
+			{Text: "world use", Description: "Switch to an open world"},
+			{Text: "world close", Description: "Close an open world"},
 			{Text: "in?", Description: "Check item containment"},
+			{Text: "ancestors?", Description: "List the ancestors of an item"},
+			{Text: "siblings?", Description: "List the siblings of an item"},
+			{Text: "owners?", Description: "List who owns an item, nearest first"},
+			{Text: "dataflow?", Description: "Trace where classified data can go"},
+			{Text: "owners import", Description: "Set owners of code items from a CODEOWNERS file"},
+			{Text: "crossings?", Description: "List relationships across trust boundaries"},
+			{Text: "threats export", Description: "Write a STRIDE threat model to Markdown or JSON"},
+			{Text: "node", Description: "Manage deployment nodes"},
+			{Text: "node export", Description: "Write a PlantUML deployment diagram of an environment"},
+			{Text: "deploy", Description: "Deploy an item to a deployment node"},
+			{Text: "undeploy", Description: "Remove an item from a deployment node"},
+			{Text: "deployed?", Description: "List where an item is deployed"},
+			{Text: "scenario", Description: "Manage scenarios of ordered calls"},
+			{Text: "scenario export", Description: "Write a sequence diagram to PlantUML or Mermaid"},
+			{Text: "step", Description: "Add a step to a scenario"},
+			{Text: "unstep", Description: "Remove a step from a scenario"},
+			{Text: "view", Description: "Manage saved views of expansion, filters and focus"},
+			{Text: "layout pin", Description: "Pin an item's position and size, or a relationship's waypoints"},
+			{Text: "layout unpin", Description: "Let an item or relationship be laid out automatically again"},
+			{Text: "layout list", Description: "List pinned positions, optionally in one view"},
+			{Text: "style", Description: "Manage styles of shape, color, border and line for every render"},
+			{Text: "tree", Description: "Show the item hierarchy"},
 			{Text: "nest", Description: "Nest items"},
 			{Text: "free", Description: "Free items"},
+			{Text: "item merge", Description: "Merge an item into another"},
+			{Text: "item split", Description: "Split an item into new ones"},
+			{Text: "item archive", Description: "Hide an item, keeping its history"},
+			{Text: "item restore", Description: "Bring back an archived item"},
+			{Text: "item link", Description: "Link an item to a runbook, dashboard, repo, ADR or API spec"},
+			{Text: "item unlink", Description: "Remove links from an item"},
+			{Text: "rel link", Description: "Link a relationship to a runbook, dashboard, repo, ADR or API spec"},
+			{Text: "rel unlink", Description: "Remove links from a relationship"},
 			{Text: "undo", Description: "Undo last action"},
 			{Text: "redo", Description: "Redo reversed action"},
 		}
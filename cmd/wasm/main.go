@@ -4,18 +4,21 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/williamflynt/topolith/pkg/app"
+	"github.com/williamflynt/topolith/pkg/errors"
 	"github.com/williamflynt/topolith/pkg/grammar"
+	"github.com/williamflynt/topolith/pkg/render"
 	"github.com/williamflynt/topolith/pkg/world"
 	"strconv"
 	"syscall/js"
 )
 
 type JavaScriptReply struct {
-	Status int               `json:"status"`
-	Data   grammar.Response  `json:"data"`
-	Error  map[string]string `json:"error"`
-	Raw    string            `json:"raw"`
+	Status int              `json:"status"`
+	Data   grammar.Response `json:"data"`
+	Error  map[string]any   `json:"error"`
+	Raw    string           `json:"raw"`
 }
 
 func main() {
@@ -29,23 +32,45 @@ func main() {
 			return "Expected one argument"
 		}
 		command := args[0].String()
+		if _, err := grammar.Parse(command); err != nil {
+			return jsReplyToJsValue(errorReply(err, command))
+		}
 		response := app.Exec(command)
 		return jsReplyToJsValue(toReply(response))
 	}))
+	// Create a JavaScript function that lays out the World, as a saved View shows it if one is given, and returns the Layout as JSON.
+	// Pinned Items and Rel keep their positions. Drag results go back through topolithSend, as `layout pin "api" x=120 y=40 view="slides"`,
+	// so they are part of history and a later `undo` sent the same way moves the Item back.
+	renderers := make(map[string]*render.LayoutRenderer)
+	js.Global().Set("topolithLayout", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if len(args) > 1 {
+			return "Expected at most one argument"
+		}
+		view := ""
+		if len(args) == 1 {
+			view = args[0].String()
+		}
+		r, ok := renderers[view]
+		if !ok {
+			r = render.NewLayoutRenderer(view)
+			renderers[view] = r
+		}
+		b, _, err := r.Render(app.World())
+		if err != nil {
+			return jsReplyToJsValue(errorReply(err, view))
+		}
+		return js.ValueOf(string(b))
+	}))
 	// Prevent the Go program from exiting.
 	select {}
 }
 
 func toReply(response string) JavaScriptReply {
 	p, err := grammar.Parse(response)
-	// TODO: Structure the error as a TopolithError.
 	if err != nil {
-		return JavaScriptReply{
-			Status: 500,
-			Data:   p.Response,
-			Error:  map[string]string{"code": "500", "message": err.Error()},
-			Raw:    response,
-		}
+		reply := errorReply(errors.New("error parsing response").UseCode(errors.TopolithErrorInternal).WithError(err), response)
+		reply.Data = p.Response
+		return reply
 	}
 	// TODO: Parse the response object Repr and return objects in JSON.
 	//  This will mean creating a structure for our World.Tree.
@@ -53,18 +78,45 @@ func toReply(response string) JavaScriptReply {
 		return JavaScriptReply{
 			Status: p.Response.Status.Code,
 			Data:   p.Response,
-			Error:  map[string]string{"code": strconv.Itoa(p.Response.Status.Code), "message": p.Response.Status.Message},
+			Error:  map[string]any{"code": strconv.Itoa(p.Response.Status.Code), "message": p.Response.Status.Message},
 			Raw:    response,
 		}
 	}
 	return JavaScriptReply{
 		Status: p.Response.Status.Code,
 		Data:   p.Response,
-		Error:  map[string]string{},
+		Error:  map[string]any{},
 		Raw:    response,
 	}
 }
 
+// errorReply structures the error as a JavaScriptReply.
+// The Data of a TopolithError, such as the line, column and expected tokens of a parse error, is added to the Error map.
+// A key that the Data repeats, such as each `suggestion` for a mistyped ID, holds the list of its values.
+func errorReply(err error, raw string) JavaScriptReply {
+	te, ok := err.(errors.TopolithError)
+	if !ok {
+		te = errors.New(err.Error())
+	}
+	values := make(map[string][]string)
+	for _, kv := range te.Data {
+		values[kv.Key] = append(values[kv.Key], kv.Value)
+	}
+	e := map[string]any{"code": strconv.Itoa(int(te.Code)), "message": fmt.Sprintf("%s: %s", te.Description, te.Message)}
+	for key, v := range values {
+		if len(v) == 1 {
+			e[key] = v[0]
+		} else {
+			e[key] = v
+		}
+	}
+	return JavaScriptReply{
+		Status: int(te.Code),
+		Error:  e,
+		Raw:    raw,
+	}
+}
+
 func jsReplyToJsValue(reply JavaScriptReply) js.Value {
 	data, _ := json.Marshal(reply)
 	return js.ValueOf(string(data))
@@ -1,17 +1,27 @@
 package app
 
 import (
+	"cmp"
 	"fmt"
 	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/williamflynt/topolith/pkg/codeowners"
 	"github.com/williamflynt/topolith/pkg/errors"
 	"github.com/williamflynt/topolith/pkg/grammar"
+	"github.com/williamflynt/topolith/pkg/persistence"
+	"github.com/williamflynt/topolith/pkg/render"
+	"github.com/williamflynt/topolith/pkg/threat"
 	"github.com/williamflynt/topolith/pkg/world"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 )
 
-// TODO: Update all commands to use Flags where appropriate.
-
 // Command is the interface that all app must implement.
 //
 // The CommandVerb is implicit in the **type** of the Command.
@@ -20,6 +30,7 @@ import (
 type Command interface {
 	Execute(w world.World) (fmt.Stringer, error) // Execute runs the command on the given world.World. Return the resource object(s) or response, and an error if any.
 	Undo(w world.World) error                    // Undo reverts the changes made by the command on the given world.World. Return an error if any. For non-mutating commands, this is a noop.
+	Dual() (Command, error)                      // Dual returns the inverse Command for the last Execute, built from its grammar representation. Return nil if there is nothing to revert.
 	fmt.Stringer
 }
 
@@ -42,15 +53,52 @@ const (
 	InQuery       CommandVerb = "in?"             // InQuery command is used to retrieve all the world.Item that are nested under the given world.Item.
 	CreateOrFetch CommandVerb = "create-or-fetch" // CreateOrFetch command is used to create a new resource if it doesn't exist, or fetch it if it does.
 	CreateOrSet   CommandVerb = "create-or-set"   // CreateOrSet command is used to create a new resource if it doesn't exist, or set the given attributes if it does.
+	Save          CommandVerb = "save"            // Save command is used to persist the world.World.
+	Load          CommandVerb = "load"            // Load command is used to replace the world.World with a persisted one.
+	New           CommandVerb = "new"             // New command is used to replace the world.World with an empty one.
+	Use           CommandVerb = "use"             // Use command is used to switch the current world.World among those open in the App.
+	Open          CommandVerb = "open"            // Open command is used to open another world.World in the App, and switch to it.
+	Close         CommandVerb = "close"           // Close command is used to close a world.World in the App.
+	Copy          CommandVerb = "copy"            // Copy command is used to copy resources from the current world.World into another open one.
+	Clone         CommandVerb = "clone"           // Clone command is used to copy a world.Item and its components under new IDs, in the same world.World.
+	Merge         CommandVerb = "merge"           // Merge command is used to fold a world.Item into another, moving its world.Rel and components, and deleting it.
+	Split         CommandVerb = "split"           // Split command is used to divide a world.Item into new ones, assigning its world.Rel and components among them.
+	Archive       CommandVerb = "archive"         // Archive command is used to hide a world.Item, its components, and their world.Rel, while keeping them in the world.World.
+	Restore       CommandVerb = "restore"         // Restore command is used to bring back an archived world.Item.
+	In            CommandVerb = "in"              // In command is used to retrieve the subtree of the world.Tree under the given world.Item.
+	Ancestors     CommandVerb = "ancestors?"      // Ancestors command is used to retrieve the path of world.Item from the parent of the given world.Item to the world.Tree root.
+	Siblings      CommandVerb = "siblings?"       // Siblings command is used to retrieve the world.Item with the same parent as the given world.Item.
+	Tree          CommandVerb = "tree"            // Tree command is used to retrieve the whole world.Tree.
+	Link          CommandVerb = "link"            // Link command is used to add links to runbooks, dashboards, etc. to a world.Item or world.Rel.
+	Unlink        CommandVerb = "unlink"          // Unlink command is used to remove links from a world.Item or world.Rel.
+	DataFlowQuery CommandVerb = "dataflow?"       // DataFlowQuery command is used to trace where data of a classification can go along world.Rel, and where it crosses into External world.Item.
+	Owners        CommandVerb = "owners?"         // Owners command is used to retrieve the world.Item that the given world.Item inherits ownership from, nearest first.
+	ImportOwners  CommandVerb = "import-owners"   // ImportOwners command is used to set the owners of code world.Item from a CODEOWNERS file.
+	Crossings     CommandVerb = "crossings?"      // Crossings command is used to retrieve the world.Rel between world.Item in different trust boundaries.
+	ExportThreats CommandVerb = "export-threats"  // ExportThreats command is used to write a STRIDE threat model of the world.World to a Markdown or JSON file.
+	Export        CommandVerb = "export"          // Export command is used to write a diagram of a resource to a file.
+	Deploy        CommandVerb = "deploy"          // Deploy command is used to deploy a world.Item to a world.DeploymentNode.
+	Undeploy      CommandVerb = "undeploy"        // Undeploy command is used to remove a world.Item from a world.DeploymentNode.
+	Deployed      CommandVerb = "deployed?"       // Deployed command is used to retrieve the world.DeploymentNode that a world.Item is deployed to, optionally in one environment.
+	Step          CommandVerb = "step"            // Step command is used to add a step to a world.Scenario, following a world.Rel.
+	Unstep        CommandVerb = "unstep"          // Unstep command is used to remove a step from a world.Scenario.
+	Pin           CommandVerb = "pin"             // Pin command is used to pin the position and size of a world.Item, or the waypoints of a world.Rel, in the layout of a world.View.
+	Unpin         CommandVerb = "unpin"           // Unpin command is used to remove a world.Pin, so renderers lay out the world.Item or world.Rel automatically again.
+	Undo          CommandVerb = "undo"            // Undo command is used to revert the last Command in the history of the current world.World.
+	Redo          CommandVerb = "redo"            // Redo command is used to execute again the last Command that was undone in the current world.World.
 )
 
 // CommandFlag represents a flag for a command.
 type CommandFlag string
 
 const (
-	Strict  CommandFlag = "strict"  // Strict flag is used to indicate that the command should only be executed if the resource already exists, or to strictly interpret IDs (ie: not consider children/parents).
-	Verbose CommandFlag = "verbose" // Verbose flag is used to indicate that the command should return more information.
-	Ids     CommandFlag = "ids"     // Ids flag is used to indicate that the command should return the IDs of the resources, rather than the resources themselves.
+	Strict   CommandFlag = "strict"   // Strict flag is used to indicate that the command should only be executed if the resource already exists, or to strictly interpret IDs (ie: not consider children/parents).
+	Verbose  CommandFlag = "verbose"  // Verbose flag is used to indicate that the command should return more information.
+	Ids      CommandFlag = "ids"      // Ids flag is used to indicate that the command should return the IDs of the resources, rather than the resources themselves.
+	Cascade  CommandFlag = "cascade"  // Cascade flag is used to indicate that deleting a world.Item should delete its components too, rather than hoist them to its parent.
+	AllRels  CommandFlag = "all-rels" // AllRels flag is used to indicate that a clone should also copy the world.Rel to and from world.Item outside the subtree.
+	Archived CommandFlag = "archived" // Archived flag is used to indicate that a list should return the archived world.Item, rather than the current ones.
+	DryRun   CommandFlag = "dry-run"  // DryRun flag is used to indicate that the command should run on a copy of the world.World, and return what it would change.
 )
 
 // CommandTarget represents the type of resource we're executing the command on.
@@ -63,9 +111,14 @@ const (
 type CommandTarget string
 
 const (
-	WorldTarget CommandTarget = "world"
-	ItemTarget  CommandTarget = "item"
-	RelTarget   CommandTarget = "rel"
+	WorldTarget    CommandTarget = "world"
+	ItemTarget     CommandTarget = "item"
+	RelTarget      CommandTarget = "rel"
+	NodeTarget     CommandTarget = "node"
+	ScenarioTarget CommandTarget = "scenario"
+	ViewTarget     CommandTarget = "view"
+	LayoutTarget   CommandTarget = "layout"
+	StyleTarget    CommandTarget = "style"
 )
 
 // StringerList is a helper type to allow for a list of fmt.Stringer to be joined into a single string.
@@ -86,630 +139,3955 @@ func (b BoolStringer) String() string {
 	return fmt.Sprintf("%t", b)
 }
 
-// CommandBase is a base struct for common command fields.
-type CommandBase struct {
-	InputAttributes grammar.InputAttributes
-	ResourceType    CommandTarget
-	Id              string
-	Flags           mapset.Set[CommandFlag]
-}
+// IdList is a helper type to allow for a list of IDs to be returned as a grammar-compatible list of identifiers.
+// The result of calling String() on an IdList is a space-separated string of the quoted IDs.
+type IdList []string
 
-func (c *CommandBase) String() string {
-	return c.InputAttributes.Raw
+func (l IdList) String() string {
+	strs := make([]string, len(l))
+	for i, id := range l {
+		strs[i] = quoted(id)
+	}
+	return strings.Join(strs, " ")
 }
 
-// --- COMMAND IMPLEMENTATIONS ---
-
-// WorldFetchCommand represents a fetch command for the whole World.
-type WorldFetchCommand struct {
-	InputAttributes grammar.InputAttributes
+// ItemDetail is the verbose representation of an Item: the Item with its parent, its components, and its inbound and outbound Rel.
+// The result of calling String() on an ItemDetail is a grammar-compatible detail object.
+type ItemDetail struct {
+	Item       world.Item
+	ParentId   string      // ParentId is the ID of the parent Item, or empty if the Item is at the root of the world.Tree.
+	Components []string    // Components are the IDs of the child Items.
+	Inbound    []world.Rel // Inbound are the Rel to the Item.
+	Outbound   []world.Rel // Outbound are the Rel from the Item.
 }
 
-func (c *WorldFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
-	return w, nil
+func (d ItemDetail) String() string {
+	lines := []string{"$$detail", d.Item.String()}
+	if d.ParentId != "" {
+		lines = append(lines, fmt.Sprintf("parent %s", quoted(d.ParentId)))
+	}
+	lines = append(lines, strings.TrimSpace("components "+IdList(d.Components).String()))
+	for _, rel := range d.Inbound {
+		lines = append(lines, "inbound "+rel.String())
+	}
+	for _, rel := range d.Outbound {
+		lines = append(lines, "outbound "+rel.String())
+	}
+	return strings.Join(append(lines, "enddetail$$"), "\n")
 }
 
-func (c *WorldFetchCommand) Undo(w world.World) error {
-	return nil
-}
+// ScenarioDetail is a Scenario with its steps.
+// The result of calling String() on a ScenarioDetail is the grammar-compatible Scenario, then a line for each step.
+type ScenarioDetail world.Scenario
 
-func (c *WorldFetchCommand) String() string {
-	return c.InputAttributes.Raw
+func (d ScenarioDetail) String() string {
+	if d.Id == "" {
+		return ""
+	}
+	scenario := world.Scenario(d)
+	return strings.Join(append([]string{scenario.String()}, scenario.StepStrings()...), "\n")
 }
 
-/* Item Commands */
-
-// ItemCreateCommand represents a create command.
-type ItemCreateCommand struct {
-	CommandBase
-	Params   world.ItemParams
-	noCreate bool
+// Subtree is a part of the world.Tree, rooted at an Item, or at the world.Tree root if Item is nil.
+// The result of calling String() on a Subtree is a grammar-compatible tree object, like world.Tree.
+type Subtree struct {
+	Item       *world.Item
+	Components []Subtree // Components are sorted by Item ID.
 }
 
-func (c *ItemCreateCommand) Execute(w world.World) (fmt.Stringer, error) {
-	if item, ok := w.ItemFetch(c.Id); ok {
-		c.noCreate = true
-		return item, nil
+func (t Subtree) String() string {
+	item := "nil"
+	if t.Item != nil {
+		item = t.Item.String()
 	}
-	return w.ItemCreate(c.Id, c.Params).Item()
+	components := make([]string, len(t.Components))
+	for i, c := range t.Components {
+		components[i] = c.String()
+	}
+	return fmt.Sprintf("tree{%s::[%s]}", item, strings.Join(components, " "))
 }
 
-func (c *ItemCreateCommand) Undo(w world.World) error {
-	if c.noCreate {
-		return nil
+// Ids returns the IDs of the Items in the Subtree, depth-first, excluding its own Item.
+func (t Subtree) Ids() IdList {
+	ids := make(IdList, 0)
+	for _, c := range t.Components {
+		ids = append(ids, c.Item.Id)
+		ids = append(ids, c.Ids()...)
 	}
-	return w.ItemDelete(c.Id).Err()
+	return ids
 }
 
-// ItemFetchCommand represents a fetch command for Item.
-type ItemFetchCommand struct {
-	CommandBase
+// Changes is what a Command would change in a world.World, from a dry run.
+// The result of calling String() on Changes is a grammar-compatible changes object.
+type Changes struct {
+	Matched []string // Matched are the IDs that the Selectors of the Command matched, if it has any.
+	world.ChangeSet
 }
 
-func (c *ItemFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
-	item, ok := w.ItemFetch(c.Id)
-	if !ok {
-		return world.Item{}, errors.New("could not find Item").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
+func (c Changes) String() string {
+	lines := []string{"$$changes"}
+	if len(c.Matched) > 0 {
+		lines = append(lines, "matched "+IdList(c.Matched).String())
 	}
-	return item, nil
+	if len(c.WorldChanged) > 0 {
+		params := make([]string, len(c.WorldChanged))
+		for i, change := range c.WorldChanged {
+			params[i] = fmt.Sprintf("%s=%s", change.Name, quoted(change.Value))
+			if change.Name == "version" || change.Name == "theme" {
+				params[i] = fmt.Sprintf("%s=%s", change.Name, change.Value)
+			}
+		}
+		lines = append(lines, "changed world "+strings.Join(params, " "))
+	}
+	for _, item := range c.ItemsCreated {
+		lines = append(lines, "created "+item.String())
+	}
+	for _, item := range c.ItemsRemoved {
+		lines = append(lines, "removed "+item.String())
+	}
+	for _, item := range c.ItemsChanged {
+		lines = append(lines, "changed "+item.String())
+	}
+	for _, move := range c.ItemsMoved {
+		lines = append(lines, fmt.Sprintf("moved %s from %s to %s", quoted(move.Id), quoted(move.From), quoted(move.To)))
+	}
+	for _, rel := range c.RelsCreated {
+		lines = append(lines, "created "+rel.String())
+	}
+	for _, rel := range c.RelsRemoved {
+		lines = append(lines, "removed "+rel.String())
+	}
+	for _, rel := range c.RelsChanged {
+		lines = append(lines, "changed "+rel.String())
+	}
+	for _, node := range c.NodesCreated {
+		lines = append(lines, "created "+node.String())
+	}
+	for _, node := range c.NodesRemoved {
+		lines = append(lines, "removed "+node.String())
+	}
+	for _, node := range c.NodesChanged {
+		lines = append(lines, "changed "+node.String())
+	}
+	for _, deployment := range c.DeploymentsCreated {
+		lines = append(lines, "created "+deployment.String())
+	}
+	for _, deployment := range c.DeploymentsRemoved {
+		lines = append(lines, "removed "+deployment.String())
+	}
+	for _, scenario := range c.ScenariosCreated {
+		lines = append(lines, "created "+scenario.String())
+	}
+	for _, scenario := range c.ScenariosRemoved {
+		lines = append(lines, "removed "+scenario.String())
+	}
+	for _, scenario := range c.ScenariosChanged {
+		lines = append(lines, "changed "+scenario.String())
+	}
+	for _, view := range c.ViewsCreated {
+		lines = append(lines, "created "+view.String())
+	}
+	for _, view := range c.ViewsRemoved {
+		lines = append(lines, "removed "+view.String())
+	}
+	for _, view := range c.ViewsChanged {
+		lines = append(lines, "changed "+view.String())
+	}
+	for _, pin := range c.PinsCreated {
+		lines = append(lines, "created "+pin.String())
+	}
+	for _, pin := range c.PinsRemoved {
+		lines = append(lines, "removed "+pin.String())
+	}
+	for _, pin := range c.PinsChanged {
+		lines = append(lines, "changed "+pin.String())
+	}
+	for _, style := range c.StylesCreated {
+		lines = append(lines, "created "+style.String())
+	}
+	for _, style := range c.StylesRemoved {
+		lines = append(lines, "removed "+style.String())
+	}
+	for _, style := range c.StylesChanged {
+		lines = append(lines, "changed "+style.String())
+	}
+	return strings.Join(append(lines, "endchanges$$"), "\n")
 }
 
-func (c *ItemFetchCommand) Undo(w world.World) error {
-	return nil
+// DataFlow is where data of a classification can go in the world.World.
+// The result of calling String() on a DataFlow is a grammar-compatible dataflow object, with a line for each Item: "source", "reached", or "external" where the data crosses into an External Item.
+type DataFlow struct {
+	world.Flow
 }
 
-// ItemListCommand represents a list command for Item.
-type ItemListCommand struct {
-	CommandBase
-	Limit int
+func (f DataFlow) String() string {
+	lines := []string{"$$dataflow", "class " + quoted(f.Class)}
+	for _, step := range f.Steps {
+		kind := "reached"
+		if step.Source {
+			kind = "source"
+		} else if step.Crossing() {
+			kind = "external"
+		}
+		line := fmt.Sprintf("%s %s", kind, quoted(step.Item.Id))
+		if len(step.Path) > 0 {
+			path := make(IdList, len(step.Path))
+			for i, rel := range step.Path {
+				path[i] = relId(rel)
+			}
+			line += " via " + path.String()
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(append(lines, "enddataflow$$"), "\n")
 }
 
-func (c *ItemListCommand) Execute(w world.World) (fmt.Stringer, error) {
-	items := w.ItemList(c.Limit)
-	return StringerList[world.Item](items), nil
+// CommandBase is a base struct for common command fields.
+type CommandBase struct {
+	InputAttributes grammar.InputAttributes
+	ResourceType    CommandTarget
+	Id              string
+	Flags           mapset.Set[CommandFlag]
 }
 
-func (c *ItemListCommand) Undo(w world.World) error {
-	return nil
+func (c *CommandBase) String() string {
+	return c.InputAttributes.Raw
 }
 
-// ItemSetCommand represents a set command for Item.
-type ItemSetCommand struct {
-	CommandBase
-	Params    world.ItemParams
-	oldParams world.ItemParams
-	noSet     bool
+func (c *CommandBase) input() grammar.InputAttributes {
+	return c.InputAttributes
 }
 
-func (c *ItemSetCommand) Execute(w world.World) (fmt.Stringer, error) {
-	item, ok := w.ItemFetch(c.Id)
-	if !ok {
-		c.noSet = true
-		return world.Item{}, errors.New("could not find Item").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
+// items returns the Items as the flags ask: their IDs for Ids, their ItemDetail for Verbose, or else the Items themselves.
+// The order of the Items is kept.
+func (c *CommandBase) items(w world.World, items []world.Item) fmt.Stringer {
+	if c.Flags.Contains(Ids) {
+		ids := make(IdList, len(items))
+		for i, item := range items {
+			ids[i] = item.Id
+		}
+		return ids
 	}
-	c.oldParams.External = boolPtr(item.External)
-	c.oldParams.Name = strPtr(item.Name)
-	c.oldParams.Type = strPtr(world.StringFromItemType(item.Type))
-	c.oldParams.Mechanism = strPtr(item.Mechanism)
-	c.oldParams.Expanded = strPtr(item.Expanded)
-	return w.ItemSet(c.Id, c.Params).Item()
-}
-
-func (c *ItemSetCommand) Undo(w world.World) error {
-	if c.noSet {
-		return nil
+	if c.Flags.Contains(Verbose) {
+		details := make(StringerList[ItemDetail], len(items))
+		for i, item := range items {
+			details[i] = itemDetail(w, item)
+		}
+		return details
 	}
-	return w.ItemSet(c.Id, c.oldParams).Err()
-}
-
-// ItemClearCommand represents a clear command for Item - a modified set command.
-type ItemClearCommand struct {
-	CommandBase
-	Params    world.ItemParams
-	oldParams world.ItemParams
-	noSet     bool
+	return StringerList[world.Item](items)
 }
 
-func (c *ItemClearCommand) Execute(w world.World) (fmt.Stringer, error) {
-	item, ok := w.ItemFetch(c.Id)
-	if !ok {
-		c.noSet = true
-		return world.Item{}, errors.New("could not find Item").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
+// rels returns the Rel as the flags ask: their IDs for Ids, or else the Rel themselves.
+// The order of the Rel is kept.
+func (c *CommandBase) rels(rels []world.Rel) fmt.Stringer {
+	if c.Flags.Contains(Ids) {
+		ids := make(IdList, len(rels))
+		for i, rel := range rels {
+			ids[i] = relId(rel)
+		}
+		return ids
 	}
-	c.oldParams.External = boolPtr(item.External)
-	c.oldParams.Name = strPtr(item.Name)
-	c.oldParams.Type = strPtr(world.StringFromItemType(item.Type))
-	c.oldParams.Mechanism = strPtr(item.Mechanism)
-	c.oldParams.Expanded = strPtr(item.Expanded)
-	return w.ItemSet(c.Id, c.Params).Item()
+	return StringerList[world.Rel](rels)
 }
 
-func (c *ItemClearCommand) Undo(w world.World) error {
-	if c.noSet {
-		return nil
+// nodes returns the DeploymentNode as the flags ask: their IDs for Ids, or else the DeploymentNode themselves.
+// The order of the DeploymentNode is kept.
+func (c *CommandBase) nodes(nodes []world.DeploymentNode) fmt.Stringer {
+	if c.Flags.Contains(Ids) {
+		ids := make(IdList, len(nodes))
+		for i, node := range nodes {
+			ids[i] = node.Id
+		}
+		return ids
 	}
-	return w.ItemSet(c.Id, c.oldParams).Err()
+	return StringerList[world.DeploymentNode](nodes)
 }
 
-// ItemDeleteCommand represents a delete command for Item.
-type ItemDeleteCommand struct {
-	CommandBase
-	oldParams world.ItemParams
-	noDelete  bool
-}
+// CommandList is a Command composed of other Command, executed in order.
+// The result of calling String() on a CommandList is a newline-separated list of grammar-compatible commands.
+type CommandList []Command
 
-func (c *ItemDeleteCommand) Execute(w world.World) (fmt.Stringer, error) {
-	item, ok := w.ItemFetch(c.Id)
-	if !ok {
-		c.noDelete = true
-		return world.Item{}, nil
+func (l CommandList) Execute(w world.World) (fmt.Stringer, error) {
+	var result fmt.Stringer = BoolStringer(true)
+	errs := make([]error, 0)
+	for _, c := range l {
+		r, err := c.Execute(w)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result = r
 	}
-	c.oldParams.External = boolPtr(item.External)
-	c.oldParams.Name = strPtr(item.Name)
-	c.oldParams.Type = strPtr(world.StringFromItemType(item.Type))
-	c.oldParams.Mechanism = strPtr(item.Mechanism)
-	c.oldParams.Expanded = strPtr(item.Expanded)
-	return world.Item{}, w.ItemDelete(c.Id).Err()
-}
-
-func (c *ItemDeleteCommand) Undo(w world.World) error {
-	if c.noDelete {
-		return nil
+	if len(errs) > 0 {
+		return result, errors.Join(errs...)
 	}
-	return w.ItemCreate(c.Id, c.oldParams).Err()
+	return result, nil
 }
 
-// ItemNestCommand represents a nest command for Item.
-type ItemNestCommand struct {
-	CommandBase
-	Ids          []string
-	ParentId     string
-	oldParentIds map[string]string
-	noNest       map[string]bool
+func (l CommandList) Undo(w world.World) error {
+	return undoWithDual(l, w)
 }
 
-func (c *ItemNestCommand) Execute(w world.World) (fmt.Stringer, error) {
-	oldParentIds := make(map[string]string)
-	noNest := make(map[string]bool)
-	errs := make([]error, 0)
-	for _, id := range c.Ids {
-		oldParentId, found := w.Parent(id)
-		if !found {
-			noNest[id] = true
-			errs = append(errs, errors.New("could not find Item").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: id}))
-			continue
+func (l CommandList) Dual() (Command, error) {
+	duals := make(CommandList, 0, len(l))
+	for i := len(l) - 1; i >= 0; i-- {
+		dual, err := l[i].Dual()
+		if err != nil {
+			return nil, err
 		}
-		oldParentIds[id] = oldParentId // Empty string if root.
-		if oldParentId == c.ParentId {
-			noNest[id] = true
-			continue
+		if dual != nil {
+			duals = append(duals, dual)
 		}
-		w.Nest(id, c.ParentId)
 	}
-	if len(errs) > 0 {
-		return BoolStringer(false), errors.Join(errs...)
+	if len(duals) == 0 {
+		return nil, nil
 	}
-	return BoolStringer(true), nil
+	return duals, nil
 }
 
-func (c *ItemNestCommand) Undo(w world.World) error {
-	for id, oldParentId := range c.oldParentIds {
-		if oldParentId == "" {
-			w.Free(id)
-			continue
+func (l CommandList) String() string {
+	return StringerList[Command](l).String()
+}
+
+func (l CommandList) usePersistence(p persistence.Persistence) {
+	for _, c := range l {
+		if pc, ok := c.(persistenceCommand); ok {
+			pc.usePersistence(p)
 		}
-		w.Nest(id, oldParentId)
 	}
-	return nil
 }
 
-// ItemFreeCommand represents a free command for Item.
-type ItemFreeCommand struct {
+// --- COMMAND IMPLEMENTATIONS ---
+
+// WorldFetchCommand represents a fetch command for the whole World.
+type WorldFetchCommand struct {
 	CommandBase
-	Ids          []string
-	oldParentIds map[string]string
 }
 
-func (c *ItemFreeCommand) Execute(w world.World) (fmt.Stringer, error) {
-	oldParentIds := make(map[string]string)
-	errs := make([]error, 0)
-
-	for _, id := range c.Ids {
-		oldParentId, found := w.Parent(id)
-		if !found {
-			errs = append(errs, errors.New("could not find Item").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: id}))
-			continue
-		}
-		oldParentIds[id] = oldParentId // Empty string if root.
-		w.Free(id)
-	}
-
-	if len(errs) > 0 {
-		return BoolStringer(false), errors.Join(errs...)
+func (c *WorldFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.Flags.Contains(Ids) {
+		return c.items(w, sortedItems(w.ItemList(0))), nil
 	}
-	return BoolStringer(true), nil
+	return w, nil
 }
 
-func (c *ItemFreeCommand) Undo(w world.World) error {
-	for id, oldParentId := range c.oldParentIds {
-		if oldParentId == "" {
-			continue
-		}
-		w.Nest(id, oldParentId)
-	}
+func (c *WorldFetchCommand) Undo(w world.World) error {
 	return nil
 }
 
-// ItemExistsCommand represents an exists command for Item.
-type ItemExistsCommand struct {
-	CommandBase
+func (c *WorldFetchCommand) Dual() (Command, error) {
+	return nil, nil
 }
 
-func (c *ItemExistsCommand) Execute(w world.World) (fmt.Stringer, error) {
-	_, ok := w.ItemFetch(c.Id)
-	return BoolStringer(ok), nil
+// WorldSetCommand represents a set command for the World info.
+type WorldSetCommand struct {
+	CommandBase
+	Params  world.InfoParams
+	oldInfo world.InfoParams
 }
 
-func (c *ItemExistsCommand) Undo(w world.World) error {
-	return nil
+func (c *WorldSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	version := w.Version()
+	c.oldInfo = world.InfoParams{Id: strPtr(w.Id()), Name: strPtr(w.Name()), Expanded: strPtr(w.Expanded()), Theme: strPtr(w.Theme()), Version: &version}
+	return world.InfoSet(w, c.Params), nil
 }
 
-// ItemCreateOrFetchCommand represents a create-or-fetch command for Item.
-type ItemCreateOrFetchCommand struct {
-	CommandBase
-	noCreate bool
+func (c *WorldSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
 }
 
-func (c *ItemCreateOrFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
-	item, ok := w.ItemFetch(c.Id)
-	if ok {
-		c.noCreate = true
-		return item, nil
+func (c *WorldSetCommand) Dual() (Command, error) {
+	if c.oldInfo.Id == nil {
+		return nil, nil
 	}
-	return w.ItemCreate(c.Id, world.ItemParams{}).Item()
-}
-
-func (c *ItemCreateOrFetchCommand) Undo(w world.World) error {
-	if c.noCreate {
-		return nil
+	params := make([]string, 0)
+	if c.Params.Name != nil {
+		params = append(params, fmt.Sprintf("name=%s", quoted(*c.oldInfo.Name)))
+	}
+	if c.Params.Id != nil {
+		params = append(params, fmt.Sprintf("id=%s", quoted(*c.oldInfo.Id)))
 	}
-	return w.ItemDelete(c.Id).Err()
+	if c.Params.Expanded != nil {
+		params = append(params, fmt.Sprintf("expanded=%s", quoted(*c.oldInfo.Expanded)))
+	}
+	if c.Params.Theme != nil {
+		params = append(params, fmt.Sprintf("theme=%s", *c.oldInfo.Theme))
+	}
+	if c.Params.Version != nil {
+		params = append(params, fmt.Sprintf("version=%d", *c.oldInfo.Version))
+	}
+	return commandFromLines("world set " + strings.Join(params, " "))
 }
 
-// ItemCreateOrSetCommand represents a create-or-set command for Item.
-type ItemCreateOrSetCommand struct {
+// WorldSaveCommand represents a save command for the World.
+// Saving writes outside the World, so it is not reverted by Undo.
+type WorldSaveCommand struct {
 	CommandBase
-	Params    world.ItemParams
-	oldParams world.ItemParams
-	noCreate  bool
+	persistence persistence.Persistence
 }
 
-func (c *ItemCreateOrSetCommand) Execute(w world.World) (fmt.Stringer, error) {
-	if item, ok := w.ItemFetch(c.Id); ok {
-		c.noCreate = true
-		c.oldParams.External = boolPtr(item.External)
-		c.oldParams.Name = strPtr(item.Name)
-		c.oldParams.Type = strPtr(world.StringFromItemType(item.Type))
-		c.oldParams.Mechanism = strPtr(item.Mechanism)
-		c.oldParams.Expanded = strPtr(item.Expanded)
-		return w.ItemSet(c.Id, c.Params).Item()
+func (c *WorldSaveCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.persistence == nil {
+		return IdList{}, errors.New("no persistence available").UseCode(errors.TopolithErrorInternal)
 	}
-	return w.ItemCreate(c.Id, c.Params).Item()
-}
-
-func (c *ItemCreateOrSetCommand) Undo(w world.World) error {
-	if c.noCreate {
-		return w.ItemSet(c.Id, c.oldParams).Err()
+	nameOrPath := c.Id
+	if nameOrPath == "" {
+		nameOrPath = w.Name()
+	}
+	path, err := c.persistence.SaveAs(w, nameOrPath)
+	if err != nil {
+		return IdList{}, errors.New("could not save World").UseCode(errors.TopolithErrorInternal).WithError(err).WithData(errors.KvPair{Key: "path", Value: nameOrPath})
 	}
-	return w.ItemDelete(c.Id).Err()
+	return IdList{path}, nil
 }
 
-// ItemComponentsListCommand represents a list command for the components of an Item.
-type ItemComponentsListCommand struct {
-	CommandBase
+func (c *WorldSaveCommand) Undo(w world.World) error {
+	return nil
 }
 
-func (c *ItemComponentsListCommand) Execute(w world.World) (fmt.Stringer, error) {
-	items, ok := w.ItemComponents(c.Id)
-	if !ok {
-		return StringerList[world.Item](items), errors.New("could not find Item").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
-	}
-	return StringerList[world.Item](items), nil
+func (c *WorldSaveCommand) Dual() (Command, error) {
+	return nil, nil
 }
 
-func (c *ItemComponentsListCommand) Undo(w world.World) error {
-	return nil
+func (c *WorldSaveCommand) usePersistence(p persistence.Persistence) {
+	c.persistence = p
 }
 
-// ItemInQueryCommand represents an in-query command for Item.
-type ItemInQueryCommand struct {
+// WorldThreatsExportCommand represents an export of the STRIDE threat model of the World, as Markdown or JSON by the extension of the Path.
+type WorldThreatsExportCommand struct {
 	CommandBase
-	ParentId string
+	Path string // Path is the file to write (ex: `docs/threats.md`, `threats.json`).
 }
 
-func (c *ItemInQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
-	strict := c.Flags.Contains(Strict)
-	isInThere := w.In(c.Id, c.ParentId, strict)
-	return BoolStringer(isInThere), nil
+func (c *WorldThreatsExportCommand) Execute(w world.World) (fmt.Stringer, error) {
+	model := threat.Build(w)
+	var b []byte
+	switch strings.ToLower(filepath.Ext(c.Path)) {
+	case ".md", ".markdown":
+		b = model.Markdown()
+	case ".json":
+		var err error
+		if b, err = model.JSON(); err != nil {
+			return IdList{}, errors.New("could not encode threat model").UseCode(errors.TopolithErrorInternal).WithError(err)
+		}
+	default:
+		return IdList{}, errors.New("unknown export format").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "path", Value: c.Path})
+	}
+	if err := os.WriteFile(c.Path, b, 0644); err != nil {
+		return IdList{}, errors.New("could not export threat model").UseCode(errors.TopolithErrorInternal).WithError(err).WithData(errors.KvPair{Key: "path", Value: c.Path})
+	}
+	return IdList{c.Path}, nil
 }
 
-func (c *ItemInQueryCommand) Undo(w world.World) error {
+func (c *WorldThreatsExportCommand) Undo(w world.World) error {
 	return nil
 }
 
-/* Rel Commands */
+func (c *WorldThreatsExportCommand) Dual() (Command, error) {
+	return nil, nil
+}
 
-// RelCreateCommand represents a create command for Rel.
-type RelCreateCommand struct {
+// WorldLoadCommand represents a load command for the World, replacing the current World.
+type WorldLoadCommand struct {
 	CommandBase
-	ToId     string
-	Params   world.RelParams
-	noCreate bool
+	persistence persistence.Persistence
+	oldLines    []string // oldLines are the grammar commands that rebuild the World as it was before loading.
 }
 
-func (c *RelCreateCommand) Execute(w world.World) (fmt.Stringer, error) {
-	if rels := w.RelFetch(c.Id, c.ToId, true); len(rels) > 0 {
-		c.noCreate = true
-		return world.Rel{}, nil
+func (c *WorldLoadCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.persistence == nil {
+		return w, errors.New("no persistence available").UseCode(errors.TopolithErrorInternal)
 	}
-	return w.RelCreate(c.Id, c.ToId, c.Params).Rel()
+	loaded, err := c.persistence.Load(c.Id)
+	if err != nil {
+		return w, errors.New("could not load World").UseCode(errors.TopolithErrorNotFound).WithError(err).WithData(errors.KvPair{Key: "name", Value: c.Id})
+	}
+	c.oldLines = worldRebuildLines(w)
+	return w, world.ReplaceWorld(w, loaded)
+}
+
+func (c *WorldLoadCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *WorldLoadCommand) Dual() (Command, error) {
+	return commandFromLines(c.oldLines...)
+}
+
+func (c *WorldLoadCommand) usePersistence(p persistence.Persistence) {
+	c.persistence = p
+}
+
+// WorldNewCommand represents a new command for the World, replacing the current World with an empty one.
+type WorldNewCommand struct {
+	CommandBase
+	oldLines []string // oldLines are the grammar commands that rebuild the World as it was before replacement.
+}
+
+func (c *WorldNewCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.Id == "" {
+		return w, errors.New("World name cannot be empty").UseCode(errors.TopolithErrorInvalid)
+	}
+	c.oldLines = worldRebuildLines(w)
+	return w, world.ReplaceWorld(w, world.CreateWorld(c.Id))
+}
+
+func (c *WorldNewCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *WorldNewCommand) Dual() (Command, error) {
+	return commandFromLines(c.oldLines...)
+}
+
+// WorldListCommand represents a list command for persisted Worlds.
+type WorldListCommand struct {
+	CommandBase
+	Limit       int
+	persistence persistence.Persistence
+}
+
+func (c *WorldListCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.persistence == nil {
+		return IdList{}, errors.New("no persistence available").UseCode(errors.TopolithErrorInternal)
+	}
+	names, err := c.persistence.ListWorlds()
+	if err != nil {
+		return IdList{}, errors.New("could not list Worlds").UseCode(errors.TopolithErrorInternal).WithError(err)
+	}
+	slices.Sort(names)
+	if c.Limit > 0 && len(names) > c.Limit {
+		names = names[:c.Limit]
+	}
+	return IdList(names), nil
+}
+
+func (c *WorldListCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *WorldListCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+func (c *WorldListCommand) usePersistence(p persistence.Persistence) {
+	c.persistence = p
+}
+
+// WorldUseCommand represents a use command, switching the current World in the App.
+type WorldUseCommand struct {
+	CommandBase
+	app *app
+}
+
+func (c *WorldUseCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.app == nil {
+		return w, errors.New("no App available").UseCode(errors.TopolithErrorInternal)
+	}
+	if err := c.app.use(c.Id); err != nil {
+		return w, err
+	}
+	return c.app.World(), nil
+}
+
+func (c *WorldUseCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *WorldUseCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+func (c *WorldUseCommand) useApp(h *app) {
+	c.app = h
+}
+
+// WorldUndoCommand represents an undo command, reverting the last Command in the history of the current World.
+// It acts on the history, so it isn't part of it.
+type WorldUndoCommand struct {
+	CommandBase
+	app *app
+}
+
+func (c *WorldUndoCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.app == nil {
+		return w, errors.New("no App available").UseCode(errors.TopolithErrorInternal)
+	}
+	if !c.app.CanUndo() {
+		return w, errors.New("nothing to undo").UseCode(errors.TopolithErrorInvalid)
+	}
+	if err := c.app.undo(); err != nil {
+		return c.app.World(), err
+	}
+	return c.app.World(), nil
+}
+
+func (c *WorldUndoCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *WorldUndoCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+func (c *WorldUndoCommand) useApp(h *app) {
+	c.app = h
+}
+
+// WorldRedoCommand represents a redo command, executing again the last Command that was undone in the current World.
+// It acts on the history, so it isn't part of it.
+type WorldRedoCommand struct {
+	CommandBase
+	app *app
+}
+
+func (c *WorldRedoCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.app == nil {
+		return w, errors.New("no App available").UseCode(errors.TopolithErrorInternal)
+	}
+	if !c.app.CanRedo() {
+		return w, errors.New("nothing to redo").UseCode(errors.TopolithErrorInvalid)
+	}
+	if err := c.app.redo(); err != nil {
+		return c.app.World(), err
+	}
+	return c.app.World(), nil
+}
+
+func (c *WorldRedoCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *WorldRedoCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+func (c *WorldRedoCommand) useApp(h *app) {
+	c.app = h
+}
+
+// WorldOpenCommand represents an open command, adding a World to the App and switching to it.
+// A stored World is loaded if one exists for the name, otherwise a new World is created with that name.
+type WorldOpenCommand struct {
+	CommandBase
+	app         *app
+	persistence persistence.Persistence
+}
+
+func (c *WorldOpenCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.app == nil || c.persistence == nil {
+		return w, errors.New("no App available").UseCode(errors.TopolithErrorInternal)
+	}
+	if _, ok := c.app.sessions[c.Id]; ok {
+		// Already open - just switch to it.
+		return w, c.app.use(c.Id)
+	}
+	opened, err := c.persistence.Load(c.Id)
+	switch {
+	case err == nil && opened.Name() != c.Id && c.app.sessions[opened.Name()] != nil:
+		return w, errors.New("World is already open").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "name", Value: opened.Name()})
+	case err == nil:
+		c.app.open(opened.Name(), opened)
+	case os.IsNotExist(err) && filepath.Ext(c.Id) != ".world":
+		opened = world.CreateWorld(c.Id)
+		c.app.open(c.Id, opened)
+	default:
+		return w, errors.New("could not open World").UseCode(errors.TopolithErrorNotFound).WithError(err).WithData(errors.KvPair{Key: "name", Value: c.Id})
+	}
+	return opened, nil
+}
+
+func (c *WorldOpenCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *WorldOpenCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+func (c *WorldOpenCommand) useApp(h *app) {
+	c.app = h
+}
+
+func (c *WorldOpenCommand) usePersistence(p persistence.Persistence) {
+	c.persistence = p
+}
+
+// WorldCloseCommand represents a close command, removing a World from the App.
+// Without a name, it closes the current World. The last open World cannot be closed.
+type WorldCloseCommand struct {
+	CommandBase
+	app *app
+}
+
+func (c *WorldCloseCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.app == nil {
+		return w, errors.New("no App available").UseCode(errors.TopolithErrorInternal)
+	}
+	name := c.Id
+	if name == "" {
+		name = c.app.current
+	}
+	if err := c.app.close(name); err != nil {
+		return w, err
+	}
+	return c.app.World(), nil
+}
+
+func (c *WorldCloseCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *WorldCloseCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+func (c *WorldCloseCommand) useApp(h *app) {
+	c.app = h
+}
+
+/* Item Commands */
+
+// ItemCreateCommand represents a create command.
+type ItemCreateCommand struct {
+	CommandBase
+	Params   world.ItemParams
+	noCreate bool
+}
+
+func (c *ItemCreateCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if item, ok := w.ItemFetch(c.Id); ok {
+		c.noCreate = true
+		return item, nil
+	}
+	return createItem(w, c.Id, c.Params)
+}
+
+func (c *ItemCreateCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemCreateCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return nil, nil
+	}
+	return commandFromLines(fmt.Sprintf("item delete %s", quoted(c.Id)))
+}
+
+// ItemFetchCommand represents a fetch command for Item.
+type ItemFetchCommand struct {
+	CommandBase
+}
+
+func (c *ItemFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
+	item, ok := w.ItemFetch(c.Id)
+	if !ok {
+		return world.Item{}, itemNotFound(w, c.Id)
+	}
+	return c.items(w, []world.Item{item}), nil
+}
+
+func (c *ItemFetchCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemFetchCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemListCommand represents a list command for Item.
+// It sorts the Items, then applies the Limit, so the same Items come back each time.
+// With an Owner, it only lists the Items with that effective owner, applying the Limit after filtering.
+type ItemListCommand struct {
+	CommandBase
+	Limit int
+	Owner string // Owner is the team that owns the listed Items, directly or through an ancestor. Empty lists every Item.
+}
+
+func (c *ItemListCommand) Execute(w world.World) (fmt.Stringer, error) {
+	items := w.ItemList(0)
+	if c.Flags.Contains(Archived) {
+		items = w.ItemListArchived(0)
+	}
+	items = sortedItems(items)
+	if c.Owner != "" {
+		items = slices.DeleteFunc(items, func(item world.Item) bool { return world.EffectiveOwner(w, item.Id) != c.Owner })
+	}
+	if c.Limit > 0 && len(items) > c.Limit {
+		items = items[:c.Limit]
+	}
+	return c.items(w, items), nil
+}
+
+func (c *ItemListCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemListCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemSetCommand represents a set command for Item.
+type ItemSetCommand struct {
+	CommandBase
+	Params  world.ItemParams
+	oldItem world.Item
+	noSet   bool
+}
+
+func (c *ItemSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	item, ok := w.ItemFetch(c.Id)
+	if !ok {
+		c.noSet = true
+		return world.Item{}, itemNotFound(w, c.Id)
+	}
+	c.oldItem = item
+	return w.ItemSet(c.Id, c.Params).Item()
+}
+
+func (c *ItemSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemSetCommand) Dual() (Command, error) {
+	if c.noSet || c.oldItem.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(itemRestoreLines(c.oldItem, itemParamKeys(c.Params))...)
+}
+
+// ItemClearCommand represents a clear command for Item - a modified set command.
+type ItemClearCommand struct {
+	CommandBase
+	Params  world.ItemParams
+	oldItem world.Item
+	noSet   bool
+}
+
+func (c *ItemClearCommand) Execute(w world.World) (fmt.Stringer, error) {
+	item, ok := w.ItemFetch(c.Id)
+	if !ok {
+		c.noSet = true
+		return world.Item{}, itemNotFound(w, c.Id)
+	}
+	c.oldItem = item
+	return w.ItemSet(c.Id, c.Params).Item()
+}
+
+func (c *ItemClearCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemClearCommand) Dual() (Command, error) {
+	if c.noSet || c.oldItem.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(itemRestoreLines(c.oldItem, itemParamKeys(c.Params))...)
+}
+
+// ItemDeleteCommand represents a delete command for Item.
+// By default, the components of the Item are hoisted to its parent. With the Cascade flag, the whole subtree is deleted.
+type ItemDeleteCommand struct {
+	CommandBase
+	oldItem         world.Item         // oldItem is the Item as it was before deletion.
+	oldParentId     string             // oldParentId is the ID of the parent Item before deletion. Empty string if root.
+	oldComponentIds []string           // oldComponentIds are the IDs of the components that were hoisted to the parent, or deleted with the Cascade flag.
+	oldDescendants  []world.Item       // oldDescendants are the Items under the Item that were deleted with the Cascade flag, depth-first.
+	oldParentIds    map[string]string  // oldParentIds are the IDs of the parents of oldDescendants.
+	oldRels         []world.Rel        // oldRels are the Rel to or from the deleted Items.
+	oldDeployments  []world.Deployment // oldDeployments are where the deleted Items were deployed.
+	oldSteps        []string           // oldSteps are the step commands for the Scenario steps that were dropped with the Items.
+	oldPins         []string           // oldPins are the pin commands for the Pin that were dropped with the Items.
+	noDelete        bool
+}
+
+func (c *ItemDeleteCommand) Execute(w world.World) (fmt.Stringer, error) {
+	item, ok := w.ItemFetch(c.Id)
+	if !ok {
+		c.noDelete = true
+		return world.Item{}, nil
+	}
+	c.oldItem = item
+	c.oldParentId, _ = w.Parent(c.Id)
+	c.oldComponentIds, _ = w.Components(c.Id)
+	c.oldDescendants = make([]world.Item, 0)
+	c.oldParentIds = make(map[string]string)
+	deleted := []string{c.Id}
+	if c.Flags.Contains(Cascade) {
+		for _, id := range descendantIds(w, c.Id) {
+			descendant, _ := w.ItemFetch(id)
+			c.oldDescendants = append(c.oldDescendants, descendant)
+			c.oldParentIds[id], _ = w.Parent(id)
+			deleted = append(deleted, id)
+		}
+	}
+	c.oldRels = make([]world.Rel, 0)
+	for _, rel := range sortedRels(world.AllRels(w)) {
+		if slices.Contains(deleted, rel.From.Id) || slices.Contains(deleted, rel.To.Id) {
+			c.oldRels = append(c.oldRels, rel)
+		}
+	}
+	c.oldDeployments = make([]world.Deployment, 0)
+	for _, deployment := range world.AllDeployments(w) {
+		if slices.Contains(deleted, deployment.ItemId) {
+			c.oldDeployments = append(c.oldDeployments, deployment)
+		}
+	}
+	scenarios, pins := w.ScenarioList(0), w.PinList(0)
+	// Delete the deepest Items first, so nothing is hoisted on the way.
+	for i := len(deleted) - 1; i >= 0; i-- {
+		if err := w.ItemDelete(deleted[i]).Err(); err != nil {
+			return world.Item{}, err
+		}
+	}
+	c.oldSteps = droppedStepLines(scenarios, w)
+	c.oldPins = droppedPinLines(pins, w)
+	return world.Item{}, nil
+}
+
+func (c *ItemDeleteCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemDeleteCommand) Dual() (Command, error) {
+	if c.noDelete || c.oldItem.Id == "" {
+		return nil, nil
+	}
+	lines := []string{itemCreateLine(c.oldItem)}
+	for _, item := range c.oldDescendants {
+		lines = append(lines, itemCreateLine(item))
+	}
+	// The Item is created under its parent path if that exists, so we restore its parent even at the Tree root.
+	lines = append(lines, treeRestoreLines(map[string]string{c.Id: c.oldParentId})...)
+	oldParentIds := make(map[string]string)
+	maps.Copy(oldParentIds, c.oldParentIds)
+	for _, id := range c.oldComponentIds {
+		oldParentIds[id] = c.Id
+	}
+	lines = append(lines, treeRestoreLines(oldParentIds)...)
+	for _, rel := range c.oldRels {
+		lines = append(lines, relCreateLine(rel))
+	}
+	for _, deployment := range c.oldDeployments {
+		lines = append(lines, deployment.String())
+	}
+	return commandFromLines(append(append(lines, c.oldSteps...), c.oldPins...)...)
+}
+
+// ItemNestCommand represents a nest command for Item.
+type ItemNestCommand struct {
+	CommandBase
+	Ids          []string
+	Selectors    []grammar.Selector // Selectors match more Items to nest, in addition to Ids.
+	ParentId     string
+	oldParentIds map[string]string
+	noNest       map[string]bool
+}
+
+func (c *ItemNestCommand) Execute(w world.World) (fmt.Stringer, error) {
+	ids, err := targetIds(w, c.Ids, c.Selectors)
+	if err != nil {
+		return BoolStringer(false), err
+	}
+	if len(c.Selectors) > 0 {
+		// A Selector may match the parent, which can't nest in itself.
+		ids = slices.DeleteFunc(ids, func(id string) bool { return id == c.ParentId })
+	}
+	c.oldParentIds = make(map[string]string)
+	c.noNest = make(map[string]bool)
+	errs := make([]error, 0)
+	for _, id := range ids {
+		oldParentId, found := w.Parent(id)
+		if !found {
+			c.noNest[id] = true
+			errs = append(errs, itemNotFound(w, id))
+			continue
+		}
+		if oldParentId == c.ParentId {
+			c.noNest[id] = true
+			continue
+		}
+		if err := w.Nest(id, c.ParentId).Err(); err != nil {
+			c.noNest[id] = true
+			errs = append(errs, err)
+			continue
+		}
+		c.oldParentIds[id] = oldParentId // Empty string if root.
+	}
+	if len(errs) > 0 {
+		return BoolStringer(false), errors.Join(errs...)
+	}
+	return BoolStringer(true), nil
+}
+
+func (c *ItemNestCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemNestCommand) Dual() (Command, error) {
+	return commandFromLines(treeRestoreLines(c.oldParentIds)...)
+}
+
+// ItemFreeCommand represents a free command for Item.
+type ItemFreeCommand struct {
+	CommandBase
+	Ids          []string
+	Selectors    []grammar.Selector // Selectors match more Items to free, in addition to Ids.
+	oldParentIds map[string]string
+}
+
+func (c *ItemFreeCommand) Execute(w world.World) (fmt.Stringer, error) {
+	ids, err := targetIds(w, c.Ids, c.Selectors)
+	if err != nil {
+		return BoolStringer(false), err
+	}
+	c.oldParentIds = make(map[string]string)
+	errs := make([]error, 0)
+
+	for _, id := range ids {
+		oldParentId, found := w.Parent(id)
+		if !found {
+			errs = append(errs, itemNotFound(w, id))
+			continue
+		}
+		if oldParentId == "" {
+			// Already at the root.
+			continue
+		}
+		if err := w.Free(id).Err(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.oldParentIds[id] = oldParentId
+	}
+
+	if len(errs) > 0 {
+		return BoolStringer(false), errors.Join(errs...)
+	}
+	return BoolStringer(true), nil
+}
+
+func (c *ItemFreeCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemFreeCommand) Dual() (Command, error) {
+	return commandFromLines(treeRestoreLines(c.oldParentIds)...)
+}
+
+// ItemBulkCommand represents a set, clear, or delete command for every Item matched by a Selector.
+// It executes the command on each matched Item, and reverts them all as a single Command.
+type ItemBulkCommand struct {
+	CommandBase
+	Selector grammar.Selector
+	commands CommandList
+}
+
+func (c *ItemBulkCommand) Execute(w world.World) (fmt.Stringer, error) {
+	ids, err := selectIds(w, []grammar.Selector{c.Selector})
+	if err != nil {
+		return IdList{}, err
+	}
+	c.commands = make(CommandList, 0, len(ids))
+	errs := make([]error, 0)
+	for _, id := range ids {
+		input := c.InputAttributes
+		input.ResourceId = id
+		input.Selectors = make([]grammar.Selector, 0)
+		input.Raw = strings.Replace(input.Raw, c.Selector.Text, quoted(id), 1)
+		command, err := InputToCommand(input)
+		if err != nil {
+			return IdList{}, err
+		}
+		c.commands = append(c.commands, command)
+		if _, err := command.Execute(w); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return IdList(ids), errors.Join(errs...)
+	}
+	return IdList(ids), nil
+}
+
+func (c *ItemBulkCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemBulkCommand) Dual() (Command, error) {
+	return c.commands.Dual()
+}
+
+// ItemCopyCommand represents a copy command for an Item and all its components, from the current World into another open World.
+// Rel are copied when both ends exist in the target World after copying.
+// The copy is part of the history of the target World, so Execute and Undo take the target World.
+type ItemCopyCommand struct {
+	CommandBase
+	WorldName string
+	source    world.World
+	copiedIds []string
+}
+
+func (c *ItemCopyCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.copiedIds = nil
+	if c.source == nil {
+		return world.Item{}, errors.New("no source World available").UseCode(errors.TopolithErrorInternal)
+	}
+	if _, ok := c.source.ItemFetch(c.Id); !ok {
+		return world.Item{}, itemNotFound(c.source, c.Id)
+	}
+
+	ids := []string{c.Id}
+	for i := 0; i < len(ids); i++ {
+		components, _ := c.source.Components(ids[i])
+		slices.Sort(components)
+		ids = append(ids, components...)
+	}
+	copied := make(map[string]bool)
+	conflicts := make([]errors.KvPair, 0)
+	for _, id := range ids {
+		copied[id] = true
+		if _, ok := w.ItemFetch(id); ok {
+			conflicts = append(conflicts, errors.KvPair{Key: "id", Value: id})
+		}
+	}
+	if len(conflicts) > 0 {
+		return world.Item{}, errors.New("Item already exists in target World").UseCode(errors.TopolithErrorConflict).WithData(conflicts...)
+	}
+
+	lines := make([]string, 0)
+	oldParentIds := make(map[string]string)
+	for _, id := range ids {
+		item, _ := c.source.ItemFetch(id)
+		lines = append(lines, itemCreateLine(item))
+		parentId, _ := c.source.Parent(id)
+		if _, ok := w.ItemFetch(parentId); copied[parentId] || ok {
+			oldParentIds[id] = parentId
+		}
+	}
+	lines = append(lines, treeRestoreLines(oldParentIds)...)
+	for _, rel := range world.AllRels(c.source) {
+		if !copied[rel.From.Id] && !copied[rel.To.Id] {
+			continue
+		}
+		_, fromOk := w.ItemFetch(rel.From.Id)
+		_, toOk := w.ItemFetch(rel.To.Id)
+		if (copied[rel.From.Id] || fromOk) && (copied[rel.To.Id] || toOk) {
+			lines = append(lines, relCreateLine(rel))
+		}
+	}
+
+	copyCommand, err := commandFromLines(lines...)
+	if err != nil {
+		return world.Item{}, err
+	}
+	c.copiedIds = ids
+	if _, err := copyCommand.Execute(w); err != nil {
+		return world.Item{}, err
+	}
+	item, _ := w.ItemFetch(c.Id)
+	return item, nil
+}
+
+func (c *ItemCopyCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemCopyCommand) Dual() (Command, error) {
+	lines := make([]string, len(c.copiedIds))
+	for i, id := range c.copiedIds {
+		lines[i] = fmt.Sprintf("item delete %s", quoted(id))
+	}
+	return commandFromLines(lines...)
+}
+
+func (c *ItemCopyCommand) targetWorld() string {
+	return c.WorldName
+}
+
+func (c *ItemCopyCommand) useSource(w world.World) {
+	c.source = w
+}
+
+// ItemCloneCommand represents a clone command for an Item and all its components, as new Items in the same World.
+// Each clone has the local ID of its original with the Prefix, so `api` clones to `eu-api`, and `payments.db` clones to `payments.eu-db`.
+// The clones of components take the ID of their cloned parent as their path, so `payments.db.replica` clones to `payments.eu-db.eu-replica`.
+// Rel among the cloned Items are cloned too. With the AllRels flag, so are Rel to and from Items outside the subtree.
+type ItemCloneCommand struct {
+	CommandBase
+	Prefix    string
+	clonedIds []string
+}
+
+func (c *ItemCloneCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.clonedIds = nil
+	if _, ok := w.ItemFetch(c.Id); !ok {
+		return world.Item{}, itemNotFound(w, c.Id)
+	}
+
+	ids := append([]string{c.Id}, descendantIds(w, c.Id)...)
+	cloneIds := make(map[string]string)
+	conflicts := make([]errors.KvPair, 0)
+	for _, id := range ids {
+		// The clone of the Item keeps its parent path, and the clones of its components take the ID of their new parent.
+		// The components come after their parents, so those clone IDs are known.
+		parentPath, localId := world.SplitPath(id)
+		if id != c.Id {
+			parentId, _ := w.Parent(id)
+			parentPath = cloneIds[parentId]
+		}
+		cloneIds[id] = world.JoinPath(parentPath, c.Prefix+localId)
+		if _, ok := w.ItemFetch(cloneIds[id]); ok {
+			conflicts = append(conflicts, errors.KvPair{Key: "id", Value: cloneIds[id]})
+		}
+	}
+	if len(conflicts) > 0 {
+		return world.Item{}, errors.New("Item already exists").UseCode(errors.TopolithErrorConflict).WithData(conflicts...)
+	}
+
+	lines := make([]string, 0)
+	oldParentIds := make(map[string]string)
+	for _, id := range ids {
+		item, _ := w.ItemFetch(id)
+		item.Id = cloneIds[id]
+		lines = append(lines, itemCreateLine(item))
+		// The clone of the Item sits beside it, and the clones of its components under it.
+		parentId, _ := w.Parent(id)
+		if cloneId, ok := cloneIds[parentId]; ok && id != c.Id {
+			parentId = cloneId
+		}
+		oldParentIds[item.Id] = parentId
+	}
+	lines = append(lines, treeRestoreLines(oldParentIds)...)
+	for _, rel := range sortedRels(world.AllRels(w)) {
+		fromId, fromOk := cloneIds[rel.From.Id]
+		toId, toOk := cloneIds[rel.To.Id]
+		if !(fromOk && toOk) && !((fromOk || toOk) && c.Flags.Contains(AllRels)) {
+			continue
+		}
+		if fromOk {
+			rel.From.Id = fromId
+		}
+		if toOk {
+			rel.To.Id = toId
+		}
+		lines = append(lines, relCreateLine(rel))
+	}
+
+	cloneCommand, err := commandFromLines(lines...)
+	if err != nil {
+		return world.Item{}, err
+	}
+	for _, id := range ids {
+		c.clonedIds = append(c.clonedIds, cloneIds[id])
+	}
+	if _, err := cloneCommand.Execute(w); err != nil {
+		return world.Item{}, err
+	}
+	item, _ := w.ItemFetch(cloneIds[c.Id])
+	return c.items(w, []world.Item{item}), nil
+}
+
+func (c *ItemCloneCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemCloneCommand) Dual() (Command, error) {
+	lines := make([]string, len(c.clonedIds))
+	for i, id := range c.clonedIds {
+		lines[i] = fmt.Sprintf("item delete %s", quoted(id))
+	}
+	return commandFromLines(lines...)
+}
+
+// ItemArchiveCommand represents an archive or restore command for Item.
+// An archived Item, its components, and their Rel are hidden from lists and queries, but stay in the World.
+type ItemArchiveCommand struct {
+	CommandBase
+	Archived bool // Archived is true to archive the Item, and false to restore it.
+	oldItem  world.Item
+}
+
+func (c *ItemArchiveCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldItem = world.Item{}
+	item, ok := w.ItemFetch(c.Id)
+	if !ok {
+		return world.Item{}, itemNotFound(w, c.Id)
+	}
+	c.oldItem = item
+	if !c.Archived {
+		// Restoring the Item alone wouldn't show it while an ancestor hides it.
+		for parent, _ := w.Parent(c.Id); parent != ""; parent, _ = w.Parent(parent) {
+			if ancestor, _ := w.ItemFetch(parent); ancestor.Archived {
+				c.oldItem = world.Item{}
+				return world.Item{}, errors.New("an archived ancestor hides the Item").
+					UseCode(errors.TopolithErrorConflict).
+					WithData(errors.KvPair{Key: "id", Value: c.Id}, errors.KvPair{Key: "ancestor", Value: parent})
+			}
+		}
+	}
+	return w.ItemSet(c.Id, world.ItemParams{Archived: boolPtr(c.Archived)}).Item()
+}
+
+func (c *ItemArchiveCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemArchiveCommand) Dual() (Command, error) {
+	if c.oldItem.Id == "" || c.oldItem.Archived == c.Archived {
+		return nil, nil
+	}
+	// A set, unlike a restore, reverts the archive even while an ancestor is archived too.
+	return commandFromLines(fmt.Sprintf("item set %s archived=%t", quoted(c.Id), !c.Archived))
+}
+
+// ItemLinkCommand represents a link or unlink command for Item.
+// Linking adds to the world.Link of the Item, and errors if one is already there. Unlinking removes them, and errors if one isn't there.
+type ItemLinkCommand struct {
+	CommandBase
+	Links   []world.Link
+	Unlink  bool // Unlink is true to remove the Links, and false to add them.
+	oldItem world.Item
+}
+
+func (c *ItemLinkCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldItem = world.Item{}
+	item, ok := w.ItemFetch(c.Id)
+	if !ok {
+		return world.Item{}, itemNotFound(w, c.Id)
+	}
+	links, err := changeLinks(item.Links, c.Links, c.Unlink)
+	if err != nil {
+		return world.Item{}, err
+	}
+	c.oldItem = item
+	return w.ItemSet(c.Id, world.ItemParams{Links: &links}).Item()
+}
+
+func (c *ItemLinkCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemLinkCommand) Dual() (Command, error) {
+	if c.oldItem.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(itemRestoreLines(c.oldItem, []string{"links"})...)
+}
+
+// ItemMergeCommand represents a merge command, which folds an Item into another.
+// The Rel of the Item are re-pointed to the other Item, its components are nested under the other Item, and then it is deleted.
+// A re-pointed Rel is dropped if it would connect the other Item to itself, or if the other Item already has the same Rel.
+// The merge is atomic: if any step fails, the steps before it are reverted.
+type ItemMergeCommand struct {
+	CommandBase
+	IntoId   string      // IntoId is the ID of the Item that remains.
+	executed CommandList // executed are the steps of the merge that were executed.
+}
+
+func (c *ItemMergeCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.executed = nil
+	if c.Id == c.IntoId {
+		return world.Item{}, errors.New("cannot merge an Item into itself").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "id", Value: c.Id})
+	}
+	if _, ok := w.ItemFetch(c.Id); !ok {
+		return world.Item{}, itemNotFound(w, c.Id)
+	}
+	if _, ok := w.ItemFetch(c.IntoId); !ok {
+		return world.Item{}, itemNotFound(w, c.IntoId)
+	}
+	if slices.Contains(descendantIds(w, c.Id), c.IntoId) {
+		return world.Item{}, errors.New("cannot merge an Item into one of its components").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "id", Value: c.Id}, errors.KvPair{Key: "into", Value: c.IntoId})
+	}
+
+	lines := make([]string, 0)
+	for _, rel := range sortedRels(append(w.RelFrom(c.Id, true), w.RelTo(c.Id, true)...)) {
+		if rel.From.Id == c.Id {
+			rel.From.Id = c.IntoId
+		}
+		if rel.To.Id == c.Id {
+			rel.To.Id = c.IntoId
+		}
+		if rel.From.Id == rel.To.Id || len(w.RelFetch(rel.From.Id, rel.To.Id, true)) > 0 {
+			continue
+		}
+		lines = append(lines, relCreateLine(rel))
+	}
+	components, _ := w.Components(c.Id)
+	oldParentIds := make(map[string]string)
+	for _, id := range components {
+		oldParentIds[id] = c.IntoId
+	}
+	lines = append(lines, treeRestoreLines(oldParentIds)...)
+	for _, node := range world.Deployed(w, c.Id, "") {
+		if !slices.Contains(node.Items, c.IntoId) {
+			lines = append(lines, world.Deployment{ItemId: c.IntoId, NodeId: node.Id}.String())
+		}
+	}
+	lines = append(lines, fmt.Sprintf("item delete %s", quoted(c.Id)))
+
+	executed, err := executeLines(w, lines...)
+	if err != nil {
+		return world.Item{}, err
+	}
+	c.executed = executed
+	item, _ := w.ItemFetch(c.IntoId)
+	return c.items(w, []world.Item{item}), nil
+}
+
+func (c *ItemMergeCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemMergeCommand) Dual() (Command, error) {
+	if len(c.executed) == 0 {
+		return nil, nil
+	}
+	return c.executed.Dual()
+}
+
+// ItemSplitCommand represents a split command, which divides an Item into the Targets.
+// Each new Target is created beside the Item, with its attributes except the name.
+// The components of the Item, and the Rel to and from it, go to the Target assigned to the ID of the component or the other end of the Rel.
+// Anything without an assignment goes to the first Target.
+// The Item is deleted, unless it is one of the Targets.
+// The split is atomic: if any step fails, the steps before it are reverted.
+type ItemSplitCommand struct {
+	CommandBase
+	Targets     []string          // Targets are the IDs of the Items to split into.
+	Assignments map[string]string // Assignments map the ID of a component, or the other end of a Rel, to one of the Targets.
+	executed    CommandList       // executed are the steps of the split that were executed.
+}
+
+func (c *ItemSplitCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.executed = nil
+	item, ok := w.ItemFetch(c.Id)
+	if !ok {
+		return IdList{}, itemNotFound(w, c.Id)
+	}
+	conflicts := make([]errors.KvPair, 0)
+	for i, id := range c.Targets {
+		if slices.Contains(c.Targets[:i], id) {
+			return IdList{}, errors.New("split target is repeated").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "id", Value: id})
+		}
+		if _, ok := w.ItemFetch(id); ok && id != c.Id {
+			conflicts = append(conflicts, errors.KvPair{Key: "id", Value: id})
+		}
+	}
+	if len(conflicts) > 0 {
+		return IdList{}, errors.New("Item already exists").UseCode(errors.TopolithErrorConflict).WithData(conflicts...)
+	}
+
+	components, _ := w.Components(c.Id)
+	slices.Sort(components)
+	rels := sortedRels(append(w.RelFrom(c.Id, true), w.RelTo(c.Id, true)...))
+	assignable := slices.Clone(components)
+	for _, rel := range rels {
+		assignable = append(assignable, rel.From.Id, rel.To.Id)
+	}
+	for id, target := range c.Assignments {
+		if id == c.Id || !slices.Contains(assignable, id) {
+			return IdList{}, errors.New("can only assign components of the Item, or Items it has a Rel with").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "id", Value: id})
+		}
+		if !slices.Contains(c.Targets, target) {
+			return IdList{}, errors.New("can only assign to a split target").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "id", Value: id}, errors.KvPair{Key: "target", Value: target})
+		}
+	}
+	targetOf := func(id string) string {
+		if target, ok := c.Assignments[id]; ok {
+			return target
+		}
+		return c.Targets[0]
+	}
+
+	lines := make([]string, 0)
+	parentId, _ := w.Parent(c.Id)
+	oldParentIds := make(map[string]string)
+	for _, id := range c.Targets {
+		if id == c.Id {
+			continue
+		}
+		target := item
+		target.Id, target.Name = id, ""
+		lines = append(lines, itemCreateLine(target))
+		oldParentIds[id] = parentId
+	}
+	lines = append(lines, treeRestoreLines(oldParentIds)...)
+	oldParentIds = make(map[string]string)
+	for _, id := range components {
+		if target := targetOf(id); target != c.Id {
+			oldParentIds[id] = target
+		}
+	}
+	lines = append(lines, treeRestoreLines(oldParentIds)...)
+	for _, rel := range rels {
+		old := rel
+		if rel.From.Id == c.Id {
+			rel.From.Id = targetOf(rel.To.Id)
+		}
+		if rel.To.Id == c.Id {
+			rel.To.Id = targetOf(old.From.Id)
+		}
+		if relId(rel) == relId(old) {
+			continue
+		}
+		if slices.Contains(c.Targets, c.Id) {
+			lines = append(lines, fmt.Sprintf("rel delete %s %s", quoted(old.From.Id), quoted(old.To.Id)))
+		}
+		lines = append(lines, relCreateLine(rel))
+	}
+	if !slices.Contains(c.Targets, c.Id) {
+		lines = append(lines, fmt.Sprintf("item delete %s", quoted(c.Id)))
+	}
+
+	executed, err := executeLines(w, lines...)
+	if err != nil {
+		return IdList{}, err
+	}
+	c.executed = executed
+	items := make([]world.Item, 0, len(c.Targets))
+	for _, id := range c.Targets {
+		target, _ := w.ItemFetch(id)
+		items = append(items, target)
+	}
+	return c.items(w, items), nil
+}
+
+func (c *ItemSplitCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemSplitCommand) Dual() (Command, error) {
+	if len(c.executed) == 0 {
+		return nil, nil
+	}
+	return c.executed.Dual()
+}
+
+// ItemImportOwnersCommand represents an import of owners from a CODEOWNERS file.
+// Each Code Item with a Source gets the team of the owners of its Source as its Owner, and all of them, comma-separated, as its Contacts.
+// Items whose Source has no owners are left alone. The import is atomic, and returns the Items it changed.
+// The file is only read the first time, so a redo sets the same owners even if the file changed since.
+type ItemImportOwnersCommand struct {
+	CommandBase
+	Path     string      // Path is the path to the CODEOWNERS file.
+	lines    []string    // lines are the set commands of the import, from the first time it executed.
+	changed  []string    // changed are the IDs of the Items that the lines set.
+	executed CommandList // executed are the set commands of the import that were executed.
+}
+
+func (c *ItemImportOwnersCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.executed = nil
+	if c.lines == nil {
+		rules, err := codeowners.Load(c.Path)
+		if err != nil {
+			return IdList{}, err
+		}
+		lines, changed := make([]string, 0), make([]string, 0)
+		for _, item := range sortedItems(world.AllItems(w)) {
+			if item.Type != world.Code || item.Source == "" {
+				continue
+			}
+			owners := rules.Owners(item.Source)
+			team, contacts := codeowners.Team(owners), strings.Join(owners, ",")
+			if team == "" || (team == item.Owner && contacts == item.Contacts) {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("item set %s owner=%s contacts=%s", quoted(item.Id), quoted(team), quoted(contacts)))
+			changed = append(changed, item.Id)
+		}
+		c.lines, c.changed = lines, changed
+	}
+
+	executed, err := executeLines(w, c.lines...)
+	if err != nil {
+		return IdList{}, err
+	}
+	c.executed = executed
+	items := make([]world.Item, 0, len(c.changed))
+	for _, id := range c.changed {
+		item, _ := w.ItemFetch(id)
+		items = append(items, item)
+	}
+	return c.items(w, items), nil
+}
+
+func (c *ItemImportOwnersCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemImportOwnersCommand) Dual() (Command, error) {
+	if len(c.executed) == 0 {
+		return nil, nil
+	}
+	return c.executed.Dual()
+}
+
+// ItemExistsCommand represents an exists command for Item.
+type ItemExistsCommand struct {
+	CommandBase
+}
+
+func (c *ItemExistsCommand) Execute(w world.World) (fmt.Stringer, error) {
+	_, ok := w.ItemFetch(c.Id)
+	return BoolStringer(ok), nil
+}
+
+func (c *ItemExistsCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemExistsCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemCreateOrFetchCommand represents a create-or-fetch command for Item.
+type ItemCreateOrFetchCommand struct {
+	CommandBase
+	noCreate bool
+}
+
+func (c *ItemCreateOrFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
+	item, ok := w.ItemFetch(c.Id)
+	if ok {
+		c.noCreate = true
+		return item, nil
+	}
+	return createItem(w, c.Id, world.ItemParams{})
+}
+
+func (c *ItemCreateOrFetchCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemCreateOrFetchCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return nil, nil
+	}
+	return commandFromLines(fmt.Sprintf("item delete %s", quoted(c.Id)))
+}
+
+// ItemCreateOrSetCommand represents a create-or-set command for Item.
+type ItemCreateOrSetCommand struct {
+	CommandBase
+	Params   world.ItemParams
+	oldItem  world.Item
+	noCreate bool
+}
+
+func (c *ItemCreateOrSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if item, ok := w.ItemFetch(c.Id); ok {
+		c.noCreate = true
+		c.oldItem = item
+		return w.ItemSet(c.Id, c.Params).Item()
+	}
+	return createItem(w, c.Id, c.Params)
+}
+
+func (c *ItemCreateOrSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemCreateOrSetCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return commandFromLines(itemRestoreLines(c.oldItem, itemParamKeys(c.Params))...)
+	}
+	return commandFromLines(fmt.Sprintf("item delete %s", quoted(c.Id)))
+}
+
+// ItemSubtreeCommand represents an in command for Item, returning the subtree of the world.Tree under it.
+// The depth limits how many levels of components we include. A 0 indicates no limit.
+type ItemSubtreeCommand struct {
+	CommandBase
+	Depth int
+}
+
+func (c *ItemSubtreeCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if _, ok := w.ItemFetch(c.Id); !ok {
+		return Subtree{}, itemNotFound(w, c.Id)
+	}
+	t := subtree(w, c.Id, c.Depth)
+	if c.Flags.Contains(Ids) {
+		return t.Ids(), nil
+	}
+	return t, nil
+}
+
+func (c *ItemSubtreeCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemSubtreeCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemAncestorsQueryCommand represents an ancestors query for Item, returning the Items from its parent up to the world.Tree root.
+type ItemAncestorsQueryCommand struct {
+	CommandBase
+}
+
+func (c *ItemAncestorsQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if _, ok := w.ItemFetch(c.Id); !ok {
+		return StringerList[world.Item]{}, itemNotFound(w, c.Id)
+	}
+	ancestors := make([]world.Item, 0)
+	for parent, ok := w.ItemParent(c.Id); ok && parent.Id != ""; parent, ok = w.ItemParent(parent.Id) {
+		ancestors = append(ancestors, parent)
+	}
+	return c.items(w, ancestors), nil
+}
+
+func (c *ItemAncestorsQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemAncestorsQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemOwnersQueryCommand represents an owners query for Item, returning the Items it inherits ownership from.
+// The Item itself comes first if it has an owner, then its owned ancestors up to the world.Tree root, so the first is the effective owner.
+type ItemOwnersQueryCommand struct {
+	CommandBase
+}
+
+func (c *ItemOwnersQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if _, ok := w.ItemFetch(c.Id); !ok {
+		return StringerList[world.Item]{}, itemNotFound(w, c.Id)
+	}
+	return c.items(w, world.Owners(w, c.Id)), nil
+}
+
+func (c *ItemOwnersQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemOwnersQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemDataFlowQueryCommand represents a dataflow query, returning the Items that could receive data of a classification, and the Rel path it takes to each.
+type ItemDataFlowQueryCommand struct {
+	CommandBase
+	Class string // Class is the classification to trace (ex: `pii`).
+}
+
+func (c *ItemDataFlowQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	return DataFlow{world.DataFlow(w, c.Class)}, nil
+}
+
+func (c *ItemDataFlowQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemDataFlowQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemSiblingsQueryCommand represents a siblings query for Item, returning the other Items with the same parent.
+type ItemSiblingsQueryCommand struct {
+	CommandBase
+}
+
+func (c *ItemSiblingsQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	parentId, ok := w.Parent(c.Id)
+	if !ok {
+		return StringerList[world.Item]{}, itemNotFound(w, c.Id)
+	}
+	siblings := make([]world.Item, 0)
+	for _, id := range componentIds(w, parentId) {
+		if id == c.Id {
+			continue
+		}
+		if item, ok := w.ItemFetch(id); ok {
+			siblings = append(siblings, item)
+		}
+	}
+	return c.items(w, siblings), nil
+}
+
+func (c *ItemSiblingsQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemSiblingsQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemTreeQueryCommand represents a tree query, returning the whole world.Tree.
+// The depth limits how many levels of components we include. A 0 indicates no limit.
+type ItemTreeQueryCommand struct {
+	CommandBase
+	Depth int
+}
+
+func (c *ItemTreeQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	t := subtree(w, "", c.Depth)
+	if c.Flags.Contains(Ids) {
+		return t.Ids(), nil
+	}
+	return t, nil
+}
+
+func (c *ItemTreeQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemTreeQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemInQueryCommand represents an in-query command for Item.
+type ItemInQueryCommand struct {
+	CommandBase
+	ParentId string
+}
+
+func (c *ItemInQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	strict := c.Flags.Contains(Strict)
+	isInThere := w.In(c.Id, c.ParentId, strict)
+	return BoolStringer(isInThere), nil
+}
+
+func (c *ItemInQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemInQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+/* Rel Commands */
+
+// RelCreateCommand represents a create command for Rel.
+type RelCreateCommand struct {
+	CommandBase
+	ToId     string
+	Params   world.RelParams
+	noCreate bool
+}
+
+func (c *RelCreateCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if rels := w.RelFetch(c.Id, c.ToId, true); len(rels) > 0 {
+		c.noCreate = true
+		return world.Rel{}, nil
+	}
+	return w.RelCreate(c.Id, c.ToId, c.Params).Rel()
+}
+
+func (c *RelCreateCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *RelCreateCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return nil, nil
+	}
+	return commandFromLines(fmt.Sprintf("rel delete %s %s", quoted(c.Id), quoted(c.ToId)))
+}
+
+// RelFetchCommand represents a fetch command for Rel.
+type RelFetchCommand struct {
+	CommandBase
+	ToId string
+}
+
+func (c *RelFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
+	strict := c.Flags.Contains(Strict)
+	rels := w.RelFetch(c.Id, c.ToId, strict)
+	if len(rels) == 0 {
+		return world.Rel{}, relNotFound(w, c.Id, c.ToId)
+	}
+	return c.rels(rels[:1]), nil
+}
+
+func (c *RelFetchCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *RelFetchCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// RelListCommand represents a list command for Rel.
+type RelListCommand struct {
+	CommandBase
+	Limit int
+}
+
+func (c *RelListCommand) Execute(w world.World) (fmt.Stringer, error) {
+	rels := sortedRels(w.RelList(0))
+	if c.Limit > 0 && len(rels) > c.Limit {
+		rels = rels[:c.Limit]
+	}
+	return c.rels(rels), nil
+}
+
+func (c *RelListCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *RelListCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// RelLinkCommand represents a link or unlink command for Rel.
+// Linking adds to the world.Link of the Rel, and errors if one is already there. Unlinking removes them, and errors if one isn't there.
+type RelLinkCommand struct {
+	CommandBase
+	ToId   string
+	Links  []world.Link
+	Unlink bool // Unlink is true to remove the Links, and false to add them.
+	oldRel world.Rel
+}
+
+func (c *RelLinkCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldRel = world.Rel{}
+	rels := w.RelFetch(c.Id, c.ToId, true)
+	if len(rels) == 0 {
+		return world.Rel{}, relNotFound(w, c.Id, c.ToId)
+	}
+	links, err := changeLinks(rels[0].Links, c.Links, c.Unlink)
+	if err != nil {
+		return world.Rel{}, err
+	}
+	c.oldRel = rels[0]
+	return w.RelSet(c.Id, c.ToId, world.RelParams{Links: &links}).Rel()
+}
+
+func (c *RelLinkCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *RelLinkCommand) Dual() (Command, error) {
+	if c.oldRel.From.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(relRestoreLines(c.oldRel, []string{"links"})...)
+}
+
+// RelClearCommand represents a clear command for Rel - a modified set command.
+type RelClearCommand struct {
+	CommandBase
+	ToId   string
+	Params world.RelParams
+	oldRel world.Rel
+	noSet  bool
+}
+
+func (c *RelClearCommand) Execute(w world.World) (fmt.Stringer, error) {
+	rels := w.RelFetch(c.Id, c.ToId, true)
+	if len(rels) == 0 {
+		c.noSet = true
+		return world.Rel{}, relNotFound(w, c.Id, c.ToId)
+	}
+	c.oldRel = rels[0]
+	return w.RelSet(c.Id, c.ToId, c.Params).Rel()
+}
+
+func (c *RelClearCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *RelClearCommand) Dual() (Command, error) {
+	if c.noSet || c.oldRel.From.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(relRestoreLines(c.oldRel, relParamKeys(c.Params))...)
+}
+
+// RelExistsCommand represents an exists command for Rel.
+type RelExistsCommand struct {
+	CommandBase
+	ToId string
+}
+
+func (c *RelExistsCommand) Execute(w world.World) (fmt.Stringer, error) {
+	rels := w.RelFetch(c.Id, c.ToId, true)
+	return BoolStringer(len(rels) > 0), nil
+}
+
+func (c *RelExistsCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *RelExistsCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// RelToQueryCommand represents a to-query command for Rel.
+type RelToQueryCommand struct {
+	CommandBase
+}
+
+func (c *RelToQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	strict := c.Flags.Contains(Strict)
+	rels := sortedRels(w.RelTo(c.Id, strict))
+	return c.rels(rels), nil
+}
+
+func (c *RelToQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *RelToQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// RelFromQueryCommand represents a from-query command for Rel.
+type RelFromQueryCommand struct {
+	CommandBase
+}
+
+func (c *RelFromQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	strict := c.Flags.Contains(Strict)
+	rels := sortedRels(w.RelFrom(c.Id, strict))
+	return c.rels(rels), nil
+}
+
+func (c *RelFromQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *RelFromQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// RelCrossingsQueryCommand represents a crossings query, returning the Rel between Items in different trust boundaries.
+type RelCrossingsQueryCommand struct {
+	CommandBase
+}
+
+func (c *RelCrossingsQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	return c.rels(world.BoundaryCrossings(w)), nil
+}
+
+func (c *RelCrossingsQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *RelCrossingsQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// RelCreateOrFetchCommand represents a create-or-fetch command for Rel.
+type RelCreateOrFetchCommand struct {
+	CommandBase
+	ToId     string
+	noCreate bool
+}
+
+func (c *RelCreateOrFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
+	rels := w.RelFetch(c.Id, c.ToId, true)
+	if len(rels) > 0 {
+		c.noCreate = true
+		return rels[0], nil
+	}
+	return w.RelCreate(c.Id, c.ToId, world.RelParams{}).Rel()
+}
+
+func (c *RelCreateOrFetchCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *RelCreateOrFetchCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return nil, nil
+	}
+	return commandFromLines(fmt.Sprintf("rel delete %s %s", quoted(c.Id), quoted(c.ToId)))
+}
+
+// RelCreateOrSetCommand represents a create-or-set command for Rel.
+type RelCreateOrSetCommand struct {
+	CommandBase
+	ToId     string
+	Params   world.RelParams
+	oldRel   world.Rel
+	noCreate bool
+}
+
+func (c *RelCreateOrSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	rels := w.RelFetch(c.Id, c.ToId, true)
+	if len(rels) > 0 {
+		c.noCreate = true
+		c.oldRel = rels[0]
+		return w.RelSet(c.Id, c.ToId, c.Params).Rel()
+	}
+	return w.RelCreate(c.Id, c.ToId, c.Params).Rel()
+}
+
+func (c *RelCreateOrSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *RelCreateOrSetCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return commandFromLines(relRestoreLines(c.oldRel, relParamKeys(c.Params))...)
+	}
+	return commandFromLines(fmt.Sprintf("rel delete %s %s", quoted(c.Id), quoted(c.ToId)))
+}
+
+// RelSetCommand represents a set command for Rel.
+type RelSetCommand struct {
+	CommandBase
+	ToId   string
+	Params world.RelParams
+	oldRel world.Rel
+	noSet  bool
+}
+
+func (c *RelSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	rels := w.RelFetch(c.Id, c.ToId, true)
+	if len(rels) == 0 {
+		c.noSet = true
+		return world.Rel{}, relNotFound(w, c.Id, c.ToId)
+	}
+	c.oldRel = rels[0]
+	return w.RelSet(c.Id, c.ToId, c.Params).Rel()
+}
+
+func (c *RelSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *RelSetCommand) Dual() (Command, error) {
+	if c.noSet || c.oldRel.From.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(relRestoreLines(c.oldRel, relParamKeys(c.Params))...)
+}
+
+// RelDeleteCommand represents a delete command for Rel.
+type RelDeleteCommand struct {
+	CommandBase
+	ToId     string
+	oldRel   world.Rel
+	oldSteps []string // oldSteps are the step commands for the Scenario steps that were dropped with the Rel.
+	oldPins  []string // oldPins are the pin commands for the Pin that were dropped with the Rel.
+	noDelete bool
+}
+
+func (c *RelDeleteCommand) Execute(w world.World) (fmt.Stringer, error) {
+	rels := w.RelFetch(c.Id, c.ToId, true)
+	if len(rels) == 0 {
+		c.noDelete = true
+		return world.Rel{}, nil
+	}
+	c.oldRel = rels[0]
+	scenarios, pins := w.ScenarioList(0), w.PinList(0)
+	if err := w.RelDelete(c.Id, c.ToId).Err(); err != nil {
+		return world.Rel{}, err
+	}
+	c.oldSteps = droppedStepLines(scenarios, w)
+	c.oldPins = droppedPinLines(pins, w)
+	return world.Rel{}, nil
+}
+
+func (c *RelDeleteCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *RelDeleteCommand) Dual() (Command, error) {
+	if c.noDelete || c.oldRel.From.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(append(append([]string{relCreateLine(c.oldRel)}, c.oldSteps...), c.oldPins...)...)
+}
+
+// NodeCreateCommand represents a create command for a DeploymentNode.
+type NodeCreateCommand struct {
+	CommandBase
+	Params   world.NodeParams
+	noCreate bool
+}
+
+func (c *NodeCreateCommand) Execute(w world.World) (fmt.Stringer, error) {
+	_, c.noCreate = w.NodeFetch(c.Id)
+	return w.NodeCreate(c.Id, c.Params).Node()
+}
+
+func (c *NodeCreateCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *NodeCreateCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return nil, nil
+	}
+	return commandFromLines(fmt.Sprintf("node delete %s", quoted(c.Id)))
+}
+
+// NodeSetCommand represents a set command for a DeploymentNode.
+type NodeSetCommand struct {
+	CommandBase
+	Params  world.NodeParams
+	oldNode world.DeploymentNode
+}
+
+func (c *NodeSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldNode, _ = w.NodeFetch(c.Id)
+	return w.NodeSet(c.Id, c.Params).Node()
+}
+
+func (c *NodeSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *NodeSetCommand) Dual() (Command, error) {
+	if c.oldNode.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(nodeRestoreLine(c.oldNode))
+}
+
+// NodeCreateOrSetCommand represents a create-or-set command for a DeploymentNode.
+// It is the form that a DeploymentNode takes in a world.World file.
+type NodeCreateOrSetCommand struct {
+	CommandBase
+	Params  world.NodeParams
+	oldNode world.DeploymentNode // oldNode is the DeploymentNode before it was set. Empty if it was created.
+}
+
+func (c *NodeCreateOrSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldNode, _ = w.NodeFetch(c.Id)
+	if c.oldNode.Id == "" {
+		return w.NodeCreate(c.Id, c.Params).Node()
+	}
+	return w.NodeSet(c.Id, c.Params).Node()
+}
+
+func (c *NodeCreateOrSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *NodeCreateOrSetCommand) Dual() (Command, error) {
+	if c.oldNode.Id == "" {
+		return commandFromLines(fmt.Sprintf("node delete %s", quoted(c.Id)))
+	}
+	return commandFromLines(nodeRestoreLine(c.oldNode))
+}
+
+// NodeDeleteCommand represents a delete command for a DeploymentNode, and what is deployed to it.
+type NodeDeleteCommand struct {
+	CommandBase
+	oldNode world.DeploymentNode
+}
+
+func (c *NodeDeleteCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldNode = world.DeploymentNode{}
+	node, ok := w.NodeFetch(c.Id)
+	if !ok {
+		return world.DeploymentNode{}, nil
+	}
+	if err := w.NodeDelete(c.Id).Err(); err != nil {
+		return world.DeploymentNode{}, err
+	}
+	c.oldNode = node
+	return world.DeploymentNode{}, nil
+}
+
+func (c *NodeDeleteCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *NodeDeleteCommand) Dual() (Command, error) {
+	if c.oldNode.Id == "" {
+		return nil, nil
+	}
+	lines := []string{nodeCreateLine(c.oldNode)}
+	for _, itemId := range c.oldNode.Items {
+		lines = append(lines, world.Deployment{ItemId: itemId, NodeId: c.oldNode.Id}.String())
+	}
+	return commandFromLines(lines...)
+}
+
+// NodeFetchCommand represents a fetch command for a DeploymentNode.
+type NodeFetchCommand struct {
+	CommandBase
+}
+
+func (c *NodeFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
+	node, ok := w.NodeFetch(c.Id)
+	if !ok {
+		return world.DeploymentNode{}, errors.New("node not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
+	}
+	return node, nil
+}
+
+func (c *NodeFetchCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *NodeFetchCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// NodeListCommand represents a list command for DeploymentNode, in the order of their hierarchy.
+type NodeListCommand struct {
+	CommandBase
+	Limit int
+}
+
+func (c *NodeListCommand) Execute(w world.World) (fmt.Stringer, error) {
+	nodes := world.HierarchyOrder(w.NodeList(0))
+	if c.Limit > 0 && len(nodes) > c.Limit {
+		nodes = nodes[:c.Limit]
+	}
+	return c.nodes(nodes), nil
+}
+
+func (c *NodeListCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *NodeListCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// NodeExportCommand represents an export of a deployment diagram for an environment, as PlantUML.
+type NodeExportCommand struct {
+	CommandBase
+	Path string // Path is the file to write (ex: `docs/prod.puml`).
+}
+
+func (c *NodeExportCommand) Execute(w world.World) (fmt.Stringer, error) {
+	b, _, err := render.NewDeploymentRenderer(c.Id).Render(w)
+	if err != nil {
+		return IdList{}, err
+	}
+	if err := os.WriteFile(c.Path, b, 0644); err != nil {
+		return IdList{}, errors.New("could not export deployment diagram").UseCode(errors.TopolithErrorInternal).WithError(err).WithData(errors.KvPair{Key: "path", Value: c.Path})
+	}
+	return IdList{c.Path}, nil
+}
+
+func (c *NodeExportCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *NodeExportCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ItemDeployCommand represents a deploy or undeploy command, which adds or removes an Item on a DeploymentNode.
+type ItemDeployCommand struct {
+	CommandBase
+	NodeId   string
+	Undeploy bool // Undeploy is true to remove the Item from the DeploymentNode, and false to deploy it there.
+	deployed bool // deployed is true once the Command has changed the world.World.
+}
+
+func (c *ItemDeployCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.deployed = false
+	if _, ok := w.ItemFetch(c.Id); !ok {
+		return world.DeploymentNode{}, itemNotFound(w, c.Id)
+	}
+	change := w.Deploy
+	if c.Undeploy {
+		change = w.Undeploy
+	}
+	if err := change(c.Id, c.NodeId).Err(); err != nil {
+		return world.DeploymentNode{}, err
+	}
+	c.deployed = true
+	node, _ := w.NodeFetch(c.NodeId)
+	return node, nil
+}
+
+func (c *ItemDeployCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ItemDeployCommand) Dual() (Command, error) {
+	if !c.deployed {
+		return nil, nil
+	}
+	deployment := world.Deployment{ItemId: c.Id, NodeId: c.NodeId}
+	if c.Undeploy {
+		return commandFromLines(deployment.String())
+	}
+	return commandFromLines(undeployLine(deployment))
+}
+
+// ItemDeployedQueryCommand represents a deployed query, returning the DeploymentNode an Item is deployed to.
+type ItemDeployedQueryCommand struct {
+	CommandBase
+	In string // In is the ID of a DeploymentNode, such as an environment, to only return those in it. Empty for all.
+}
+
+func (c *ItemDeployedQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if _, ok := w.ItemFetch(c.Id); !ok {
+		return StringerList[world.DeploymentNode]{}, itemNotFound(w, c.Id)
+	}
+	if _, ok := w.NodeFetch(c.In); c.In != "" && !ok {
+		return StringerList[world.DeploymentNode]{}, errors.New("node not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.In})
+	}
+	return c.nodes(world.Deployed(w, c.Id, c.In)), nil
+}
+
+func (c *ItemDeployedQueryCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ItemDeployedQueryCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ScenarioCreateCommand represents a create command for a Scenario, without steps.
+type ScenarioCreateCommand struct {
+	CommandBase
+	Params   world.ScenarioParams
+	noCreate bool
+}
+
+func (c *ScenarioCreateCommand) Execute(w world.World) (fmt.Stringer, error) {
+	_, c.noCreate = w.ScenarioFetch(c.Id)
+	scenario, err := w.ScenarioCreate(c.Id, c.Params).Scenario()
+	return ScenarioDetail(scenario), err
+}
+
+func (c *ScenarioCreateCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ScenarioCreateCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return nil, nil
+	}
+	return commandFromLines(fmt.Sprintf("scenario delete %s", quoted(c.Id)))
+}
+
+// ScenarioSetCommand represents a set command for a Scenario.
+type ScenarioSetCommand struct {
+	CommandBase
+	Params      world.ScenarioParams
+	oldScenario world.Scenario
+}
+
+func (c *ScenarioSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldScenario, _ = w.ScenarioFetch(c.Id)
+	scenario, err := w.ScenarioSet(c.Id, c.Params).Scenario()
+	return ScenarioDetail(scenario), err
+}
+
+func (c *ScenarioSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ScenarioSetCommand) Dual() (Command, error) {
+	if c.oldScenario.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(scenarioRestoreLine(c.oldScenario))
+}
+
+// ScenarioCreateOrSetCommand represents a create-or-set command for a Scenario.
+// It is the form that a Scenario takes in a world.World file.
+type ScenarioCreateOrSetCommand struct {
+	CommandBase
+	Params      world.ScenarioParams
+	oldScenario world.Scenario // oldScenario is the Scenario before it was set. Empty if it was created.
+}
+
+func (c *ScenarioCreateOrSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldScenario, _ = w.ScenarioFetch(c.Id)
+	if c.oldScenario.Id == "" {
+		scenario, err := w.ScenarioCreate(c.Id, c.Params).Scenario()
+		return ScenarioDetail(scenario), err
+	}
+	scenario, err := w.ScenarioSet(c.Id, c.Params).Scenario()
+	return ScenarioDetail(scenario), err
+}
+
+func (c *ScenarioCreateOrSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ScenarioCreateOrSetCommand) Dual() (Command, error) {
+	if c.oldScenario.Id == "" {
+		return commandFromLines(fmt.Sprintf("scenario delete %s", quoted(c.Id)))
+	}
+	return commandFromLines(scenarioRestoreLine(c.oldScenario))
+}
+
+// ScenarioDeleteCommand represents a delete command for a Scenario, and its steps.
+type ScenarioDeleteCommand struct {
+	CommandBase
+	oldScenario world.Scenario
+}
+
+func (c *ScenarioDeleteCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldScenario, _ = w.ScenarioFetch(c.Id)
+	return ScenarioDetail{}, w.ScenarioDelete(c.Id).Err()
+}
+
+func (c *ScenarioDeleteCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ScenarioDeleteCommand) Dual() (Command, error) {
+	if c.oldScenario.Id == "" {
+		return nil, nil
+	}
+	lines := []string{"scenario create " + strings.TrimPrefix(c.oldScenario.String(), "scenario ")}
+	return commandFromLines(append(lines, c.oldScenario.StepStrings()...)...)
+}
+
+// ScenarioFetchCommand represents a fetch command for a Scenario, with its steps.
+type ScenarioFetchCommand struct {
+	CommandBase
+}
+
+func (c *ScenarioFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
+	scenario, ok := w.ScenarioFetch(c.Id)
+	if !ok {
+		return ScenarioDetail{}, errors.New("scenario not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
+	}
+	return ScenarioDetail(scenario), nil
+}
+
+func (c *ScenarioFetchCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ScenarioFetchCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ScenarioListCommand represents a list command for Scenario, sorted by ID and without their steps.
+type ScenarioListCommand struct {
+	CommandBase
+	Limit int
+}
+
+func (c *ScenarioListCommand) Execute(w world.World) (fmt.Stringer, error) {
+	scenarios := sortedScenarios(w)
+	if c.Limit > 0 && len(scenarios) > c.Limit {
+		scenarios = scenarios[:c.Limit]
+	}
+	if c.Flags.Contains(Ids) {
+		ids := make(IdList, len(scenarios))
+		for i, scenario := range scenarios {
+			ids[i] = scenario.Id
+		}
+		return ids, nil
+	}
+	return StringerList[world.Scenario](scenarios), nil
+}
+
+func (c *ScenarioListCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ScenarioListCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ScenarioExportCommand represents an export of a sequence diagram for a Scenario, as PlantUML or Mermaid by the extension of the Path.
+type ScenarioExportCommand struct {
+	CommandBase
+	Path string // Path is the file to write (ex: `docs/checkout.puml`, `checkout.mmd`).
+}
+
+func (c *ScenarioExportCommand) Execute(w world.World) (fmt.Stringer, error) {
+	var format render.RenderedReturnType
+	switch strings.ToLower(filepath.Ext(c.Path)) {
+	case ".puml", ".plantuml", ".pu":
+		format = render.PlantUml
+	case ".mmd", ".mermaid":
+		format = render.Mermaid
+	default:
+		return IdList{}, errors.New("unknown export format").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "path", Value: c.Path})
+	}
+	b, _, err := render.NewSequenceRenderer(c.Id, format).Render(w)
+	if err != nil {
+		return IdList{}, err
+	}
+	if err := os.WriteFile(c.Path, b, 0644); err != nil {
+		return IdList{}, errors.New("could not export sequence diagram").UseCode(errors.TopolithErrorInternal).WithError(err).WithData(errors.KvPair{Key: "path", Value: c.Path})
+	}
+	return IdList{c.Path}, nil
+}
+
+func (c *ScenarioExportCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ScenarioExportCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ScenarioStepCommand represents a step command, which adds a step to a Scenario, at the end or at a position.
+type ScenarioStepCommand struct {
+	CommandBase
+	Step  world.ScenarioStep
+	At    int // At is the position of the step, where 1 is the first. 0 adds it at the end.
+	added int // added is the position the step was added at, or 0 if it wasn't.
+}
+
+func (c *ScenarioStepCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.added = 0
+	scenario, err := w.ScenarioStep(c.Id, c.Step, c.At).Scenario()
+	if err != nil {
+		return ScenarioDetail{}, err
+	}
+	c.added = c.At
+	if c.added == 0 {
+		c.added = len(scenario.Steps)
+	}
+	return ScenarioDetail(scenario), nil
+}
+
+func (c *ScenarioStepCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ScenarioStepCommand) Dual() (Command, error) {
+	if c.added == 0 {
+		return nil, nil
+	}
+	return commandFromLines(fmt.Sprintf("unstep %s %d", quoted(c.Id), c.added))
+}
+
+// ScenarioUnstepCommand represents an unstep command, which removes the step at a position from a Scenario.
+type ScenarioUnstepCommand struct {
+	CommandBase
+	At      int // At is the position of the step, where 1 is the first.
+	oldStep *world.ScenarioStep
+}
+
+func (c *ScenarioUnstepCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldStep = nil
+	old, _ := w.ScenarioFetch(c.Id)
+	scenario, err := w.ScenarioUnstep(c.Id, c.At).Scenario()
+	if err != nil {
+		return ScenarioDetail{}, err
+	}
+	c.oldStep = &old.Steps[c.At-1]
+	return ScenarioDetail(scenario), nil
+}
+
+func (c *ScenarioUnstepCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ScenarioUnstepCommand) Dual() (Command, error) {
+	if c.oldStep == nil {
+		return nil, nil
+	}
+	return commandFromLines(stepLine(c.Id, *c.oldStep, c.At))
+}
+
+// ViewCreateCommand represents a create command for a saved View.
+type ViewCreateCommand struct {
+	CommandBase
+	Params   world.ViewParams
+	noCreate bool
+}
+
+func (c *ViewCreateCommand) Execute(w world.World) (fmt.Stringer, error) {
+	_, c.noCreate = w.ViewFetch(c.Id)
+	params, err := resolveViewParams(w, c.Params)
+	if err != nil {
+		return world.View{}, err
+	}
+	return w.ViewCreate(c.Id, params).View()
+}
+
+func (c *ViewCreateCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ViewCreateCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return nil, nil
+	}
+	return commandFromLines(fmt.Sprintf("view delete %s", quoted(c.Id)))
+}
+
+// ViewSetCommand represents a set command for a saved View.
+type ViewSetCommand struct {
+	CommandBase
+	Params  world.ViewParams
+	oldView world.View
+}
+
+func (c *ViewSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldView, _ = w.ViewFetch(c.Id)
+	params, err := resolveViewParams(w, c.Params)
+	if err != nil {
+		c.oldView = world.View{}
+		return world.View{}, err
+	}
+	return w.ViewSet(c.Id, params).View()
+}
+
+func (c *ViewSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ViewSetCommand) Dual() (Command, error) {
+	if c.oldView.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(viewRestoreLine(c.oldView))
+}
+
+// resolveViewParams returns the world.ViewParams with each path in its Focus and Expand resolved to an ID, as a View keeps them.
+// We error if the Focus isn't an Item, since the View couldn't render.
+func resolveViewParams(w world.World, params world.ViewParams) (world.ViewParams, error) {
+	if params.Focus != nil && *params.Focus != "" {
+		id, err := resolvePath(w, *params.Focus)
+		if err != nil {
+			return params, err
+		}
+		if _, ok := w.ItemFetch(id); !ok {
+			return params, itemNotFound(w, id).WithData(errors.KvPair{Key: "param", Value: "focus"})
+		}
+		params.Focus = &id
+	}
+	if params.Expand != nil {
+		ids := make([]string, 0)
+		for _, path := range strings.Split(*params.Expand, ",") {
+			if path = strings.TrimSpace(path); path == "" || path == world.ExpandAll {
+				ids = append(ids, path)
+				continue
+			}
+			id, err := resolvePath(w, path)
+			if err != nil {
+				return params, err
+			}
+			ids = append(ids, id)
+		}
+		expand := strings.Join(ids, ",")
+		params.Expand = &expand
+	}
+	return params, nil
+}
+
+// ViewCreateOrSetCommand represents a create-or-set command for a saved View.
+// It is the form that a View takes in a world.World file.
+type ViewCreateOrSetCommand struct {
+	CommandBase
+	Params  world.ViewParams
+	oldView world.View // oldView is the View before it was set. Empty if it was created.
+}
+
+func (c *ViewCreateOrSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldView, _ = w.ViewFetch(c.Id)
+	if c.oldView.Id == "" {
+		return w.ViewCreate(c.Id, c.Params).View()
+	}
+	return w.ViewSet(c.Id, c.Params).View()
+}
+
+func (c *ViewCreateOrSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ViewCreateOrSetCommand) Dual() (Command, error) {
+	if c.oldView.Id == "" {
+		return commandFromLines(fmt.Sprintf("view delete %s", quoted(c.Id)))
+	}
+	return commandFromLines(viewRestoreLine(c.oldView))
+}
+
+// ViewDeleteCommand represents a delete command for a saved View.
+type ViewDeleteCommand struct {
+	CommandBase
+	oldView world.View
+	oldPins []string // oldPins are the pin commands for the Pin in the View.
+}
+
+func (c *ViewDeleteCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldView, _ = w.ViewFetch(c.Id)
+	pins := w.PinList(0)
+	if err := w.ViewDelete(c.Id).Err(); err != nil {
+		return world.View{}, err
+	}
+	c.oldPins = droppedPinLines(pins, w)
+	return world.View{}, nil
+}
+
+func (c *ViewDeleteCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *ViewDeleteCommand) Dual() (Command, error) {
+	if c.oldView.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(append([]string{viewCreateLine(c.oldView)}, c.oldPins...)...)
+}
+
+// ViewFetchCommand represents a fetch command for a saved View.
+type ViewFetchCommand struct {
+	CommandBase
+}
+
+func (c *ViewFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
+	view, ok := w.ViewFetch(c.Id)
+	if !ok {
+		return world.View{}, errors.New("view not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
+	}
+	return view, nil
+}
+
+func (c *ViewFetchCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ViewFetchCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// ViewListCommand represents a list command for saved View, sorted by ID.
+type ViewListCommand struct {
+	CommandBase
+	Limit int
+}
+
+func (c *ViewListCommand) Execute(w world.World) (fmt.Stringer, error) {
+	views := sortedViews(w)
+	if c.Limit > 0 && len(views) > c.Limit {
+		views = views[:c.Limit]
+	}
+	if c.Flags.Contains(Ids) {
+		ids := make(IdList, len(views))
+		for i, view := range views {
+			ids[i] = view.Id
+		}
+		return ids, nil
+	}
+	return StringerList[world.View](views), nil
+}
+
+func (c *ViewListCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *ViewListCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// LayoutPinCommand represents a pin command, which pins an Item or a Rel in the layout of a View.
+// Only the given parameters change, so a drag can move a pinned Item without losing its size.
+type LayoutPinCommand struct {
+	CommandBase
+	View   string // View is the ID of the View to pin in, or empty for the whole World.
+	ToId   string // ToId is the ID of the Item at the other end of the pinned Rel, or empty to pin an Item.
+	Params world.PinParams
+	oldPin *world.Pin // oldPin is the Pin before it was set, or nil if it was created.
+	pinned bool
+}
+
+func (c *LayoutPinCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldPin, c.pinned = nil, false
+	if old, ok := w.PinFetch(c.View, c.Id, c.ToId); ok {
+		c.oldPin = &old
+	}
+	pin, err := w.PinSet(c.View, c.Id, c.ToId, c.Params).Pin()
+	if err != nil {
+		return world.Pin{}, err
+	}
+	c.pinned = true
+	return pin, nil
+}
+
+func (c *LayoutPinCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *LayoutPinCommand) Dual() (Command, error) {
+	if !c.pinned {
+		return nil, nil
+	}
+	if c.oldPin == nil {
+		return commandFromLines(unpinLine(world.Pin{View: c.View, Id: c.Id, To: c.ToId}))
+	}
+	return commandFromLines(pinRestoreLine(*c.oldPin))
+}
+
+// LayoutUnpinCommand represents an unpin command, which removes the Pin of an Item or a Rel from the layout of a View.
+type LayoutUnpinCommand struct {
+	CommandBase
+	View   string // View is the ID of the View to unpin in, or empty for the whole World.
+	ToId   string // ToId is the ID of the Item at the other end of the pinned Rel, or empty to unpin an Item.
+	oldPin *world.Pin
+}
+
+func (c *LayoutUnpinCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldPin = nil
+	if old, ok := w.PinFetch(c.View, c.Id, c.ToId); ok {
+		c.oldPin = &old
+	}
+	return world.Pin{}, w.PinDelete(c.View, c.Id, c.ToId).Err()
+}
+
+func (c *LayoutUnpinCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *LayoutUnpinCommand) Dual() (Command, error) {
+	if c.oldPin == nil {
+		return nil, nil
+	}
+	return commandFromLines(c.oldPin.String())
+}
+
+// LayoutListCommand represents a list command for Pin, in one View or in all of them.
+type LayoutListCommand struct {
+	CommandBase
+	View    string // View is the ID of the View to list the Pin of, or empty for the whole World.
+	AllPins bool   // AllPins indicates that no View was given, so we list the Pin in every View.
+}
+
+func (c *LayoutListCommand) Execute(w world.World) (fmt.Stringer, error) {
+	if c.AllPins {
+		return StringerList[world.Pin](sortedPins(w)), nil
+	}
+	return StringerList[world.Pin](world.ViewPins(w, c.View)), nil
+}
+
+func (c *LayoutListCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *LayoutListCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// StyleCreateCommand represents a create command for a Style.
+type StyleCreateCommand struct {
+	CommandBase
+	Params   world.StyleParams
+	noCreate bool
+}
+
+func (c *StyleCreateCommand) Execute(w world.World) (fmt.Stringer, error) {
+	_, c.noCreate = w.StyleFetch(c.Id)
+	return w.StyleCreate(c.Id, c.Params).Style()
+}
+
+func (c *StyleCreateCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *StyleCreateCommand) Dual() (Command, error) {
+	if c.noCreate {
+		return nil, nil
+	}
+	return commandFromLines(fmt.Sprintf("style delete %s", quoted(c.Id)))
+}
+
+// StyleSetCommand represents a set command for a Style.
+type StyleSetCommand struct {
+	CommandBase
+	Params   world.StyleParams
+	oldStyle world.Style
+}
+
+func (c *StyleSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldStyle, _ = w.StyleFetch(c.Id)
+	return w.StyleSet(c.Id, c.Params).Style()
+}
+
+func (c *StyleSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *StyleSetCommand) Dual() (Command, error) {
+	if c.oldStyle.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(styleRestoreLine(c.oldStyle))
+}
+
+// StyleCreateOrSetCommand represents a create-or-set command for a Style.
+// It is the form that a Style takes in a world.World file.
+type StyleCreateOrSetCommand struct {
+	CommandBase
+	Params   world.StyleParams
+	oldStyle world.Style // oldStyle is the Style before it was set. Empty if it was created.
+}
+
+func (c *StyleCreateOrSetCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldStyle, _ = w.StyleFetch(c.Id)
+	if c.oldStyle.Id == "" {
+		return w.StyleCreate(c.Id, c.Params).Style()
+	}
+	return w.StyleSet(c.Id, c.Params).Style()
+}
+
+func (c *StyleCreateOrSetCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *StyleCreateOrSetCommand) Dual() (Command, error) {
+	if c.oldStyle.Id == "" {
+		return commandFromLines(fmt.Sprintf("style delete %s", quoted(c.Id)))
+	}
+	return commandFromLines(styleRestoreLine(c.oldStyle))
+}
+
+// StyleDeleteCommand represents a delete command for a Style.
+type StyleDeleteCommand struct {
+	CommandBase
+	oldStyle world.Style
+}
+
+func (c *StyleDeleteCommand) Execute(w world.World) (fmt.Stringer, error) {
+	c.oldStyle, _ = w.StyleFetch(c.Id)
+	if err := w.StyleDelete(c.Id).Err(); err != nil {
+		return world.Style{}, err
+	}
+	return world.Style{}, nil
+}
+
+func (c *StyleDeleteCommand) Undo(w world.World) error {
+	return undoWithDual(c, w)
+}
+
+func (c *StyleDeleteCommand) Dual() (Command, error) {
+	if c.oldStyle.Id == "" {
+		return nil, nil
+	}
+	return commandFromLines(styleCreateLine(c.oldStyle))
+}
+
+// StyleFetchCommand represents a fetch command for a Style.
+type StyleFetchCommand struct {
+	CommandBase
+}
+
+func (c *StyleFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
+	style, ok := w.StyleFetch(c.Id)
+	if !ok {
+		return world.Style{}, errors.New("style not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
+	}
+	return style, nil
+}
+
+func (c *StyleFetchCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *StyleFetchCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// StyleListCommand represents a list command for Style, in the order they apply.
+type StyleListCommand struct {
+	CommandBase
+	Limit int
+}
+
+func (c *StyleListCommand) Execute(w world.World) (fmt.Stringer, error) {
+	styles := world.SortedStyles(w)
+	if c.Limit > 0 && len(styles) > c.Limit {
+		styles = styles[:c.Limit]
+	}
+	if c.Flags.Contains(Ids) {
+		ids := make(IdList, len(styles))
+		for i, style := range styles {
+			ids[i] = style.Id
+		}
+		return ids, nil
+	}
+	return StringerList[world.Style](styles), nil
+}
+
+func (c *StyleListCommand) Undo(w world.World) error {
+	return nil
+}
+
+func (c *StyleListCommand) Dual() (Command, error) {
+	return nil, nil
+}
+
+// --- EXPORTED FUNCTIONS ---
+
+// InputToCommand converts a grammar.InputAttributes to a Command.
+func InputToCommand(input grammar.InputAttributes) (Command, error) {
+	base := CommandBase{
+		InputAttributes: input,
+		ResourceType:    CommandTarget(input.ResourceType),
+		Id:              input.ResourceId,
+		Flags:           mapset.NewSet[CommandFlag](),
+	}
+	for _, flag := range input.Flags {
+		base.Flags.Add(CommandFlag(flag))
+	}
+
+	switch base.ResourceType {
+	case WorldTarget:
+		return worldCommand(base, input)
+	case ItemTarget:
+		return itemCommand(base, input)
+	case RelTarget:
+		return relCommand(base, input)
+	case NodeTarget:
+		return nodeCommand(base, input)
+	case ScenarioTarget:
+		return scenarioCommand(base, input)
+	case ViewTarget:
+		return viewCommand(base, input)
+	case LayoutTarget:
+		return layoutCommand(base, input)
+	case StyleTarget:
+		return styleCommand(base, input)
+	default:
+		return nil, errors.New("invalid resource type").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "resourceType", Value: input.ResourceType})
+	}
+}
+
+// CommandFromString parses newline-separated, grammar-compatible commands to a Command.
+// A single command is returned as-is, and multiple commands are returned as a CommandList.
+// It is the inverse of Command.String(), so the result of Command.Dual() can be stored or sent elsewhere and restored here.
+func CommandFromString(s string) (Command, error) {
+	commands := make(CommandList, 0)
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		p, err := grammar.Parse(line)
+		if err != nil {
+			return nil, parseError(err, line)
+		}
+		if p.StmtType != "Command" {
+			return nil, errors.New("invalid command").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "input", Value: line})
+		}
+		c, err := InputToCommand(p.InputAttributes)
+		if err != nil {
+			return nil, err
+		}
+		commands = append(commands, c)
+	}
+	if len(commands) == 0 {
+		return nil, errors.New("no commands found").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "input", Value: s})
+	}
+	if len(commands) == 1 {
+		return commands[0], nil
+	}
+	return commands, nil
+}
+
+// --- INTERNAL FUNCTIONS ---
+
+// persistenceCommand is implemented by Command that need persistence.Persistence, such as saving or loading a world.World.
+// The App provides its persistence.Persistence before execution.
+type persistenceCommand interface {
+	usePersistence(p persistence.Persistence)
+}
+
+// sessionCommand is implemented by Command that act on the open world.World in the App, rather than a single world.World.
+// The App provides itself before execution, and these Command are not part of any world.World history.
+type sessionCommand interface {
+	useApp(h *app)
+}
+
+// crossWorldCommand is implemented by Command that read from the current world.World and write into another open world.World.
+// The App provides the current world.World as the source, executes the Command on the target, and records it in the target history.
+type crossWorldCommand interface {
+	targetWorld() string
+	useSource(w world.World)
+}
+
+// inputCommand is implemented by Command built from grammar.InputAttributes.
+// The App uses the input to resolve paths through the world.Tree before it executes the Command.
+type inputCommand interface {
+	input() grammar.InputAttributes
+}
+
+// undoWithDual reverts a Command by executing its Dual on the given world.World.
+func undoWithDual(c Command, w world.World) error {
+	dual, err := c.Dual()
+	if err != nil || dual == nil {
+		return err
+	}
+	_, err = dual.Execute(w)
+	return err
+}
+
+// executeLines executes the grammar-compatible lines in order, and returns the Command that were executed.
+// It is all or nothing: if a line fails, we revert it and the lines before it, and return the error.
+func executeLines(w world.World, lines ...string) (CommandList, error) {
+	executed := make(CommandList, 0, len(lines))
+	for _, line := range lines {
+		c, err := CommandFromString(line)
+		if err == nil {
+			executed = append(executed, c)
+			_, err = c.Execute(w)
+		}
+		if err != nil {
+			// The failing Command may have changed part of the world.World already, so it is reverted too.
+			if undoErr := executed.Undo(w); undoErr != nil {
+				return nil, errors.Join(err, undoErr)
+			}
+			return nil, err
+		}
+	}
+	return executed, nil
+}
+
+// commandFromLines builds a Command from grammar-compatible lines. Return nil if there are no lines.
+func commandFromLines(lines ...string) (Command, error) {
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	return CommandFromString(strings.Join(lines, "\n"))
+}
+
+// subtree returns the Subtree of the world.Tree under the Item with the given ID, or under the root for an empty ID.
+// The depth limits how many levels of components we include. A 0 indicates no limit.
+func subtree(w world.World, id string, depth int) Subtree {
+	t := Subtree{Components: make([]Subtree, 0)}
+	if item, ok := w.ItemFetch(id); ok {
+		t.Item = &item
+	}
+	for _, componentId := range componentIds(w, id) {
+		if depth == 1 {
+			item, _ := w.ItemFetch(componentId)
+			t.Components = append(t.Components, Subtree{Item: &item, Components: make([]Subtree, 0)})
+			continue
+		}
+		t.Components = append(t.Components, subtree(w, componentId, max(depth-1, 0)))
+	}
+	return t
+}
+
+// componentIds returns the sorted IDs of the components of the Item with the given ID, or of the world.Tree root for an empty ID.
+// Archived components are hidden.
+func componentIds(w world.World, id string) []string {
+	if id != "" {
+		ids, _ := w.Components(id)
+		ids = slices.DeleteFunc(ids, w.Archived)
+		slices.Sort(ids)
+		return ids
+	}
+	ids := make([]string, 0)
+	for _, item := range w.ItemList(0) {
+		if parentId, _ := w.Parent(item.Id); parentId == "" {
+			ids = append(ids, item.Id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// descendantIds returns the IDs of every Item under the Item with the given ID, archived or not.
+// Each Item comes before its components.
+func descendantIds(w world.World, id string) []string {
+	ids := make([]string, 0)
+	components, _ := w.Components(id)
+	slices.Sort(components)
+	for _, componentId := range components {
+		ids = append(ids, componentId)
+		ids = append(ids, descendantIds(w, componentId)...)
+	}
+	return ids
+}
+
+// sortedItems returns the Items sorted by ID.
+func sortedItems(items []world.Item) []world.Item {
+	slices.SortFunc(items, func(a, b world.Item) int { return strings.Compare(a.Id, b.Id) })
+	return items
+}
+
+// sortedRels returns the Rel sorted by ID.
+func sortedRels(rels []world.Rel) []world.Rel {
+	slices.SortFunc(rels, func(a, b world.Rel) int { return strings.Compare(relId(a), relId(b)) })
+	return rels
+}
+
+func relId(rel world.Rel) string {
+	return rel.From.Id + world.RelIdSeparator + rel.To.Id
+}
+
+// itemDetail returns the ItemDetail for the Item, with its Rel sorted by the IDs at the other end.
+// Archived components and Rel are hidden, unless the Item is archived itself.
+func itemDetail(w world.World, item world.Item) ItemDetail {
+	parentId, _ := w.Parent(item.Id)
+	components, _ := w.Components(item.Id)
+	slices.Sort(components)
+	inbound, outbound := w.RelTo(item.Id, true), w.RelFrom(item.Id, true)
+	if !w.Archived(item.Id) {
+		components = slices.DeleteFunc(components, w.Archived)
+		archived := func(rel world.Rel) bool { return w.Archived(rel.From.Id) || w.Archived(rel.To.Id) }
+		inbound, outbound = slices.DeleteFunc(inbound, archived), slices.DeleteFunc(outbound, archived)
+	}
+	slices.SortFunc(inbound, func(a, b world.Rel) int { return strings.Compare(a.From.Id, b.From.Id) })
+	slices.SortFunc(outbound, func(a, b world.Rel) int { return strings.Compare(a.To.Id, b.To.Id) })
+	return ItemDetail{Item: item, ParentId: parentId, Components: components, Inbound: inbound, Outbound: outbound}
+}
+
+// createItem creates the Item, and nests it under the Item at the parent path of its ID, if that exists.
+// This lets `item create payments.db` put a `db` under `payments`.
+func createItem(w world.World, id string, params world.ItemParams) (world.Item, error) {
+	item, err := w.ItemCreate(id, params).Item()
+	if err != nil {
+		return item, err
+	}
+	parentId, _ := world.SplitPath(id)
+	if _, ok := w.ItemFetch(parentId); !ok {
+		return item, nil
+	}
+	if _, err := w.Nest(id, parentId).Item(); err != nil {
+		w.ItemDelete(id)
+		return world.Item{}, err
+	}
+	return item, nil
+}
+
+// resolvePaths returns the Command rebuilt with any paths through the world.Tree in its input replaced by the IDs they resolve to.
+// An ID that doesn't resolve is kept, so the Command can handle it as it would any missing Item.
+// The ID of a new Item resolves through its parent path, so `item create payments.db` works after `payments` moves.
+func resolvePaths(w world.World, c Command) (Command, error) {
+	ic, ok := c.(inputCommand)
+	if !ok {
+		return c, nil
+	}
+	input := ic.input()
+	verb := CommandVerb(input.Verb)
+	if target := CommandTarget(input.ResourceType); target == WorldTarget || target == NodeTarget || target == ViewTarget || target == StyleTarget {
+		return c, nil
+	}
+	// A Scenario has its own ID, but its steps are between Items.
+	scenario := CommandTarget(input.ResourceType) == ScenarioTarget
+	creates := CommandTarget(input.ResourceType) == ItemTarget && (verb == Create || verb == CreateOrFetch || verb == CreateOrSet)
+
+	var err error
+	resolved := input
+	paths := make(map[string]string) // paths are the IDs that each path in the command resolved to, where they differ.
+	record := func(path, id string) string {
+		if path != id {
+			paths[path] = id
+		}
+		return id
+	}
+	if resolved.ResourceId != "" && !scenario {
+		if creates {
+			resolved.ResourceId, err = resolveNewPath(w, input.ResourceId)
+		} else {
+			resolved.ResourceId, err = resolvePath(w, input.ResourceId)
+		}
+		if err != nil {
+			return nil, err
+		}
+		record(input.ResourceId, resolved.ResourceId)
+	}
+	resolved.ResourceIds = make([]string, len(input.ResourceIds))
+	for i, id := range input.ResourceIds {
+		if resolved.ResourceIds[i], err = resolvePath(w, id); err != nil {
+			return nil, err
+		}
+		record(id, resolved.ResourceIds[i])
+	}
+	resolved.SecondaryIds = make([]string, len(input.SecondaryIds))
+	for i, id := range input.SecondaryIds {
+		if verb == Copy || verb == Clone || verb == Deploy || verb == Undeploy || verb == Deployed {
+			// The secondary ID names a World, a prefix, or a DeploymentNode, not an Item.
+			resolved.SecondaryIds[i] = id
+			continue
+		}
+		if verb == Split {
+			// The secondary IDs name the new Items of a split.
+			if resolved.SecondaryIds[i], err = resolveNewPath(w, id); err != nil {
+				return nil, err
+			}
+			record(id, resolved.SecondaryIds[i])
+			continue
+		}
+		if resolved.SecondaryIds[i], err = resolvePath(w, id); err != nil {
+			return nil, err
+		}
+		record(id, resolved.SecondaryIds[i])
+	}
+	resolved.Assignments = make(map[string]string, len(input.Assignments))
+	for path, target := range input.Assignments {
+		id, err := resolvePath(w, path)
+		if err != nil {
+			return nil, err
+		}
+		if resolved.Assignments[record(path, id)], err = resolveNewPath(w, target); err != nil {
+			return nil, err
+		}
+		record(target, resolved.Assignments[id])
+	}
+	resolved.Raw = resolvedRaw(input.Raw, paths)
+	return InputToCommand(resolved)
+}
+
+// resolvedRaw returns the text of the command with each path replaced by the ID it resolved to, so the command reads as it executes.
+// The IDs are by path. We only replace whole IDs in the command, never a Param or Selector that happens to match a path.
+func resolvedRaw(raw string, ids map[string]string) string {
+	if len(ids) == 0 {
+		return raw
+	}
+	var b strings.Builder
+	last := 0
+	for _, span := range grammar.IdSpans(raw) {
+		id, ok := ids[strings.Trim(raw[span.Start:span.End], `"`)]
+		if !ok {
+			continue
+		}
+		b.WriteString(raw[last:span.Start])
+		b.WriteString(quoted(id))
+		last = span.End
+	}
+	b.WriteString(raw[last:])
+	return b.String()
+}
+
+// resolvePath returns the ID of the Item at the path, or the path itself if it doesn't resolve.
+// We error if the path is ambiguous.
+func resolvePath(w world.World, path string) (string, error) {
+	ids := w.Resolve(path)
+	switch len(ids) {
+	case 0:
+		return path, nil
+	case 1:
+		return ids[0], nil
+	}
+	return "", errors.New("path is ambiguous").
+		UseCode(errors.TopolithErrorConflict).
+		WithData(errors.KvPair{Key: "path", Value: path}).
+		WithSuggestions(path, ids...)
+}
+
+// resolveNewPath returns the ID for an Item at the path, which may not exist yet.
+// If it doesn't, its ID is the local ID under the ID that the parent path resolves to.
+func resolveNewPath(w world.World, path string) (string, error) {
+	if ids := w.Resolve(path); len(ids) > 0 {
+		return resolvePath(w, path)
+	}
+	parentPath, localId := world.SplitPath(path)
+	if parentPath == "" {
+		return path, nil
+	}
+	parentId, err := resolvePath(w, parentPath)
+	if err != nil {
+		return "", err
+	}
+	return world.JoinPath(parentId, localId), nil
+}
+
+// dryRun executes the Command on a copy of the world.World, and returns what it would change.
+// The world.World itself is left alone.
+func dryRun(w world.World, c Command) (fmt.Stringer, error) {
+	if _, ok := c.(*WorldSaveCommand); ok {
+		return nil, errors.New("cannot dry run a save").UseCode(errors.TopolithErrorInvalid)
+	}
+	switch c.(type) {
+	case *WorldThreatsExportCommand, *NodeExportCommand, *ScenarioExportCommand:
+		return nil, errors.New("cannot dry run an export").UseCode(errors.TopolithErrorInvalid)
+	}
+	copied, err := world.Clone(w)
+	if err != nil {
+		return nil, err
+	}
+	changes := Changes{Matched: make([]string, 0)}
+	if ic, ok := c.(inputCommand); ok && len(ic.input().Selectors) > 0 {
+		if changes.Matched, err = selectIds(copied, ic.input().Selectors); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := c.Execute(copied); err != nil {
+		return nil, err
+	}
+	changes.ChangeSet = world.Diff(w, copied)
+	return changes, nil
 }
 
-func (c *RelCreateCommand) Undo(w world.World) error {
-	if c.noCreate {
-		return nil
+// inView executes the Command on the view of the world.World named by its view flag, and returns the result.
+// A view is a copy of the world.World, so we error if the Command would change it.
+func inView(w world.World, c Command) (fmt.Stringer, error) {
+	name := c.(inputCommand).input().Params["view"]
+	view, err := namedView(w, name)
+	if err != nil {
+		return nil, err
+	}
+	before, err := world.Clone(view)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.Execute(view)
+	if !world.Diff(before, view).Empty() {
+		return nil, errors.New("cannot change the world through a view").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "view", Value: name})
 	}
-	return w.RelDelete(c.Id, c.ToId).Err()
+	return result, err
 }
 
-// RelFetchCommand represents a fetch command for Rel.
-type RelFetchCommand struct {
-	CommandBase
-	ToId string
+// namedView returns the view of the world.World with the given name: a lifecycle view like world.AsIs, or else a saved world.View.
+func namedView(w world.World, name string) (world.World, error) {
+	if statuses, ok := world.StatusesFromView(name); ok {
+		return world.StatusView(w, statuses...)
+	}
+	if saved, ok := w.ViewFetch(name); ok {
+		return world.ApplyView(w, saved)
+	}
+	return nil, errors.New("unknown view").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "view", Value: name})
 }
 
-func (c *RelFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
-	strict := c.Flags.Contains(Strict)
-	rels := w.RelFetch(c.Id, c.ToId, strict)
-	if len(rels) == 0 {
-		return world.Rel{}, errors.New("could not find Rel").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
+// hasView indicates whether the Command has the view flag.
+func hasView(c Command) bool {
+	ic, ok := c.(inputCommand)
+	return ok && ic.input().Params["view"] != ""
+}
+
+// isDryRun indicates whether the Command has the DryRun flag.
+func isDryRun(c Command) bool {
+	ic, ok := c.(inputCommand)
+	return ok && slices.Contains(ic.input().Flags, string(DryRun))
+}
+
+// targetIds returns the IDs, followed by the IDs of the Items matched by the Selectors that aren't already among them.
+func targetIds(w world.World, ids []string, selectors []grammar.Selector) ([]string, error) {
+	if len(selectors) == 0 {
+		return ids, nil
+	}
+	selected, err := selectIds(w, selectors)
+	if err != nil {
+		return nil, err
 	}
-	return rels[0], nil
+	targets := slices.Clone(ids)
+	for _, id := range selected {
+		if !slices.Contains(targets, id) {
+			targets = append(targets, id)
+		}
+	}
+	return targets, nil
 }
 
-func (c *RelFetchCommand) Undo(w world.World) error {
-	return nil
+// selectIds returns the sorted IDs of the Items matched by any of the Selectors.
+func selectIds(w world.World, selectors []grammar.Selector) ([]string, error) {
+	matched := mapset.NewSet[string]()
+	for _, s := range selectors {
+		switch s.Kind {
+		case "glob":
+			if _, err := path.Match(s.Pattern, ""); err != nil {
+				return nil, errors.New("invalid glob").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "selector", Value: s.Text})
+			}
+			for _, item := range w.ItemList(0) {
+				if ok, _ := path.Match(s.Pattern, item.Id); ok {
+					matched.Add(item.Id)
+				}
+			}
+		case "regex":
+			re, err := regexp.Compile(s.Pattern)
+			if err != nil {
+				return nil, errors.New("invalid regex").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "selector", Value: s.Text})
+			}
+			for _, item := range w.ItemList(0) {
+				if re.MatchString(item.Id) {
+					matched.Add(item.Id)
+				}
+			}
+		case "in":
+			id, err := resolvePath(w, s.Pattern)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := w.ItemFetch(id); !ok {
+				return nil, itemNotFound(w, id)
+			}
+			matched.Append(subtree(w, id, 0).Ids()...)
+		default:
+			return nil, errors.New("invalid selector").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "selector", Value: s.Text})
+		}
+	}
+	ids := matched.ToSlice()
+	slices.Sort(ids)
+	return ids, nil
 }
 
-// RelListCommand represents a list command for Rel.
-type RelListCommand struct {
-	CommandBase
-	Limit int
+// itemNotFound returns a not found error for the Item, with the closest existing IDs as suggestions.
+func itemNotFound(w world.World, id string) errors.TopolithError {
+	return errors.New("could not find Item").
+		UseCode(errors.TopolithErrorNotFound).
+		WithData(errors.KvPair{Key: "id", Value: id}).
+		WithSuggestions(id, world.SuggestIds(w, id, world.SuggestionLimit)...)
 }
 
-func (c *RelListCommand) Execute(w world.World) (fmt.Stringer, error) {
-	rels := w.RelList(c.Limit)
-	return StringerList[world.Rel](rels), nil
+// relNotFound returns a not found error for the Rel.
+// If one of its Item doesn't exist, we suggest the closest existing IDs for it.
+func relNotFound(w world.World, fromId, toId string) errors.TopolithError {
+	err := errors.New("could not find Rel").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "fromId", Value: fromId}, errors.KvPair{Key: "toId", Value: toId})
+	for _, id := range []string{fromId, toId} {
+		if _, ok := w.ItemFetch(id); !ok {
+			return err.WithSuggestions(id, world.SuggestIds(w, id, world.SuggestionLimit)...)
+		}
+	}
+	return err
 }
 
-func (c *RelListCommand) Undo(w world.World) error {
-	return nil
+func quoted(s string) string {
+	return fmt.Sprintf(`"%s"`, s)
 }
 
-// RelClearCommand represents a clear command for Rel - a modified set command.
-type RelClearCommand struct {
-	CommandBase
-	ToId      string
-	Params    world.RelParams
-	oldParams world.RelParams
-	noSet     bool
+// itemCreateLine returns the create command that recreates the given Item with all its attributes.
+func itemCreateLine(item world.Item) string {
+	return "item create " + strings.TrimPrefix(item.String(), "item ")
 }
 
-func (c *RelClearCommand) Execute(w world.World) (fmt.Stringer, error) {
-	rels := w.RelFetch(c.Id, c.ToId, true)
-	if len(rels) == 0 {
-		c.noSet = true
-		return world.Rel{}, errors.New("could not find Rel").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
-	}
-	rel := rels[0]
-	c.oldParams.Verb = strPtr(rel.Verb)
-	c.oldParams.Mechanism = strPtr(rel.Mechanism)
-	c.oldParams.Async = boolPtr(rel.Async)
-	c.oldParams.Expanded = strPtr(rel.Expanded)
-	return w.RelSet(c.Id, c.ToId, c.Params).Rel()
+// nodeCreateLine returns the create command that recreates the given DeploymentNode with all its attributes, without what is deployed to it.
+func nodeCreateLine(node world.DeploymentNode) string {
+	return "node create " + strings.TrimPrefix(node.String(), "node ")
 }
 
-func (c *RelClearCommand) Undo(w world.World) error {
-	if c.noSet {
-		return nil
-	}
-	return w.RelSet(c.Id, c.ToId, c.oldParams).Err()
+// nodeRestoreLine returns the set command that restores every attribute of the old DeploymentNode.
+// DeploymentNode have no clear command, so an empty attribute is set empty.
+func nodeRestoreLine(old world.DeploymentNode) string {
+	return fmt.Sprintf("node set %s kind=%s name=%s mechanism=%s parent=%s", quoted(old.Id), world.StringFromDeploymentKind(old.Kind), quoted(old.Name), quoted(old.Mechanism), quoted(old.Parent))
 }
 
-// RelExistsCommand represents an exists command for Rel.
-type RelExistsCommand struct {
-	CommandBase
-	ToId string
+// undeployLine returns the undeploy command that removes the Deployment.
+func undeployLine(d world.Deployment) string {
+	return fmt.Sprintf("undeploy %s from %s", quoted(d.ItemId), quoted(d.NodeId))
 }
 
-func (c *RelExistsCommand) Execute(w world.World) (fmt.Stringer, error) {
-	rels := w.RelFetch(c.Id, c.ToId, true)
-	return BoolStringer(len(rels) > 0), nil
+// scenarioRestoreLine returns the set command that restores the attributes of the old Scenario, but not its steps.
+func scenarioRestoreLine(old world.Scenario) string {
+	return fmt.Sprintf("scenario set %s name=%s", quoted(old.Id), quoted(old.Name))
 }
 
-func (c *RelExistsCommand) Undo(w world.World) error {
-	return nil
+// viewCreateLine returns the create command that recreates the given View with all its attributes.
+func viewCreateLine(view world.View) string {
+	return "view create " + strings.TrimPrefix(view.String(), "view ")
 }
 
-// RelToQueryCommand represents a to-query command for Rel.
-type RelToQueryCommand struct {
-	CommandBase
+// viewRestoreLine returns the set command that restores all the attributes of the old View.
+func viewRestoreLine(old world.View) string {
+	return fmt.Sprintf("view set %s name=%s expand=%s filter=%s focus=%s hops=%d", quoted(old.Id), quoted(old.Name), quoted(strings.Join(old.Expand, ",")), quoted(old.Filter), quoted(old.Focus), old.Hops)
 }
 
-func (c *RelToQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
-	strict := c.Flags.Contains(Strict)
-	rels := w.RelTo(c.Id, strict)
-	return StringerList[world.Rel](rels), nil
+// pinRestoreLine returns the pin command that restores all the attributes of the old Pin.
+func pinRestoreLine(old world.Pin) string {
+	if old.Rel() {
+		return old.String()
+	}
+	line := fmt.Sprintf("pin %s x=%s y=%s width=%s height=%s", quoted(old.Id), formatFloat(old.X), formatFloat(old.Y), formatFloat(old.Width), formatFloat(old.Height))
+	if old.View != "" {
+		line += " view=" + quoted(old.View)
+	}
+	return line
 }
 
-func (c *RelToQueryCommand) Undo(w world.World) error {
-	return nil
+// unpinLine returns the unpin command that removes the Pin.
+func unpinLine(pin world.Pin) string {
+	line := "layout unpin " + quoted(pin.Id)
+	if pin.Rel() {
+		line += " " + quoted(pin.To)
+	}
+	if pin.View != "" {
+		line += " view=" + quoted(pin.View)
+	}
+	return line
 }
 
-// RelFromQueryCommand represents a from-query command for Rel.
-type RelFromQueryCommand struct {
-	CommandBase
+// droppedPinLines returns the pin commands that put back the before Pin that are gone from the world.World.
+func droppedPinLines(before []world.Pin, w world.World) []string {
+	lines := make([]string, 0)
+	for _, old := range sortedPinList(before) {
+		if _, ok := w.PinFetch(old.View, old.Id, old.To); !ok {
+			lines = append(lines, old.String())
+		}
+	}
+	return lines
 }
 
-func (c *RelFromQueryCommand) Execute(w world.World) (fmt.Stringer, error) {
-	strict := c.Flags.Contains(Strict)
-	rels := w.RelFrom(c.Id, strict)
-	return StringerList[world.Rel](rels), nil
+// sortedPins returns the world.Pin in the world.World, sorted by View, then the IDs they pin.
+func sortedPins(w world.World) []world.Pin {
+	return sortedPinList(w.PinList(0))
 }
 
-func (c *RelFromQueryCommand) Undo(w world.World) error {
-	return nil
+func sortedPinList(pins []world.Pin) []world.Pin {
+	slices.SortFunc(pins, func(a, b world.Pin) int {
+		return cmp.Or(strings.Compare(a.View, b.View), strings.Compare(a.Id, b.Id), strings.Compare(a.To, b.To))
+	})
+	return pins
 }
 
-// RelCreateOrFetchCommand represents a create-or-fetch command for Rel.
-type RelCreateOrFetchCommand struct {
-	CommandBase
-	ToId     string
-	noCreate bool
+// formatFloat returns the shortest decimal for the number, so it parses back the same.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
 }
 
-func (c *RelCreateOrFetchCommand) Execute(w world.World) (fmt.Stringer, error) {
-	rels := w.RelFetch(c.Id, c.ToId, true)
-	if len(rels) > 0 {
-		c.noCreate = true
-		return rels[0], nil
-	}
-	return w.RelCreate(c.Id, c.ToId, world.RelParams{}).Rel()
+// styleCreateLine returns the create command that recreates the given Style with all its attributes.
+func styleCreateLine(style world.Style) string {
+	return "style create " + strings.TrimPrefix(style.String(), "style ")
 }
 
-func (c *RelCreateOrFetchCommand) Undo(w world.World) error {
-	if c.noCreate {
-		return nil
-	}
-	return w.RelDelete(c.Id, c.ToId).Err()
+// styleRestoreLine returns the set command that restores all the attributes of the old Style.
+func styleRestoreLine(old world.Style) string {
+	return fmt.Sprintf("style set %s name=%s match=%s shape=%s color=%s border=%s line=%s", quoted(old.Id), quoted(old.Name), quoted(old.Match), quoted(old.Shape), quoted(old.Color), quoted(old.Border), quoted(old.Line))
 }
 
-// RelCreateOrSetCommand represents a create-or-set command for Rel.
-type RelCreateOrSetCommand struct {
-	CommandBase
-	ToId      string
-	Params    world.RelParams
-	oldParams world.RelParams
-	noCreate  bool
+// sortedViews returns the world.View in the world.World, sorted by ID.
+func sortedViews(w world.World) []world.View {
+	views := w.ViewList(0)
+	slices.SortFunc(views, func(a, b world.View) int { return strings.Compare(a.Id, b.Id) })
+	return views
 }
 
-func (c *RelCreateOrSetCommand) Execute(w world.World) (fmt.Stringer, error) {
-	rels := w.RelFetch(c.Id, c.ToId, true)
-	if len(rels) > 0 {
-		c.noCreate = true
-		rel := rels[0]
-		c.oldParams.Verb = strPtr(rel.Verb)
-		c.oldParams.Mechanism = strPtr(rel.Mechanism)
-		c.oldParams.Async = boolPtr(rel.Async)
-		c.oldParams.Expanded = strPtr(rel.Expanded)
-		return w.RelSet(c.Id, c.ToId, c.Params).Rel()
-	}
-	return w.RelCreate(c.Id, c.ToId, c.Params).Rel()
+// stepLine returns the step command that adds the step to the Scenario at the position.
+func stepLine(scenarioId string, step world.ScenarioStep, at int) string {
+	return fmt.Sprintf("%s at=%d", step.Line(scenarioId), at)
 }
 
-func (c *RelCreateOrSetCommand) Undo(w world.World) error {
-	if c.noCreate {
-		return w.RelSet(c.Id, c.ToId, c.oldParams).Err()
+// droppedStepLines returns the step commands that put back the steps of the before Scenario that are gone from the world.World, each at its old position.
+// Steps are only dropped when the world.Rel they follow are deleted, so the Scenario keep the rest of their steps in order.
+func droppedStepLines(before []world.Scenario, w world.World) []string {
+	lines := make([]string, 0)
+	for _, old := range before {
+		scenario, ok := w.ScenarioFetch(old.Id)
+		if !ok {
+			continue
+		}
+		kept := 0
+		for i, step := range old.Steps {
+			if kept < len(scenario.Steps) && scenario.Steps[kept] == step {
+				kept++
+				continue
+			}
+			lines = append(lines, stepLine(old.Id, step, i+1))
+		}
 	}
-	return w.RelDelete(c.Id, c.ToId).Err()
+	return lines
 }
 
-// RelSetCommand represents a set command for Rel.
-type RelSetCommand struct {
-	CommandBase
-	ToId      string
-	Params    world.RelParams
-	oldParams world.RelParams
-	noSet     bool
+// sortedScenarios returns the world.Scenario in the world.World, sorted by ID.
+func sortedScenarios(w world.World) []world.Scenario {
+	scenarios := w.ScenarioList(0)
+	slices.SortFunc(scenarios, func(a, b world.Scenario) int { return strings.Compare(a.Id, b.Id) })
+	return scenarios
 }
 
-func (c *RelSetCommand) Execute(w world.World) (fmt.Stringer, error) {
-	rels := w.RelFetch(c.Id, c.ToId, true)
-	if len(rels) == 0 {
-		c.noSet = true
-		return world.Rel{}, errors.New("could not find Rel").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: c.Id})
+// relCreateLine returns the create command that recreates the given Rel with all its attributes.
+func relCreateLine(rel world.Rel) string {
+	return "rel create " + strings.TrimPrefix(rel.String(), "rel ")
+}
+
+// itemParamKeys returns the grammar keys for the not-nil attributes of the given world.ItemParams.
+func itemParamKeys(params world.ItemParams) []string {
+	keys := make([]string, 0)
+	if params.External != nil {
+		keys = append(keys, "external")
 	}
-	rel := rels[0]
-	c.oldParams.Verb = strPtr(rel.Verb)
-	c.oldParams.Mechanism = strPtr(rel.Mechanism)
-	c.oldParams.Async = boolPtr(rel.Async)
-	c.oldParams.Expanded = strPtr(rel.Expanded)
-	return w.RelSet(c.Id, c.ToId, c.Params).Rel()
+	if params.Type != nil {
+		keys = append(keys, "type")
+	}
+	if params.Name != nil {
+		keys = append(keys, "name")
+	}
+	if params.Mechanism != nil {
+		keys = append(keys, "mechanism")
+	}
+	if params.Expanded != nil {
+		keys = append(keys, "expanded")
+	}
+	if params.Status != nil {
+		keys = append(keys, "status")
+	}
+	if params.Archived != nil {
+		keys = append(keys, "archived")
+	}
+	if params.Owner != nil {
+		keys = append(keys, "owner")
+	}
+	if params.Contacts != nil {
+		keys = append(keys, "contacts")
+	}
+	if params.Source != nil {
+		keys = append(keys, "source")
+	}
+	if params.Links != nil {
+		keys = append(keys, "links")
+	}
+	if params.Classification != nil {
+		keys = append(keys, "classification")
+	}
+	if params.Boundary != nil {
+		keys = append(keys, "boundary")
+	}
+	if params.Tags != nil {
+		keys = append(keys, "tags")
+	}
+	return keys
 }
 
-func (c *RelSetCommand) Undo(w world.World) error {
-	if c.noSet {
-		return nil
+// relParamKeys returns the grammar keys for the not-nil attributes of the given world.RelParams.
+func relParamKeys(params world.RelParams) []string {
+	keys := make([]string, 0)
+	if params.Verb != nil {
+		keys = append(keys, "verb")
+	}
+	if params.Mechanism != nil {
+		keys = append(keys, "mechanism")
+	}
+	if params.Async != nil {
+		keys = append(keys, "async")
+	}
+	if params.Expanded != nil {
+		keys = append(keys, "expanded")
 	}
-	return w.RelSet(c.Id, c.ToId, c.oldParams).Err()
+	if params.Status != nil {
+		keys = append(keys, "status")
+	}
+	if params.Links != nil {
+		keys = append(keys, "links")
+	}
+	if params.Classification != nil {
+		keys = append(keys, "classification")
+	}
+	return keys
 }
 
-// RelDeleteCommand represents a delete command for Rel.
-type RelDeleteCommand struct {
-	CommandBase
-	ToId      string
-	oldParams world.RelParams
-	noDelete  bool
+// itemRestoreLines returns the set and clear commands that restore the given keys to their values on the old Item.
+// Empty values are restored with a clear, since the grammar has no representation for an empty Item type.
+func itemRestoreLines(old world.Item, keys []string) []string {
+	values := map[string]string{
+		"external":       fmt.Sprintf("%t", old.External),
+		"type":           world.StringFromItemType(old.Type),
+		"name":           old.Name,
+		"mechanism":      old.Mechanism,
+		"expanded":       old.Expanded,
+		"status":         world.StringFromStatus(old.Status),
+		"archived":       fmt.Sprintf("%t", old.Archived),
+		"owner":          old.Owner,
+		"contacts":       old.Contacts,
+		"source":         old.Source,
+		"links":          world.LinksString(old.Links),
+		"classification": old.Classification,
+		"boundary":       old.Boundary,
+		"tags":           old.Tags,
+	}
+	return restoreLines(fmt.Sprintf("item %%s %s", quoted(old.Id)), values, keys)
 }
 
-func (c *RelDeleteCommand) Execute(w world.World) (fmt.Stringer, error) {
-	rels := w.RelFetch(c.Id, c.Id, true)
-	if len(rels) == 0 {
-		c.noDelete = true
-		return world.Rel{}, nil
+// relRestoreLines returns the set and clear commands that restore the given keys to their values on the old Rel.
+func relRestoreLines(old world.Rel, keys []string) []string {
+	values := map[string]string{
+		"verb":           old.Verb,
+		"mechanism":      old.Mechanism,
+		"async":          fmt.Sprintf("%t", old.Async),
+		"expanded":       old.Expanded,
+		"status":         world.StringFromStatus(old.Status),
+		"links":          world.LinksString(old.Links),
+		"classification": old.Classification,
 	}
-	rel := rels[0]
-	c.oldParams.Verb = strPtr(rel.Verb)
-	c.oldParams.Mechanism = strPtr(rel.Mechanism)
-	c.oldParams.Async = boolPtr(rel.Async)
-	c.oldParams.Expanded = strPtr(rel.Expanded)
-	return world.Rel{}, w.RelDelete(c.Id, c.ToId).Err()
+	return restoreLines(fmt.Sprintf("rel %%s %s %s", quoted(old.From.Id), quoted(old.To.Id)), values, keys)
 }
 
-func (c *RelDeleteCommand) Undo(w world.World) error {
-	if c.noDelete {
-		return nil
+// restoreLines returns a set command for the keys with values, and a clear command for the keys without.
+// The prefix is a format string with a single verb for the command verb (ex: `item %s "abc"`).
+func restoreLines(prefix string, values map[string]string, keys []string) []string {
+	setParams := make([]string, 0)
+	clearKeys := make([]string, 0)
+	for _, key := range keys {
+		switch v := values[key]; {
+		case v == "":
+			clearKeys = append(clearKeys, key)
+		case key == "external" || key == "type" || key == "async" || key == "status" || key == "archived":
+			setParams = append(setParams, fmt.Sprintf("%s=%s", key, v))
+		case key == "links":
+			// The links are already params, keyed by their kinds.
+			setParams = append(setParams, v)
+		default:
+			setParams = append(setParams, fmt.Sprintf("%s=%s", key, quoted(v)))
+		}
+	}
+	lines := make([]string, 0)
+	if len(setParams) > 0 {
+		lines = append(lines, fmt.Sprintf(prefix, Set)+" "+strings.Join(setParams, " "))
 	}
-	return w.RelCreate(c.Id, c.Id, c.oldParams).Err()
+	if len(clearKeys) > 0 {
+		lines = append(lines, fmt.Sprintf(prefix, Clear)+" "+strings.Join(clearKeys, " "))
+	}
+	return lines
 }
 
-// --- EXPORTED FUNCTIONS ---
+// changeLinks returns the world.Link with the changed ones removed when unlinking, or added otherwise.
+func changeLinks(links, changed []world.Link, unlink bool) ([]world.Link, error) {
+	if unlink {
+		return world.RemoveLinks(links, changed)
+	}
+	return world.AddLinks(links, changed)
+}
 
-// InputToCommand converts a grammar.InputAttributes to a Command.
-func InputToCommand(input grammar.InputAttributes) (Command, error) {
-	base := CommandBase{
-		InputAttributes: input,
-		ResourceType:    CommandTarget(input.ResourceType),
-		Id:              input.ResourceId,
-		Flags:           mapset.NewSet[CommandFlag](),
+// worldRebuildLines returns the commands that rebuild the given world.World from scratch, replacing whatever World they run on.
+func worldRebuildLines(w world.World) []string {
+	lines := []string{
+		fmt.Sprintf("world new %s", quoted(w.Name())),
+		fmt.Sprintf("world set id=%s expanded=%s theme=%s version=%d", quoted(w.Id()), quoted(w.Expanded()), w.Theme(), w.Version()),
 	}
-	for _, flag := range input.Flags {
-		base.Flags.Add(CommandFlag(flag))
+	items := world.AllItems(w)
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Id < items[j].Id
+	})
+	oldParentIds := make(map[string]string)
+	for _, item := range items {
+		lines = append(lines, itemCreateLine(item))
+		if parentId, _ := w.Parent(item.Id); parentId != "" {
+			oldParentIds[item.Id] = parentId
+		}
 	}
-
-	switch base.ResourceType {
-	case WorldTarget:
-		return &WorldFetchCommand{InputAttributes: input}, nil
-	case ItemTarget:
-		return itemCommand(base, input)
-	case RelTarget:
-		return relCommand(base, input)
-	default:
-		return nil, errors.New("invalid resource type").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "resourceType", Value: input.ResourceType})
+	lines = append(lines, treeRestoreLines(oldParentIds)...)
+	rels := world.AllRels(w)
+	sort.Slice(rels, func(i, j int) bool {
+		return rels[i].From.Id+world.RelIdSeparator+rels[i].To.Id < rels[j].From.Id+world.RelIdSeparator+rels[j].To.Id
+	})
+	for _, rel := range rels {
+		lines = append(lines, relCreateLine(rel))
+	}
+	for _, node := range world.HierarchyOrder(w.NodeList(0)) {
+		lines = append(lines, nodeCreateLine(node))
+	}
+	for _, deployment := range world.AllDeployments(w) {
+		lines = append(lines, deployment.String())
 	}
+	for _, scenario := range sortedScenarios(w) {
+		lines = append(lines, "scenario create "+strings.TrimPrefix(scenario.String(), "scenario "))
+		lines = append(lines, scenario.StepStrings()...)
+	}
+	for _, view := range sortedViews(w) {
+		lines = append(lines, viewCreateLine(view))
+	}
+	for _, pin := range sortedPins(w) {
+		lines = append(lines, pin.String())
+	}
+	for _, style := range world.SortedStyles(w) {
+		lines = append(lines, styleCreateLine(style))
+	}
+	return lines
 }
 
-// --- INTERNAL FUNCTIONS ---
+// treeRestoreLines returns the nest and free commands that move each Item back to its old parent.
+// The map is keyed by Item ID, and an empty parent ID is the world.Tree root.
+func treeRestoreLines(oldParentIds map[string]string) []string {
+	idsByParent := make(map[string][]string)
+	for id, parentId := range oldParentIds {
+		idsByParent[parentId] = append(idsByParent[parentId], quoted(id))
+	}
+	parentIds := make([]string, 0, len(idsByParent))
+	for parentId := range idsByParent {
+		parentIds = append(parentIds, parentId)
+	}
+	sort.Strings(parentIds)
+	lines := make([]string, 0)
+	for _, parentId := range parentIds {
+		ids := idsByParent[parentId]
+		slices.Sort(ids)
+		if parentId == "" {
+			lines = append(lines, fmt.Sprintf("free %s", strings.Join(ids, " ")))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("nest %s in %s", strings.Join(ids, " "), quoted(parentId)))
+	}
+	return lines
+}
 
 func strPtr(s string) *string {
 	return &s
@@ -719,14 +4097,48 @@ func boolPtr(b bool) *bool {
 	return &b
 }
 
+func worldCommand(base CommandBase, input grammar.InputAttributes) (Command, error) {
+	switch CommandVerb(input.Verb) {
+	case Fetch:
+		return &WorldFetchCommand{CommandBase: base}, nil
+	case Set:
+		return &WorldSetCommand{CommandBase: base, Params: world.InfoParamsFromInput(input)}, nil
+	case Save:
+		return &WorldSaveCommand{CommandBase: base}, nil
+	case Load:
+		return &WorldLoadCommand{CommandBase: base}, nil
+	case New:
+		return &WorldNewCommand{CommandBase: base}, nil
+	case List:
+		return &WorldListCommand{CommandBase: base, Limit: limitFromInput(input)}, nil
+	case Use:
+		return &WorldUseCommand{CommandBase: base}, nil
+	case Open:
+		return &WorldOpenCommand{CommandBase: base}, nil
+	case Close:
+		return &WorldCloseCommand{CommandBase: base}, nil
+	case ExportThreats:
+		return &WorldThreatsExportCommand{CommandBase: base, Path: input.Params["path"]}, nil
+	case Undo:
+		return &WorldUndoCommand{CommandBase: base}, nil
+	case Redo:
+		return &WorldRedoCommand{CommandBase: base}, nil
+	default:
+		return nil, errors.New("invalid verb").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "verb", Value: input.Verb}, errors.KvPair{Key: "resourceType", Value: input.ResourceType})
+	}
+}
+
 func itemCommand(base CommandBase, input grammar.InputAttributes) (Command, error) {
+	if verb := CommandVerb(input.Verb); len(input.Selectors) == 1 && (verb == Set || verb == Clear || verb == Delete) {
+		return &ItemBulkCommand{CommandBase: base, Selector: input.Selectors[0]}, nil
+	}
 	switch CommandVerb(input.Verb) {
 	case Create:
 		return &ItemCreateCommand{CommandBase: base, Params: world.ItemParamsFromInput(input)}, nil
 	case Fetch:
 		return &ItemFetchCommand{CommandBase: base}, nil
 	case List:
-		return &ItemListCommand{CommandBase: base, Limit: limitFromInput(input)}, nil
+		return &ItemListCommand{CommandBase: base, Limit: limitFromInput(input), Owner: input.Params["owner"]}, nil
 	case Set:
 		return &ItemSetCommand{CommandBase: base, Params: world.ItemParamsFromInput(input)}, nil
 	case Clear:
@@ -734,13 +4146,49 @@ func itemCommand(base CommandBase, input grammar.InputAttributes) (Command, erro
 	case Delete:
 		return &ItemDeleteCommand{CommandBase: base}, nil
 	case Nest:
-		return &ItemNestCommand{CommandBase: base, Ids: input.ResourceIds, ParentId: input.SecondaryIds[0], oldParentIds: make(map[string]string), noNest: make(map[string]bool)}, nil
+		return &ItemNestCommand{CommandBase: base, Ids: input.ResourceIds, Selectors: input.Selectors, ParentId: input.SecondaryIds[0], oldParentIds: make(map[string]string), noNest: make(map[string]bool)}, nil
 	case Free:
-		return &ItemFreeCommand{CommandBase: base, Ids: input.ResourceIds, oldParentIds: make(map[string]string)}, nil
+		return &ItemFreeCommand{CommandBase: base, Ids: input.ResourceIds, Selectors: input.Selectors, oldParentIds: make(map[string]string)}, nil
+	case Copy:
+		return &ItemCopyCommand{CommandBase: base, WorldName: input.SecondaryIds[0]}, nil
+	case Clone:
+		return &ItemCloneCommand{CommandBase: base, Prefix: input.SecondaryIds[0]}, nil
+	case Archive:
+		return &ItemArchiveCommand{CommandBase: base, Archived: true}, nil
+	case Restore:
+		return &ItemArchiveCommand{CommandBase: base, Archived: false}, nil
+	case Link, Unlink:
+		return &ItemLinkCommand{CommandBase: base, Links: world.LinksFromInput(input), Unlink: CommandVerb(input.Verb) == Unlink}, nil
+	case Merge:
+		return &ItemMergeCommand{CommandBase: base, IntoId: input.SecondaryIds[0]}, nil
+	case Split:
+		return &ItemSplitCommand{CommandBase: base, Targets: input.SecondaryIds, Assignments: input.Assignments}, nil
 	case Exists:
 		return &ItemExistsCommand{CommandBase: base}, nil
 	case InQuery:
 		return &ItemInQueryCommand{CommandBase: base, ParentId: input.SecondaryIds[0]}, nil
+	case In:
+		return &ItemSubtreeCommand{CommandBase: base, Depth: depthFromInput(input)}, nil
+	case Ancestors:
+		return &ItemAncestorsQueryCommand{CommandBase: base}, nil
+	case Siblings:
+		return &ItemSiblingsQueryCommand{CommandBase: base}, nil
+	case Owners:
+		return &ItemOwnersQueryCommand{CommandBase: base}, nil
+	case DataFlowQuery:
+		return &ItemDataFlowQueryCommand{CommandBase: base, Class: input.Params["class"]}, nil
+	case ImportOwners:
+		return &ItemImportOwnersCommand{CommandBase: base, Path: input.Params["path"]}, nil
+	case Deploy, Undeploy:
+		return &ItemDeployCommand{CommandBase: base, NodeId: input.SecondaryIds[0], Undeploy: CommandVerb(input.Verb) == Undeploy}, nil
+	case Deployed:
+		deployed := &ItemDeployedQueryCommand{CommandBase: base}
+		if len(input.SecondaryIds) > 0 {
+			deployed.In = input.SecondaryIds[0]
+		}
+		return deployed, nil
+	case Tree:
+		return &ItemTreeQueryCommand{CommandBase: base, Depth: depthFromInput(input)}, nil
 	case CreateOrFetch:
 		return &ItemCreateOrFetchCommand{CommandBase: base}, nil
 	case CreateOrSet:
@@ -750,6 +4198,60 @@ func itemCommand(base CommandBase, input grammar.InputAttributes) (Command, erro
 	}
 }
 
+func nodeCommand(base CommandBase, input grammar.InputAttributes) (Command, error) {
+	switch CommandVerb(input.Verb) {
+	case Create:
+		return &NodeCreateCommand{CommandBase: base, Params: world.NodeParamsFromInput(input)}, nil
+	case Set:
+		return &NodeSetCommand{CommandBase: base, Params: world.NodeParamsFromInput(input)}, nil
+	case CreateOrSet:
+		return &NodeCreateOrSetCommand{CommandBase: base, Params: world.NodeParamsFromInput(input)}, nil
+	case Delete:
+		return &NodeDeleteCommand{CommandBase: base}, nil
+	case Fetch:
+		return &NodeFetchCommand{CommandBase: base}, nil
+	case List:
+		return &NodeListCommand{CommandBase: base, Limit: limitFromInput(input)}, nil
+	case Export:
+		return &NodeExportCommand{CommandBase: base, Path: input.Params["path"]}, nil
+	default:
+		return nil, errors.New("invalid verb").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "verb", Value: input.Verb}, errors.KvPair{Key: "resourceType", Value: input.ResourceType})
+	}
+}
+
+func scenarioCommand(base CommandBase, input grammar.InputAttributes) (Command, error) {
+	switch CommandVerb(input.Verb) {
+	case Create, CreateOrFetch:
+		return &ScenarioCreateCommand{CommandBase: base, Params: world.ScenarioParamsFromInput(input)}, nil
+	case Set:
+		return &ScenarioSetCommand{CommandBase: base, Params: world.ScenarioParamsFromInput(input)}, nil
+	case CreateOrSet:
+		return &ScenarioCreateOrSetCommand{CommandBase: base, Params: world.ScenarioParamsFromInput(input)}, nil
+	case Delete:
+		return &ScenarioDeleteCommand{CommandBase: base}, nil
+	case Fetch:
+		return &ScenarioFetchCommand{CommandBase: base}, nil
+	case List:
+		return &ScenarioListCommand{CommandBase: base, Limit: limitFromInput(input)}, nil
+	case Export:
+		return &ScenarioExportCommand{CommandBase: base, Path: input.Params["path"]}, nil
+	case Step:
+		step, at, err := world.StepFromInput(input)
+		if err != nil {
+			return nil, err
+		}
+		return &ScenarioStepCommand{CommandBase: base, Step: step, At: at}, nil
+	case Unstep:
+		at, err := world.StepPosition(input)
+		if err != nil {
+			return nil, err
+		}
+		return &ScenarioUnstepCommand{CommandBase: base, At: at}, nil
+	default:
+		return nil, errors.New("invalid verb").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "verb", Value: input.Verb}, errors.KvPair{Key: "resourceType", Value: input.ResourceType})
+	}
+}
+
 func relCommand(base CommandBase, input grammar.InputAttributes) (Command, error) {
 	switch CommandVerb(input.Verb) {
 	case Create:
@@ -764,12 +4266,16 @@ func relCommand(base CommandBase, input grammar.InputAttributes) (Command, error
 		return &RelClearCommand{CommandBase: base, ToId: input.SecondaryIds[0], Params: world.RelParamsFromInput(input)}, nil
 	case Delete:
 		return &RelDeleteCommand{CommandBase: base, ToId: input.SecondaryIds[0]}, nil
+	case Link, Unlink:
+		return &RelLinkCommand{CommandBase: base, ToId: input.SecondaryIds[0], Links: world.LinksFromInput(input), Unlink: CommandVerb(input.Verb) == Unlink}, nil
 	case Exists:
 		return &RelExistsCommand{CommandBase: base, ToId: input.SecondaryIds[0]}, nil
 	case ToQuery:
 		return &RelToQueryCommand{CommandBase: base}, nil
 	case FromQuery:
 		return &RelFromQueryCommand{CommandBase: base}, nil
+	case Crossings:
+		return &RelCrossingsQueryCommand{CommandBase: base}, nil
 	case CreateOrFetch:
 		return &RelCreateOrFetchCommand{CommandBase: base, ToId: input.SecondaryIds[0]}, nil
 	case CreateOrSet:
@@ -780,7 +4286,15 @@ func relCommand(base CommandBase, input grammar.InputAttributes) (Command, error
 }
 
 func limitFromInput(input grammar.InputAttributes) int {
-	v, ok := input.Params["limit"]
+	return intParamFromInput(input, "limit")
+}
+
+func depthFromInput(input grammar.InputAttributes) int {
+	return intParamFromInput(input, "depth")
+}
+
+func intParamFromInput(input grammar.InputAttributes, key string) int {
+	v, ok := input.Params[key]
 	if !ok {
 		return 0
 	}
@@ -790,3 +4304,56 @@ func limitFromInput(input grammar.InputAttributes) int {
 	}
 	return x
 }
+
+func viewCommand(base CommandBase, input grammar.InputAttributes) (Command, error) {
+	switch CommandVerb(input.Verb) {
+	case Create, CreateOrFetch:
+		return &ViewCreateCommand{CommandBase: base, Params: world.ViewParamsFromInput(input)}, nil
+	case Set:
+		return &ViewSetCommand{CommandBase: base, Params: world.ViewParamsFromInput(input)}, nil
+	case CreateOrSet:
+		return &ViewCreateOrSetCommand{CommandBase: base, Params: world.ViewParamsFromInput(input)}, nil
+	case Delete:
+		return &ViewDeleteCommand{CommandBase: base}, nil
+	case Fetch:
+		return &ViewFetchCommand{CommandBase: base}, nil
+	case List:
+		return &ViewListCommand{CommandBase: base, Limit: limitFromInput(input)}, nil
+	default:
+		return nil, errors.New("invalid verb").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "verb", Value: input.Verb}, errors.KvPair{Key: "resourceType", Value: input.ResourceType})
+	}
+}
+
+func styleCommand(base CommandBase, input grammar.InputAttributes) (Command, error) {
+	switch CommandVerb(input.Verb) {
+	case Create, CreateOrFetch:
+		return &StyleCreateCommand{CommandBase: base, Params: world.StyleParamsFromInput(input)}, nil
+	case Set:
+		return &StyleSetCommand{CommandBase: base, Params: world.StyleParamsFromInput(input)}, nil
+	case CreateOrSet:
+		return &StyleCreateOrSetCommand{CommandBase: base, Params: world.StyleParamsFromInput(input)}, nil
+	case Delete:
+		return &StyleDeleteCommand{CommandBase: base}, nil
+	case Fetch:
+		return &StyleFetchCommand{CommandBase: base}, nil
+	case List:
+		return &StyleListCommand{CommandBase: base, Limit: limitFromInput(input)}, nil
+	default:
+		return nil, errors.New("invalid verb").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "verb", Value: input.Verb}, errors.KvPair{Key: "resourceType", Value: input.ResourceType})
+	}
+}
+
+func layoutCommand(base CommandBase, input grammar.InputAttributes) (Command, error) {
+	view, _, toId := world.PinTargetFromInput(input)
+	switch CommandVerb(input.Verb) {
+	case Pin:
+		return &LayoutPinCommand{CommandBase: base, View: view, ToId: toId, Params: world.PinParamsFromInput(input)}, nil
+	case Unpin:
+		return &LayoutUnpinCommand{CommandBase: base, View: view, ToId: toId}, nil
+	case List:
+		_, filtered := input.Params["layout-view"]
+		return &LayoutListCommand{CommandBase: base, View: view, AllPins: !filtered}, nil
+	default:
+		return nil, errors.New("invalid verb").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "verb", Value: input.Verb}, errors.KvPair{Key: "resourceType", Value: input.ResourceType})
+	}
+}
@@ -0,0 +1,537 @@
+package app
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/world"
+	"strings"
+	"testing"
+)
+
+var dualSetup = `item create app type=server name="The App"
+item create db type=database mechanism=Postgres
+item create cache
+item create svc
+item create worker external=true
+nest cache worker in svc
+rel create app db verb=reads async=false
+rel create worker db verb="writes to" mechanism=SQL
+rel create db cache
+node create prod name=Production
+node create prod-k8s kind=cluster parent=prod mechanism=Kubernetes
+deploy app to prod-k8s
+deploy db to prod
+scenario create checkout name=Checkout
+step checkout app db label=read
+step checkout db app label=rows
+step checkout db cache async=true
+view create exec expand="" filter="status:as-is"
+pin app x=10 y=20 width=200
+pin app db waypoints="10,20 30,40"
+pin db x=5 y=5 view=exec
+style create db match="type:database" shape=cylinder color="#438DD5"`
+
+var dualCommands = []string{
+	"item create new-item name=New",
+	"item create app",
+	"item set app name=Renamed type=code",
+	"item set db type=queue external=true expanded=\"more info\"",
+	"item clear app name type",
+	"item clear db mechanism",
+	"item delete db",
+	"item delete svc",
+	"item delete worker",
+	"item delete not-found",
+	"item delete svc --cascade",
+	"item clone svc as eu-",
+	"item clone svc as eu- --all-rels",
+	"item delete worker --cascade",
+	"item set app status=deprecated",
+	"item set db status=planned",
+	"item clear db status",
+	"rel set app db status=retired",
+	"rel create cache db status=planned",
+	"item archive svc",
+	"item archive db",
+	"item restore app",
+	"item set app archived=true",
+	"item set app owner=payments contacts=\"@acme/payments alice@acme.com\" source=\"src/app\"",
+	"item clear app owner contacts source",
+	"item link app runbook=\"https://wiki.acme.com/app\" adr=\"docs/adr/0001.md\"",
+	"item set app dashboard=\"https://grafana.acme.com/d/app\" name=Linked",
+	"item clear app links",
+	"item set db classification=\"pii,pci\"",
+	"rel set app db classification=pii",
+	"item set db boundary=data",
+	"item set db tags=\"pci,edge\"",
+	"rel link app db dashboard=\"https://grafana.acme.com/d/db\" repo=\"file:///src/db\"",
+	"item merge worker into app",
+	"item merge svc into db",
+	"item split db into db-read db-write assign app=db-read worker=db-write",
+	"item split svc into svc svc-jobs assign worker=svc-jobs",
+	"item split db into reader",
+	"nest app db in svc",
+	"nest worker in app",
+	"nest cache in svc",
+	"free cache worker",
+	"free app",
+	"item fresh",
+	"item app",
+	"item app mechanism=Go",
+	"item fresh name=Fresh",
+	"rel create cache app verb=warms",
+	"rel create app db",
+	"rel set app db verb=writes async=true",
+	"rel clear worker db verb mechanism",
+	"rel delete worker db",
+	"rel delete db app",
+	"rel cache svc",
+	"rel app db",
+	"rel app db mechanism=TCP",
+	"rel svc app verb=calls",
+	"node create staging name=Staging",
+	"node create prod-k8s kind=cluster parent=prod mechanism=Kubernetes",
+	"node create prod-k8s",
+	"node set prod-k8s name=K8s mechanism=EKS",
+	"node delete prod-k8s",
+	"node prod-eu kind=cluster parent=prod",
+	"node prod name=Prod",
+	"deploy cache to prod-k8s",
+	"undeploy app from prod-k8s",
+	"undeploy db from prod",
+	"item merge db into app",
+	"scenario create refund name=Refund",
+	"scenario create checkout",
+	"scenario set checkout name=Renamed",
+	"scenario delete checkout",
+	"scenario refund name=Refund",
+	"scenario checkout name=Other",
+	"step checkout worker db label=writes async=true",
+	"step checkout app db at=1",
+	"unstep checkout 2",
+	"view create around-db focus=db hops=2 expand=svc",
+	"view create exec",
+	"view set exec name=Exec filter=\"tag:pci\"",
+	"view delete exec",
+	"view exec expand=svc",
+	"view fresh",
+	"layout pin app x=120 y=40",
+	"layout pin cache x=1 y=2 height=50 view=exec",
+	"layout pin app db waypoints=\"1,1 2,2\"",
+	"layout unpin app",
+	"layout unpin app db",
+	"layout unpin db view=exec",
+	"pin svc x=0 y=0",
+	"style create async match=\"async:true\" line=dashed",
+	"style create db",
+	"style set db name=Databases color=\"\" border=bold",
+	"style delete db",
+	"style db shape=rounded",
+	"style external match=\"external:true\" color=\"#999\"",
+	"rel delete app db",
+	"item delete cache",
+	"world set name=Renamed expanded=\"About the world\"",
+	"world set id=other-id",
+	"world set theme=print",
+	"world set version=3",
+	"world new fresh-world",
+}
+
+func TestCommandDualRoundTrip(t *testing.T) {
+	for i, s := range dualCommands {
+		t.Run(fmt.Sprintf("TestCommandDualRoundTrip-%d", i), func(t *testing.T) {
+			w := dualWorld(t)
+			expected := dualWorld(t)
+
+			c := mustCommand(t, s)
+			if _, err := c.Execute(w); err != nil {
+				t.Fatalf("error executing %q: %v", s, err)
+			}
+			dual, err := c.Dual()
+			if err != nil {
+				t.Fatalf("error getting dual for %q: %v", s, err)
+			}
+			if dual == nil {
+				if !world.WorldEqual(w, expected) {
+					t.Fatalf("nil dual for %q but the world changed", s)
+				}
+				return
+			}
+
+			// Serialize the dual and restore it, as if sent to another process.
+			restored := mustCommand(t, dual.String())
+			if restored.String() != dual.String() {
+				t.Fatalf("expected restored dual %q, got %q", dual.String(), restored.String())
+			}
+			if _, err := restored.Execute(w); err != nil {
+				t.Fatalf("error executing dual %q: %v", dual.String(), err)
+			}
+			if !world.WorldEqual(w, expected) {
+				t.Fatalf("world not restored by dual of %q:\n%s\ngot:\n%s\nexpected:\n%s", s, dual.String(), w.String(), expected.String())
+			}
+		})
+	}
+}
+
+func TestCommandUndoUsesDual(t *testing.T) {
+	for i, s := range dualCommands {
+		t.Run(fmt.Sprintf("TestCommandUndoUsesDual-%d", i), func(t *testing.T) {
+			w := dualWorld(t)
+			c := mustCommand(t, s)
+			if _, err := c.Execute(w); err != nil {
+				t.Fatalf("error executing %q: %v", s, err)
+			}
+			if err := c.Undo(w); err != nil {
+				t.Fatalf("error undoing %q: %v", s, err)
+			}
+			if !world.WorldEqual(w, dualWorld(t)) {
+				t.Fatalf("world not restored by undo of %q", s)
+			}
+		})
+	}
+}
+
+func TestCommandDualReplica(t *testing.T) {
+	w := dualWorld(t)
+	replica := dualWorld(t)
+
+	// Apply a sequence of mutations locally, and keep the duals as an undo log.
+	log := make(CommandList, 0)
+	for _, s := range []string{"item set app name=Renamed", "nest app in svc", "item delete db", "rel create app cache verb=reads"} {
+		c := mustCommand(t, s)
+		if _, err := c.Execute(w); err != nil {
+			t.Fatalf("error executing %q: %v", s, err)
+		}
+		if _, err := mustCommand(t, c.String()).Execute(replica); err != nil {
+			t.Fatalf("error executing %q on replica: %v", s, err)
+		}
+		log = append(log, c)
+	}
+	if !world.WorldEqual(w, replica) {
+		t.Fatalf("replica diverged")
+	}
+
+	// Revert the replica from the serialized undo log.
+	dual, err := log.Dual()
+	if err != nil {
+		t.Fatalf("error getting dual for log: %v", err)
+	}
+	if _, err := mustCommand(t, dual.String()).Execute(replica); err != nil {
+		t.Fatalf("error executing dual log: %v", err)
+	}
+	if !world.WorldEqual(replica, dualWorld(t)) {
+		t.Fatalf("replica not restored by dual log:\n%s", dual.String())
+	}
+}
+
+func TestQueryCommandDualIsNil(t *testing.T) {
+	for _, s := range []string{"world", "item fetch app", "item list", "item? app", "in? cache svc", "rel fetch app db", "rel list", "rel? app db", "to? db", "from? app"} {
+		c := mustCommand(t, s)
+		if _, err := c.Execute(dualWorld(t)); err != nil {
+			t.Fatalf("error executing %q: %v", s, err)
+		}
+		if dual, err := c.Dual(); dual != nil || err != nil {
+			t.Fatalf("expected nil dual for %q, got %v, %v", s, dual, err)
+		}
+	}
+}
+
+func TestItemDeleteModes(t *testing.T) {
+	for _, c := range []struct {
+		In        string
+		Deleted   []string
+		Hoisted   []string
+		RelsAfter int
+	}{
+		// By default, the components of svc move up to the root, and keep their Rel.
+		{"item delete svc", []string{"svc"}, []string{"cache", "worker"}, 3},
+		// With cascade, the whole subtree goes, with every Rel to or from it.
+		{"item delete svc --cascade", []string{"svc", "cache", "worker"}, []string{}, 1},
+	} {
+		t.Run(c.In, func(t *testing.T) {
+			w := dualWorld(t)
+			cmd := mustCommand(t, c.In)
+			if _, err := cmd.Execute(w); err != nil {
+				t.Fatalf("error executing %q: %v", c.In, err)
+			}
+			for _, id := range c.Deleted {
+				if _, ok := w.ItemFetch(id); ok {
+					t.Fatalf("expected %s deleted", id)
+				}
+			}
+			for _, id := range c.Hoisted {
+				if parentId, ok := w.Parent(id); !ok || parentId != "" {
+					t.Fatalf("expected %s hoisted to the root", id)
+				}
+			}
+			if len(w.RelList(0)) != c.RelsAfter {
+				t.Fatalf("expected %d Rel after %q, got %d", c.RelsAfter, c.In, len(w.RelList(0)))
+			}
+			if err := cmd.Undo(w); err != nil {
+				t.Fatalf("error undoing %q: %v", c.In, err)
+			}
+			if !world.WorldEqual(w, dualWorld(t)) {
+				t.Fatalf("expected undo of %q to restore items, nesting and Rel:\n%s", c.In, w.String())
+			}
+		})
+	}
+}
+
+func TestItemClone(t *testing.T) {
+	for _, c := range []struct {
+		In     string
+		Items  map[string]string // Items are the clones, with their parents.
+		Rels   []string
+		NoRels []string
+	}{
+		{"item clone svc as eu-", map[string]string{"eu-svc": "", "eu-svc.eu-cache": "eu-svc", "eu-svc.eu-worker": "eu-svc"}, []string{}, []string{"eu-svc.eu-worker::db", "db::eu-svc.eu-cache"}},
+		{"item clone svc as eu- --all-rels", map[string]string{"eu-svc": "", "eu-svc.eu-cache": "eu-svc", "eu-svc.eu-worker": "eu-svc"}, []string{"eu-svc.eu-worker::db", "db::eu-svc.eu-cache"}, []string{}},
+		{"item clone cache as eu-", map[string]string{"eu-cache": "svc"}, []string{}, []string{"db::eu-cache"}},
+	} {
+		t.Run(c.In, func(t *testing.T) {
+			w := dualWorld(t)
+			cmd := mustCommand(t, c.In)
+			if _, err := cmd.Execute(w); err != nil {
+				t.Fatalf("error executing %q: %v", c.In, err)
+			}
+			for id, parentId := range c.Items {
+				if p, ok := w.Parent(id); !ok || p != parentId {
+					t.Fatalf("expected clone %s under %q, got %q (found: %t)", id, parentId, p, ok)
+				}
+			}
+			assertRels(t, w, c.Rels, c.NoRels)
+			if err := cmd.Undo(w); err != nil {
+				t.Fatalf("error undoing %q: %v", c.In, err)
+			}
+			if !world.WorldEqual(w, dualWorld(t)) {
+				t.Fatalf("expected undo of %q to remove the clones", c.In)
+			}
+		})
+	}
+
+	// Clones of a dotted ID stay beside the original, with the prefix on the local ID.
+	w := world.CreateWorld("clone-world")
+	if _, err := mustCommand(t, "item create payments\nitem create payments.db\nitem create payments.db.replica\nrel create payments.db payments.db.replica").Execute(w); err != nil {
+		t.Fatalf("error setting up world: %v", err)
+	}
+	if _, err := mustCommand(t, "item clone payments.db as eu-").Execute(w); err != nil {
+		t.Fatalf("error cloning: %v", err)
+	}
+	if parentId, _ := w.Parent("payments.eu-db.eu-replica"); parentId != "payments.eu-db" || len(w.RelFetch("payments.eu-db", "payments.eu-db.eu-replica", true)) != 1 {
+		t.Fatalf("expected the clones and their Rel under payments, got %v", w.Resolve("payments.eu-db.eu-replica"))
+	}
+
+	// Nested clones take the ID of their cloned parent, whatever the ID of the original.
+	w = world.CreateWorld("clone-world")
+	if _, err := mustCommand(t, "item create p\nitem create p.q\nitem create r\nnest r in p.q").Execute(w); err != nil {
+		t.Fatalf("error setting up world: %v", err)
+	}
+	if _, err := mustCommand(t, "item clone p as eu-").Execute(w); err != nil {
+		t.Fatalf("error cloning: %v", err)
+	}
+	for id, parentId := range map[string]string{"eu-p": "", "eu-p.eu-q": "eu-p", "eu-p.eu-q.eu-r": "eu-p.eu-q"} {
+		if p, ok := w.Parent(id); !ok || p != parentId {
+			t.Fatalf("expected clone %s under %q, got %q (found: %t)", id, parentId, p, ok)
+		}
+	}
+	if _, ok := w.ItemFetch("p.eu-q"); ok {
+		t.Fatalf("expected no clone with the path of the original")
+	}
+
+	if _, err := mustCommand(t, "item clone svc as \"\"").Execute(dualWorld(t)); err == nil {
+		t.Fatalf("expected conflict cloning without a prefix")
+	}
+}
+
+func TestItemMerge(t *testing.T) {
+	for _, c := range []struct {
+		In      string
+		Parents map[string]string // Parents are the Items that moved, with their new parents.
+		Rels    []string
+		NoRels  []string
+	}{
+		// The Rel of worker move to app, and app already has the one to db.
+		{"item merge worker into app", map[string]string{}, []string{"app::db"}, []string{"worker::db"}},
+		{"item merge svc into db", map[string]string{"cache": "db", "worker": "db"}, []string{"worker::db", "db::cache"}, []string{}},
+		// The Rel between cache and db would point db at itself, so it goes.
+		{"item merge cache into db", map[string]string{}, []string{"app::db"}, []string{"db::cache", "db::db"}},
+	} {
+		t.Run(c.In, func(t *testing.T) {
+			w := dualWorld(t)
+			cmd := mustCommand(t, c.In)
+			if _, err := cmd.Execute(w); err != nil {
+				t.Fatalf("error executing %q: %v", c.In, err)
+			}
+			for id, parentId := range c.Parents {
+				if p, ok := w.Parent(id); !ok || p != parentId {
+					t.Fatalf("expected %s under %q, got %q (found: %t)", id, parentId, p, ok)
+				}
+			}
+			assertRels(t, w, c.Rels, c.NoRels)
+			if err := cmd.Undo(w); err != nil {
+				t.Fatalf("error undoing %q: %v", c.In, err)
+			}
+			if !world.WorldEqual(w, dualWorld(t)) {
+				t.Fatalf("expected undo of %q to restore the world:\n%s", c.In, w.String())
+			}
+		})
+	}
+
+	for _, s := range []string{"item merge app into app", "item merge svc into cache", "item merge nope into app", "item merge app into nope"} {
+		if _, err := mustCommand(t, s).Execute(dualWorld(t)); err == nil {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+
+	// A component with the same local ID as one under the other Item fails the merge, so the steps before it are reverted.
+	w := world.CreateWorld("merge-world")
+	setup := "item create a\nitem create a.x\nitem create b\nitem create b.x\nitem create c\nrel create a c"
+	if _, err := mustCommand(t, setup).Execute(w); err != nil {
+		t.Fatalf("error setting up world: %v", err)
+	}
+	before, _ := world.Clone(w)
+	if _, err := mustCommand(t, "item merge a into b").Execute(w); err == nil {
+		t.Fatalf("expected conflict merging a into b")
+	}
+	if !world.WorldEqual(w, before) {
+		t.Fatalf("expected a failed merge to change nothing:\n%s", w.String())
+	}
+}
+
+func TestItemSplit(t *testing.T) {
+	for _, c := range []struct {
+		In      string
+		Deleted bool
+		Parents map[string]string // Parents are the Items with their parents after the split.
+		Rels    []string
+		NoRels  []string
+	}{
+		{"item split db into db-read db-write assign app=db-read worker=db-write", true, map[string]string{"db-read": "", "db-write": ""}, []string{"app::db-read", "worker::db-write", "db-read::cache"}, []string{"app::db-write"}},
+		{"item split svc into svc svc-jobs assign worker=svc-jobs", false, map[string]string{"svc-jobs": "", "worker": "svc-jobs", "cache": "svc"}, []string{}, []string{}},
+		{"item split cache into cache-a cache-b", true, map[string]string{"cache-a": "svc", "cache-b": "svc"}, []string{"db::cache-a"}, []string{"db::cache-b"}},
+	} {
+		t.Run(c.In, func(t *testing.T) {
+			w := dualWorld(t)
+			cmd := mustCommand(t, c.In)
+			if _, err := cmd.Execute(w); err != nil {
+				t.Fatalf("error executing %q: %v", c.In, err)
+			}
+			if _, ok := w.ItemFetch(cmd.(*ItemSplitCommand).Id); ok == c.Deleted {
+				t.Fatalf("expected %s deleted: %t", cmd.(*ItemSplitCommand).Id, c.Deleted)
+			}
+			for id, parentId := range c.Parents {
+				if p, ok := w.Parent(id); !ok || p != parentId {
+					t.Fatalf("expected %s under %q, got %q (found: %t)", id, parentId, p, ok)
+				}
+			}
+			assertRels(t, w, c.Rels, c.NoRels)
+			if err := cmd.Undo(w); err != nil {
+				t.Fatalf("error undoing %q: %v", c.In, err)
+			}
+			if !world.WorldEqual(w, dualWorld(t)) {
+				t.Fatalf("expected undo of %q to restore the world:\n%s", c.In, w.String())
+			}
+		})
+	}
+
+	// The new Items keep the attributes of the original, except the name.
+	w := dualWorld(t)
+	if _, err := mustCommand(t, "item split db into db-read db-write").Execute(w); err != nil {
+		t.Fatalf("error splitting: %v", err)
+	}
+	if item, _ := w.ItemFetch("db-write"); item.Type != world.Database || item.Mechanism != "Postgres" || item.Name != "" {
+		t.Fatalf("expected db-write to copy db, got %s", item.String())
+	}
+
+	for _, s := range []string{"item split db into app", "item split db into a b assign app=c", "item split db into a b assign svc=a", "item split db into a a", "item split nope into a"} {
+		if _, err := mustCommand(t, s).Execute(dualWorld(t)); err == nil {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+}
+
+// assertRels fails the test if a Rel in rels is missing, or a Rel in noRels exists. Each is given by its Rel ID.
+func assertRels(t *testing.T, w world.World, rels, noRels []string) {
+	t.Helper()
+	for _, id := range rels {
+		ids := strings.Split(id, world.RelIdSeparator)
+		if len(w.RelFetch(ids[0], ids[1], true)) == 0 {
+			t.Fatalf("expected Rel %s", id)
+		}
+	}
+	for _, id := range noRels {
+		ids := strings.Split(id, world.RelIdSeparator)
+		if len(w.RelFetch(ids[0], ids[1], true)) != 0 {
+			t.Fatalf("expected no Rel %s", id)
+		}
+	}
+}
+
+func TestCommandFromStringInvalid(t *testing.T) {
+	for _, s := range []string{"", "not a command", "item create app\nnope"} {
+		if _, err := CommandFromString(s); err == nil {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+}
+
+func TestItemArchiveUndo(t *testing.T) {
+	w := world.CreateWorld("archive-world")
+	if _, err := mustCommand(t, "item create legacy\nitem create legacy.db").Execute(w); err != nil {
+		t.Fatalf("error setting up world: %v", err)
+	}
+	archiveDb, archiveLegacy := mustCommand(t, "item archive legacy.db"), mustCommand(t, "item archive legacy")
+	for _, c := range []Command{archiveDb, archiveLegacy} {
+		if _, err := c.Execute(w); err != nil {
+			t.Fatalf("error executing %q: %v", c, err)
+		}
+	}
+	// Undo reverts the archive of the component, even while its parent is still archived.
+	if err := archiveDb.Undo(w); err != nil {
+		t.Fatalf("error undoing %q: %v", archiveDb, err)
+	}
+	if item, _ := w.ItemFetch("legacy.db"); item.Archived || !w.Archived("legacy.db") {
+		t.Fatalf("expected legacy.db unarchived itself, and hidden by legacy")
+	}
+}
+
+func TestResolvePathsRaw(t *testing.T) {
+	w := world.CreateWorld("paths-world")
+	for _, s := range []string{"item create payments", "item create api", "item create db", "item create orders", "nest api db in payments"} {
+		if _, err := mustCommand(t, s).Execute(w); err != nil {
+			t.Fatalf("error executing %q: %v", s, err)
+		}
+	}
+	for _, c := range []struct{ In, Raw string }{
+		{`item set payments.api name="payments   api"`, `item set "api" name="payments   api"`},
+		{"rel create payments.api payments.db verb=payments.db", `rel create "api" "db" verb=payments.db`},
+		{`nest payments.api "payments.db" in orders`, `nest "api" "db" in orders`},
+		{"item split payments into payments.web payments.jobs assign payments.api=payments.jobs", `item split payments into payments.web payments.jobs assign "api"=payments.jobs`},
+		{"item list --ids", "item list --ids"},
+	} {
+		resolved, err := resolvePaths(w, mustCommand(t, c.In))
+		if err != nil {
+			t.Fatalf("error resolving %q: %v", c.In, err)
+		}
+		if resolved.String() != c.Raw {
+			t.Errorf("expected %q to read %q, got %q", c.In, c.Raw, resolved.String())
+		}
+	}
+}
+
+// --- HELPERS ---
+
+func dualWorld(t *testing.T) world.World {
+	w := world.CreateWorld("dual-world").SetVersion(2)
+	if _, err := mustCommand(t, dualSetup).Execute(w); err != nil {
+		t.Fatalf("error setting up world: %v", err)
+	}
+	return w
+}
+
+func mustCommand(t *testing.T, s string) Command {
+	c, err := CommandFromString(s)
+	if err != nil {
+		t.Fatalf("error parsing %q: %v", s, err)
+	}
+	return c
+}
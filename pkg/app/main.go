@@ -6,12 +6,14 @@ import (
 	"github.com/williamflynt/topolith/pkg/grammar"
 	"github.com/williamflynt/topolith/pkg/persistence"
 	"github.com/williamflynt/topolith/pkg/world"
+	"sort"
 )
 
 type App interface {
-	World() world.World                   // World returns the world.World associated with this App.
+	World() world.World                   // World returns the current world.World associated with this App.
+	Worlds() []string                     // Worlds returns the sorted names of all open world.World. The current world.World is one of them.
 	Exec(s string) string                 // Exec parses the given string to a valid Command and executes it. Return a string response in accordance with our grammar.
-	History() []Command                   // History returns the list of Command that have been executed for the present state of the world.World.
+	History() []Command                   // History returns the list of Command that have been executed for the present state of the current world.World.
 	CanUndo() bool                        // CanUndo indicates whether more Command objects exist to Undo.
 	CanRedo() bool                        // CanRedo indicates whether more Command objects exist to Redo.
 	Persistence() persistence.Persistence // Persistence returns the persistence.Persistence object associated with this App.
@@ -22,33 +24,54 @@ func NewApp(world world.World) (App, error) {
 		return nil, errors.New("cannot create App with nil World").UseCode(errors.TopolithErrorInvalid)
 	}
 	return &app{
-		world:       world,
-		commands:    make([]Command, 0),
-		commandsIdx: -1,
+		sessions:    map[string]*session{world.Name(): newSession(world)},
+		current:     world.Name(),
 		persistence: persistence.NewFilePersistence(),
 	}, nil
 }
 
 // app implements App.
 type app struct {
-	world       world.World // world is the world.World associated with this App.
+	sessions    map[string]*session // sessions are the open world.World with their history, keyed by the name each was opened with.
+	current     string              // current is the key of the session that receives Command.
+	persistence persistence.Persistence
+}
+
+// session is an open world.World in the App, with its own Command history.
+type session struct {
+	world       world.World // world is the world.World for this session.
 	commands    []Command   // commands is a list of Command that have been executed.
 	commandsIdx int         // commandsIdx is the index of the last executed Command in the commands list. It must initialize to -1.
-	persistence persistence.Persistence
+}
+
+func newSession(w world.World) *session {
+	return &session{
+		world:       w,
+		commands:    make([]Command, 0),
+		commandsIdx: -1,
+	}
 }
 
 func (h *app) World() world.World {
-	return h.world
+	return h.sessions[h.current].world
+}
+
+func (h *app) Worlds() []string {
+	names := make([]string, 0, len(h.sessions))
+	for name := range h.sessions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 func (h *app) Exec(s string) string {
 	p, err := grammar.Parse(s)
-	if err != nil || p.StmtType != "Command" {
-		if p != nil {
-			p.PrintSyntaxTree()
-		}
-		return errors.New("invalid input").UseCode(errors.TopolithErrorInvalid).WithError(err).WithDescription("invalid input").WithData(errors.KvPair{Key: "input", Value: s}).String()
-
+	if err != nil {
+		return parseError(err, s).String()
+	}
+	if p.StmtType != "Command" {
+		return errors.New("expected a command").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "input", Value: s}).String()
 	}
 	c, err := InputToCommand(p.InputAttributes)
 	if err != nil {
@@ -56,28 +79,28 @@ func (h *app) Exec(s string) string {
 	}
 	stringerObj, err := h.exec(c)
 	if err != nil {
-		return errors.New("error executing command").UseCode(errors.TopolithErrorCommandErr).WithError(err).WithDescription("unexpected error executing command").WithData(errors.KvPair{Key: "input", Value: s}).String()
+		missing, suggestions := errors.Suggestions(err)
+		return errors.New("error executing command").UseCode(errors.TopolithErrorCommandErr).WithError(err).WithDescription("unexpected error executing command").WithData(errors.KvPair{Key: "input", Value: s}).WithSuggestions(missing, suggestions...).String()
 	}
 	response := okString(stringerObj, err)
 	if p, err := grammar.Parse(response); err != nil || p.StmtType != "Response" {
-		if p != nil {
-			p.PrintSyntaxTree()
-		}
 		return errors.New("error generating response").UseCode(errors.TopolithErrorInternal).WithError(err).WithDescription("unexpected error generating response").WithData(errors.KvPair{Key: "input", Value: s}).String()
 	}
 	return response
 }
 
 func (h *app) History() []Command {
-	return h.commands[:h.commandsIdx+1]
+	current := h.sessions[h.current]
+	return current.commands[:current.commandsIdx+1]
 }
 
 func (h *app) CanUndo() bool {
-	return h.commandsIdx >= 0
+	return h.sessions[h.current].commandsIdx >= 0
 }
 
 func (h *app) CanRedo() bool {
-	return h.commandsIdx < len(h.commands)-1
+	current := h.sessions[h.current]
+	return current.commandsIdx < len(current.commands)-1
 }
 
 func (h *app) Persistence() persistence.Persistence {
@@ -87,40 +110,189 @@ func (h *app) Persistence() persistence.Persistence {
 // --- INTERNAL ---
 
 func (h *app) exec(c Command) (fmt.Stringer, error) {
-	h.commands = append(h.commands, c)
-	h.commandsIdx++
-	return c.Execute(h.world)
+	c, err := resolvePaths(h.World(), c)
+	if err != nil {
+		return nil, err
+	}
+	if pc, ok := c.(persistenceCommand); ok {
+		pc.usePersistence(h.persistence)
+	}
+	if sc, ok := c.(sessionCommand); ok {
+		if isDryRun(c) {
+			return nil, errors.New("cannot dry run a command on open worlds").UseCode(errors.TopolithErrorInvalid)
+		}
+		if hasView(c) {
+			return nil, errors.New("cannot view a command on open worlds").UseCode(errors.TopolithErrorInvalid)
+		}
+		// Session commands act on the App itself, so they aren't part of any world.World history.
+		sc.useApp(h)
+		return c.Execute(h.World())
+	}
+	key := h.current
+	if cc, ok := c.(crossWorldCommand); ok {
+		if _, found := h.sessions[cc.targetWorld()]; !found {
+			return nil, errors.New("World is not open").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "name", Value: cc.targetWorld()})
+		}
+		cc.useSource(h.World())
+		key = cc.targetWorld()
+	}
+	s := h.sessions[key]
+	idx := s.commandsIdx
+	result, err := s.exec(c)
+	// A world.World renamed by new, load or set can't take the name of another open world.World, so we revert the Command.
+	if rekeyErr := h.rekey(key); rekeyErr != nil {
+		if s.commandsIdx > idx {
+			if undoErr, _ := s.undo(); undoErr != nil {
+				return nil, undoErr
+			}
+			s.commands = s.commands[:s.commandsIdx+1]
+		}
+		return nil, rekeyErr
+	}
+	return result, err
 }
 
-func (h *app) undo() (error, int) {
-	if h.commandsIdx < 0 {
+// undo reverts the last Command in the history of the current session, unless that renames it onto another open world.World.
+func (h *app) undo() error {
+	s := h.sessions[h.current]
+	if err, _ := s.undo(); err != nil {
+		return err
+	}
+	if err := h.rekey(h.current); err != nil {
+		if redoErr, _ := s.redo(); redoErr != nil {
+			return redoErr
+		}
+		return err
+	}
+	return nil
+}
+
+// redo executes again the last Command undone in the current session, unless that renames it onto another open world.World.
+func (h *app) redo() error {
+	s := h.sessions[h.current]
+	if err, _ := s.redo(); err != nil {
+		return err
+	}
+	if err := h.rekey(h.current); err != nil {
+		if undoErr, _ := s.undo(); undoErr != nil {
+			return undoErr
+		}
+		return err
+	}
+	return nil
+}
+
+// rekey moves the session with the given key to the name of its world.World, if that changed.
+// The current session stays current. Return an error if another session has that name, and leave the sessions alone.
+func (h *app) rekey(key string) error {
+	s := h.sessions[key]
+	name := s.world.Name()
+	if name == key {
+		return nil
+	}
+	if h.sessions[name] != nil {
+		return errors.New("World is already open").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "name", Value: name})
+	}
+	delete(h.sessions, key)
+	h.sessions[name] = s
+	if h.current == key {
+		h.current = name
+	}
+	return nil
+}
+
+// open adds the world.World as a session under the given name, and makes it current.
+func (h *app) open(name string, w world.World) {
+	h.sessions[name] = newSession(w)
+	h.current = name
+}
+
+// use makes the session with the given name current.
+func (h *app) use(name string) error {
+	if _, ok := h.sessions[name]; !ok {
+		return errors.New("World is not open").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "name", Value: name})
+	}
+	h.current = name
+	return nil
+}
+
+// close removes the session with the given name. If it was current, the first remaining session by name becomes current.
+func (h *app) close(name string) error {
+	if _, ok := h.sessions[name]; !ok {
+		return errors.New("World is not open").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "name", Value: name})
+	}
+	if len(h.sessions) == 1 {
+		return errors.New("cannot close the last open World").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "name", Value: name})
+	}
+	delete(h.sessions, name)
+	if h.current == name {
+		h.current = h.Worlds()[0]
+	}
+	return nil
+}
+
+func (s *session) exec(c Command) (fmt.Stringer, error) {
+	if isDryRun(c) {
+		// A dry run changes nothing, so it isn't part of history.
+		return dryRun(s.world, c)
+	}
+	if hasView(c) {
+		// Neither does a query on a view.
+		return inView(s.world, c)
+	}
+	result, err := c.Execute(s.world)
+	if err != nil {
+		return result, err
+	}
+	// Only a Command with something to revert is part of history, so Undo skips queries.
+	if dual, dualErr := c.Dual(); dual == nil && dualErr == nil {
+		return result, nil
+	}
+	// Executing a new Command discards anything we could have redone.
+	s.commands = append(s.commands[:s.commandsIdx+1], c)
+	s.commandsIdx++
+	return result, nil
+}
+
+func (s *session) undo() (error, int) {
+	if s.commandsIdx < 0 {
 		return nil, 0
 	}
-	if err := h.commands[h.commandsIdx].Undo(h.world); err != nil {
+	if err := s.commands[s.commandsIdx].Undo(s.world); err != nil {
 		// We aren't going to validate state of the World. But a problem happened.
-		// Clear app, reset commandsIdx, and return the error.
-		h.commands = make([]Command, 0)
-		h.commandsIdx = -1
+		// Clear history, reset commandsIdx, and return the error.
+		s.commands = make([]Command, 0)
+		s.commandsIdx = -1
 		return err, 0
 	}
-	h.commandsIdx--
-	return nil, len(h.commands) - h.commandsIdx - 1
+	s.commandsIdx--
+	return nil, len(s.commands) - s.commandsIdx - 1
 }
 
-func (h *app) redo() (error, int) {
-	if h.commandsIdx >= len(h.commands)-1 {
+func (s *session) redo() (error, int) {
+	if s.commandsIdx >= len(s.commands)-1 {
 		return nil, 0
 	}
-	_, err := h.commands[h.commandsIdx].Execute(h.world)
+	_, err := s.commands[s.commandsIdx+1].Execute(s.world)
 	if err != nil {
 		// We aren't going to validate state of the World. But a problem happened.
-		// Clear app, reset commandsIdx, and return the error.
-		h.commands = make([]Command, 0)
-		h.commandsIdx = -1
+		// Clear history, reset commandsIdx, and return the error.
+		s.commands = make([]Command, 0)
+		s.commandsIdx = -1
 		return err, 0
 	}
-	h.commandsIdx++
-	return nil, len(h.commands) - h.commandsIdx - 1
+	s.commandsIdx++
+	return nil, len(s.commands) - s.commandsIdx - 1
+}
+
+// parseError returns the error from grammar.Parse with the input attached.
+// The grammar describes where parsing failed, so we keep its message rather than wrapping it.
+func parseError(err error, input string) errors.TopolithError {
+	te, ok := err.(errors.TopolithError)
+	if !ok {
+		te = errors.New("invalid input").UseCode(errors.TopolithErrorInvalid).WithError(err)
+	}
+	return te.WithData(errors.KvPair{Key: "input", Value: input})
 }
 
 func errOrEmpty(err error) string {
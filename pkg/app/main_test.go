@@ -1,8 +1,14 @@
 package app
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/williamflynt/topolith/pkg/grammar"
 	"github.com/williamflynt/topolith/pkg/world"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -23,3 +29,1297 @@ func TestBareWorldResponse(t *testing.T) {
 		t.Fatalf("expected world type, got %s", p.Response.Object.Type)
 	}
 }
+
+func TestWorldFileCommands(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	testApp.Persistence().SetSourcePath(t.TempDir())
+	w := testApp.World()
+
+	for _, s := range []string{"item create abc123", "world save", "world new other-world", "item create def456", "world save", "world load test-world"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("expected 200 status code for %q, got %d", s, code)
+		}
+	}
+	if w.Name() != "test-world" {
+		t.Fatalf("expected the App World to be replaced in place, got %s", w.Name())
+	}
+	if _, ok := w.ItemFetch("abc123"); !ok {
+		t.Fatalf("expected loaded World to have Item abc123")
+	}
+
+	// Loading is undone by rebuilding the previous World.
+	c := testApp.History()[len(testApp.History())-1]
+	if err := c.Undo(w); err != nil {
+		t.Fatalf("error undoing load: %v", err)
+	}
+	if _, ok := w.ItemFetch("def456"); w.Name() != "other-world" || !ok {
+		t.Fatalf("expected undo to restore other-world, got %s", w.Name())
+	}
+
+	p, err := grammar.Parse(testApp.Exec("world list"))
+	if err != nil {
+		t.Fatalf("error parsing response: %v", err)
+	}
+	if p.Response.Object.Repr != `["other-world","test-world"]` {
+		t.Fatalf("expected both saved Worlds, got %s", p.Response.Object.Repr)
+	}
+
+	if code := responseCode(t, testApp.Exec("world load not-saved")); code == 200 {
+		t.Fatalf("expected error loading an unsaved World")
+	}
+}
+
+// --- HELPERS ---
+
+func responseCode(t *testing.T, response string) int {
+	p, err := grammar.Parse(response)
+	if err != nil {
+		t.Fatalf("error parsing response %q: %v", response, err)
+	}
+	return p.Response.Status.Code
+}
+
+func TestMultipleWorlds(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("current"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	testApp.Persistence().SetSourcePath(t.TempDir())
+	current := testApp.World()
+
+	for _, s := range []string{
+		"item create api", "item create handler", "item create store", "item create client",
+		"nest handler store in api", "rel create handler store verb=writes", "rel create client api",
+		"world open target", "item create client", "world use current",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("expected 200 status code for %q, got %d", s, code)
+		}
+	}
+	if worlds := testApp.Worlds(); len(worlds) != 2 || worlds[0] != "current" || worlds[1] != "target" {
+		t.Fatalf("expected current and target Worlds, got %v", worlds)
+	}
+
+	// Copy from the current World into the target, which keeps its own history.
+	historyLen := len(testApp.History())
+	if code := responseCode(t, testApp.Exec("item copy api to target")); code != 200 {
+		t.Fatalf("expected 200 status code for copy, got %d", code)
+	}
+	if len(testApp.History()) != historyLen {
+		t.Fatalf("expected copy to stay out of the current World history")
+	}
+	if code := responseCode(t, testApp.Exec("world use target")); code != 200 {
+		t.Fatalf("expected 200 status code for use, got %d", code)
+	}
+	target := testApp.World()
+	if target == current {
+		t.Fatalf("expected the target World to be current")
+	}
+	for _, id := range []string{"api", "handler", "store"} {
+		if _, ok := target.ItemFetch(id); !ok {
+			t.Fatalf("expected %s in target World", id)
+		}
+	}
+	if !target.In("store", "api", false) {
+		t.Fatalf("expected copied nesting in target World")
+	}
+	if len(target.RelFetch("handler", "store", true)) != 1 || len(target.RelFetch("client", "api", true)) != 1 {
+		t.Fatalf("expected copied Rel in target World")
+	}
+
+	// Undo in the target World removes the copy, and leaves the current World alone.
+	if err, _ := testApp.(*app).sessions["target"].undo(); err != nil {
+		t.Fatalf("error undoing copy: %v", err)
+	}
+	if _, ok := target.ItemFetch("api"); ok {
+		t.Fatalf("expected undo to remove the copy")
+	}
+	if _, ok := current.ItemFetch("api"); !ok {
+		t.Fatalf("expected the current World to be unchanged")
+	}
+
+	for i, s := range []string{"world use current", "item copy api to target", "item copy api to target", "world use target"} {
+		if code := responseCode(t, testApp.Exec(s)); (code == 200) != (i != 2) {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+
+	// Renaming a World renames its session, but not onto another open World.
+	if code := responseCode(t, testApp.Exec("world set name=current")); code == 200 {
+		t.Fatalf("expected error renaming onto an open World")
+	}
+	for _, s := range []string{"world set name=renamed", "world use current", "world use renamed"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("expected 200 status code for %q, got %d", s, code)
+		}
+	}
+	if worlds := testApp.Worlds(); len(worlds) != 2 || worlds[1] != "renamed" || testApp.World() != target {
+		t.Fatalf("expected the target World open as renamed, got %v", worlds)
+	}
+	// Neither can a new or loaded World take the name of another open World, and the renamed World stays as it was.
+	for _, c := range []struct {
+		In string
+		Ok bool
+	}{
+		{"world use current", true},
+		{"world save", true},
+		{"world use renamed", true},
+		{"world new current", false},
+		{"world load current", false},
+	} {
+		if code := responseCode(t, testApp.Exec(c.In)); (code == 200) != c.Ok {
+			t.Fatalf("unexpected status code %d for %q", code, c.In)
+		}
+	}
+	if worlds := testApp.Worlds(); len(worlds) != 2 || worlds[1] != "renamed" || testApp.World().Name() != "renamed" || !testApp.World().In("store", "api", false) {
+		t.Fatalf("expected the renamed World to be unchanged, got %v", worlds)
+	}
+	if code := responseCode(t, testApp.Exec("undo")); code != 200 || testApp.World().Name() != "target" || testApp.Worlds()[1] != "target" {
+		t.Fatalf("expected undo to revert the rename, not the rejected commands, got %v", testApp.Worlds())
+	}
+	if code := responseCode(t, testApp.Exec("redo")); code != 200 || testApp.World().Name() != "renamed" || testApp.Worlds()[1] != "renamed" {
+		t.Fatalf("expected redo to rename the World again, got %v", testApp.Worlds())
+	}
+
+	if code := responseCode(t, testApp.Exec("world close")); code != 200 {
+		t.Fatalf("expected 200 status code for close, got %d", code)
+	}
+	if testApp.World() != current {
+		t.Fatalf("expected closing the target World to switch back")
+	}
+	if code := responseCode(t, testApp.Exec("world close")); code == 200 {
+		t.Fatalf("expected error closing the last open World")
+	}
+	if code := responseCode(t, testApp.Exec("world use target")); code == 200 {
+		t.Fatalf("expected error using a closed World")
+	}
+}
+
+func TestParseErrorResponse(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	p, err := grammar.Parse(testApp.Exec("item create abc mechanism Go"))
+	if err != nil {
+		t.Fatalf("error parsing response: %v", err)
+	}
+	if p.Response.Status.Code != 400 {
+		t.Fatalf("expected 400 status code, got %d", p.Response.Status.Code)
+	}
+	if expected := "Invalid input: line 1 col 26: expected `=` after `mechanism`"; p.Response.Status.Message != expected {
+		t.Fatalf("expected message %q, got %q", expected, p.Response.Status.Message)
+	}
+}
+
+func TestNotFoundSuggestionResponse(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{"item create api", "item create db"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	for _, c := range []struct{ In, Missing, Suggestion string }{
+		{"item fetch apx", "apx", "api"},
+		{"item set apx name=API", "apx", "api"},
+		{"rel create apx db", "apx", "api"},
+		{"nest apx in db", "apx", "api"},
+		{"rel fetch api dc", "dc", "db"},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		status := p.Response.Status
+		if status.Missing != c.Missing || len(status.Suggestions) == 0 || status.Suggestions[0] != c.Suggestion {
+			t.Fatalf("expected suggestion %q for %q in %q, got %q in %v", c.Suggestion, c.Missing, c.In, status.Missing, status.Suggestions)
+		}
+	}
+}
+
+func TestIdsAndVerboseFlags(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{"item create svc", "item create api", "item create handler", "item create db", "nest api in svc", "nest handler in api", "rel create api db", "rel create svc api", "rel create handler db"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+
+	for _, c := range []struct{ In, Repr string }{
+		{"item list --ids", `["api","db","handler","svc"]`},
+		{"item list 2 --ids", `["api","db"]`},
+		{"item fetch api --ids", `["api"]`},
+		{"world --ids", `["api","db","handler","svc"]`},
+		{"rel list --ids", `["api::db","handler::db","svc::api"]`},
+		{"rel list 2 --ids", `["api::db","handler::db"]`},
+		{"rel fetch api db --ids", `["api::db"]`},
+		{"to? db --ids", `["api::db","handler::db"]`},
+		{"to? svc --ids", `["svc::api"]`},
+		{"to? svc --strict --ids", `[]`},
+		{"from? api --ids", `["api::db","handler::db"]`},
+		{"from? api --strict --ids", `["api::db"]`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if c.Repr == `[]` {
+			if p.Response.Object.Repr != "" {
+				t.Fatalf("expected no ids for %q, got %s", c.In, p.Response.Object.Repr)
+			}
+			continue
+		}
+		if p.Response.Object.Type != "ids" || p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected ids %s for %q, got %s %s", c.Repr, c.In, p.Response.Object.Type, p.Response.Object.Repr)
+		}
+	}
+
+	p, err := grammar.Parse(testApp.Exec("item fetch api --verbose"))
+	if err != nil {
+		t.Fatalf("error parsing verbose response: %v", err)
+	}
+	if p.Response.Object.Type != "detail" {
+		t.Fatalf("expected detail object, got %s", p.Response.Object.Type)
+	}
+	details := make([]grammar.ItemDetail, 0)
+	if err := json.Unmarshal([]byte(p.Response.Object.Repr), &details); err != nil {
+		t.Fatalf("error decoding details: %v", err)
+	}
+	expected := []grammar.ItemDetail{{Item: `item "api"`, Parent: "svc", Components: []string{"handler"}, Inbound: []string{`rel "svc" "api"`}, Outbound: []string{`rel "api" "db"`}}}
+	if !reflect.DeepEqual(details, expected) {
+		t.Fatalf("expected details %v, got %v", expected, details)
+	}
+
+	p, err = grammar.Parse(testApp.Exec("item list --verbose"))
+	if err != nil {
+		t.Fatalf("error parsing verbose list response: %v", err)
+	}
+	if len(p.Details) != 4 {
+		t.Fatalf("expected 4 details, got %d", len(p.Details))
+	}
+}
+
+func TestHierarchyQueries(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{"item create svc", "item create api", "item create handler", "item create worker", "item create db", "nest api worker in svc", "nest handler in api"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+
+	for _, c := range []struct{ In, Type, Repr string }{
+		{"item in svc", "tree", `tree{item "svc"::[tree{item "api"::[tree{item "handler"::[]}]} tree{item "worker"::[]}]}`},
+		{"item in svc --depth 1", "tree", `tree{item "svc"::[tree{item "api"::[]} tree{item "worker"::[]}]}`},
+		{"item in svc --ids", "ids", `["api","handler","worker"]`},
+		{"tree", "tree", `tree{nil::[tree{item "db"::[]} tree{item "svc"::[tree{item "api"::[tree{item "handler"::[]}]} tree{item "worker"::[]}]}]}`},
+		{"tree --depth 1", "tree", `tree{nil::[tree{item "db"::[]} tree{item "svc"::[]}]}`},
+		{"ancestors? handler", "item", `item "svc"`},
+		{"ancestors? handler --ids", "ids", `["api","svc"]`},
+		{"siblings? api --ids", "ids", `["worker"]`},
+		{"siblings? db --ids", "ids", `["svc"]`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Type != c.Type || p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s %s for %q, got %s %s", c.Type, c.Repr, c.In, p.Response.Object.Type, p.Response.Object.Repr)
+		}
+	}
+
+	if code := responseCode(t, testApp.Exec("item in nope")); code == 200 {
+		t.Fatalf("expected error for subtree of missing Item")
+	}
+}
+
+func TestDottedPaths(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	// Each system has its own `db`, addressed by path.
+	for _, s := range []string{"item create payments", "item create orders", "item create payments.api", "item create payments.db", "item create orders.db", "rel create payments.api payments.db verb=reads", "item create audit", "nest audit in payments.api", "item create payments.api.audit.log"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q: %s", code, s, testApp.Exec(s))
+		}
+	}
+	for child, parent := range map[string]string{"payments.db": "payments", "orders.db": "orders", "audit.log": "audit"} {
+		if p, _ := testApp.World().Parent(child); p != parent {
+			t.Fatalf("expected %s nested under %s, got %q", child, parent, p)
+		}
+	}
+
+	for _, c := range []struct{ In, Type, Repr string }{
+		{"item in payments --ids", "ids", `["payments.api","audit","audit.log","payments.db"]`},
+		{"from? payments.api --ids", "ids", `["payments.api::payments.db"]`},
+		{"item fetch payments.api.audit --ids", "ids", `["audit"]`},
+		{"ancestors? payments.api.audit.log --ids", "ids", `["audit","payments.api","payments"]`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Type != c.Type || p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s %s for %q, got %s %s", c.Type, c.Repr, c.In, p.Response.Object.Type, p.Response.Object.Repr)
+		}
+	}
+
+	// A second `db` in payments would make the path ambiguous.
+	if code := responseCode(t, testApp.Exec("nest orders.db in payments")); code == 200 {
+		t.Fatalf("expected conflict nesting a second db in payments")
+	}
+
+	// The world serializes with unambiguous IDs, and restores the same paths.
+	restored, err := world.FromString(testApp.World().String())
+	if err != nil {
+		t.Fatalf("error restoring world: %v", err)
+	}
+	if !world.WorldEqual(restored, testApp.World()) {
+		t.Fatalf("expected restored world to equal original")
+	}
+	if ids := restored.Resolve("payments.api.audit.log"); len(ids) != 1 || ids[0] != "audit.log" {
+		t.Fatalf("expected path to resolve in restored world, got %v", ids)
+	}
+
+	// Undo puts a deleted Item back where it was, even when its ID is a path to another parent.
+	for _, s := range []string{"free payments.db", "item delete payments.db", "undo"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	if p, ok := testApp.World().Parent("payments.db"); !ok || p != "" {
+		t.Fatalf("expected undo to restore payments.db at the root, got %q (found: %t)", p, ok)
+	}
+}
+
+func TestBulkSelectors(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{"item create api-svc", "item create auth-svc", "item create db", "item create tmp-1", "item create tmp-2", "item create vendor", "item create stripe", "item create legacy", "item create legacy-app", "item create legacy-job", "nest stripe in vendor", "rel create api-svc tmp-1", "nest tmp-2 in tmp-1"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	before, err := world.FromString(testApp.World().String())
+	if err != nil {
+		t.Fatalf("error copying world: %v", err)
+	}
+	session := testApp.(*app).sessions[testApp.(*app).current]
+	historyLen := len(testApp.History())
+
+	// A dry run lists the matched IDs, and leaves the World and history alone.
+	for _, c := range []struct {
+		In      string
+		Matched []string
+	}{
+		{`item set "*-svc" mechanism=Go --dry-run`, []string{"api-svc", "auth-svc"}},
+		{"item delete /^tmp-/ --dry-run", []string{"tmp-1", "tmp-2"}},
+		{"item set in:vendor external=true --dry-run", []string{"stripe"}},
+		{`nest "legacy*" db in legacy --dry-run`, []string{"legacy", "legacy-app", "legacy-job"}},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if !reflect.DeepEqual(p.Changes.Matched, c.Matched) {
+			t.Fatalf("expected %v for %q, got %v", c.Matched, c.In, p.Changes.Matched)
+		}
+	}
+	if !world.WorldEqual(testApp.World(), before) || len(testApp.History()) != historyLen {
+		t.Fatalf("expected dry runs to leave the world and history alone")
+	}
+
+	// Each bulk change is a single entry in history, and a single undo reverts it.
+	for _, s := range []string{`item set "*-svc" mechanism=Go`, "item delete /^tmp-/", "item set in:vendor external=true", `nest "legacy*" in legacy`} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+		if len(testApp.History()) != historyLen+1 {
+			t.Fatalf("expected one history entry for %q", s)
+		}
+		if err, _ := session.undo(); err != nil {
+			t.Fatalf("error undoing %q: %v", s, err)
+		}
+		if !world.WorldEqual(testApp.World(), before) {
+			t.Fatalf("expected undo to revert %q:\n%s\nexpected:\n%s", s, testApp.World().String(), before.String())
+		}
+		if err, _ := session.redo(); err != nil {
+			t.Fatalf("error redoing %q: %v", s, err)
+		}
+		if err, _ := session.undo(); err != nil {
+			t.Fatalf("error undoing %q: %v", s, err)
+		}
+	}
+
+	if code := responseCode(t, testApp.Exec("item delete /(/")); code == 200 {
+		t.Fatalf("expected error for an invalid regex")
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{"item create svc", "item create api", "item create worker", "item create db", "nest api worker in svc", "rel create api db verb=reads", "rel create worker svc"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	before, err := world.FromString(testApp.World().String())
+	if err != nil {
+		t.Fatalf("error copying world: %v", err)
+	}
+	historyLen := len(testApp.History())
+
+	for _, c := range []struct {
+		In      string
+		Changes []grammar.Change
+	}{
+		// Deleting an Item hoists its components, and drops its Rel.
+		{"item delete svc --dry-run", []grammar.Change{
+			{Action: "removed", Object: `item "svc"`},
+			{Action: "moved", Object: "api", From: "svc"},
+			{Action: "moved", Object: "worker", From: "svc"},
+			{Action: "removed", Object: `rel "worker" "svc"`},
+		}},
+		{"item create cache name=Cache --dry-run", []grammar.Change{{Action: "created", Object: `item "cache" name="Cache"`}}},
+		{"rel set api db mechanism=SQL --dry-run", []grammar.Change{{Action: "changed", Object: `rel "api" "db" verb="reads" mechanism="SQL"`}}},
+		{"nest db in svc --dry-run", []grammar.Change{{Action: "moved", Object: "db", To: "svc"}}},
+		{"item fetch api --dry-run", []grammar.Change{}},
+		{`world set name="Big World" expanded="All of it" --dry-run`, []grammar.Change{{Action: "changed", Object: `world name="Big World" expanded="All of it"`}}},
+		{"world set id=other version=3 --dry-run", []grammar.Change{{Action: "changed", Object: `world id="other" version=3`}}},
+		{"world set theme=dark --dry-run", []grammar.Change{{Action: "changed", Object: `world theme=dark`}}},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Type != "changes" || !reflect.DeepEqual(p.Changes.Changes, c.Changes) {
+			t.Fatalf("expected changes %v for %q, got %s %v", c.Changes, c.In, p.Response.Object.Type, p.Changes.Changes)
+		}
+	}
+	if !world.WorldEqual(testApp.World(), before) || len(testApp.History()) != historyLen {
+		t.Fatalf("expected dry runs to leave the world and history alone")
+	}
+
+	// Saving writes a file, and the App owns open worlds, so neither runs on a copy.
+	for _, s := range []string{"world save --dry-run", "world use test-world --dry-run"} {
+		if code := responseCode(t, testApp.Exec(s)); code == 200 {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+}
+
+func TestArchive(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{"item create legacy", "item create legacy.db", "item create api", "rel create api legacy.db", "item archive legacy"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+
+	// The archived Item, its components, and their Rel are hidden, but kept in the world.
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"item list --ids", `["api"]`},
+		{"item list --archived --ids", `["legacy","legacy.db"]`},
+		{"tree", `tree{nil::[tree{item "api"::[]}]}`},
+		{"from? api --ids", `[]`},
+		{"rel fetch api legacy.db --ids", `["api::legacy.db"]`},
+		{"rel fetch api legacy --ids", `[]`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if c.Repr == `[]` && p.Response.Object.Repr == "" {
+			continue
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+	if rels := testApp.World().RelList(0); len(rels) != 0 {
+		t.Fatalf("expected archived Rel hidden, got %v", rels)
+	}
+	reloaded, err := world.FromString(testApp.World().String())
+	if err != nil || len(reloaded.RelListArchived(0)) != 1 || !reloaded.Archived("legacy.db") {
+		t.Fatalf("expected the world file to keep the archive: %v", err)
+	}
+
+	if code := responseCode(t, testApp.Exec("item restore legacy")); code != 200 {
+		t.Fatalf("unexpected status code %d restoring", code)
+	}
+	if len(testApp.World().ItemList(0)) != 3 || len(testApp.World().RelList(0)) != 1 {
+		t.Fatalf("expected restore to bring back the Items and Rel")
+	}
+	if code := responseCode(t, testApp.Exec("item archive nope")); code == 200 {
+		t.Fatalf("expected error archiving a missing Item")
+	}
+
+	// Restoring a component doesn't show it while its archived parent hides it.
+	for _, s := range []string{"item archive legacy.db", "item archive legacy"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	if code := responseCode(t, testApp.Exec("item restore legacy.db")); code == 200 {
+		t.Fatalf("expected error restoring under an archived parent")
+	}
+	if item, _ := testApp.World().ItemFetch("legacy.db"); !item.Archived {
+		t.Fatalf("expected a failed restore to leave the Item archived")
+	}
+
+	// Details hide archived components and Rel, unless the detailed Item is archived too.
+	for _, s := range []string{"item create api.cache", "rel create api api.cache", "item archive api.cache"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	for _, c := range []struct {
+		In       string
+		Detailed int // Detailed is the number of components and Rel in the detail.
+	}{
+		{"item fetch api --verbose", 0},
+		{"item fetch legacy --verbose", 1},
+		{"item fetch legacy.db --verbose", 1},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil || len(p.Details) != 1 {
+			t.Fatalf("error parsing the detail for %q: %v", c.In, err)
+		}
+		if d := p.Details[0]; len(d.Components)+len(d.Inbound)+len(d.Outbound) != c.Detailed {
+			t.Fatalf("expected %d components and Rel for %q, got %v", c.Detailed, c.In, d)
+		}
+	}
+}
+
+func TestLifecycleViews(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{"item create monolith status=deprecated", "item create orders status=planned", "item create web", "rel create web monolith", "rel create web orders status=planned"} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	historyLen := len(testApp.History())
+
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"item list --view as-is --ids", `["monolith","web"]`},
+		{"item list --view to-be --ids", `["orders","web"]`},
+		{"item list --view deprecated --ids", `["monolith"]`},
+		{"from? web --view to-be --ids", `["web::orders"]`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+
+	// A view is for reading, so changes through it fail, and nothing is part of history.
+	for _, s := range []string{"item set web status=retired --view to-be", "item list --view someday", "world use test-world --view as-is", "world set theme=dark --view as-is"} {
+		if code := responseCode(t, testApp.Exec(s)); code == 200 {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+	if item, _ := testApp.World().ItemFetch("web"); item.Status != 0 || len(testApp.History()) != historyLen {
+		t.Fatalf("expected views to leave the world and history alone")
+	}
+}
+
+func TestSavedViews(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{
+		"item create payments tags=pci",
+		"item create api type=server tags=\"pci,edge\"",
+		"item create db type=database",
+		"nest api db in payments",
+		"item create web type=browser",
+		"item create legacy status=retired",
+		"rel create web api verb=calls",
+		"rel create api db",
+		"rel create legacy db",
+		`view create exec-summary name="Exec summary" expand="" filter="status:as-is"`,
+		`view create pci filter="tag:pci" expand=payments`,
+		"view create around-web focus=web",
+		"view create around-api focus=payments.api expand=payments.api hops=0",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"view fetch exec-summary", `view "exec-summary" name="Exec summary" expand="" filter="status:as-is"`},
+		{"view list --ids", `["around-api","around-web","exec-summary","pci"]`},
+		{"view fetch around-api", `view "around-api" expand="api" focus="api" hops=0`},
+		{"item list --view exec-summary --ids", `["payments","web"]`},
+		{"rel list --view exec-summary --ids", `["web::payments"]`},
+		{"item list --view pci --ids", `["api","payments"]`},
+		{"item list --view around-web --ids", `["api","payments","web"]`},
+		{"item list --view to-be --ids", `["api","db","payments","web"]`},
+		{"view set pci hops=2 --dry-run", `{"matched":[],"changes":[{"action":"changed","object":"view \"pci\" expand=\"payments\" filter=\"tag:pci\" hops=2"}]}`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+
+	for _, s := range []string{
+		`view create broken filter="owner:payments"`,
+		"view set missing hops=2",
+		"view create exec-summary focus=web",
+		"view create as-is",
+		"view create deprecated filter=tag:pci",
+		"view create typo focus=nope",
+		"view set pci focus=nope",
+		"item set web name=Web --view exec-summary",
+		"item list --view nowhere",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code == 200 {
+			t.Fatalf("expected an error for %q", s)
+		}
+	}
+	if view, _ := testApp.World().ViewFetch("exec-summary"); view.Focus != "" {
+		t.Fatalf("expected the failed commands to leave the view alone, got %v", view)
+	}
+	if item, _ := testApp.World().ItemFetch("web"); item.Name != "" {
+		t.Fatalf("expected the world to be left alone through a view")
+	}
+
+	// Views are part of the World, so undo puts back a deleted one.
+	if code := responseCode(t, testApp.Exec("view delete pci")); code != 200 {
+		t.Fatalf("unexpected status code %d deleting the view", code)
+	}
+	history := testApp.History()
+	if err := history[len(history)-1].Undo(testApp.World()); err != nil {
+		t.Fatalf("error undoing the delete: %v", err)
+	}
+	if view, ok := testApp.World().ViewFetch("pci"); !ok || view.Filter != "tag:pci" {
+		t.Fatalf("expected undo to restore the view, got %v", view)
+	}
+}
+
+func TestOwnership(t *testing.T) {
+	codeowners := filepath.Join(t.TempDir(), "CODEOWNERS")
+	if err := os.WriteFile(codeowners, []byte("*  @acme/platform\n/src/payments/  @acme/payments alice@acme.com\n"), 0644); err != nil {
+		t.Fatalf("error writing CODEOWNERS: %v", err)
+	}
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{
+		`item create payments type=code source="src/payments"`,
+		"item create payments.api",
+		"item create payments.api.ledger owner=ledger",
+		"item create web type=code source=web",
+		"item create docs source=docs",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	if code := responseCode(t, testApp.Exec(fmt.Sprintf("owners import %q", codeowners))); code != 200 {
+		t.Fatalf("unexpected status code %d importing owners", code)
+	}
+	// The import is a single entry in history.
+	history := testApp.History()
+	imported := history[len(history)-1]
+
+	// Only Code Items with a Source are imported, and components inherit their owner through the Tree.
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"item fetch payments", `item "payments" type=code owner="payments" contacts="@acme/payments,alice@acme.com" source="src/payments"`},
+		{"item list owner=payments --ids", `["payments","payments.api"]`},
+		{"item list owner=platform --ids", `["web"]`},
+		{"owners? payments.api.ledger --ids", `["payments.api.ledger","payments"]`},
+		{"owners? payments.api --ids", `["payments"]`},
+		{"owners? docs --ids", `[]`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if c.Repr == `[]` && p.Response.Object.Repr == "" {
+			continue
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+
+	if err := imported.Undo(testApp.World()); err != nil {
+		t.Fatalf("error undoing import: %v", err)
+	}
+	for _, id := range []string{"payments", "web"} {
+		if item, _ := testApp.World().ItemFetch(id); item.Owner != "" || item.Contacts != "" {
+			t.Fatalf("expected undo to clear the owner of %s, got %v", id, item)
+		}
+	}
+	// Redo sets the same owners as the import did, even if the file changed since.
+	if err := os.WriteFile(codeowners, []byte("*  @acme/other\n"), 0644); err != nil {
+		t.Fatalf("error rewriting CODEOWNERS: %v", err)
+	}
+	if _, err := imported.Execute(testApp.World()); err != nil {
+		t.Fatalf("error redoing import: %v", err)
+	}
+	if item, _ := testApp.World().ItemFetch("payments"); item.Owner != "payments" {
+		t.Fatalf("expected redo to set the imported owner, got %v", item)
+	}
+	if code := responseCode(t, testApp.Exec(`owners import "no/such/CODEOWNERS"`)); code == 200 {
+		t.Fatalf("expected error importing a missing file")
+	}
+}
+
+func TestLinks(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{
+		"item create api",
+		"item create db",
+		"rel create api db",
+		`item link api runbook="https://wiki.acme.com/api" adr="docs/adr/0002.md" adr="docs/adr/0001.md"`,
+		`rel link api db dashboard="https://grafana.acme.com/d/db"`,
+		`item unlink api adr="docs/adr/0002.md"`,
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+
+	// Links are sorted by kind and target, and kept in the world file.
+	item, _ := testApp.World().ItemFetch("api")
+	if repr := item.String(); repr != `item "api" runbook="https://wiki.acme.com/api" adr="docs/adr/0001.md"` {
+		t.Fatalf("unexpected Item %s", repr)
+	}
+	reloaded, err := world.FromString(testApp.World().String())
+	if err != nil || !world.WorldEqual(reloaded, testApp.World()) {
+		t.Fatalf("expected the world file to keep the links: %v", err)
+	}
+
+	for _, s := range []string{
+		`item link api runbook="https://wiki.acme.com/api"`,
+		`item link api dashboard="https://"`,
+		`item link api dashboard="javascript:alert(1)"`,
+		`item set api repo="docs" repo="docs"`,
+		`item unlink api repo="docs"`,
+		`rel link db api adr="docs/adr/0001.md"`,
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code == 200 {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+	if after, _ := testApp.World().ItemFetch("api"); !world.ItemEqual(after, item) {
+		t.Fatalf("expected failed links to leave the Item alone, got %s", after)
+	}
+}
+
+func TestDataFlow(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{
+		"item create payments",
+		"item create payments.db classification=pii",
+		"item create api",
+		"item create crm external=true status=planned",
+		"rel create api payments.db",
+		"rel create api crm status=planned",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"dataflow? pii", `{"class":"pii","steps":[{"kind":"source","id":"payments.db","path":[]},{"kind":"reached","id":"payments","path":[]},{"kind":"reached","id":"api","path":["api::payments.db"]},{"kind":"external","id":"crm","path":["api::payments.db","api::crm"]}]}`},
+		{"dataflow? pii --view as-is", `{"class":"pii","steps":[{"kind":"source","id":"payments.db","path":[]},{"kind":"reached","id":"payments","path":[]},{"kind":"reached","id":"api","path":["api::payments.db"]}]}`},
+		{"dataflow? pci", `{"class":"pci","steps":[]}`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+}
+
+func TestThreatModel(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{
+		"item create customer type=person external=true",
+		"item create dmz boundary=dmz",
+		"item create dmz.gateway type=server",
+		"item create api type=server",
+		"item create events type=queue status=planned",
+		"rel create customer dmz.gateway",
+		"rel create dmz.gateway api",
+		"rel create api events async=true status=planned",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"item fetch dmz", `item "dmz" boundary="dmz"`},
+		{"crossings? --ids", `["customer::dmz.gateway","dmz.gateway::api"]`},
+		{"item set events boundary=data", `item "events" type=queue status=planned boundary="data"`},
+		{"crossings? --ids", `["api::events","customer::dmz.gateway","dmz.gateway::api"]`},
+		{"crossings? --ids --view as-is", `["customer::dmz.gateway","dmz.gateway::api"]`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+
+	dir := t.TempDir()
+	md := filepath.Join(dir, "threats.md")
+	historyLen := len(testApp.History())
+	if code := responseCode(t, testApp.Exec(fmt.Sprintf("threats export %q", md))); code != 200 {
+		t.Fatalf("unexpected status code %d exporting markdown", code)
+	}
+	if len(testApp.History()) != historyLen {
+		t.Fatalf("expected the export to stay out of history")
+	}
+	b, err := os.ReadFile(md)
+	if err != nil {
+		t.Fatalf("error reading markdown export: %v", err)
+	}
+	if !strings.Contains(string(b), "### api → events (internal → data)") {
+		t.Fatalf("expected the api to events crossing in the markdown, got:\n%s", b)
+	}
+
+	asIs := filepath.Join(dir, "threats.json")
+	if code := responseCode(t, testApp.Exec(fmt.Sprintf("threats export %q --view as-is", asIs))); code != 200 {
+		t.Fatalf("unexpected status code %d exporting json", code)
+	}
+	var model struct {
+		Crossings []struct{ From, To string }
+	}
+	if b, err = os.ReadFile(asIs); err != nil {
+		t.Fatalf("error reading json export: %v", err)
+	}
+	if err := json.Unmarshal(b, &model); err != nil {
+		t.Fatalf("error decoding json export: %v", err)
+	}
+	if len(model.Crossings) != 2 {
+		t.Fatalf("expected the as-is view to have 2 crossings, got %v", model.Crossings)
+	}
+
+	for _, s := range []string{
+		fmt.Sprintf("threats export %q", filepath.Join(dir, "threats.txt")),
+		fmt.Sprintf("threats export %q --dry-run", md),
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code == 200 {
+			t.Fatalf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestDeployment(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{
+		"item create api type=server mechanism=Go",
+		"item create db type=database",
+		"nest db in api",
+		"rel create api db verb=reads",
+		"node create prod name=Production",
+		"node create prod-k8s kind=cluster parent=prod mechanism=Kubernetes",
+		"node create prod-rds parent=prod mechanism=RDS",
+		"node create staging",
+		"deploy api to prod-k8s",
+		"deploy api.db to prod-rds",
+		"deploy api to staging",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"deployed? api --ids", `["prod-k8s","staging"]`},
+		{"deployed? api in prod --ids", `["prod-k8s"]`},
+		{"deployed? api.db in staging --ids", `[]`},
+		{"node list --ids", `["prod","prod-k8s","prod-rds","staging"]`},
+		{"node fetch prod-k8s", `node "prod-k8s" kind=cluster mechanism="Kubernetes" parent="prod"`},
+		{"item in api --ids", `["db"]`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if c.Repr == `[]` && p.Response.Object.Repr == "" {
+			continue
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+
+	// The deployment hierarchy survives a round trip through the World file.
+	restored, err := world.FromString(testApp.World().String())
+	if err != nil {
+		t.Fatalf("error restoring world: %v", err)
+	}
+	if !world.WorldEqual(restored, testApp.World()) {
+		t.Fatalf("expected the restored world to equal the original:\n%s", restored.String())
+	}
+
+	path := filepath.Join(t.TempDir(), "prod.puml")
+	historyLen := len(testApp.History())
+	if code := responseCode(t, testApp.Exec(fmt.Sprintf("node export prod %q", path))); code != 200 {
+		t.Fatalf("unexpected status code %d exporting the deployment diagram", code)
+	}
+	if len(testApp.History()) != historyLen {
+		t.Fatalf("expected the export to stay out of history")
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading the deployment diagram: %v", err)
+	}
+	for _, s := range []string{
+		`Deployment_Node(prod_k8s, "prod-k8s", "Kubernetes") {`,
+		`ContainerDb(db__prod_rds, "db", "", "", $tags="look_`,
+		`Rel(api__prod_k8s, db__prod_rds, "reads", "", $tags="line_1")`,
+	} {
+		if !strings.Contains(string(b), s) {
+			t.Fatalf("expected %s in the deployment diagram, got:\n%s", s, b)
+		}
+	}
+
+	for _, s := range []string{
+		"node create prod-db kind=cluster",
+		"node create prod parent=staging",
+		"deploy api to nowhere",
+		"undeploy api.db from staging",
+		"node delete prod",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code == 200 {
+			t.Fatalf("expected an error for %q", s)
+		}
+	}
+	if code := responseCode(t, testApp.Exec("item delete api --cascade")); code != 200 {
+		t.Fatalf("unexpected status code %d deleting api", code)
+	}
+	if node, _ := testApp.World().NodeFetch("prod-k8s"); len(node.Items) != 0 {
+		t.Fatalf("expected deleting api to undeploy it, got %v", node.Items)
+	}
+}
+
+func TestScenarios(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{
+		"item create shop",
+		"item create shop.web",
+		"item create api",
+		"rel create shop.web api verb=calls",
+		`scenario create checkout name="Checkout"`,
+		`step checkout shop.web api label="POST /orders"`,
+		`step checkout api shop.web label="201 Created"`,
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"scenario fetch checkout", "scenario \"checkout\" name=\"Checkout\"\nstep \"checkout\" \"shop.web\" \"api\" label=\"POST /orders\"\nstep \"checkout\" \"api\" \"shop.web\" label=\"201 Created\""},
+		{"scenario list --ids", `["checkout"]`},
+		{"step checkout api shop.web label=retry at=1 --dry-run", `{"matched":[],"changes":[{"action":"changed","object":"scenario \"checkout\" name=\"Checkout\""}]}`},
+		{"unstep checkout 1", "scenario \"checkout\" name=\"Checkout\"\nstep \"checkout\" \"api\" \"shop.web\" label=\"201 Created\""},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+
+	dir := t.TempDir()
+	historyLen := len(testApp.History())
+	for path, expected := range map[string]string{
+		filepath.Join(dir, "checkout.puml"): `api-->shop_web: 201 Created`,
+		filepath.Join(dir, "checkout.mmd"):  `api-->>shop_web: 201 Created`,
+	} {
+		if code := responseCode(t, testApp.Exec(fmt.Sprintf("scenario export checkout %q", path))); code != 200 {
+			t.Fatalf("unexpected status code %d exporting %s", code, path)
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("error reading %s: %v", path, err)
+		}
+		if !strings.Contains(string(b), expected) {
+			t.Fatalf("expected %s in %s, got:\n%s", expected, path, b)
+		}
+	}
+	if len(testApp.History()) != historyLen {
+		t.Fatalf("expected the exports to stay out of history")
+	}
+
+	for _, s := range []string{
+		"step checkout shop api",
+		"step checkout api nowhere",
+		"step refund shop.web api",
+		"unstep checkout 5",
+		fmt.Sprintf("scenario export checkout %q", filepath.Join(dir, "checkout.png")),
+		fmt.Sprintf("scenario export checkout %q --dry-run", filepath.Join(dir, "checkout.puml")),
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code == 200 {
+			t.Fatalf("expected an error for %q", s)
+		}
+	}
+
+	if code := responseCode(t, testApp.Exec("rel delete shop.web api")); code != 200 {
+		t.Fatalf("unexpected status code %d deleting the rel", code)
+	}
+	if scenario, _ := testApp.World().ScenarioFetch("checkout"); len(scenario.Steps) != 0 {
+		t.Fatalf("expected deleting the rel to drop its steps, got %v", scenario.Steps)
+	}
+}
+
+func TestLayoutPins(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{
+		"item create payments",
+		"item create payments.api",
+		"item create db",
+		"rel create payments.api db",
+		`view create slides expand=""`,
+		"layout pin payments.api x=120 y=40",
+		`layout pin payments x=0 y=0 width=400 height=300 view=slides`,
+		`pin payments.api db waypoints="200,120 200,300"`,
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"layout pin payments.api x=130.5", `pin "payments.api" x=130.5 y=40`},
+		{"layout list view=slides", `pin "payments" x=0 y=0 width=400 height=300 view="slides"`},
+		{"layout list", "pin \"payments.api\" x=130.5 y=40\npin \"payments.api\" \"db\" waypoints=\"200,120 200,300\"\npin \"payments\" x=0 y=0 width=400 height=300 view=\"slides\""},
+		{"layout pin db x=1 y=2 --dry-run", `{"matched":[],"changes":[{"action":"created","object":"pin \"db\" x=1 y=2"}]}`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+
+	for _, s := range []string{
+		"layout pin db y=2",
+		"layout pin nowhere x=1 y=2",
+		"layout pin db x=1 y=2 view=nowhere",
+		"layout pin db x=1 y=2 width=-5",
+		`layout pin payments.api db x=1 waypoints="1,1"`,
+		`layout pin payments.api db waypoints="1;1"`,
+		"layout pin db x=1 y=2 --view to-be",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code == 200 {
+			t.Fatalf("expected an error for %q", s)
+		}
+	}
+
+	// A drag is a pin command, so undo moves the Item back.
+	if code := responseCode(t, testApp.Exec("layout pin payments.api x=300 y=40")); code != 200 {
+		t.Fatalf("unexpected status code %d dragging the item", code)
+	}
+	for _, c := range []struct {
+		In string
+		X  float64
+	}{
+		{"undo", 130.5},
+		{"redo", 300},
+		{"undo", 130.5},
+	} {
+		if code := responseCode(t, testApp.Exec(c.In)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, c.In)
+		}
+		if pin, _ := testApp.World().PinFetch("", "payments.api", ""); pin.X != c.X {
+			t.Fatalf("expected %q to leave the item at x=%v, got %v", c.In, c.X, pin)
+		}
+	}
+	if code := responseCode(t, testApp.Exec("undo --dry-run")); code == 200 {
+		t.Fatalf("expected an error for a dry run of undo")
+	}
+
+	if code := responseCode(t, testApp.Exec("item delete payments --cascade")); code != 200 {
+		t.Fatalf("unexpected status code %d deleting the item", code)
+	}
+	if pins := testApp.World().PinList(0); len(pins) != 0 {
+		t.Fatalf("expected deleting the items to drop their pins, got %v", pins)
+	}
+	history := testApp.History()
+	if err := history[len(history)-1].Undo(testApp.World()); err != nil {
+		t.Fatalf("error undoing the delete: %v", err)
+	}
+	if pins := testApp.World().PinList(0); len(pins) != 3 {
+		t.Fatalf("expected undo to restore the pins, got %v", pins)
+	}
+}
+
+func TestUndoSkipsQueries(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{"item create p", "item create p.c", "item delete p --cascade", "item list", "world", "rel list", "item fetch p"} {
+		testApp.Exec(s)
+	}
+	if len(testApp.History()) != 3 {
+		t.Fatalf("expected only the 3 changes in history, got %v", testApp.History())
+	}
+	if code := responseCode(t, testApp.Exec("undo")); code != 200 {
+		t.Fatalf("unexpected status code %d for undo", code)
+	}
+	if _, ok := testApp.World().ItemFetch("p.c"); !ok {
+		t.Fatalf("expected undo to restore the deleted items")
+	}
+	if code := responseCode(t, testApp.Exec("item fetch nowhere")); code == 200 {
+		t.Fatalf("expected an error fetching a missing item")
+	}
+	if code := responseCode(t, testApp.Exec("redo")); code != 200 {
+		t.Fatalf("unexpected status code %d for redo", code)
+	}
+	if _, ok := testApp.World().ItemFetch("p"); ok {
+		t.Fatalf("expected redo after a failed command to delete the items again")
+	}
+}
+
+func TestStyles(t *testing.T) {
+	testApp, err := NewApp(world.CreateWorld("test-world"))
+	if err != nil {
+		t.Fatalf("error creating app: %v", err)
+	}
+	for _, s := range []string{
+		"item create db type=database",
+		`style create db name=Databases match="type:database" shape=cylinder`,
+		`style async match="async:true" line=dashed`,
+		"world set theme=dark",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code != 200 {
+			t.Fatalf("unexpected status code %d for %q", code, s)
+		}
+	}
+	for _, c := range []struct {
+		In   string
+		Repr string
+	}{
+		{"style fetch db", `style "db" name="Databases" match="type:database" shape="cylinder"`},
+		{`style set db color="#123456"`, `style "db" name="Databases" match="type:database" shape="cylinder" color="#123456"`},
+		{"styles list --ids", `["async","db"]`},
+		{`style create edge match="tag:edge" --dry-run`, `{"matched":[],"changes":[{"action":"created","object":"style \"edge\" match=\"tag:edge\""}]}`},
+	} {
+		p, err := grammar.Parse(testApp.Exec(c.In))
+		if err != nil {
+			t.Fatalf("error parsing response for %q: %v", c.In, err)
+		}
+		if p.Response.Object.Repr != c.Repr {
+			t.Fatalf("expected %s for %q, got %s", c.Repr, c.In, p.Response.Object.Repr)
+		}
+	}
+	if theme := testApp.World().Theme(); theme != world.ThemeDark {
+		t.Fatalf("expected the dark theme, got %q", theme)
+	}
+
+	for _, s := range []string{
+		"style set nowhere shape=box",
+		"style create bad shape=star",
+		`style create bad match="color:red"`,
+		"style create bad color=red",
+		"world set theme=neon",
+	} {
+		if code := responseCode(t, testApp.Exec(s)); code == 200 {
+			t.Fatalf("expected an error for %q", s)
+		}
+	}
+
+	if code := responseCode(t, testApp.Exec("world set theme=print")); code != 200 {
+		t.Fatalf("unexpected status code %d setting the theme", code)
+	}
+	history := testApp.History()
+	if err := history[len(history)-1].Undo(testApp.World()); err != nil {
+		t.Fatalf("error undoing the theme: %v", err)
+	}
+	if theme := testApp.World().Theme(); theme != world.ThemeDark {
+		t.Fatalf("expected undo to restore the dark theme, got %q", theme)
+	}
+}
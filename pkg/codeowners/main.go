@@ -0,0 +1,115 @@
+package codeowners
+
+import (
+	"bufio"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Rule is a line of a CODEOWNERS file: a pattern of paths, and who owns them.
+type Rule struct {
+	Pattern string         // Pattern is the gitignore-style pattern from the file (ex: `/src/payments/`, `*.go`).
+	Owners  []string       // Owners are the users, teams, or emails that own the paths, as written (ex: `@acme/payments`). A Rule without Owners unsets them.
+	re      *regexp.Regexp // re matches the paths for the Pattern.
+}
+
+// Rules are the Rule of a CODEOWNERS file, in the order they appear.
+type Rules []Rule
+
+// Load reads and parses the CODEOWNERS file at the given path.
+func Load(path string) (Rules, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.New("error reading CODEOWNERS").UseCode(errors.TopolithErrorNotFound).WithError(err).WithData(errors.KvPair{Key: "path", Value: path})
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parse parses the contents of a CODEOWNERS file. Blank lines, comments and section headers are skipped.
+func Parse(r io.Reader) (Rules, error) {
+	rules := make(Rules, 0)
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], "[") || strings.HasPrefix(fields[0], "^[") {
+			continue
+		}
+		re, err := regexp.Compile(patternRegex(fields[0]))
+		if err != nil {
+			return nil, errors.New("invalid CODEOWNERS pattern").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "line", Value: strconv.Itoa(n)}, errors.KvPair{Key: "pattern", Value: fields[0]})
+		}
+		rules = append(rules, Rule{Pattern: fields[0], Owners: fields[1:], re: re})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.New("error reading CODEOWNERS").UseCode(errors.TopolithErrorInternal).WithError(err)
+	}
+	return rules, nil
+}
+
+// Match indicates whether the Rule applies to the path, relative to the repository root.
+// A Rule that matches a directory applies to everything under it.
+func (r Rule) Match(path string) bool {
+	return r.re.MatchString(strings.Trim(path, "/"))
+}
+
+// Owners returns the Owners for the path. As in CODEOWNERS, the last Rule that matches wins.
+// An empty slice means no Rule matched, or the last one that did unsets the Owners.
+func (rules Rules) Owners(path string) []string {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].Match(path) {
+			return rules[i].Owners
+		}
+	}
+	return []string{}
+}
+
+// Team returns the name of the team among the owners: the first `@org/team`, without its org, or else the first owner without its `@`.
+func Team(owners []string) string {
+	for _, owner := range owners {
+		if _, team, ok := strings.Cut(strings.TrimPrefix(owner, "@"), "/"); ok && strings.HasPrefix(owner, "@") {
+			return team
+		}
+	}
+	if len(owners) == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(owners[0], "@")
+}
+
+// patternRegex converts a gitignore-style pattern to a regex.
+// A pattern with a `/` other than at its end is anchored to the root. Otherwise, it matches at any depth.
+func patternRegex(pattern string) string {
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	pattern = strings.Trim(pattern, "/")
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			b.WriteString("[^/]*")
+		case pattern[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	prefix := "^(.*/)?"
+	if anchored {
+		prefix = "^"
+	}
+	return prefix + b.String() + "(/.*)?$"
+}
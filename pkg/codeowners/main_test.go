@@ -0,0 +1,63 @@
+package codeowners
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testFile = `# Everything falls back to the platform team.
+*                   @acme/platform
+*.md                @alice          # Docs
+
+[Payments]
+/src/payments/      @acme/payments bob@acme.com
+src/payments/vendor/
+docs/**/api.md      @acme/api
+`
+
+func TestOwners(t *testing.T) {
+	rules, err := Parse(strings.NewReader(testFile))
+	if err != nil {
+		t.Fatalf("error parsing: %v", err)
+	}
+	if len(rules) != 5 {
+		t.Fatalf("expected 5 rules, got %d", len(rules))
+	}
+	for _, c := range []struct {
+		Path   string
+		Owners []string
+	}{
+		{"main.go", []string{"@acme/platform"}},
+		{"README.md", []string{"@alice"}},
+		{"src/payments", []string{"@acme/payments", "bob@acme.com"}},
+		{"src/payments/api/main.go", []string{"@acme/payments", "bob@acme.com"}},
+		{"/src/payments/", []string{"@acme/payments", "bob@acme.com"}},
+		{"lib/src/payments", []string{"@acme/platform"}},
+		{"src/payments/vendor/lib", []string{}},
+		{"docs/api.md", []string{"@acme/api"}},
+		{"docs/v1/http/api.md", []string{"@acme/api"}},
+	} {
+		t.Run(c.Path, func(t *testing.T) {
+			if owners := rules.Owners(c.Path); !slices.Equal(owners, c.Owners) {
+				t.Errorf("expected %v, got %v", c.Owners, owners)
+			}
+		})
+	}
+}
+
+func TestTeam(t *testing.T) {
+	for _, c := range []struct {
+		Owners []string
+		Team   string
+	}{
+		{[]string{"@alice", "@acme/payments"}, "payments"},
+		{[]string{"@alice", "bob@acme.com"}, "alice"},
+		{[]string{"bob@acme.com"}, "bob@acme.com"},
+		{[]string{}, ""},
+	} {
+		if team := Team(c.Owners); team != c.Team {
+			t.Errorf("expected team %q for %v, got %q", c.Team, c.Owners, team)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // TopolithErrorCode is an iota that represents the error code of a TopolithError.
 type TopolithErrorCode int
@@ -20,7 +23,9 @@ type TopolithError struct {
 	Message     string            // Message is a human-readable message that is generally more detailed than Description.
 	Data        []KvPair          // Data is a list of key-value pairs that provide additional context to the error.
 
-	errs []error // errs is a list of errors that are wrapped by this error.
+	errs        []error  // errs is a list of errors that are wrapped by this error.
+	missing     string   // missing is the value that wasn't found, such as a mistyped ID, if we have suggestions for it.
+	suggestions []string // suggestions are the closest known values to missing, best first.
 }
 
 func (e TopolithError) UseCode(code TopolithErrorCode) TopolithError {
@@ -53,6 +58,25 @@ func (e TopolithError) WithError(errs ...error) TopolithError {
 	return e
 }
 
+// WithSuggestions adds "did you mean" suggestions for a value that wasn't found, such as a mistyped ID.
+// Each suggestion is added to Data, and shown in the String representation.
+func (e TopolithError) WithSuggestions(missing string, suggestions ...string) TopolithError {
+	if len(suggestions) == 0 {
+		return e
+	}
+	e.missing = missing
+	e.suggestions = append([]string{}, suggestions...)
+	for _, s := range suggestions {
+		e.Data = append(e.Data, KvPair{Key: "suggestion", Value: s})
+	}
+	return e
+}
+
+// Suggestions returns the missing value and its "did you mean" suggestions, if any.
+func (e TopolithError) Suggestions() (string, []string) {
+	return e.missing, e.suggestions
+}
+
 // --- ERROR IMPLEMENTATION ---
 
 // New returns a new TopolithError with the given text.
@@ -69,6 +93,14 @@ func New(text string) TopolithError {
 
 // String returns a grammar-compatible string representation of the TopolithError.
 func (e TopolithError) String() string {
+	status := fmt.Sprintf(`%d error "%s: %s"`, e.Code, e.Description, e.Message)
+	if len(e.suggestions) > 0 {
+		quoted := make([]string, len(e.suggestions))
+		for i, s := range e.suggestions {
+			quoted[i] = fmt.Sprintf(`"%s"`, s)
+		}
+		status += fmt.Sprintf(` suggestions="%s":[%s]`, e.missing, strings.Join(quoted, " "))
+	}
 	if e.errs != nil && len(e.errs) > 0 {
 		errStrings := make([]string, 0)
 		for _, err := range e.errs {
@@ -77,9 +109,9 @@ func (e TopolithError) String() string {
 			}
 			errStrings = append(errStrings, err.Error())
 		}
-		return fmt.Sprintf(`%d error "%s: %s" errors=[%s]`, e.Code, e.Description, e.Message, fmt.Sprintf(`"%s"`, errStrings))
+		return fmt.Sprintf(`%s errors=[%s]`, status, fmt.Sprintf(`"%s"`, errStrings))
 	}
-	return fmt.Sprintf(`%d error "%s: %s"`, e.Code, e.Description, e.Message)
+	return status
 }
 
 // Error returns a string representation of the TopolithError.
@@ -104,3 +136,20 @@ func Join(errs ...error) TopolithError {
 	}
 	return joined
 }
+
+// Suggestions returns the first "did you mean" suggestions found in the error, or in the errors it wraps.
+func Suggestions(err error) (string, []string) {
+	e, ok := err.(TopolithError)
+	if !ok {
+		return "", nil
+	}
+	if len(e.suggestions) > 0 {
+		return e.missing, e.suggestions
+	}
+	for _, inner := range e.errs {
+		if missing, suggestions := Suggestions(inner); len(suggestions) > 0 {
+			return missing, suggestions
+		}
+	}
+	return "", nil
+}
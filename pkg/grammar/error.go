@@ -0,0 +1,228 @@
+package grammar
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// expectation is something the grammar may expect at a position, and the text we use to probe for it.
+type expectation struct {
+	label string // label is how we show the expectation to the user.
+	probe string // probe is text that satisfies the expectation, which we append to the input to test it.
+}
+
+// unshown are the tokens that only frame a value or a Response, so we never list them.
+var unshown = map[string]bool{"QUOTE": true, "DELIMITER": true}
+
+// toParseError converts an error from the generated Parser to a TopolithError.
+// The error tells where parsing stopped, the offending token, and what the grammar expected there.
+// ex: line 1 col 26: expected `=` after `mechanism`
+func toParseError(p *Parser, err error) error {
+	pe, ok := err.(*parseError)
+	if !ok {
+		return errors.New(err.Error()).UseCode(errors.TopolithErrorInvalid).WithError(err)
+	}
+	buffer := []rune(p.Buffer)
+	pos := int(pe.max.end)
+	if pos > len(buffer) {
+		pos = len(buffer)
+	}
+	expected := expectedAt(buffer, pos, pe.max.pegRule)
+	if len(expected) == 0 {
+		// Nothing lets parsing continue, so we point at what the grammar can't take instead.
+		return unexpectedError(buffer, pos)
+	}
+	line, col := linePosition(buffer, pos)
+	token, after := tokenAt(buffer, pos), tokenBefore(buffer, pos)
+
+	msg := fmt.Sprintf("line %d col %d: expected %s", line, col, joinExpected(expected))
+	if after != "" {
+		msg += fmt.Sprintf(" after `%s`", after)
+	}
+	if token == "" {
+		token = "end of input"
+	}
+	return errors.New(msg).UseCode(errors.TopolithErrorInvalid).WithData(
+		errors.KvPair{Key: "line", Value: strconv.Itoa(line)},
+		errors.KvPair{Key: "column", Value: strconv.Itoa(col)},
+		errors.KvPair{Key: "token", Value: token},
+		errors.KvPair{Key: "expected", Value: strings.Join(expected, ", ")},
+	)
+}
+
+// unexpectedError returns the error for a position in the buffer where the grammar expects nothing.
+// In a quoted value, that is the first character a quoted value can't hold, which we find by closing the value before it.
+// A quoted message can't hold it either, so we show its code point.
+// Otherwise it is the token at the position.
+// ex: line 1 col 26: unexpected character U+007C in a quoted value
+func unexpectedError(buffer []rune, pos int) error {
+	msg, token := "", tokenAt(buffer, pos)
+	if pos > 0 && buffer[pos-1] == '"' {
+		for i := pos; i < len(buffer) && buffer[i] != '"'; i++ {
+			text := string(buffer[:i+1]) + `"`
+			if reached, ok, _ := probe(text); !ok && reached < len([]rune(text)) {
+				pos, token = i, string(buffer[i])
+				msg = fmt.Sprintf("unexpected character %U in a quoted value", buffer[i])
+				break
+			}
+		}
+	}
+	if msg == "" {
+		msg = fmt.Sprintf("unexpected `%s`", token)
+	}
+	line, col := linePosition(buffer, pos)
+	return errors.New(fmt.Sprintf("line %d col %d: %s", line, col, msg)).UseCode(errors.TopolithErrorInvalid).WithData(
+		errors.KvPair{Key: "line", Value: strconv.Itoa(line)},
+		errors.KvPair{Key: "column", Value: strconv.Itoa(col)},
+		errors.KvPair{Key: "token", Value: token},
+		errors.KvPair{Key: "expected", Value: ""},
+	)
+}
+
+// linePosition returns the 1-indexed line and column of the position in the buffer.
+func linePosition(buffer []rune, pos int) (int, int) {
+	line, col := 1, 1
+	for _, r := range buffer[:pos] {
+		if r == '\n' {
+			line, col = line+1, 1
+			continue
+		}
+		col++
+	}
+	return line, col
+}
+
+// tokenAt returns the whitespace-delimited token at the position in the buffer, skipping leading whitespace.
+func tokenAt(buffer []rune, pos int) string {
+	start := pos
+	for start < len(buffer) && unicode.IsSpace(buffer[start]) {
+		start++
+	}
+	end := start
+	for end < len(buffer) && !unicode.IsSpace(buffer[end]) {
+		end++
+	}
+	return string(buffer[start:end])
+}
+
+// tokenBefore returns the whitespace-delimited token that ends before the position in the buffer.
+func tokenBefore(buffer []rune, pos int) string {
+	end := pos
+	for end > 0 && unicode.IsSpace(buffer[end-1]) {
+		end--
+	}
+	start := end
+	for start > 0 && !unicode.IsSpace(buffer[start-1]) {
+		start--
+	}
+	return string(buffer[start:end])
+}
+
+// expectedAt returns the labels of expectations that let parsing continue past the position in the buffer, after the rule the Parser matched last.
+// The tokens that grammar.peg has after the rule are the candidates, with identifiers and numbers.
+// We check each by appending its probe to the input before the position, and checking how far the Parser gets.
+func expectedAt(buffer []rune, pos int, rule pegRule) []string {
+	prefix := string(buffer[:pos])
+	expected := make([]string, 0)
+	if _, ok, _ := probe(prefix); ok {
+		expected = append(expected, "end of input")
+	}
+	name := rul3s[rule]
+	if name == "_" || name == "Whitespace" || name == "EOL" {
+		// Whitespace may come after anything, so look at what came before it.
+		_, _, last := probe(strings.TrimRightFunc(prefix, unicode.IsSpace))
+		name = rul3s[last]
+	}
+	found := make(map[string]bool)
+	keywords := make(map[string]bool)
+	candidates := candidatesAfter(name)
+	for _, e := range candidates {
+		text := prefix
+		if needsSpace(buffer[:pos], e.probe) {
+			text += " "
+		}
+		text += e.probe
+		if reached, ok, last := probe(text); ok || reached >= len([]rune(text)) {
+			found[e.label], keywords[e.label] = true, isKeywordRule(last)
+		}
+	}
+	for _, e := range candidates {
+		if !found[e.label] {
+			continue
+		}
+		// A keyword that the Parser took as an identifier here is an identifier, so only show the more general expectation.
+		if e.label != "identifier" && found["identifier"] && !keywords[e.label] {
+			continue
+		}
+		expected = append(expected, e.label)
+	}
+	return expected
+}
+
+// candidatesAfter returns the expectations to probe for after the rule: its tokens, then an identifier and a number.
+func candidatesAfter(rule string) []expectation {
+	candidates := make([]expectation, 0)
+	for _, t := range follows[rule] {
+		switch {
+		case unshown[t.rule]:
+		case t.rule == flagToken:
+			for _, f := range follows[flagToken] {
+				candidates = append(candidates, expectation{"`--" + f.text + "`", "--" + f.text})
+			}
+		default:
+			candidates = append(candidates, expectation{"`" + t.text + "`", t.text})
+		}
+	}
+	return append(candidates, expectation{"identifier", "x"}, expectation{"number", "1"})
+}
+
+// probe parses the text, and returns the furthest position the Parser reached, whether parsing succeeded,
+// and the innermost rule that ends at that position, which is the last the Parser matched.
+func probe(text string) (int, bool, pegRule) {
+	p := &Parser{Buffer: text}
+	if err := p.Init(); err != nil {
+		return 0, false, ruleUnknown
+	}
+	err := p.Parse()
+	if err == nil {
+		end := uint32(len([]rune(text)))
+		for _, t := range p.Tokens() {
+			if t.end == end && t.begin < t.end {
+				return len(p.buffer), true, t.pegRule
+			}
+		}
+		return len(p.buffer), true, ruleUnknown
+	}
+	if pe, ok := err.(*parseError); ok {
+		return int(pe.max.end), false, pe.max.pegRule
+	}
+	return 0, false, ruleUnknown
+}
+
+// isKeywordRule indicates whether the rule matches a keyword or other token, rather than an identifier.
+func isKeywordRule(rule pegRule) bool {
+	return isTokenName(rul3s[rule])
+}
+
+// needsSpace indicates whether the probe would run into the end of the prefix, making a single word.
+func needsSpace(prefix []rune, probe string) bool {
+	if len(prefix) == 0 || len(probe) == 0 {
+		return false
+	}
+	return isTextChar(prefix[len(prefix)-1]) && (isTextChar([]rune(probe)[0]) || probe[0] == '-')
+}
+
+func isTextChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.'
+}
+
+// joinExpected joins expectation labels for a message: "`a`", "`a` or `b`", "`a`, `b` or `c`".
+func joinExpected(expected []string) string {
+	if len(expected) == 1 {
+		return expected[0]
+	}
+	return strings.Join(expected[:len(expected)-1], ", ") + " or " + expected[len(expected)-1]
+}
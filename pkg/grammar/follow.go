@@ -0,0 +1,424 @@
+package grammar
+
+import (
+	_ "embed"
+	"strings"
+	"unicode"
+)
+
+// grammarPeg is the grammar the Parser is generated from, which tells us the tokens to expect after each rule.
+//
+//go:embed grammar.peg
+var grammarPeg string
+
+// flagToken is the token that starts a Flag, which we show together with the name of each Flag (ex: `--strict`).
+const flagToken = "FLAG"
+
+// follows are the tokens that may come right after each rule, by the name of the rule, in the order grammar.peg defines them.
+var follows = analyzeGrammar(grammarPeg)
+
+// token is a keyword or other token of the grammar: a rule that grammar.peg names in capitals, which matches a fixed text.
+type token struct {
+	rule string // rule is the name of the rule in grammar.peg.
+	text string // text is what the rule matches (ex: `create`, `item?`, `=`).
+}
+
+// pegExpr is a parsed expression of grammar.peg.
+type pegExpr struct {
+	op   byte // op is one of `r` for a rule, `'` for a literal, `[` for a class, `.`, `s` for a sequence, `/`, `?`, `*`, `+`, `&` or `!`.
+	text string
+	kids []*pegExpr
+}
+
+// analyzeGrammar returns the tokens that may come after each rule of the grammar.
+// It ignores predicates and actions, so the tokens after a rule are more than the Parser may accept: we check each by parsing.
+func analyzeGrammar(source string) map[string][]token {
+	names, rules := parsePeg(source)
+	found := make([]token, 0)
+	for _, name := range names {
+		if text, ok := literalText(rules[name]); ok && isTokenName(name) && text != "" {
+			found = append(found, token{rule: name, text: text})
+		}
+	}
+
+	// The usual fixed points: which rules match nothing, the tokens that start each rule, then the tokens after each.
+	nullable := make(map[string]bool)
+	first := make(map[string]map[string]bool)
+	for _, name := range names {
+		first[name] = make(map[string]bool)
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, name := range names {
+			if n := isNullable(rules[name], nullable); n && !nullable[name] {
+				nullable[name], changed = true, true
+			}
+			if isTokenName(name) {
+				if !first[name][name] {
+					first[name][name], changed = true, true
+				}
+				continue
+			}
+			for t := range firstOf(rules[name], first, nullable) {
+				if !first[name][t] {
+					first[name][t], changed = true, true
+				}
+			}
+		}
+	}
+	after := make(map[string]map[string]bool)
+	for _, name := range names {
+		after[name] = make(map[string]bool)
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, name := range names {
+			changed = addFollows(rules[name], after[name], after, first, nullable) || changed
+		}
+	}
+
+	byRule := make(map[string][]token)
+	for name, set := range after {
+		for _, t := range found {
+			if set[t.rule] {
+				byRule[name] = append(byRule[name], t)
+			}
+		}
+	}
+	return byRule
+}
+
+// isTokenName indicates whether grammar.peg names a token with the rule name: all capitals, ex: `CREATE`, `API_SPEC`.
+func isTokenName(name string) bool {
+	return strings.ToUpper(name) == name && strings.ToLower(name) != name
+}
+
+// literalText returns the text of an expression that is a fixed text with optional predicates and whitespace (ex: `'item' 's'? !TextChar _`).
+func literalText(e *pegExpr) (string, bool) {
+	switch e.op {
+	case '\'':
+		return e.text, true
+	case 's':
+		text := ""
+		for _, kid := range e.kids {
+			switch {
+			case kid.op == '\'':
+				text += kid.text
+			case kid.op == '?' || kid.op == '!' || kid.op == '&' || (kid.op == 'r' && kid.text == "_"):
+				// Optional suffixes such as a plural `s`, and whatever follows, aren't part of the keyword.
+			default:
+				return "", false
+			}
+		}
+		return text, true
+	}
+	return "", false
+}
+
+func isNullable(e *pegExpr, nullable map[string]bool) bool {
+	switch e.op {
+	case 'r':
+		return nullable[e.text]
+	case '\'':
+		return e.text == ""
+	case '[', '.':
+		return false
+	case '?', '*', '&', '!':
+		return true
+	case '+':
+		return isNullable(e.kids[0], nullable)
+	case 's':
+		for _, kid := range e.kids {
+			if !isNullable(kid, nullable) {
+				return false
+			}
+		}
+		return true
+	case '/':
+		for _, kid := range e.kids {
+			if isNullable(kid, nullable) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// firstOf returns the tokens that may start the expression.
+func firstOf(e *pegExpr, first map[string]map[string]bool, nullable map[string]bool) map[string]bool {
+	set := make(map[string]bool)
+	switch e.op {
+	case 'r':
+		for t := range first[e.text] {
+			set[t] = true
+		}
+	case '?', '*', '+':
+		return firstOf(e.kids[0], first, nullable)
+	case 's':
+		for _, kid := range e.kids {
+			for t := range firstOf(kid, first, nullable) {
+				set[t] = true
+			}
+			if !isNullable(kid, nullable) {
+				break
+			}
+		}
+	case '/':
+		for _, kid := range e.kids {
+			for t := range firstOf(kid, first, nullable) {
+				set[t] = true
+			}
+		}
+	}
+	return set
+}
+
+// addFollows adds the tokens that may come after each rule in the expression, given those that may come after the expression.
+// It returns whether it added any.
+func addFollows(e *pegExpr, next map[string]bool, after map[string]map[string]bool, first map[string]map[string]bool, nullable map[string]bool) bool {
+	changed := false
+	switch e.op {
+	case 'r':
+		for t := range next {
+			if !after[e.text][t] {
+				after[e.text][t], changed = true, true
+			}
+		}
+	case '?':
+		changed = addFollows(e.kids[0], next, after, first, nullable)
+	case '&', '!':
+		// A predicate only looks ahead, so nothing after it follows what it matched.
+		changed = addFollows(e.kids[0], map[string]bool{}, after, first, nullable)
+	case '*', '+':
+		again := firstOf(e.kids[0], first, nullable)
+		for t := range next {
+			again[t] = true
+		}
+		changed = addFollows(e.kids[0], again, after, first, nullable)
+	case '/':
+		for _, kid := range e.kids {
+			changed = addFollows(kid, next, after, first, nullable) || changed
+		}
+	case 's':
+		for i := len(e.kids) - 1; i >= 0; i-- {
+			changed = addFollows(e.kids[i], next, after, first, nullable) || changed
+			starts := firstOf(e.kids[i], first, nullable)
+			if isNullable(e.kids[i], nullable) {
+				for t := range next {
+					starts[t] = true
+				}
+			}
+			next = starts
+		}
+	}
+	return changed
+}
+
+// parsePeg returns the names of the rules in grammar.peg, in order, and the expression of each.
+func parsePeg(source string) ([]string, map[string]*pegExpr) {
+	// The rules start after the Parser struct.
+	start := strings.Index(source, "type Parser Peg")
+	p := &pegReader{s: []rune(source)}
+	if start >= 0 {
+		p.pos = len([]rune(source[:start]))
+		for p.pos < len(p.s) && p.s[p.pos] != '{' {
+			p.pos++
+		}
+		p.skipAction()
+	}
+	names := make([]string, 0)
+	rules := make(map[string]*pegExpr)
+	for {
+		p.skipSpace()
+		name := p.ident()
+		if name == "" {
+			break
+		}
+		p.skipSpace()
+		if !p.consume("<-") {
+			break
+		}
+		names = append(names, name)
+		rules[name] = p.choice()
+	}
+	return names, rules
+}
+
+// pegReader reads expressions from the text of grammar.peg.
+type pegReader struct {
+	s   []rune
+	pos int
+}
+
+func (p *pegReader) choice() *pegExpr {
+	kids := []*pegExpr{p.sequence()}
+	for p.skipSpace(); p.consume("/"); p.skipSpace() {
+		kids = append(kids, p.sequence())
+	}
+	if len(kids) == 1 {
+		return kids[0]
+	}
+	return &pegExpr{op: '/', kids: kids}
+}
+
+func (p *pegReader) sequence() *pegExpr {
+	kids := make([]*pegExpr, 0)
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.s) || p.atRule() {
+			break
+		}
+		if c := p.s[p.pos]; c == '/' || c == ')' {
+			break
+		}
+		if kid := p.prefixed(); kid != nil {
+			kids = append(kids, kid)
+		}
+	}
+	if len(kids) == 1 {
+		return kids[0]
+	}
+	return &pegExpr{op: 's', kids: kids}
+}
+
+func (p *pegReader) prefixed() *pegExpr {
+	if c := p.s[p.pos]; c == '&' || c == '!' {
+		p.pos++
+		p.skipSpace()
+		return &pegExpr{op: byte(c), kids: []*pegExpr{p.suffixed()}}
+	}
+	return p.suffixed()
+}
+
+func (p *pegReader) suffixed() *pegExpr {
+	e := p.primary()
+	for e != nil && p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if c != '?' && c != '*' && c != '+' {
+			break
+		}
+		p.pos++
+		e = &pegExpr{op: byte(c), kids: []*pegExpr{e}}
+	}
+	return e
+}
+
+// primary returns the next rule, literal, class, dot or group, or nil for the markers and actions that don't match anything.
+func (p *pegReader) primary() *pegExpr {
+	switch c := p.s[p.pos]; {
+	case c == '(':
+		p.pos++
+		e := p.choice()
+		p.skipSpace()
+		p.consume(")")
+		return e
+	case c == '\'' || c == '"':
+		return &pegExpr{op: '\'', text: p.quoted(c)}
+	case c == '[':
+		return &pegExpr{op: '[', text: p.quoted(']')}
+	case c == '.':
+		p.pos++
+		return &pegExpr{op: '.'}
+	case c == '<' || c == '>':
+		p.pos++
+		return nil
+	case c == '{':
+		p.skipAction()
+		return nil
+	}
+	if name := p.ident(); name != "" {
+		return &pegExpr{op: 'r', text: name}
+	}
+	// Skip anything else, so a mistake in the grammar never stops us.
+	p.pos++
+	return nil
+}
+
+// quoted reads a literal or a class up to the closing rune, and returns the text between, with escapes replaced.
+func (p *pegReader) quoted(closing rune) string {
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.s) && p.s[p.pos] != closing {
+		c := p.s[p.pos]
+		if c == '\\' && p.pos+1 < len(p.s) {
+			p.pos++
+			switch c = p.s[p.pos]; c {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			}
+		}
+		b.WriteRune(c)
+		p.pos++
+	}
+	p.pos++
+	return b.String()
+}
+
+// skipAction skips a block in braces, with any braces in the Go strings inside.
+func (p *pegReader) skipAction() {
+	depth := 0
+	for ; p.pos < len(p.s); p.pos++ {
+		switch c := p.s[p.pos]; c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				p.pos++
+				return
+			}
+		case '"', '`':
+			for p.pos++; p.pos < len(p.s) && p.s[p.pos] != c; p.pos++ {
+				if c == '"' && p.s[p.pos] == '\\' {
+					p.pos++
+				}
+			}
+		}
+	}
+}
+
+func (p *pegReader) skipSpace() {
+	for p.pos < len(p.s) {
+		switch c := p.s[p.pos]; {
+		case unicode.IsSpace(c):
+			p.pos++
+		case c == '#':
+			for p.pos < len(p.s) && p.s[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// atRule indicates whether the next rule in the grammar starts here (ex: `Identifier <-`).
+func (p *pegReader) atRule() bool {
+	pos := p.pos
+	defer func() { p.pos = pos }()
+	if p.ident() == "" {
+		return false
+	}
+	p.skipSpace()
+	return p.consume("<-")
+}
+
+func (p *pegReader) ident() string {
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] == '_' || unicode.IsLetter(p.s[p.pos]) || (p.pos > start && unicode.IsDigit(p.s[p.pos]))) {
+		p.pos++
+	}
+	return string(p.s[start:p.pos])
+}
+
+func (p *pegReader) consume(s string) bool {
+	if !strings.HasPrefix(string(p.s[p.pos:min(p.pos+len(s), len(p.s))]), s) {
+		return false
+	}
+	p.pos += len([]rune(s))
+	return true
+}
@@ -15,7 +15,7 @@ import (
 const endSymbol rune = 1114112
 
 /* The rule types inferred from the grammar are below. */
-type pegRule uint8
+type pegRule uint16
 
 const (
 	ruleUnknown pegRule = iota
@@ -23,6 +23,8 @@ const (
 	ruleResponse
 	ruleCommand
 	ruleMutation
+	ruleWorldMutation
+	ruleHistoryMutation
 	ruleTreeMutation
 	ruleQuery
 	ruleFetchQuery
@@ -35,37 +37,112 @@ const (
 	ruleWorldObject
 	ruleItemObject
 	ruleRelObject
+	ruleNodeObject
+	ruleDeployObject
+	ruleScenarioObject
+	ruleStepObject
+	ruleItemDetailObject
+	ruleChangeSetObject
+	ruleDataFlowObject
 	ruleIdentifierListObject
 	ruleTree
 	ruleNil
 	ruleStatusObject
+	ruleStatusMessage
+	ruleStatusSuggestions
+	ruleStatusMissing
+	ruleStatusSuggestion
+	ruleDetailItem
+	ruleViewObject
+	rulePinObject
+	ruleStyleObject
+	ruleDetailParent
+	ruleDetailComponents
+	ruleDetailComponent
+	ruleDetailRel
+	ruleDetailEnd
+	ruleChangeMatched
+	ruleChangeMatchedId
+	ruleChange
+	ruleChangeAction
+	ruleChangeMoved
+	ruleChangeFrom
+	ruleChangeTo
+	ruleChangeEnd
+	ruleFlowClass
+	ruleFlowStep
+	ruleFlowKind
+	ruleFlowId
+	ruleFlowPathRel
+	ruleFlowEnd
 	ruleErrCode
 	ruleLimit
+	ruleOwnerFilter
 	ruleIdentifier
 	ruleSecondIdentifier
+	ruleTargets
+	ruleNestTargets
+	ruleTarget
+	ruleNestParent
+	ruleSelector
+	ruleGlobSelector
+	ruleGlobChar
+	ruleRegexSelector
+	ruleInSelector
+	ruleAssignment
+	ruleAssignmentKey
+	ruleAssignmentValue
 	ruleDualIdentifier
+	rulePinTarget
 	ruleIdentifierList
 	ruleWorldParams
 	ruleItemParams
 	ruleRelParams
+	ruleNodeParams
+	ruleScenarioParams
+	ruleStepParams
+	ruleViewParams
+	rulePinParams
+	ruleStyleParams
+	ruleLinkParams
+	ruleWorldSetParams
 	ruleWorldParamVersion
 	ruleWorldParamId
 	ruleWorldParamName
 	ruleWorldParamExpanded
+	ruleWorldParamTheme
+	ruleWorldSetParam
 	ruleItemParam
 	ruleRelParam
+	ruleNodeParam
+	ruleScenarioParam
+	ruleStepParam
+	ruleViewParam
+	rulePinParam
+	ruleStyleParam
+	rulePinView
+	ruleLinkParam
+	ruleLinkKind
+	ruleLinkTarget
 	ruleItemKeys
 	ruleRelKeys
 	ruleItemKey
 	ruleRelKey
 	ruleStringLike
 	ruleNumber
+	ruleDecimal
 	ruleBoolean
 	ruleText
+	ruleTextChar
 	ruleQuotedText
 	ruleItemExists
 	ruleRelExists
 	ruleWorld
+	ruleNode
+	ruleScenario
+	ruleView
+	ruleStyle
+	ruleLayout
 	ruleItem
 	ruleRel
 	ruleCreate
@@ -80,14 +157,65 @@ const (
 	ruleInQuery
 	ruleFromQuery
 	ruleToQuery
+	ruleAncestorsQuery
+	ruleSiblingsQuery
+	ruleOwnersQuery
+	ruleDataFlowQuery
+	ruleOwnersImport
+	ruleCrossingsQuery
+	ruleThreatsExport
+	ruleDeployedQuery
+	ruleDeploy
+	ruleUndeploy
+	ruleStep
+	ruleUnstep
+	rulePin
+	ruleUnpin
+	ruleTreeQuery
+	ruleUndo
+	ruleRedo
+	ruleSave
+	ruleLoad
+	ruleNew
+	ruleUse
+	ruleOpen
+	ruleClose
+	ruleCopy
+	ruleClone
+	ruleMerge
+	ruleSplit
+	ruleArchive
+	ruleRestore
+	ruleLink
+	ruleUnlink
+	ruleExport
 	ruleFlag
 	ruleStrictFlag
 	ruleVerboseFlag
 	ruleIdsFlag
+	ruleDryRunFlag
+	ruleCascadeFlag
+	ruleAllRelsFlag
+	ruleArchivedFlag
+	ruleDepthFlag
+	ruleViewFlag
 	ruleBeginWorld
 	ruleEndWorld
+	ruleBeginDetail
+	ruleEndDetail
+	ruleBeginChanges
+	ruleEndChanges
+	ruleBeginDataFlow
+	ruleEndDataFlow
 	ruleItemType
-	ruleKeyword
+	ruleLifecycleStatus
+	ruleDeploymentKind
+	ruleThemeName
+	ruleNotStatement
+	ruleNotVerb
+	ruleNotIn
+	ruleNotAssign
+	ruleNotFlag
 	ruleWORLD
 	ruleENDWORLD
 	ruleERROR
@@ -99,7 +227,30 @@ const (
 	ruleFROM_QUERY
 	ruleTO_QUERY
 	ruleIN
+	ruleIN_SELECTOR
 	ruleIN_QUERY
+	ruleANCESTORS_QUERY
+	ruleSIBLINGS_QUERY
+	ruleOWNERS_QUERY
+	ruleDATAFLOW_QUERY
+	ruleCROSSINGS_QUERY
+	ruleDEPLOYED_QUERY
+	ruleOWNERS
+	ruleIMPORT
+	ruleTHREATS
+	ruleEXPORT
+	ruleNODE
+	ruleDEPLOY
+	ruleUNDEPLOY
+	ruleFROM
+	ruleSCENARIO
+	ruleSTEP
+	ruleUNSTEP
+	ruleLAYOUT
+	rulePIN
+	ruleUNPIN
+	ruleSTYLE
+	ruleTREE
 	ruleCREATE
 	ruleDELETE
 	ruleSET
@@ -109,6 +260,26 @@ const (
 	ruleEXISTS
 	ruleFREE
 	ruleNEST
+	ruleSAVE
+	ruleLOAD
+	ruleNEW
+	ruleUSE
+	ruleOPEN
+	ruleCLOSE
+	ruleUNDO
+	ruleREDO
+	ruleCOPY
+	ruleCLONE
+	ruleMERGE
+	ruleSPLIT
+	ruleARCHIVE
+	ruleRESTORE
+	ruleLINK
+	ruleUNLINK
+	ruleTO
+	ruleAS
+	ruleINTO
+	ruleASSIGN
 	ruleTRUE
 	ruleFALSE
 	ruleEXTERNAL
@@ -118,8 +289,44 @@ const (
 	ruleMECHANISM
 	ruleASYNC
 	ruleEXPANDED
+	ruleARCHIVED
+	ruleSTATUS
+	ruleOWNER
+	ruleCONTACTS
+	ruleSOURCE
+	ruleLINKS
+	ruleCLASSIFICATION
+	ruleBOUNDARY
+	ruleTAGS
+	ruleEXPAND
+	ruleFILTER
+	ruleFOCUS
+	ruleHOPS
+	ruleX
+	ruleY
+	ruleWIDTH
+	ruleHEIGHT
+	ruleWAYPOINTS
+	ruleTHEME
+	ruleMATCH
+	ruleSHAPE
+	ruleCOLOR
+	ruleBORDER
+	ruleLINE
+	ruleKIND
+	rulePARENT
+	ruleLABEL
+	ruleAT
+	ruleRUNBOOK
+	ruleDASHBOARD
+	ruleREPO
+	ruleADR
+	ruleAPI_SPEC
 	ruleVERSION
 	ruleID
+	ruleENVIRONMENT
+	ruleCLUSTER
+	ruleNODE_KIND
 	rulePERSON
 	ruleDATABASE
 	ruleQUEUE
@@ -129,6 +336,13 @@ const (
 	ruleSERVER
 	ruleDEVICE
 	ruleCODE
+	rulePLANNED
+	ruleACTIVE
+	ruleDEPRECATED
+	ruleRETIRED
+	ruleC4
+	ruleDARK
+	rulePRINT
 	ruleDELIMITER
 	ruleQUOTE
 	ruleEQUALS
@@ -136,6 +350,11 @@ const (
 	ruleSTRICT
 	ruleVERBOSE
 	ruleIDS
+	ruleDRY_RUN
+	ruleCASCADE
+	ruleALL_RELS
+	ruleDEPTH
+	ruleVIEW
 	rule_
 	ruleWhitespace
 	ruleEOL
@@ -199,6 +418,144 @@ const (
 	ruleAction55
 	ruleAction56
 	ruleAction57
+	ruleAction58
+	ruleAction59
+	ruleAction60
+	ruleAction61
+	ruleAction62
+	ruleAction63
+	ruleAction64
+	ruleAction65
+	ruleAction66
+	ruleAction67
+	ruleAction68
+	ruleAction69
+	ruleAction70
+	ruleAction71
+	ruleAction72
+	ruleAction73
+	ruleAction74
+	ruleAction75
+	ruleAction76
+	ruleAction77
+	ruleAction78
+	ruleAction79
+	ruleAction80
+	ruleAction81
+	ruleAction82
+	ruleAction83
+	ruleAction84
+	ruleAction85
+	ruleAction86
+	ruleAction87
+	ruleAction88
+	ruleAction89
+	ruleAction90
+	ruleAction91
+	ruleAction92
+	ruleAction93
+	ruleAction94
+	ruleAction95
+	ruleAction96
+	ruleAction97
+	ruleAction98
+	ruleAction99
+	ruleAction100
+	ruleAction101
+	ruleAction102
+	ruleAction103
+	ruleAction104
+	ruleAction105
+	ruleAction106
+	ruleAction107
+	ruleAction108
+	ruleAction109
+	ruleAction110
+	ruleAction111
+	ruleAction112
+	ruleAction113
+	ruleAction114
+	ruleAction115
+	ruleAction116
+	ruleAction117
+	ruleAction118
+	ruleAction119
+	ruleAction120
+	ruleAction121
+	ruleAction122
+	ruleAction123
+	ruleAction124
+	ruleAction125
+	ruleAction126
+	ruleAction127
+	ruleAction128
+	ruleAction129
+	ruleAction130
+	ruleAction131
+	ruleAction132
+	ruleAction133
+	ruleAction134
+	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
+	ruleAction151
+	ruleAction152
+	ruleAction153
+	ruleAction154
+	ruleAction155
+	ruleAction156
+	ruleAction157
+	ruleAction158
+	ruleAction159
+	ruleAction160
+	ruleAction161
+	ruleAction162
+	ruleAction163
+	ruleAction164
+	ruleAction165
+	ruleAction166
+	ruleAction167
+	ruleAction168
+	ruleAction169
+	ruleAction170
+	ruleAction171
+	ruleAction172
+	ruleAction173
+	ruleAction174
+	ruleAction175
+	ruleAction176
+	ruleAction177
+	ruleAction178
+	ruleAction179
+	ruleAction180
+	ruleAction181
+	ruleAction182
+	ruleAction183
+	ruleAction184
+	ruleAction185
+	ruleAction186
+	ruleAction187
+	ruleAction188
+	ruleAction189
+	ruleAction190
+	ruleAction191
+	ruleAction192
+	ruleAction193
+	ruleAction194
+	ruleAction195
 )
 
 var rul3s = [...]string{
@@ -207,6 +564,8 @@ var rul3s = [...]string{
 	"Response",
 	"Command",
 	"Mutation",
+	"WorldMutation",
+	"HistoryMutation",
 	"TreeMutation",
 	"Query",
 	"FetchQuery",
@@ -219,37 +578,112 @@ var rul3s = [...]string{
 	"WorldObject",
 	"ItemObject",
 	"RelObject",
+	"NodeObject",
+	"DeployObject",
+	"ScenarioObject",
+	"StepObject",
+	"ItemDetailObject",
+	"ChangeSetObject",
+	"DataFlowObject",
 	"IdentifierListObject",
 	"Tree",
 	"Nil",
 	"StatusObject",
+	"StatusMessage",
+	"StatusSuggestions",
+	"StatusMissing",
+	"StatusSuggestion",
+	"DetailItem",
+	"ViewObject",
+	"PinObject",
+	"StyleObject",
+	"DetailParent",
+	"DetailComponents",
+	"DetailComponent",
+	"DetailRel",
+	"DetailEnd",
+	"ChangeMatched",
+	"ChangeMatchedId",
+	"Change",
+	"ChangeAction",
+	"ChangeMoved",
+	"ChangeFrom",
+	"ChangeTo",
+	"ChangeEnd",
+	"FlowClass",
+	"FlowStep",
+	"FlowKind",
+	"FlowId",
+	"FlowPathRel",
+	"FlowEnd",
 	"ErrCode",
 	"Limit",
+	"OwnerFilter",
 	"Identifier",
 	"SecondIdentifier",
+	"Targets",
+	"NestTargets",
+	"Target",
+	"NestParent",
+	"Selector",
+	"GlobSelector",
+	"GlobChar",
+	"RegexSelector",
+	"InSelector",
+	"Assignment",
+	"AssignmentKey",
+	"AssignmentValue",
 	"DualIdentifier",
+	"PinTarget",
 	"IdentifierList",
 	"WorldParams",
 	"ItemParams",
 	"RelParams",
+	"NodeParams",
+	"ScenarioParams",
+	"StepParams",
+	"ViewParams",
+	"PinParams",
+	"StyleParams",
+	"LinkParams",
+	"WorldSetParams",
 	"WorldParamVersion",
 	"WorldParamId",
 	"WorldParamName",
 	"WorldParamExpanded",
+	"WorldParamTheme",
+	"WorldSetParam",
 	"ItemParam",
 	"RelParam",
+	"NodeParam",
+	"ScenarioParam",
+	"StepParam",
+	"ViewParam",
+	"PinParam",
+	"StyleParam",
+	"PinView",
+	"LinkParam",
+	"LinkKind",
+	"LinkTarget",
 	"ItemKeys",
 	"RelKeys",
 	"ItemKey",
 	"RelKey",
 	"StringLike",
 	"Number",
+	"Decimal",
 	"Boolean",
 	"Text",
+	"TextChar",
 	"QuotedText",
 	"ItemExists",
 	"RelExists",
 	"World",
+	"Node",
+	"Scenario",
+	"View",
+	"Style",
+	"Layout",
 	"Item",
 	"Rel",
 	"Create",
@@ -264,14 +698,65 @@ var rul3s = [...]string{
 	"InQuery",
 	"FromQuery",
 	"ToQuery",
+	"AncestorsQuery",
+	"SiblingsQuery",
+	"OwnersQuery",
+	"DataFlowQuery",
+	"OwnersImport",
+	"CrossingsQuery",
+	"ThreatsExport",
+	"DeployedQuery",
+	"Deploy",
+	"Undeploy",
+	"Step",
+	"Unstep",
+	"Pin",
+	"Unpin",
+	"TreeQuery",
+	"Undo",
+	"Redo",
+	"Save",
+	"Load",
+	"New",
+	"Use",
+	"Open",
+	"Close",
+	"Copy",
+	"Clone",
+	"Merge",
+	"Split",
+	"Archive",
+	"Restore",
+	"Link",
+	"Unlink",
+	"Export",
 	"Flag",
 	"StrictFlag",
 	"VerboseFlag",
 	"IdsFlag",
+	"DryRunFlag",
+	"CascadeFlag",
+	"AllRelsFlag",
+	"ArchivedFlag",
+	"DepthFlag",
+	"ViewFlag",
 	"BeginWorld",
 	"EndWorld",
+	"BeginDetail",
+	"EndDetail",
+	"BeginChanges",
+	"EndChanges",
+	"BeginDataFlow",
+	"EndDataFlow",
 	"ItemType",
-	"Keyword",
+	"LifecycleStatus",
+	"DeploymentKind",
+	"ThemeName",
+	"NotStatement",
+	"NotVerb",
+	"NotIn",
+	"NotAssign",
+	"NotFlag",
 	"WORLD",
 	"ENDWORLD",
 	"ERROR",
@@ -283,7 +768,30 @@ var rul3s = [...]string{
 	"FROM_QUERY",
 	"TO_QUERY",
 	"IN",
+	"IN_SELECTOR",
 	"IN_QUERY",
+	"ANCESTORS_QUERY",
+	"SIBLINGS_QUERY",
+	"OWNERS_QUERY",
+	"DATAFLOW_QUERY",
+	"CROSSINGS_QUERY",
+	"DEPLOYED_QUERY",
+	"OWNERS",
+	"IMPORT",
+	"THREATS",
+	"EXPORT",
+	"NODE",
+	"DEPLOY",
+	"UNDEPLOY",
+	"FROM",
+	"SCENARIO",
+	"STEP",
+	"UNSTEP",
+	"LAYOUT",
+	"PIN",
+	"UNPIN",
+	"STYLE",
+	"TREE",
 	"CREATE",
 	"DELETE",
 	"SET",
@@ -293,6 +801,26 @@ var rul3s = [...]string{
 	"EXISTS",
 	"FREE",
 	"NEST",
+	"SAVE",
+	"LOAD",
+	"NEW",
+	"USE",
+	"OPEN",
+	"CLOSE",
+	"UNDO",
+	"REDO",
+	"COPY",
+	"CLONE",
+	"MERGE",
+	"SPLIT",
+	"ARCHIVE",
+	"RESTORE",
+	"LINK",
+	"UNLINK",
+	"TO",
+	"AS",
+	"INTO",
+	"ASSIGN",
 	"TRUE",
 	"FALSE",
 	"EXTERNAL",
@@ -302,8 +830,44 @@ var rul3s = [...]string{
 	"MECHANISM",
 	"ASYNC",
 	"EXPANDED",
+	"ARCHIVED",
+	"STATUS",
+	"OWNER",
+	"CONTACTS",
+	"SOURCE",
+	"LINKS",
+	"CLASSIFICATION",
+	"BOUNDARY",
+	"TAGS",
+	"EXPAND",
+	"FILTER",
+	"FOCUS",
+	"HOPS",
+	"X",
+	"Y",
+	"WIDTH",
+	"HEIGHT",
+	"WAYPOINTS",
+	"THEME",
+	"MATCH",
+	"SHAPE",
+	"COLOR",
+	"BORDER",
+	"LINE",
+	"KIND",
+	"PARENT",
+	"LABEL",
+	"AT",
+	"RUNBOOK",
+	"DASHBOARD",
+	"REPO",
+	"ADR",
+	"API_SPEC",
 	"VERSION",
 	"ID",
+	"ENVIRONMENT",
+	"CLUSTER",
+	"NODE_KIND",
 	"PERSON",
 	"DATABASE",
 	"QUEUE",
@@ -313,6 +877,13 @@ var rul3s = [...]string{
 	"SERVER",
 	"DEVICE",
 	"CODE",
+	"PLANNED",
+	"ACTIVE",
+	"DEPRECATED",
+	"RETIRED",
+	"C4",
+	"DARK",
+	"PRINT",
 	"DELIMITER",
 	"QUOTE",
 	"EQUALS",
@@ -320,6 +891,11 @@ var rul3s = [...]string{
 	"STRICT",
 	"VERBOSE",
 	"IDS",
+	"DRY_RUN",
+	"CASCADE",
+	"ALL_RELS",
+	"DEPTH",
+	"VIEW",
 	"_",
 	"Whitespace",
 	"EOL",
@@ -383,6 +959,144 @@ var rul3s = [...]string{
 	"Action55",
 	"Action56",
 	"Action57",
+	"Action58",
+	"Action59",
+	"Action60",
+	"Action61",
+	"Action62",
+	"Action63",
+	"Action64",
+	"Action65",
+	"Action66",
+	"Action67",
+	"Action68",
+	"Action69",
+	"Action70",
+	"Action71",
+	"Action72",
+	"Action73",
+	"Action74",
+	"Action75",
+	"Action76",
+	"Action77",
+	"Action78",
+	"Action79",
+	"Action80",
+	"Action81",
+	"Action82",
+	"Action83",
+	"Action84",
+	"Action85",
+	"Action86",
+	"Action87",
+	"Action88",
+	"Action89",
+	"Action90",
+	"Action91",
+	"Action92",
+	"Action93",
+	"Action94",
+	"Action95",
+	"Action96",
+	"Action97",
+	"Action98",
+	"Action99",
+	"Action100",
+	"Action101",
+	"Action102",
+	"Action103",
+	"Action104",
+	"Action105",
+	"Action106",
+	"Action107",
+	"Action108",
+	"Action109",
+	"Action110",
+	"Action111",
+	"Action112",
+	"Action113",
+	"Action114",
+	"Action115",
+	"Action116",
+	"Action117",
+	"Action118",
+	"Action119",
+	"Action120",
+	"Action121",
+	"Action122",
+	"Action123",
+	"Action124",
+	"Action125",
+	"Action126",
+	"Action127",
+	"Action128",
+	"Action129",
+	"Action130",
+	"Action131",
+	"Action132",
+	"Action133",
+	"Action134",
+	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
+	"Action151",
+	"Action152",
+	"Action153",
+	"Action154",
+	"Action155",
+	"Action156",
+	"Action157",
+	"Action158",
+	"Action159",
+	"Action160",
+	"Action161",
+	"Action162",
+	"Action163",
+	"Action164",
+	"Action165",
+	"Action166",
+	"Action167",
+	"Action168",
+	"Action169",
+	"Action170",
+	"Action171",
+	"Action172",
+	"Action173",
+	"Action174",
+	"Action175",
+	"Action176",
+	"Action177",
+	"Action178",
+	"Action179",
+	"Action180",
+	"Action181",
+	"Action182",
+	"Action183",
+	"Action184",
+	"Action185",
+	"Action186",
+	"Action187",
+	"Action188",
+	"Action189",
+	"Action190",
+	"Action191",
+	"Action192",
+	"Action193",
+	"Action194",
+	"Action195",
 }
 
 type token32 struct {
@@ -503,10 +1217,17 @@ type Parser struct {
 	number int    // Number parsed by the Number rule.
 	bool   bool   // Boolean parsed by the Boolean rule.
 
-	Tree        Node     // The root of the world.Tree.
-	TreeString  string   // Track the string representation of the Tree parsed by the Tree rule.
-	ItemStrings []string // Track the string representations of Items parsed by the ItemObject rule.
-	RelStrings  []string // Track the string representations of Rels parsed by the RelObject rule.
+	Tree            Node     // The root of the world.Tree.
+	TreeString      string   // Track the string representation of the Tree parsed by the Tree rule.
+	ItemStrings     []string // Track the string representations of Items parsed by the ItemObject rule.
+	RelStrings      []string // Track the string representations of Rels parsed by the RelObject rule.
+	NodeStrings     []string // Track the string representations of deployment Nodes parsed by the NodeObject rule.
+	DeployStrings   []string // Track the string representations of deployments parsed by the DeployObject rule.
+	ScenarioStrings []string // Track the string representations of Scenarios, without their steps, parsed by the ScenarioObject rule.
+	StepStrings     []string // Track the string representations of Scenario steps parsed by the StepObject rule.
+	ViewStrings     []string // Track the string representations of saved Views parsed by the ViewObject rule.
+	PinStrings      []string // Track the string representations of layout Pins parsed by the PinObject rule.
+	StyleStrings    []string // Track the string representations of Styles parsed by the StyleObject rule.
 
 	// For building the tree.
 	currentId string // Current Identifier being parsed.
@@ -515,9 +1236,24 @@ type Parser struct {
 	// For parsing World.
 	WorldParams map[string]string
 
+	// For parsing verbose Item details.
+	Details []ItemDetail // Details parsed by the ItemDetailObject rule.
+	detail  ItemDetail   // Current ItemDetail being parsed.
+
+	// For parsing links.
+	linkKind string // Kind of the Link being parsed.
+
+	// For parsing a ChangeSet from a dry run.
+	Changes ChangeSet // Changes parsed by the ChangeSetObject rule.
+	change  Change    // Current Change being parsed.
+
+	// For parsing a DataFlow from a dataflow query.
+	DataFlow DataFlow // DataFlow parsed by the DataFlowObject rule.
+	flowStep FlowStep // Current FlowStep being parsed.
+
 	Buffer string
 	buffer []rune
-	rules  [181]func() bool
+	rules  [538]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -631,20 +1367,39 @@ func (p *Parser) Execute() {
 		case ruleAction2:
 			p.InputAttributes.SecondaryIds = append(p.InputAttributes.SecondaryIds, cleanString(text))
 		case ruleAction3:
-			p.InputAttributes.Verb = "fetch"
+			p.InputAttributes.SecondaryIds = append(p.InputAttributes.SecondaryIds, cleanString(text))
 		case ruleAction4:
-			p.InputAttributes.Verb = "list"
+			p.InputAttributes.Params["path"] = cleanString(text)
 		case ruleAction5:
-			p.InputAttributes.Verb = "create-or-fetch"
+			p.InputAttributes.Params["path"] = cleanString(text)
 		case ruleAction6:
-			p.InputAttributes.Verb = "create-or-set"
+			p.InputAttributes.Params["path"] = cleanString(text)
 		case ruleAction7:
+			p.InputAttributes.Params["at"] = cleanString(text)
+		case ruleAction8:
+			p.InputAttributes.Params["path"] = cleanString(text)
+		case ruleAction9:
+			p.InputAttributes.Verb = "fetch"
+		case ruleAction10:
+			p.InputAttributes.Verb = "in"
+		case ruleAction11:
+			p.InputAttributes.Params["class"] = cleanString(text)
+		case ruleAction12:
+			p.InputAttributes.Verb = "create-or-fetch"
+		case ruleAction13:
+			p.InputAttributes.Verb = "create-or-set"
+		case ruleAction14:
 
 			p.StmtType = "WorldObject"
 			p.Response.Object.Type = "world"
-			p.Response.Object.Repr = strings.Join(append([]string{p.WorldParams["paramString"], p.TreeString}, p.RelStrings...), "\n")
+			lines := append([]string{p.WorldParams["paramString"], p.TreeString}, p.RelStrings...)
+			lines = append(append(lines, p.NodeStrings...), p.DeployStrings...)
+			lines = append(append(lines, p.ScenarioStrings...), p.StepStrings...)
+			lines = append(append(lines, p.ViewStrings...), p.PinStrings...)
+			lines = append(lines, p.StyleStrings...)
+			p.Response.Object.Repr = strings.Join(lines, "\n")
 
-		case ruleAction8:
+		case ruleAction15:
 
 			p.Response.Object.Type = "item"
 			p.Response.Object.Repr = strings.TrimSpace(text)
@@ -652,15 +1407,49 @@ func (p *Parser) Execute() {
 			p.currentId = p.InputAttributes.ResourceId
 			p.nodeStack = append(p.nodeStack, Node{Id: p.currentId, Children: []Node{}})
 
-		case ruleAction9:
+		case ruleAction16:
 			p.Response.Object.Type = "rel"
 			p.Response.Object.Repr = strings.TrimSpace(text)
 			p.RelStrings = append(p.RelStrings, strings.TrimSpace(text))
-		case ruleAction10:
+		case ruleAction17:
+			p.Response.Object.Type = "node"
+			p.Response.Object.Repr = strings.TrimSpace(text)
+			p.NodeStrings = append(p.NodeStrings, strings.TrimSpace(text))
+		case ruleAction18:
+			p.DeployStrings = append(p.DeployStrings, strings.TrimSpace(text))
+		case ruleAction19:
+			p.Response.Object.Type = "scenario"
+			p.Response.Object.Repr = strings.TrimSpace(text)
+			p.ScenarioStrings = append(p.ScenarioStrings, strings.TrimSpace(text))
+		case ruleAction20:
+
+			p.Response.Object.Repr = strings.TrimSpace(p.Response.Object.Repr + "\n" + strings.TrimSpace(text))
+			p.StepStrings = append(p.StepStrings, strings.TrimSpace(text))
+
+		case ruleAction21:
+
+			p.Details = append(p.Details, p.detail)
+			p.Response.Object.Type = "detail"
+			b, _ := json.Marshal(p.Details)
+			p.Response.Object.Repr = string(b)
+
+		case ruleAction22:
+
+			p.Response.Object.Type = "changes"
+			b, _ := json.Marshal(p.Changes)
+			p.Response.Object.Repr = string(b)
+
+		case ruleAction23:
+
+			p.Response.Object.Type = "dataflow"
+			b, _ := json.Marshal(p.DataFlow)
+			p.Response.Object.Repr = string(b)
+
+		case ruleAction24:
 			p.Response.Object.Type = "ids"
 			b, _ := json.Marshal(p.InputAttributes.ResourceIds)
 			p.Response.Object.Repr = string(b)
-		case ruleAction11:
+		case ruleAction25:
 
 			p.StmtType = "Tree"
 			p.Response.Object.Type = "tree"
@@ -676,27 +1465,115 @@ func (p *Parser) Execute() {
 				}
 			}
 
-		case ruleAction12:
+		case ruleAction26:
 
 			p.currentId = "nil"
 			p.nodeStack = append(p.nodeStack, Node{Id: p.currentId, Children: []Node{}})
 
-		case ruleAction13:
+		case ruleAction27:
 
 			p.StmtType = "Status"
-			p.Response.Status.Message = cleanString(text)
 
-		case ruleAction14:
+		case ruleAction28:
+			p.Response.Status.Message = cleanString(text)
+		case ruleAction29:
+			p.Response.Status.Missing = cleanString(text)
+		case ruleAction30:
+			p.Response.Status.Suggestions = append(p.Response.Status.Suggestions, cleanString(text))
+		case ruleAction31:
+			p.detail = ItemDetail{Item: strings.TrimSpace(text), Components: []string{}, Inbound: []string{}, Outbound: []string{}}
+		case ruleAction32:
+			p.Response.Object.Type = "view"
+			p.Response.Object.Repr = strings.TrimSpace(text)
+			p.ViewStrings = append(p.ViewStrings, strings.TrimSpace(text))
+		case ruleAction33:
+			p.Response.Object.Type = "pin"
+			p.Response.Object.Repr = strings.TrimSpace(p.Response.Object.Repr + "\n" + strings.TrimSpace(text))
+			p.PinStrings = append(p.PinStrings, strings.TrimSpace(text))
+		case ruleAction34:
+			p.Response.Object.Type = "style"
+			p.Response.Object.Repr = strings.TrimSpace(text)
+			p.StyleStrings = append(p.StyleStrings, strings.TrimSpace(text))
+		case ruleAction35:
+			p.detail.Parent = cleanString(text)
+		case ruleAction36:
+			p.detail.Components = append(p.detail.Components, cleanString(text))
+		case ruleAction37:
+			p.detail.Inbound = append(p.detail.Inbound, strings.TrimSpace(text))
+		case ruleAction38:
+			p.detail.Outbound = append(p.detail.Outbound, strings.TrimSpace(text))
+		case ruleAction39:
+			p.Changes.Matched = append(p.Changes.Matched, cleanString(text))
+		case ruleAction40:
+			p.change.Object = strings.TrimSpace(text)
+			p.Changes.Changes = append(p.Changes.Changes, p.change)
+		case ruleAction41:
+			p.change.Object = strings.TrimSpace(text)
+			p.Changes.Changes = append(p.Changes.Changes, p.change)
+		case ruleAction42:
+			p.change.Object = strings.TrimSpace(text)
+			p.Changes.Changes = append(p.Changes.Changes, p.change)
+		case ruleAction43:
+			p.change.Object = strings.TrimSpace(text)
+			p.Changes.Changes = append(p.Changes.Changes, p.change)
+		case ruleAction44:
+			p.change.Object = strings.TrimSpace(text)
+			p.Changes.Changes = append(p.Changes.Changes, p.change)
+		case ruleAction45:
+			p.change.Object = strings.TrimSpace(text)
+			p.Changes.Changes = append(p.Changes.Changes, p.change)
+		case ruleAction46:
+			p.change.Object = strings.TrimSpace(text)
+			p.Changes.Changes = append(p.Changes.Changes, p.change)
+		case ruleAction47:
+			p.Changes.Changes = append(p.Changes.Changes, p.change)
+		case ruleAction48:
+			p.change = Change{Action: text}
+		case ruleAction49:
+			p.change = Change{Action: "moved", Object: cleanString(text)}
+		case ruleAction50:
+			p.change.From = cleanString(text)
+		case ruleAction51:
+			p.change.To = cleanString(text)
+		case ruleAction52:
+			p.DataFlow.Class = cleanString(text)
+		case ruleAction53:
+			p.DataFlow.Steps = append(p.DataFlow.Steps, p.flowStep)
+		case ruleAction54:
+			p.flowStep = FlowStep{Kind: text, Path: []string{}}
+		case ruleAction55:
+			p.flowStep.Id = cleanString(text)
+		case ruleAction56:
+			p.flowStep.Path = append(p.flowStep.Path, cleanString(text))
+		case ruleAction57:
 			p.Response.Status.Code = p.number
-		case ruleAction15:
+		case ruleAction58:
 			p.InputAttributes.Params["limit"] = cleanString(text)
-		case ruleAction16:
+		case ruleAction59:
+			p.InputAttributes.Params["owner"] = cleanString(text)
+		case ruleAction60:
 			p.InputAttributes.ResourceId = cleanString(text)
-		case ruleAction17:
+		case ruleAction61:
 
 			p.InputAttributes.SecondaryIds = append(p.InputAttributes.SecondaryIds, cleanString(text))
 
-		case ruleAction18:
+		case ruleAction62:
+			p.InputAttributes.ResourceIds = append(p.InputAttributes.ResourceIds, cleanString(text))
+		case ruleAction63:
+			p.InputAttributes.SecondaryIds = append(p.InputAttributes.SecondaryIds, cleanString(text))
+		case ruleAction64:
+			p.InputAttributes.Selectors[len(p.InputAttributes.Selectors)-1].Text = strings.TrimSpace(text)
+		case ruleAction65:
+			p.InputAttributes.Selectors = append(p.InputAttributes.Selectors, Selector{Kind: "glob", Pattern: text})
+		case ruleAction66:
+			p.InputAttributes.Selectors = append(p.InputAttributes.Selectors, Selector{Kind: "regex", Pattern: text})
+		case ruleAction67:
+			p.InputAttributes.Selectors = append(p.InputAttributes.Selectors, Selector{Kind: "in", Pattern: cleanString(text)})
+		case ruleAction68:
+			p.currentId = cleanString(text)
+		case ruleAction69:
+			p.InputAttributes.Assignments[p.currentId] = cleanString(text)
+		case ruleAction70:
 
 			p.InputAttributes.ResourceId = ""
 			ids := strings.Fields(text)
@@ -704,94 +1581,286 @@ func (p *Parser) Execute() {
 				p.InputAttributes.ResourceIds = append(p.InputAttributes.ResourceIds, cleanString(id))
 			}
 
-		case ruleAction19:
+		case ruleAction71:
 
 			p.WorldParams["paramString"] = fmt.Sprintf("version=%s\nid=%s\nname=%s\nexpanded=%s", p.WorldParams["version"], p.WorldParams["id"], p.WorldParams["name"], p.WorldParams["expanded"])
+			if theme, ok := p.WorldParams["theme"]; ok {
+				p.WorldParams["paramString"] += "\ntheme=" + theme
+			}
 
-		case ruleAction20:
+		case ruleAction72:
 			p.WorldParams["version"] = cleanString(text)
-		case ruleAction21:
+		case ruleAction73:
 			p.WorldParams["id"] = cleanString(text)
-		case ruleAction22:
+		case ruleAction74:
 			p.WorldParams["name"] = strings.TrimSpace(text)
-		case ruleAction23:
+		case ruleAction75:
 			p.WorldParams["expanded"] = strings.TrimSpace(text)
-		case ruleAction24:
+		case ruleAction76:
+			p.WorldParams["theme"] = strings.TrimSpace(text)
+		case ruleAction77:
+			p.Params["name"] = cleanString(text)
+		case ruleAction78:
+			p.Params["id"] = cleanString(text)
+		case ruleAction79:
+			p.Params["expanded"] = cleanString(text)
+		case ruleAction80:
+			p.Params["theme"] = cleanString(text)
+		case ruleAction81:
+			p.Params["version"] = cleanString(text)
+		case ruleAction82:
 			p.Params["external"] = cleanString(text)
-		case ruleAction25:
+		case ruleAction83:
 			p.Params["type"] = cleanString(text)
-		case ruleAction26:
+		case ruleAction84:
 			p.Params["name"] = cleanString(text)
-		case ruleAction27:
+		case ruleAction85:
 			p.Params["mechanism"] = cleanString(text)
-		case ruleAction28:
+		case ruleAction86:
 			p.Params["expanded"] = cleanString(text)
-		case ruleAction29:
+		case ruleAction87:
+			p.Params["status"] = cleanString(text)
+		case ruleAction88:
+			p.Params["archived"] = cleanString(text)
+		case ruleAction89:
+			p.Params["owner"] = cleanString(text)
+		case ruleAction90:
+			p.Params["contacts"] = cleanString(text)
+		case ruleAction91:
+			p.Params["source"] = cleanString(text)
+		case ruleAction92:
+			p.Params["classification"] = cleanString(text)
+		case ruleAction93:
+			p.Params["boundary"] = cleanString(text)
+		case ruleAction94:
+			p.Params["tags"] = cleanString(text)
+		case ruleAction95:
 			p.Params["verb"] = cleanString(text)
-		case ruleAction30:
+		case ruleAction96:
 			p.Params["mechanism"] = cleanString(text)
-		case ruleAction31:
+		case ruleAction97:
 			p.Params["async"] = cleanString(text)
-		case ruleAction32:
+		case ruleAction98:
 			p.Params["expanded"] = cleanString(text)
-		case ruleAction33:
+		case ruleAction99:
+			p.Params["status"] = cleanString(text)
+		case ruleAction100:
+			p.Params["classification"] = cleanString(text)
+		case ruleAction101:
+			p.Params["kind"] = cleanString(text)
+		case ruleAction102:
+			p.Params["name"] = cleanString(text)
+		case ruleAction103:
+			p.Params["mechanism"] = cleanString(text)
+		case ruleAction104:
+			p.Params["parent"] = cleanString(text)
+		case ruleAction105:
+			p.Params["name"] = cleanString(text)
+		case ruleAction106:
+			p.Params["label"] = cleanString(text)
+		case ruleAction107:
+			p.Params["async"] = cleanString(text)
+		case ruleAction108:
+			p.Params["at"] = cleanString(text)
+		case ruleAction109:
+			p.Params["name"] = cleanString(text)
+		case ruleAction110:
+			p.Params["expand"] = cleanString(text)
+		case ruleAction111:
+			p.Params["filter"] = cleanString(text)
+		case ruleAction112:
+			p.Params["focus"] = cleanString(text)
+		case ruleAction113:
+			p.Params["hops"] = cleanString(text)
+		case ruleAction114:
+			p.Params["x"] = cleanString(text)
+		case ruleAction115:
+			p.Params["y"] = cleanString(text)
+		case ruleAction116:
+			p.Params["width"] = cleanString(text)
+		case ruleAction117:
+			p.Params["height"] = cleanString(text)
+		case ruleAction118:
+			p.Params["waypoints"] = cleanString(text)
+		case ruleAction119:
+			p.Params["name"] = cleanString(text)
+		case ruleAction120:
+			p.Params["match"] = cleanString(text)
+		case ruleAction121:
+			p.Params["shape"] = cleanString(text)
+		case ruleAction122:
+			p.Params["color"] = cleanString(text)
+		case ruleAction123:
+			p.Params["border"] = cleanString(text)
+		case ruleAction124:
+			p.Params["line"] = cleanString(text)
+		case ruleAction125:
+			p.Params["layout-view"] = cleanString(text)
+		case ruleAction126:
+			p.linkKind = text
+		case ruleAction127:
+			p.InputAttributes.Links = append(p.InputAttributes.Links, Link{Kind: p.linkKind, Target: cleanString(text)})
+		case ruleAction128:
 			p.InputAttributes.Params[cleanString(text)] = ""
-		case ruleAction34:
+		case ruleAction129:
 			p.InputAttributes.Params[cleanString(text)] = ""
-		case ruleAction35:
+		case ruleAction130:
 			p.text = cleanString(text)
-		case ruleAction36:
+		case ruleAction131:
 			n, _ := strconv.Atoi(text)
 			p.number = n
-		case ruleAction37:
+		case ruleAction132:
 			p.bool = text == "true"
-		case ruleAction38:
+		case ruleAction133:
 			p.InputAttributes.ResourceType = "item"
 			p.InputAttributes.Verb = "exists"
-		case ruleAction39:
+		case ruleAction134:
 			p.InputAttributes.ResourceType = "rel"
 			p.InputAttributes.Verb = "exists"
-		case ruleAction40:
+		case ruleAction135:
 			p.InputAttributes.ResourceType = "world"
-		case ruleAction41:
+		case ruleAction136:
+			p.InputAttributes.ResourceType = "node"
+		case ruleAction137:
+			p.InputAttributes.ResourceType = "scenario"
+		case ruleAction138:
+			p.InputAttributes.ResourceType = "view"
+		case ruleAction139:
+			p.InputAttributes.ResourceType = "style"
+		case ruleAction140:
+			p.InputAttributes.ResourceType = "layout"
+		case ruleAction141:
 			p.InputAttributes.ResourceType = "item"
-		case ruleAction42:
+		case ruleAction142:
 			p.InputAttributes.ResourceType = "rel"
-		case ruleAction43:
+		case ruleAction143:
 			p.InputAttributes.Verb = "create"
-		case ruleAction44:
+		case ruleAction144:
 			p.InputAttributes.Verb = "fetch"
-		case ruleAction45:
+		case ruleAction145:
 			p.InputAttributes.Verb = "set"
-		case ruleAction46:
+		case ruleAction146:
 			p.InputAttributes.Verb = "clear"
-		case ruleAction47:
+		case ruleAction147:
 			p.InputAttributes.Verb = "delete"
-		case ruleAction48:
+		case ruleAction148:
 			p.InputAttributes.Verb = "list"
-		case ruleAction49:
+		case ruleAction149:
 			p.InputAttributes.Verb = "nest"
 			p.InputAttributes.ResourceType = "item"
-		case ruleAction50:
+		case ruleAction150:
 			p.InputAttributes.Verb = "free"
 			p.InputAttributes.ResourceType = "item"
-		case ruleAction51:
+		case ruleAction151:
 			p.InputAttributes.Verb = "exists"
-		case ruleAction52:
+		case ruleAction152:
 			p.InputAttributes.Verb = "in?"
 			p.InputAttributes.ResourceType = "item"
-		case ruleAction53:
+		case ruleAction153:
 			p.InputAttributes.Verb = "from?"
 			p.InputAttributes.ResourceType = "rel"
-		case ruleAction54:
+		case ruleAction154:
 			p.InputAttributes.Verb = "to?"
 			p.InputAttributes.ResourceType = "rel"
-		case ruleAction55:
+		case ruleAction155:
+			p.InputAttributes.Verb = "ancestors?"
+			p.InputAttributes.ResourceType = "item"
+		case ruleAction156:
+			p.InputAttributes.Verb = "siblings?"
+			p.InputAttributes.ResourceType = "item"
+		case ruleAction157:
+			p.InputAttributes.Verb = "owners?"
+			p.InputAttributes.ResourceType = "item"
+		case ruleAction158:
+			p.InputAttributes.Verb = "dataflow?"
+			p.InputAttributes.ResourceType = "item"
+		case ruleAction159:
+			p.InputAttributes.Verb = "import-owners"
+			p.InputAttributes.ResourceType = "item"
+		case ruleAction160:
+			p.InputAttributes.Verb = "crossings?"
+			p.InputAttributes.ResourceType = "rel"
+		case ruleAction161:
+			p.InputAttributes.Verb = "export-threats"
+			p.InputAttributes.ResourceType = "world"
+		case ruleAction162:
+			p.InputAttributes.Verb = "deployed?"
+			p.InputAttributes.ResourceType = "item"
+		case ruleAction163:
+			p.InputAttributes.Verb = "deploy"
+			p.InputAttributes.ResourceType = "item"
+		case ruleAction164:
+			p.InputAttributes.Verb = "undeploy"
+			p.InputAttributes.ResourceType = "item"
+		case ruleAction165:
+			p.InputAttributes.Verb = "step"
+			p.InputAttributes.ResourceType = "scenario"
+		case ruleAction166:
+			p.InputAttributes.Verb = "unstep"
+			p.InputAttributes.ResourceType = "scenario"
+		case ruleAction167:
+			p.InputAttributes.Verb = "pin"
+			p.InputAttributes.ResourceType = "layout"
+		case ruleAction168:
+			p.InputAttributes.Verb = "unpin"
+			p.InputAttributes.ResourceType = "layout"
+		case ruleAction169:
+			p.InputAttributes.Verb = "tree"
+			p.InputAttributes.ResourceType = "item"
+		case ruleAction170:
+			p.InputAttributes.Verb = "undo"
+			p.InputAttributes.ResourceType = "world"
+		case ruleAction171:
+			p.InputAttributes.Verb = "redo"
+			p.InputAttributes.ResourceType = "world"
+		case ruleAction172:
+			p.InputAttributes.Verb = "save"
+		case ruleAction173:
+			p.InputAttributes.Verb = "load"
+		case ruleAction174:
+			p.InputAttributes.Verb = "new"
+		case ruleAction175:
+			p.InputAttributes.Verb = "use"
+		case ruleAction176:
+			p.InputAttributes.Verb = "open"
+		case ruleAction177:
+			p.InputAttributes.Verb = "close"
+		case ruleAction178:
+			p.InputAttributes.Verb = "copy"
+		case ruleAction179:
+			p.InputAttributes.Verb = "clone"
+		case ruleAction180:
+			p.InputAttributes.Verb = "merge"
+		case ruleAction181:
+			p.InputAttributes.Verb = "split"
+		case ruleAction182:
+			p.InputAttributes.Verb = "archive"
+		case ruleAction183:
+			p.InputAttributes.Verb = "restore"
+		case ruleAction184:
+			p.InputAttributes.Verb = "link"
+		case ruleAction185:
+			p.InputAttributes.Verb = "unlink"
+		case ruleAction186:
+			p.InputAttributes.Verb = "export"
+		case ruleAction187:
 			p.InputAttributes.Flags = append(p.InputAttributes.Flags, "strict")
-		case ruleAction56:
+		case ruleAction188:
 			p.InputAttributes.Flags = append(p.InputAttributes.Flags, "verbose")
-		case ruleAction57:
+		case ruleAction189:
 			p.InputAttributes.Flags = append(p.InputAttributes.Flags, "ids")
+		case ruleAction190:
+			p.InputAttributes.Flags = append(p.InputAttributes.Flags, "dry-run")
+		case ruleAction191:
+			p.InputAttributes.Flags = append(p.InputAttributes.Flags, "cascade")
+		case ruleAction192:
+			p.InputAttributes.Flags = append(p.InputAttributes.Flags, "all-rels")
+		case ruleAction193:
+			p.InputAttributes.Flags = append(p.InputAttributes.Flags, "archived")
+		case ruleAction194:
+			p.InputAttributes.Params["depth"] = cleanString(text)
+		case ruleAction195:
+			p.InputAttributes.Params["view"] = cleanString(text)
 
 		}
 	}
@@ -906,4446 +1975,16300 @@ func (p *Parser) Init(options ...func(*Parser) error) error {
 									if !_rules[ruleItem]() {
 										goto l9
 									}
+									if !_rules[ruleSet]() {
+										goto l9
+									}
+									if !_rules[ruleSelector]() {
+										goto l9
+									}
+									if !_rules[ruleItemParams]() {
+										goto l9
+									}
+									goto l8
+								l9:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleItem]() {
+										goto l10
+									}
+									if !_rules[ruleClear]() {
+										goto l10
+									}
+									if !_rules[ruleSelector]() {
+										goto l10
+									}
+									if !_rules[ruleItemKeys]() {
+										goto l10
+									}
+									goto l8
+								l10:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleItem]() {
+										goto l11
+									}
+									if !_rules[ruleDelete]() {
+										goto l11
+									}
+									if !_rules[ruleSelector]() {
+										goto l11
+									}
+									goto l8
+								l11:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleItem]() {
+										goto l12
+									}
 									{
-										position10, tokenIndex10 := position, tokenIndex
+										position13, tokenIndex13 := position, tokenIndex
 										if !_rules[ruleCreate]() {
-											goto l11
+											goto l14
 										}
-										goto l10
-									l11:
-										position, tokenIndex = position10, tokenIndex10
+										goto l13
+									l14:
+										position, tokenIndex = position13, tokenIndex13
 										if !_rules[ruleSet]() {
-											goto l9
+											goto l12
 										}
 									}
-								l10:
+								l13:
 									if !_rules[ruleIdentifier]() {
-										goto l9
+										goto l12
 									}
 									{
-										position12, tokenIndex12 := position, tokenIndex
+										position15, tokenIndex15 := position, tokenIndex
 										if !_rules[ruleItemParams]() {
-											goto l12
+											goto l15
 										}
-										goto l13
-									l12:
-										position, tokenIndex = position12, tokenIndex12
+										goto l16
+									l15:
+										position, tokenIndex = position15, tokenIndex15
 									}
-								l13:
+								l16:
 									goto l8
-								l9:
+								l12:
 									position, tokenIndex = position8, tokenIndex8
 									if !_rules[ruleItem]() {
-										goto l14
+										goto l17
 									}
 									if !_rules[ruleClear]() {
-										goto l14
+										goto l17
 									}
 									if !_rules[ruleIdentifier]() {
-										goto l14
+										goto l17
 									}
-									{
-										position15 := position
-										{
-											position18 := position
-											{
-												position19 := position
-												{
-													position20, tokenIndex20 := position, tokenIndex
-													if !_rules[ruleEXTERNAL]() {
-														goto l21
-													}
-													goto l20
-												l21:
-													position, tokenIndex = position20, tokenIndex20
-													{
-														switch buffer[position] {
-														case 'e':
-															if !_rules[ruleEXPANDED]() {
-																goto l14
-															}
-														case 'm':
-															if !_rules[ruleMECHANISM]() {
-																goto l14
-															}
-														case 't':
-															if !_rules[ruleTYPE]() {
-																goto l14
-															}
-														default:
-															if !_rules[ruleNAME]() {
-																goto l14
-															}
-														}
-													}
-
-												}
-											l20:
-												add(rulePegText, position19)
-											}
-											if !_rules[rule_]() {
-												goto l14
-											}
-											{
-												add(ruleAction33, position)
-											}
-											add(ruleItemKey, position18)
-										}
-									l16:
-										{
-											position17, tokenIndex17 := position, tokenIndex
-											{
-												position24 := position
-												{
-													position25 := position
-													{
-														position26, tokenIndex26 := position, tokenIndex
-														if !_rules[ruleEXTERNAL]() {
-															goto l27
-														}
-														goto l26
-													l27:
-														position, tokenIndex = position26, tokenIndex26
-														{
-															switch buffer[position] {
-															case 'e':
-																if !_rules[ruleEXPANDED]() {
-																	goto l17
-																}
-															case 'm':
-																if !_rules[ruleMECHANISM]() {
-																	goto l17
-																}
-															case 't':
-																if !_rules[ruleTYPE]() {
-																	goto l17
-																}
-															default:
-																if !_rules[ruleNAME]() {
-																	goto l17
-																}
-															}
-														}
-
-													}
-												l26:
-													add(rulePegText, position25)
-												}
-												if !_rules[rule_]() {
-													goto l17
-												}
-												{
-													add(ruleAction33, position)
-												}
-												add(ruleItemKey, position24)
-											}
-											goto l16
-										l17:
-											position, tokenIndex = position17, tokenIndex17
-										}
-										add(ruleItemKeys, position15)
+									if !_rules[ruleItemKeys]() {
+										goto l17
 									}
 									goto l8
-								l14:
+								l17:
 									position, tokenIndex = position8, tokenIndex8
 									if !_rules[ruleItem]() {
-										goto l30
+										goto l18
 									}
 									if !_rules[ruleDelete]() {
-										goto l30
+										goto l18
 									}
 									if !_rules[ruleIdentifier]() {
-										goto l30
+										goto l18
 									}
 									goto l8
-								l30:
+								l18:
 									position, tokenIndex = position8, tokenIndex8
 									if !_rules[ruleRel]() {
-										goto l31
+										goto l19
 									}
 									{
-										position32, tokenIndex32 := position, tokenIndex
+										position20, tokenIndex20 := position, tokenIndex
 										if !_rules[ruleCreate]() {
-											goto l33
+											goto l21
 										}
-										goto l32
-									l33:
-										position, tokenIndex = position32, tokenIndex32
+										goto l20
+									l21:
+										position, tokenIndex = position20, tokenIndex20
 										if !_rules[ruleSet]() {
-											goto l31
+											goto l19
 										}
 									}
-								l32:
+								l20:
 									if !_rules[ruleDualIdentifier]() {
-										goto l31
+										goto l19
 									}
 									{
-										position34, tokenIndex34 := position, tokenIndex
+										position22, tokenIndex22 := position, tokenIndex
 										if !_rules[ruleRelParams]() {
-											goto l34
+											goto l22
 										}
-										goto l35
-									l34:
-										position, tokenIndex = position34, tokenIndex34
+										goto l23
+									l22:
+										position, tokenIndex = position22, tokenIndex22
 									}
-								l35:
+								l23:
 									goto l8
-								l31:
+								l19:
 									position, tokenIndex = position8, tokenIndex8
 									if !_rules[ruleRel]() {
-										goto l36
+										goto l24
 									}
 									if !_rules[ruleClear]() {
-										goto l36
+										goto l24
 									}
 									if !_rules[ruleDualIdentifier]() {
-										goto l36
+										goto l24
 									}
 									{
-										position37 := position
+										position25 := position
 										{
-											position40 := position
+											position28 := position
 											{
-												position41 := position
+												position29 := position
 												{
 													switch buffer[position] {
+													case 'c':
+														if !_rules[ruleCLASSIFICATION]() {
+															goto l24
+														}
+													case 'l':
+														if !_rules[ruleLINKS]() {
+															goto l24
+														}
+													case 's':
+														if !_rules[ruleSTATUS]() {
+															goto l24
+														}
 													case 'e':
 														if !_rules[ruleEXPANDED]() {
-															goto l36
+															goto l24
 														}
 													case 'a':
 														if !_rules[ruleASYNC]() {
-															goto l36
+															goto l24
 														}
 													case 'm':
 														if !_rules[ruleMECHANISM]() {
-															goto l36
+															goto l24
 														}
 													default:
 														if !_rules[ruleVERB]() {
-															goto l36
+															goto l24
 														}
 													}
 												}
 
-												add(rulePegText, position41)
+												add(rulePegText, position29)
 											}
 											if !_rules[rule_]() {
-												goto l36
+												goto l24
 											}
 											{
-												add(ruleAction34, position)
+												add(ruleAction129, position)
 											}
-											add(ruleRelKey, position40)
+											add(ruleRelKey, position28)
 										}
-									l38:
+									l26:
 										{
-											position39, tokenIndex39 := position, tokenIndex
+											position27, tokenIndex27 := position, tokenIndex
 											{
-												position44 := position
+												position32 := position
 												{
-													position45 := position
+													position33 := position
 													{
 														switch buffer[position] {
+														case 'c':
+															if !_rules[ruleCLASSIFICATION]() {
+																goto l27
+															}
+														case 'l':
+															if !_rules[ruleLINKS]() {
+																goto l27
+															}
+														case 's':
+															if !_rules[ruleSTATUS]() {
+																goto l27
+															}
 														case 'e':
 															if !_rules[ruleEXPANDED]() {
-																goto l39
+																goto l27
 															}
 														case 'a':
 															if !_rules[ruleASYNC]() {
-																goto l39
+																goto l27
 															}
 														case 'm':
 															if !_rules[ruleMECHANISM]() {
-																goto l39
+																goto l27
 															}
 														default:
 															if !_rules[ruleVERB]() {
-																goto l39
+																goto l27
 															}
 														}
 													}
 
-													add(rulePegText, position45)
+													add(rulePegText, position33)
 												}
 												if !_rules[rule_]() {
-													goto l39
+													goto l27
 												}
 												{
-													add(ruleAction34, position)
+													add(ruleAction129, position)
 												}
-												add(ruleRelKey, position44)
+												add(ruleRelKey, position32)
 											}
-											goto l38
-										l39:
-											position, tokenIndex = position39, tokenIndex39
+											goto l26
+										l27:
+											position, tokenIndex = position27, tokenIndex27
 										}
-										add(ruleRelKeys, position37)
+										add(ruleRelKeys, position25)
 									}
 									goto l8
-								l36:
+								l24:
 									position, tokenIndex = position8, tokenIndex8
 									if !_rules[ruleRel]() {
-										goto l6
+										goto l36
 									}
 									if !_rules[ruleDelete]() {
-										goto l6
+										goto l36
 									}
 									if !_rules[ruleDualIdentifier]() {
-										goto l6
-									}
-								}
-							l8:
-								add(ruleMutation, position7)
-							}
-							goto l5
-						l6:
-							position, tokenIndex = position5, tokenIndex5
-							{
-								position49 := position
-								{
-									position50, tokenIndex50 := position, tokenIndex
-									{
-										position52 := position
-										if !_rules[ruleFREE]() {
-											goto l51
-										}
-										{
-											add(ruleAction50, position)
-										}
-										add(ruleFree, position52)
+										goto l36
 									}
-									if !_rules[ruleIdentifierList]() {
-										goto l51
+									goto l8
+								l36:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleItem]() {
+										goto l37
 									}
-									goto l50
-								l51:
-									position, tokenIndex = position50, tokenIndex50
 									{
-										position54 := position
-										if !_rules[ruleNEST]() {
-											goto l48
+										position38 := position
+										{
+											position39 := position
+											if buffer[position] != rune('c') {
+												goto l37
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l37
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l37
+											}
+											position++
+											if buffer[position] != rune('y') {
+												goto l37
+											}
+											position++
+											{
+												position40, tokenIndex40 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l40
+												}
+												goto l37
+											l40:
+												position, tokenIndex = position40, tokenIndex40
+											}
+											if !_rules[rule_]() {
+												goto l37
+											}
+											add(ruleCOPY, position39)
 										}
 										{
-											add(ruleAction49, position)
+											add(ruleAction178, position)
 										}
-										add(ruleNest, position54)
+										add(ruleCopy, position38)
 									}
-									if !_rules[ruleIdentifierList]() {
-										goto l48
-									}
-									if !_rules[rule_]() {
-										goto l48
+									if !_rules[ruleIdentifier]() {
+										goto l37
 									}
-									if !_rules[ruleIN]() {
-										goto l48
+									if !_rules[ruleTO]() {
+										goto l37
 									}
 									{
-										position56 := position
+										position42 := position
 										if !_rules[ruleStringLike]() {
-											goto l48
+											goto l37
 										}
-										add(rulePegText, position56)
+										add(rulePegText, position42)
 									}
 									{
 										add(ruleAction2, position)
 									}
-								}
-							l50:
-								add(ruleTreeMutation, position49)
-							}
-							goto l5
-						l48:
-							position, tokenIndex = position5, tokenIndex5
-							{
-								position59 := position
-								{
-									position60, tokenIndex60 := position, tokenIndex
-									{
-										position62 := position
-										{
-											switch buffer[position] {
-											case 'w':
-												{
-													position64 := position
-													if !_rules[ruleWORLD]() {
-														goto l61
-													}
-													{
-														add(ruleAction40, position)
-													}
-													add(ruleWorld, position64)
-												}
-												{
-													add(ruleAction3, position)
-												}
-											case 'r':
-												if !_rules[ruleRel]() {
-													goto l61
-												}
-												if !_rules[ruleFetch]() {
-													goto l61
-												}
-												if !_rules[ruleDualIdentifier]() {
-													goto l61
-												}
-											default:
-												if !_rules[ruleItem]() {
-													goto l61
-												}
-												if !_rules[ruleFetch]() {
-													goto l61
-												}
-												if !_rules[ruleIdentifier]() {
-													goto l61
-												}
-											}
-										}
-
-										add(ruleFetchQuery, position62)
+									goto l8
+								l37:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleItem]() {
+										goto l44
 									}
-									goto l60
-								l61:
-									position, tokenIndex = position60, tokenIndex60
 									{
-										position68 := position
+										position45 := position
 										{
-											position69, tokenIndex69 := position, tokenIndex
-											{
-												position71, tokenIndex71 := position, tokenIndex
-												if !_rules[ruleItem]() {
-													goto l72
-												}
-												goto l71
-											l72:
-												position, tokenIndex = position71, tokenIndex71
-												if !_rules[ruleRel]() {
-													goto l70
-												}
-											}
-										l71:
-											{
-												position73 := position
-												if !_rules[ruleLIST]() {
-													goto l70
-												}
-												{
-													add(ruleAction48, position)
-												}
-												add(ruleList, position73)
-											}
-											{
-												position75, tokenIndex75 := position, tokenIndex
-												{
-													position77 := position
-													{
-														position78 := position
-														if !_rules[ruleNumber]() {
-															goto l75
-														}
-														add(rulePegText, position78)
-													}
-													{
-														add(ruleAction15, position)
-													}
-													add(ruleLimit, position77)
-												}
-												goto l76
-											l75:
-												position, tokenIndex = position75, tokenIndex75
-											}
-										l76:
-											goto l69
-										l70:
-											position, tokenIndex = position69, tokenIndex69
-											{
-												switch buffer[position] {
-												case 'f':
-													{
-														position81 := position
-														if !_rules[ruleFROM_QUERY]() {
-															goto l67
-														}
-														{
-															add(ruleAction53, position)
-														}
-														add(ruleFromQuery, position81)
-													}
-													if !_rules[ruleIdentifier]() {
-														goto l67
-													}
-												case 't':
-													{
-														position83 := position
-														if !_rules[ruleTO_QUERY]() {
-															goto l67
-														}
-														{
-															add(ruleAction54, position)
-														}
-														add(ruleToQuery, position83)
-													}
-													if !_rules[ruleIdentifier]() {
-														goto l67
-													}
-												default:
-													if !_rules[ruleItem]() {
-														goto l67
-													}
-													if !_rules[ruleIN]() {
-														goto l67
-													}
-													if !_rules[ruleIdentifier]() {
-														goto l67
-													}
-													{
-														add(ruleAction4, position)
-													}
-												}
+											position46 := position
+											if buffer[position] != rune('c') {
+												goto l44
 											}
-
-										}
-									l69:
-										add(ruleListQuery, position68)
-									}
-									goto l60
-								l67:
-									position, tokenIndex = position60, tokenIndex60
-									{
-										position86 := position
-										{
-											position87, tokenIndex87 := position, tokenIndex
-											{
-												position89 := position
-												if !_rules[ruleIN_QUERY]() {
-													goto l88
-												}
-												{
-													add(ruleAction52, position)
-												}
-												add(ruleInQuery, position89)
+											position++
+											if buffer[position] != rune('l') {
+												goto l44
 											}
-											if !_rules[ruleDualIdentifier]() {
-												goto l88
+											position++
+											if buffer[position] != rune('o') {
+												goto l44
 											}
-											goto l87
-										l88:
-											position, tokenIndex = position87, tokenIndex87
-											{
-												position92 := position
-												{
-													position93, tokenIndex93 := position, tokenIndex
-													if !_rules[ruleITEM_EXISTS]() {
-														goto l94
-													}
-													goto l93
-												l94:
-													position, tokenIndex = position93, tokenIndex93
-													if !_rules[ruleItem]() {
-														goto l91
-													}
-													if !_rules[ruleExists]() {
-														goto l91
-													}
-												}
-											l93:
-												{
-													add(ruleAction38, position)
-												}
-												add(ruleItemExists, position92)
+											position++
+											if buffer[position] != rune('n') {
+												goto l44
 											}
-											if !_rules[ruleIdentifier]() {
-												goto l91
+											position++
+											if buffer[position] != rune('e') {
+												goto l44
 											}
-											goto l87
-										l91:
-											position, tokenIndex = position87, tokenIndex87
+											position++
 											{
-												position96 := position
-												{
-													position97, tokenIndex97 := position, tokenIndex
-													if !_rules[ruleREL_EXISTS]() {
-														goto l98
-													}
-													goto l97
-												l98:
-													position, tokenIndex = position97, tokenIndex97
-													if !_rules[ruleRel]() {
-														goto l58
-													}
-													if !_rules[ruleExists]() {
-														goto l58
-													}
-												}
-											l97:
-												{
-													add(ruleAction39, position)
+												position47, tokenIndex47 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l47
 												}
-												add(ruleRelExists, position96)
+												goto l44
+											l47:
+												position, tokenIndex = position47, tokenIndex47
 											}
-											if !_rules[ruleDualIdentifier]() {
-												goto l58
+											if !_rules[rule_]() {
+												goto l44
 											}
+											add(ruleCLONE, position46)
 										}
-									l87:
-										add(ruleExistsQuery, position86)
-									}
-								}
-							l60:
-								add(ruleQuery, position59)
-							}
-							goto l5
-						l58:
-							position, tokenIndex = position5, tokenIndex5
-							{
-								position100 := position
-								{
-									position101, tokenIndex101 := position, tokenIndex
-									{
-										position103 := position
 										{
-											position104, tokenIndex104 := position, tokenIndex
-											if !_rules[ruleItem]() {
-												goto l105
-											}
-											if !_rules[ruleIdentifier]() {
-												goto l105
-											}
-											{
-												position106, tokenIndex106 := position, tokenIndex
-												if !_rules[ruleItemParams]() {
-													goto l106
-												}
-												goto l105
-											l106:
-												position, tokenIndex = position106, tokenIndex106
-											}
-											goto l104
-										l105:
-											position, tokenIndex = position104, tokenIndex104
-											if !_rules[ruleRel]() {
-												goto l102
-											}
-											if !_rules[ruleDualIdentifier]() {
-												goto l102
-											}
-											{
-												position107, tokenIndex107 := position, tokenIndex
-												if !_rules[ruleRelParams]() {
-													goto l107
-												}
-												goto l102
-											l107:
-												position, tokenIndex = position107, tokenIndex107
-											}
+											add(ruleAction179, position)
 										}
-									l104:
-										add(ruleCreateOrFetch, position103)
+										add(ruleClone, position45)
 									}
-									{
-										add(ruleAction5, position)
+									if !_rules[ruleIdentifier]() {
+										goto l44
 									}
-									goto l101
-								l102:
-									position, tokenIndex = position101, tokenIndex101
 									{
-										position109 := position
+										position49 := position
+										if buffer[position] != rune('a') {
+											goto l44
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l44
+										}
+										position++
 										{
-											position110, tokenIndex110 := position, tokenIndex
-											if !_rules[ruleItem]() {
-												goto l111
-											}
-											if !_rules[ruleIdentifier]() {
-												goto l111
-											}
-											if !_rules[ruleItemParams]() {
-												goto l111
-											}
-											goto l110
-										l111:
-											position, tokenIndex = position110, tokenIndex110
-											if !_rules[ruleRel]() {
-												goto l3
-											}
-											if !_rules[ruleDualIdentifier]() {
-												goto l3
-											}
-											if !_rules[ruleRelParams]() {
-												goto l3
+											position50, tokenIndex50 := position, tokenIndex
+											if !_rules[ruleTextChar]() {
+												goto l50
 											}
+											goto l44
+										l50:
+											position, tokenIndex = position50, tokenIndex50
+										}
+										if !_rules[rule_]() {
+											goto l44
 										}
-									l110:
-										add(ruleCreateOrSet, position109)
+										add(ruleAS, position49)
 									}
 									{
-										add(ruleAction6, position)
+										position51 := position
+										if !_rules[ruleStringLike]() {
+											goto l44
+										}
+										add(rulePegText, position51)
 									}
-								}
-							l101:
-								add(ruleStateBound, position100)
-							}
-						}
-					l5:
-					l113:
-						{
-							position114, tokenIndex114 := position, tokenIndex
-							{
-								position115 := position
-								{
-									position116, tokenIndex116 := position, tokenIndex
 									{
-										position118 := position
-										if !_rules[ruleFLAG]() {
-											goto l117
-										}
+										add(ruleAction3, position)
+									}
+									goto l8
+								l44:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleItem]() {
+										goto l53
+									}
+									{
+										position54 := position
 										{
-											position119 := position
-											if buffer[position] != rune('s') {
-												goto l117
+											position55 := position
+											if buffer[position] != rune('m') {
+												goto l53
 											}
 											position++
-											if buffer[position] != rune('t') {
-												goto l117
+											if buffer[position] != rune('e') {
+												goto l53
 											}
 											position++
 											if buffer[position] != rune('r') {
-												goto l117
+												goto l53
 											}
 											position++
-											if buffer[position] != rune('i') {
-												goto l117
+											if buffer[position] != rune('g') {
+												goto l53
 											}
 											position++
-											if buffer[position] != rune('c') {
-												goto l117
+											if buffer[position] != rune('e') {
+												goto l53
 											}
 											position++
-											if buffer[position] != rune('t') {
-												goto l117
+											{
+												position56, tokenIndex56 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l56
+												}
+												goto l53
+											l56:
+												position, tokenIndex = position56, tokenIndex56
 											}
-											position++
 											if !_rules[rule_]() {
-												goto l117
+												goto l53
 											}
-											add(ruleSTRICT, position119)
+											add(ruleMERGE, position55)
 										}
 										{
-											add(ruleAction55, position)
+											add(ruleAction180, position)
 										}
-										add(ruleStrictFlag, position118)
+										add(ruleMerge, position54)
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l53
+									}
+									if !_rules[ruleINTO]() {
+										goto l53
+									}
+									if !_rules[ruleSecondIdentifier]() {
+										goto l53
+									}
+									goto l8
+								l53:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleItem]() {
+										goto l58
 									}
-									goto l116
-								l117:
-									position, tokenIndex = position116, tokenIndex116
 									{
-										position122 := position
-										if !_rules[ruleFLAG]() {
-											goto l121
-										}
+										position59 := position
 										{
-											position123 := position
-											if buffer[position] != rune('v') {
-												goto l121
+											position60 := position
+											if buffer[position] != rune('s') {
+												goto l58
 											}
 											position++
-											if buffer[position] != rune('e') {
-												goto l121
+											if buffer[position] != rune('p') {
+												goto l58
 											}
 											position++
-											if buffer[position] != rune('r') {
-												goto l121
+											if buffer[position] != rune('l') {
+												goto l58
 											}
 											position++
-											if buffer[position] != rune('b') {
-												goto l121
+											if buffer[position] != rune('i') {
+												goto l58
 											}
 											position++
-											if buffer[position] != rune('o') {
-												goto l121
+											if buffer[position] != rune('t') {
+												goto l58
+											}
+											position++
+											{
+												position61, tokenIndex61 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l61
+												}
+												goto l58
+											l61:
+												position, tokenIndex = position61, tokenIndex61
+											}
+											if !_rules[rule_]() {
+												goto l58
+											}
+											add(ruleSPLIT, position60)
+										}
+										{
+											add(ruleAction181, position)
+										}
+										add(ruleSplit, position59)
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l58
+									}
+									if !_rules[ruleINTO]() {
+										goto l58
+									}
+									{
+										position65 := position
+										{
+											position66, tokenIndex66 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l66
 											}
 											position++
 											if buffer[position] != rune('s') {
-												goto l121
+												goto l66
 											}
 											position++
-											if buffer[position] != rune('e') {
-												goto l121
+											if buffer[position] != rune('s') {
+												goto l66
 											}
 											position++
-											if !_rules[rule_]() {
-												goto l121
+											if buffer[position] != rune('i') {
+												goto l66
+											}
+											position++
+											if buffer[position] != rune('g') {
+												goto l66
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l66
+											}
+											position++
+											{
+												position67, tokenIndex67 := position, tokenIndex
+												{
+													switch buffer[position] {
+													case '.':
+														if buffer[position] != rune('.') {
+															goto l67
+														}
+														position++
+													case '_':
+														if buffer[position] != rune('_') {
+															goto l67
+														}
+														position++
+													case '-':
+														if buffer[position] != rune('-') {
+															goto l67
+														}
+														position++
+													case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+														if c := buffer[position]; c < rune('0') || c > rune('9') {
+															goto l67
+														}
+														position++
+													case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+														if c := buffer[position]; c < rune('A') || c > rune('Z') {
+															goto l67
+														}
+														position++
+													default:
+														if c := buffer[position]; c < rune('a') || c > rune('z') {
+															goto l67
+														}
+														position++
+													}
+												}
+
+												goto l66
+											l67:
+												position, tokenIndex = position67, tokenIndex67
 											}
-											add(ruleVERBOSE, position123)
+											goto l58
+										l66:
+											position, tokenIndex = position66, tokenIndex66
 										}
+										add(ruleNotAssign, position65)
+									}
+									if !_rules[ruleSecondIdentifier]() {
+										goto l58
+									}
+								l63:
+									{
+										position64, tokenIndex64 := position, tokenIndex
 										{
-											add(ruleAction56, position)
+											position69 := position
+											{
+												position70, tokenIndex70 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l70
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l70
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l70
+												}
+												position++
+												if buffer[position] != rune('i') {
+													goto l70
+												}
+												position++
+												if buffer[position] != rune('g') {
+													goto l70
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l70
+												}
+												position++
+												{
+													position71, tokenIndex71 := position, tokenIndex
+													{
+														switch buffer[position] {
+														case '.':
+															if buffer[position] != rune('.') {
+																goto l71
+															}
+															position++
+														case '_':
+															if buffer[position] != rune('_') {
+																goto l71
+															}
+															position++
+														case '-':
+															if buffer[position] != rune('-') {
+																goto l71
+															}
+															position++
+														case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+															if c := buffer[position]; c < rune('0') || c > rune('9') {
+																goto l71
+															}
+															position++
+														case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+															if c := buffer[position]; c < rune('A') || c > rune('Z') {
+																goto l71
+															}
+															position++
+														default:
+															if c := buffer[position]; c < rune('a') || c > rune('z') {
+																goto l71
+															}
+															position++
+														}
+													}
+
+													goto l70
+												l71:
+													position, tokenIndex = position71, tokenIndex71
+												}
+												goto l64
+											l70:
+												position, tokenIndex = position70, tokenIndex70
+											}
+											add(ruleNotAssign, position69)
+										}
+										if !_rules[ruleSecondIdentifier]() {
+											goto l64
 										}
-										add(ruleVerboseFlag, position122)
+										goto l63
+									l64:
+										position, tokenIndex = position64, tokenIndex64
 									}
-									goto l116
-								l121:
-									position, tokenIndex = position116, tokenIndex116
 									{
-										position125 := position
-										if !_rules[ruleFLAG]() {
-											goto l114
-										}
+										position73, tokenIndex73 := position, tokenIndex
 										{
-											position126 := position
-											if buffer[position] != rune('i') {
-												goto l114
+											position75 := position
+											if buffer[position] != rune('a') {
+												goto l73
 											}
 											position++
-											if buffer[position] != rune('d') {
-												goto l114
+											if buffer[position] != rune('s') {
+												goto l73
 											}
 											position++
 											if buffer[position] != rune('s') {
-												goto l114
+												goto l73
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l73
+											}
+											position++
+											if buffer[position] != rune('g') {
+												goto l73
 											}
 											position++
+											if buffer[position] != rune('n') {
+												goto l73
+											}
+											position++
+											{
+												position76, tokenIndex76 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l76
+												}
+												goto l73
+											l76:
+												position, tokenIndex = position76, tokenIndex76
+											}
 											if !_rules[rule_]() {
-												goto l114
+												goto l73
 											}
-											add(ruleIDS, position126)
+											add(ruleASSIGN, position75)
 										}
 										{
-											add(ruleAction57, position)
+											position79 := position
+											{
+												position80 := position
+												{
+													position81 := position
+													{
+														position82, tokenIndex82 := position, tokenIndex
+														if !_rules[ruleText]() {
+															goto l83
+														}
+														goto l82
+													l83:
+														position, tokenIndex = position82, tokenIndex82
+														if !_rules[ruleQuotedText]() {
+															goto l73
+														}
+													}
+												l82:
+													add(rulePegText, position81)
+												}
+												{
+													add(ruleAction68, position)
+												}
+												add(ruleAssignmentKey, position80)
+											}
+											if buffer[position] != rune('=') {
+												goto l73
+											}
+											position++
+											{
+												position85 := position
+												{
+													position86 := position
+													if !_rules[ruleStringLike]() {
+														goto l73
+													}
+													add(rulePegText, position86)
+												}
+												{
+													add(ruleAction69, position)
+												}
+												add(ruleAssignmentValue, position85)
+											}
+											add(ruleAssignment, position79)
 										}
-										add(ruleIdsFlag, position125)
-									}
-								}
-							l116:
-								add(ruleFlag, position115)
-							}
-							goto l113
-						l114:
-							position, tokenIndex = position114, tokenIndex114
-						}
-						if !_rules[ruleEND]() {
-							goto l3
-						}
-						{
-							add(ruleAction1, position)
-						}
-						add(ruleCommand, position4)
-					}
-					goto l2
-				l3:
-					position, tokenIndex = position2, tokenIndex2
-					{
-						position130 := position
-						{
-							position131, tokenIndex131 := position, tokenIndex
-							{
-								position133 := position
-								{
-									position134, tokenIndex134 := position, tokenIndex
-									if !_rules[ruleWorldObject]() {
-										goto l135
-									}
-									goto l134
-								l135:
-									position, tokenIndex = position134, tokenIndex134
-									if !_rules[ruleTree]() {
-										goto l136
-									}
-									goto l134
-								l136:
-									position, tokenIndex = position134, tokenIndex134
-									if !_rules[ruleItemObject]() {
-										goto l137
-									}
-								l138:
-									{
-										position139, tokenIndex139 := position, tokenIndex
-										if !_rules[ruleItemObject]() {
-											goto l139
+									l77:
+										{
+											position78, tokenIndex78 := position, tokenIndex
+											{
+												position88 := position
+												{
+													position89 := position
+													{
+														position90 := position
+														{
+															position91, tokenIndex91 := position, tokenIndex
+															if !_rules[ruleText]() {
+																goto l92
+															}
+															goto l91
+														l92:
+															position, tokenIndex = position91, tokenIndex91
+															if !_rules[ruleQuotedText]() {
+																goto l78
+															}
+														}
+													l91:
+														add(rulePegText, position90)
+													}
+													{
+														add(ruleAction68, position)
+													}
+													add(ruleAssignmentKey, position89)
+												}
+												if buffer[position] != rune('=') {
+													goto l78
+												}
+												position++
+												{
+													position94 := position
+													{
+														position95 := position
+														if !_rules[ruleStringLike]() {
+															goto l78
+														}
+														add(rulePegText, position95)
+													}
+													{
+														add(ruleAction69, position)
+													}
+													add(ruleAssignmentValue, position94)
+												}
+												add(ruleAssignment, position88)
+											}
+											goto l77
+										l78:
+											position, tokenIndex = position78, tokenIndex78
 										}
-										goto l138
-									l139:
-										position, tokenIndex = position139, tokenIndex139
-									}
-									goto l134
-								l137:
-									position, tokenIndex = position134, tokenIndex134
-									if !_rules[ruleRelObject]() {
-										goto l140
+										goto l74
+									l73:
+										position, tokenIndex = position73, tokenIndex73
 									}
-								l141:
-									{
-										position142, tokenIndex142 := position, tokenIndex
-										if !_rules[ruleRelObject]() {
-											goto l142
-										}
-										goto l141
-									l142:
-										position, tokenIndex = position142, tokenIndex142
+								l74:
+									goto l8
+								l58:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleItem]() {
+										goto l97
 									}
-									goto l134
-								l140:
-									position, tokenIndex = position134, tokenIndex134
 									{
-										position143 := position
-										if !_rules[ruleIdentifierList]() {
-											goto l131
-										}
+										position98, tokenIndex98 := position, tokenIndex
 										{
-											add(ruleAction10, position)
+											position100 := position
+											{
+												position101 := position
+												if buffer[position] != rune('a') {
+													goto l99
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l99
+												}
+												position++
+												if buffer[position] != rune('c') {
+													goto l99
+												}
+												position++
+												if buffer[position] != rune('h') {
+													goto l99
+												}
+												position++
+												if buffer[position] != rune('i') {
+													goto l99
+												}
+												position++
+												if buffer[position] != rune('v') {
+													goto l99
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l99
+												}
+												position++
+												{
+													position102, tokenIndex102 := position, tokenIndex
+													if !_rules[ruleTextChar]() {
+														goto l102
+													}
+													goto l99
+												l102:
+													position, tokenIndex = position102, tokenIndex102
+												}
+												if !_rules[rule_]() {
+													goto l99
+												}
+												add(ruleARCHIVE, position101)
+											}
+											{
+												add(ruleAction182, position)
+											}
+											add(ruleArchive, position100)
 										}
-										add(ruleIdentifierListObject, position143)
-									}
-								}
-							l134:
-								add(ruleObjects, position133)
-							}
-							goto l132
-						l131:
-							position, tokenIndex = position131, tokenIndex131
-						}
-					l132:
-						if !_rules[rule_]() {
-							goto l129
-						}
-						if !_rules[ruleDELIMITER]() {
-							goto l129
-						}
-						if !_rules[ruleDELIMITER]() {
-							goto l129
-						}
-						if !_rules[rule_]() {
-							goto l129
-						}
-						if !_rules[ruleStatusObject]() {
-							goto l129
-						}
-						if !_rules[ruleEND]() {
-							goto l129
-						}
-						{
+										goto l98
+									l99:
+										position, tokenIndex = position98, tokenIndex98
+										{
+											position104 := position
+											{
+												position105 := position
+												if buffer[position] != rune('r') {
+													goto l97
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l97
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l97
+												}
+												position++
+												if buffer[position] != rune('t') {
+													goto l97
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l97
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l97
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l97
+												}
+												position++
+												{
+													position106, tokenIndex106 := position, tokenIndex
+													if !_rules[ruleTextChar]() {
+														goto l106
+													}
+													goto l97
+												l106:
+													position, tokenIndex = position106, tokenIndex106
+												}
+												if !_rules[rule_]() {
+													goto l97
+												}
+												add(ruleRESTORE, position105)
+											}
+											{
+												add(ruleAction183, position)
+											}
+											add(ruleRestore, position104)
+										}
+									}
+								l98:
+									if !_rules[ruleIdentifier]() {
+										goto l97
+									}
+									goto l8
+								l97:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleNode]() {
+										goto l108
+									}
+									if !_rules[ruleCreate]() {
+										goto l108
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l108
+									}
+									{
+										position109, tokenIndex109 := position, tokenIndex
+										if !_rules[ruleNodeParams]() {
+											goto l109
+										}
+										goto l110
+									l109:
+										position, tokenIndex = position109, tokenIndex109
+									}
+								l110:
+									goto l8
+								l108:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleNode]() {
+										goto l111
+									}
+									if !_rules[ruleSet]() {
+										goto l111
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l111
+									}
+									if !_rules[ruleNodeParams]() {
+										goto l111
+									}
+									goto l8
+								l111:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleNode]() {
+										goto l112
+									}
+									if !_rules[ruleDelete]() {
+										goto l112
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l112
+									}
+									goto l8
+								l112:
+									position, tokenIndex = position8, tokenIndex8
+									{
+										position114 := position
+										{
+											position115 := position
+											if buffer[position] != rune('u') {
+												goto l113
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l113
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l113
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l113
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l113
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l113
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l113
+											}
+											position++
+											if buffer[position] != rune('y') {
+												goto l113
+											}
+											position++
+											{
+												position116, tokenIndex116 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l116
+												}
+												goto l113
+											l116:
+												position, tokenIndex = position116, tokenIndex116
+											}
+											if !_rules[rule_]() {
+												goto l113
+											}
+											add(ruleUNDEPLOY, position115)
+										}
+										{
+											add(ruleAction164, position)
+										}
+										add(ruleUndeploy, position114)
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l113
+									}
+									{
+										position118 := position
+										if buffer[position] != rune('f') {
+											goto l113
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l113
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l113
+										}
+										position++
+										if buffer[position] != rune('m') {
+											goto l113
+										}
+										position++
+										{
+											position119, tokenIndex119 := position, tokenIndex
+											if !_rules[ruleTextChar]() {
+												goto l119
+											}
+											goto l113
+										l119:
+											position, tokenIndex = position119, tokenIndex119
+										}
+										if !_rules[rule_]() {
+											goto l113
+										}
+										add(ruleFROM, position118)
+									}
+									if !_rules[ruleSecondIdentifier]() {
+										goto l113
+									}
+									goto l8
+								l113:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleScenario]() {
+										goto l120
+									}
+									if !_rules[ruleCreate]() {
+										goto l120
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l120
+									}
+									{
+										position121, tokenIndex121 := position, tokenIndex
+										if !_rules[ruleScenarioParams]() {
+											goto l121
+										}
+										goto l122
+									l121:
+										position, tokenIndex = position121, tokenIndex121
+									}
+								l122:
+									goto l8
+								l120:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleScenario]() {
+										goto l123
+									}
+									if !_rules[ruleSet]() {
+										goto l123
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l123
+									}
+									if !_rules[ruleScenarioParams]() {
+										goto l123
+									}
+									goto l8
+								l123:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleScenario]() {
+										goto l124
+									}
+									if !_rules[ruleDelete]() {
+										goto l124
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l124
+									}
+									goto l8
+								l124:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleScenario]() {
+										goto l125
+									}
+									if !_rules[ruleExport]() {
+										goto l125
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l125
+									}
+									{
+										position126 := position
+										if !_rules[ruleStringLike]() {
+											goto l125
+										}
+										add(rulePegText, position126)
+									}
+									{
+										add(ruleAction6, position)
+									}
+									goto l8
+								l125:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleStep]() {
+										goto l128
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l128
+									}
+									if !_rules[ruleSecondIdentifier]() {
+										goto l128
+									}
+									if !_rules[ruleSecondIdentifier]() {
+										goto l128
+									}
+									if !_rules[ruleStepParams]() {
+										goto l128
+									}
+									goto l8
+								l128:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleStep]() {
+										goto l129
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l129
+									}
+									if !_rules[ruleSecondIdentifier]() {
+										goto l129
+									}
+									if !_rules[ruleSecondIdentifier]() {
+										goto l129
+									}
+									goto l8
+								l129:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleView]() {
+										goto l130
+									}
+									if !_rules[ruleCreate]() {
+										goto l130
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l130
+									}
+									{
+										position131, tokenIndex131 := position, tokenIndex
+										if !_rules[ruleViewParams]() {
+											goto l131
+										}
+										goto l132
+									l131:
+										position, tokenIndex = position131, tokenIndex131
+									}
+								l132:
+									goto l8
+								l130:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleView]() {
+										goto l133
+									}
+									if !_rules[ruleSet]() {
+										goto l133
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l133
+									}
+									if !_rules[ruleViewParams]() {
+										goto l133
+									}
+									goto l8
+								l133:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleStyle]() {
+										goto l134
+									}
+									if !_rules[ruleCreate]() {
+										goto l134
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l134
+									}
+									{
+										position135, tokenIndex135 := position, tokenIndex
+										if !_rules[ruleStyleParams]() {
+											goto l135
+										}
+										goto l136
+									l135:
+										position, tokenIndex = position135, tokenIndex135
+									}
+								l136:
+									goto l8
+								l134:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleStyle]() {
+										goto l137
+									}
+									if !_rules[ruleSet]() {
+										goto l137
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l137
+									}
+									if !_rules[ruleStyleParams]() {
+										goto l137
+									}
+									goto l8
+								l137:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleLayout]() {
+										goto l138
+									}
+									if !_rules[rulePin]() {
+										goto l138
+									}
+									if !_rules[rulePinTarget]() {
+										goto l138
+									}
+									if !_rules[rulePinParams]() {
+										goto l138
+									}
+									goto l8
+								l138:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleLayout]() {
+										goto l139
+									}
+									if !_rules[ruleUnpin]() {
+										goto l139
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l139
+									}
+									if !_rules[ruleSecondIdentifier]() {
+										goto l139
+									}
+									if !_rules[rulePinView]() {
+										goto l139
+									}
+									goto l8
+								l139:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleLayout]() {
+										goto l140
+									}
+									if !_rules[ruleUnpin]() {
+										goto l140
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l140
+									}
+									if !_rules[rulePinView]() {
+										goto l140
+									}
+									goto l8
+								l140:
+									position, tokenIndex = position8, tokenIndex8
+									if !_rules[ruleLayout]() {
+										goto l141
+									}
+									if !_rules[ruleUnpin]() {
+										goto l141
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l141
+									}
+									if !_rules[ruleSecondIdentifier]() {
+										goto l141
+									}
+									goto l8
+								l141:
+									position, tokenIndex = position8, tokenIndex8
+									{
+										switch buffer[position] {
+										case 'l':
+											if !_rules[ruleLayout]() {
+												goto l6
+											}
+											if !_rules[ruleUnpin]() {
+												goto l6
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l6
+											}
+										case 'p':
+											if !_rules[rulePin]() {
+												goto l6
+											}
+											if !_rules[rulePinTarget]() {
+												goto l6
+											}
+											if !_rules[rulePinParams]() {
+												goto l6
+											}
+										case 's':
+											if !_rules[ruleStyle]() {
+												goto l6
+											}
+											if !_rules[ruleDelete]() {
+												goto l6
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l6
+											}
+										case 'v':
+											if !_rules[ruleView]() {
+												goto l6
+											}
+											if !_rules[ruleDelete]() {
+												goto l6
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l6
+											}
+										case 'u':
+											{
+												position143 := position
+												{
+													position144 := position
+													if buffer[position] != rune('u') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('p') {
+														goto l6
+													}
+													position++
+													{
+														position145, tokenIndex145 := position, tokenIndex
+														if !_rules[ruleTextChar]() {
+															goto l145
+														}
+														goto l6
+													l145:
+														position, tokenIndex = position145, tokenIndex145
+													}
+													if !_rules[rule_]() {
+														goto l6
+													}
+													add(ruleUNSTEP, position144)
+												}
+												{
+													add(ruleAction166, position)
+												}
+												add(ruleUnstep, position143)
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l6
+											}
+											{
+												position147 := position
+												if !_rules[ruleNumber]() {
+													goto l6
+												}
+												add(rulePegText, position147)
+											}
+											{
+												add(ruleAction7, position)
+											}
+										case 'd':
+											if !_rules[ruleDeploy]() {
+												goto l6
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l6
+											}
+											if !_rules[ruleTO]() {
+												goto l6
+											}
+											if !_rules[ruleSecondIdentifier]() {
+												goto l6
+											}
+										case 'n':
+											if !_rules[ruleNode]() {
+												goto l6
+											}
+											if !_rules[ruleExport]() {
+												goto l6
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l6
+											}
+											{
+												position149 := position
+												if !_rules[ruleStringLike]() {
+													goto l6
+												}
+												add(rulePegText, position149)
+											}
+											{
+												add(ruleAction5, position)
+											}
+										case 'o':
+											{
+												position151 := position
+												{
+													position152 := position
+													if buffer[position] != rune('o') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('w') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l6
+													}
+													position++
+													{
+														position153, tokenIndex153 := position, tokenIndex
+														if !_rules[ruleTextChar]() {
+															goto l153
+														}
+														goto l6
+													l153:
+														position, tokenIndex = position153, tokenIndex153
+													}
+													if !_rules[rule_]() {
+														goto l6
+													}
+													add(ruleOWNERS, position152)
+												}
+												{
+													position154 := position
+													if buffer[position] != rune('i') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('m') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('p') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l6
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l6
+													}
+													position++
+													{
+														position155, tokenIndex155 := position, tokenIndex
+														if !_rules[ruleTextChar]() {
+															goto l155
+														}
+														goto l6
+													l155:
+														position, tokenIndex = position155, tokenIndex155
+													}
+													if !_rules[rule_]() {
+														goto l6
+													}
+													add(ruleIMPORT, position154)
+												}
+												{
+													add(ruleAction159, position)
+												}
+												add(ruleOwnersImport, position151)
+											}
+											{
+												position157 := position
+												if !_rules[ruleStringLike]() {
+													goto l6
+												}
+												add(rulePegText, position157)
+											}
+											{
+												add(ruleAction4, position)
+											}
+										case 'r':
+											if !_rules[ruleRel]() {
+												goto l6
+											}
+											{
+												position159, tokenIndex159 := position, tokenIndex
+												if !_rules[ruleLink]() {
+													goto l160
+												}
+												goto l159
+											l160:
+												position, tokenIndex = position159, tokenIndex159
+												if !_rules[ruleUnlink]() {
+													goto l6
+												}
+											}
+										l159:
+											if !_rules[ruleDualIdentifier]() {
+												goto l6
+											}
+											if !_rules[ruleLinkParams]() {
+												goto l6
+											}
+										default:
+											if !_rules[ruleItem]() {
+												goto l6
+											}
+											{
+												position161, tokenIndex161 := position, tokenIndex
+												if !_rules[ruleLink]() {
+													goto l162
+												}
+												goto l161
+											l162:
+												position, tokenIndex = position161, tokenIndex161
+												if !_rules[ruleUnlink]() {
+													goto l6
+												}
+											}
+										l161:
+											if !_rules[ruleIdentifier]() {
+												goto l6
+											}
+											if !_rules[ruleLinkParams]() {
+												goto l6
+											}
+										}
+									}
+
+								}
+							l8:
+								add(ruleMutation, position7)
+							}
+							goto l5
+						l6:
+							position, tokenIndex = position5, tokenIndex5
+							{
+								position164 := position
+								{
+									position165, tokenIndex165 := position, tokenIndex
+									if !_rules[ruleWorld]() {
+										goto l166
+									}
+									if !_rules[ruleSet]() {
+										goto l166
+									}
+									if !_rules[ruleWorldSetParams]() {
+										goto l166
+									}
+									goto l165
+								l166:
+									position, tokenIndex = position165, tokenIndex165
+									if !_rules[ruleWorld]() {
+										goto l167
+									}
+									{
+										position168 := position
+										{
+											position169 := position
+											if buffer[position] != rune('s') {
+												goto l167
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l167
+											}
+											position++
+											if buffer[position] != rune('v') {
+												goto l167
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l167
+											}
+											position++
+											{
+												position170, tokenIndex170 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l170
+												}
+												goto l167
+											l170:
+												position, tokenIndex = position170, tokenIndex170
+											}
+											if !_rules[rule_]() {
+												goto l167
+											}
+											add(ruleSAVE, position169)
+										}
+										{
+											add(ruleAction172, position)
+										}
+										add(ruleSave, position168)
+									}
+									{
+										position172, tokenIndex172 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l172
+										}
+										goto l173
+									l172:
+										position, tokenIndex = position172, tokenIndex172
+									}
+								l173:
+									goto l165
+								l167:
+									position, tokenIndex = position165, tokenIndex165
+									{
+										position175 := position
+										{
+											position176 := position
+											if buffer[position] != rune('t') {
+												goto l174
+											}
+											position++
+											if buffer[position] != rune('h') {
+												goto l174
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l174
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l174
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l174
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l174
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l174
+											}
+											position++
+											{
+												position177, tokenIndex177 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l177
+												}
+												goto l174
+											l177:
+												position, tokenIndex = position177, tokenIndex177
+											}
+											if !_rules[rule_]() {
+												goto l174
+											}
+											add(ruleTHREATS, position176)
+										}
+										if !_rules[ruleEXPORT]() {
+											goto l174
+										}
+										{
+											add(ruleAction161, position)
+										}
+										add(ruleThreatsExport, position175)
+									}
+									{
+										position179 := position
+										if !_rules[ruleStringLike]() {
+											goto l174
+										}
+										add(rulePegText, position179)
+									}
+									{
+										add(ruleAction8, position)
+									}
+									goto l165
+								l174:
+									position, tokenIndex = position165, tokenIndex165
+									if !_rules[ruleWorld]() {
+										goto l181
+									}
+									{
+										position182 := position
+										{
+											position183 := position
+											if buffer[position] != rune('l') {
+												goto l181
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l181
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l181
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l181
+											}
+											position++
+											{
+												position184, tokenIndex184 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l184
+												}
+												goto l181
+											l184:
+												position, tokenIndex = position184, tokenIndex184
+											}
+											if !_rules[rule_]() {
+												goto l181
+											}
+											add(ruleLOAD, position183)
+										}
+										{
+											add(ruleAction173, position)
+										}
+										add(ruleLoad, position182)
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l181
+									}
+									goto l165
+								l181:
+									position, tokenIndex = position165, tokenIndex165
+									if !_rules[ruleWorld]() {
+										goto l186
+									}
+									{
+										position187 := position
+										{
+											position188 := position
+											if buffer[position] != rune('n') {
+												goto l186
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l186
+											}
+											position++
+											if buffer[position] != rune('w') {
+												goto l186
+											}
+											position++
+											{
+												position189, tokenIndex189 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l189
+												}
+												goto l186
+											l189:
+												position, tokenIndex = position189, tokenIndex189
+											}
+											if !_rules[rule_]() {
+												goto l186
+											}
+											add(ruleNEW, position188)
+										}
+										{
+											add(ruleAction174, position)
+										}
+										add(ruleNew, position187)
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l186
+									}
+									goto l165
+								l186:
+									position, tokenIndex = position165, tokenIndex165
+									if !_rules[ruleWorld]() {
+										goto l191
+									}
+									{
+										position192 := position
+										{
+											position193 := position
+											if buffer[position] != rune('u') {
+												goto l191
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l191
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l191
+											}
+											position++
+											{
+												position194, tokenIndex194 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l194
+												}
+												goto l191
+											l194:
+												position, tokenIndex = position194, tokenIndex194
+											}
+											if !_rules[rule_]() {
+												goto l191
+											}
+											add(ruleUSE, position193)
+										}
+										{
+											add(ruleAction175, position)
+										}
+										add(ruleUse, position192)
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l191
+									}
+									goto l165
+								l191:
+									position, tokenIndex = position165, tokenIndex165
+									if !_rules[ruleWorld]() {
+										goto l196
+									}
+									{
+										position197 := position
+										{
+											position198 := position
+											if buffer[position] != rune('o') {
+												goto l196
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l196
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l196
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l196
+											}
+											position++
+											{
+												position199, tokenIndex199 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l199
+												}
+												goto l196
+											l199:
+												position, tokenIndex = position199, tokenIndex199
+											}
+											if !_rules[rule_]() {
+												goto l196
+											}
+											add(ruleOPEN, position198)
+										}
+										{
+											add(ruleAction176, position)
+										}
+										add(ruleOpen, position197)
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l196
+									}
+									goto l165
+								l196:
+									position, tokenIndex = position165, tokenIndex165
+									if !_rules[ruleWorld]() {
+										goto l163
+									}
+									{
+										position201 := position
+										{
+											position202 := position
+											if buffer[position] != rune('c') {
+												goto l163
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l163
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l163
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l163
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l163
+											}
+											position++
+											{
+												position203, tokenIndex203 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l203
+												}
+												goto l163
+											l203:
+												position, tokenIndex = position203, tokenIndex203
+											}
+											if !_rules[rule_]() {
+												goto l163
+											}
+											add(ruleCLOSE, position202)
+										}
+										{
+											add(ruleAction177, position)
+										}
+										add(ruleClose, position201)
+									}
+									{
+										position205, tokenIndex205 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l205
+										}
+										goto l206
+									l205:
+										position, tokenIndex = position205, tokenIndex205
+									}
+								l206:
+								}
+							l165:
+								add(ruleWorldMutation, position164)
+							}
+							goto l5
+						l163:
+							position, tokenIndex = position5, tokenIndex5
+							{
+								position208 := position
+								{
+									position209, tokenIndex209 := position, tokenIndex
+									{
+										position211 := position
+										{
+											position212 := position
+											if buffer[position] != rune('f') {
+												goto l210
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l210
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l210
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l210
+											}
+											position++
+											{
+												position213, tokenIndex213 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l213
+												}
+												goto l210
+											l213:
+												position, tokenIndex = position213, tokenIndex213
+											}
+											if !_rules[rule_]() {
+												goto l210
+											}
+											add(ruleFREE, position212)
+										}
+										{
+											add(ruleAction150, position)
+										}
+										add(ruleFree, position211)
+									}
+									{
+										position215 := position
+										{
+											position218, tokenIndex218 := position, tokenIndex
+											if !_rules[ruleSelector]() {
+												goto l219
+											}
+											goto l218
+										l219:
+											position, tokenIndex = position218, tokenIndex218
+											if !_rules[ruleTarget]() {
+												goto l210
+											}
+										}
+									l218:
+									l216:
+										{
+											position217, tokenIndex217 := position, tokenIndex
+											{
+												position220, tokenIndex220 := position, tokenIndex
+												if !_rules[ruleSelector]() {
+													goto l221
+												}
+												goto l220
+											l221:
+												position, tokenIndex = position220, tokenIndex220
+												if !_rules[ruleTarget]() {
+													goto l217
+												}
+											}
+										l220:
+											goto l216
+										l217:
+											position, tokenIndex = position217, tokenIndex217
+										}
+										add(ruleTargets, position215)
+									}
+									goto l209
+								l210:
+									position, tokenIndex = position209, tokenIndex209
+									{
+										position222 := position
+										{
+											position223 := position
+											if buffer[position] != rune('n') {
+												goto l207
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l207
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l207
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l207
+											}
+											position++
+											{
+												position224, tokenIndex224 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l224
+												}
+												goto l207
+											l224:
+												position, tokenIndex = position224, tokenIndex224
+											}
+											if !_rules[rule_]() {
+												goto l207
+											}
+											add(ruleNEST, position223)
+										}
+										{
+											add(ruleAction149, position)
+										}
+										add(ruleNest, position222)
+									}
+									{
+										position226 := position
+										{
+											position229, tokenIndex229 := position, tokenIndex
+											if !_rules[ruleSelector]() {
+												goto l230
+											}
+											goto l229
+										l230:
+											position, tokenIndex = position229, tokenIndex229
+											{
+												position231 := position
+												{
+													position232, tokenIndex232 := position, tokenIndex
+													if buffer[position] != rune('i') {
+														goto l232
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l232
+													}
+													position++
+													{
+														position233, tokenIndex233 := position, tokenIndex
+														{
+															switch buffer[position] {
+															case ':':
+																if buffer[position] != rune(':') {
+																	goto l233
+																}
+																position++
+															case '.':
+																if buffer[position] != rune('.') {
+																	goto l233
+																}
+																position++
+															case '_':
+																if buffer[position] != rune('_') {
+																	goto l233
+																}
+																position++
+															case '-':
+																if buffer[position] != rune('-') {
+																	goto l233
+																}
+																position++
+															case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+																if c := buffer[position]; c < rune('0') || c > rune('9') {
+																	goto l233
+																}
+																position++
+															case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+																if c := buffer[position]; c < rune('A') || c > rune('Z') {
+																	goto l233
+																}
+																position++
+															default:
+																if c := buffer[position]; c < rune('a') || c > rune('z') {
+																	goto l233
+																}
+																position++
+															}
+														}
+
+														goto l232
+													l233:
+														position, tokenIndex = position233, tokenIndex233
+													}
+													goto l207
+												l232:
+													position, tokenIndex = position232, tokenIndex232
+												}
+												add(ruleNotIn, position231)
+											}
+											if !_rules[ruleTarget]() {
+												goto l207
+											}
+										}
+									l229:
+									l227:
+										{
+											position228, tokenIndex228 := position, tokenIndex
+											{
+												position235, tokenIndex235 := position, tokenIndex
+												if !_rules[ruleSelector]() {
+													goto l236
+												}
+												goto l235
+											l236:
+												position, tokenIndex = position235, tokenIndex235
+												{
+													position237 := position
+													{
+														position238, tokenIndex238 := position, tokenIndex
+														if buffer[position] != rune('i') {
+															goto l238
+														}
+														position++
+														if buffer[position] != rune('n') {
+															goto l238
+														}
+														position++
+														{
+															position239, tokenIndex239 := position, tokenIndex
+															{
+																switch buffer[position] {
+																case ':':
+																	if buffer[position] != rune(':') {
+																		goto l239
+																	}
+																	position++
+																case '.':
+																	if buffer[position] != rune('.') {
+																		goto l239
+																	}
+																	position++
+																case '_':
+																	if buffer[position] != rune('_') {
+																		goto l239
+																	}
+																	position++
+																case '-':
+																	if buffer[position] != rune('-') {
+																		goto l239
+																	}
+																	position++
+																case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+																	if c := buffer[position]; c < rune('0') || c > rune('9') {
+																		goto l239
+																	}
+																	position++
+																case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+																	if c := buffer[position]; c < rune('A') || c > rune('Z') {
+																		goto l239
+																	}
+																	position++
+																default:
+																	if c := buffer[position]; c < rune('a') || c > rune('z') {
+																		goto l239
+																	}
+																	position++
+																}
+															}
+
+															goto l238
+														l239:
+															position, tokenIndex = position239, tokenIndex239
+														}
+														goto l228
+													l238:
+														position, tokenIndex = position238, tokenIndex238
+													}
+													add(ruleNotIn, position237)
+												}
+												if !_rules[ruleTarget]() {
+													goto l228
+												}
+											}
+										l235:
+											goto l227
+										l228:
+											position, tokenIndex = position228, tokenIndex228
+										}
+										add(ruleNestTargets, position226)
+									}
+									if !_rules[rule_]() {
+										goto l207
+									}
+									if !_rules[ruleIN]() {
+										goto l207
+									}
+									{
+										position241 := position
+										{
+											position242 := position
+											if !_rules[ruleStringLike]() {
+												goto l207
+											}
+											add(rulePegText, position242)
+										}
+										{
+											add(ruleAction63, position)
+										}
+										add(ruleNestParent, position241)
+									}
+								}
+							l209:
+								add(ruleTreeMutation, position208)
+							}
+							goto l5
+						l207:
+							position, tokenIndex = position5, tokenIndex5
+							{
+								position245 := position
+								{
+									position246, tokenIndex246 := position, tokenIndex
+									{
+										position248 := position
+										{
+											position249 := position
+											if buffer[position] != rune('u') {
+												goto l247
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l247
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l247
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l247
+											}
+											position++
+											{
+												position250, tokenIndex250 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l250
+												}
+												goto l247
+											l250:
+												position, tokenIndex = position250, tokenIndex250
+											}
+											if !_rules[rule_]() {
+												goto l247
+											}
+											add(ruleUNDO, position249)
+										}
+										{
+											add(ruleAction170, position)
+										}
+										add(ruleUndo, position248)
+									}
+									goto l246
+								l247:
+									position, tokenIndex = position246, tokenIndex246
+									{
+										position252 := position
+										{
+											position253 := position
+											if buffer[position] != rune('r') {
+												goto l244
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l244
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l244
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l244
+											}
+											position++
+											{
+												position254, tokenIndex254 := position, tokenIndex
+												if !_rules[ruleTextChar]() {
+													goto l254
+												}
+												goto l244
+											l254:
+												position, tokenIndex = position254, tokenIndex254
+											}
+											if !_rules[rule_]() {
+												goto l244
+											}
+											add(ruleREDO, position253)
+										}
+										{
+											add(ruleAction171, position)
+										}
+										add(ruleRedo, position252)
+									}
+								}
+							l246:
+								add(ruleHistoryMutation, position245)
+							}
+							goto l5
+						l244:
+							position, tokenIndex = position5, tokenIndex5
+							{
+								position257 := position
+								{
+									position258, tokenIndex258 := position, tokenIndex
+									{
+										position260 := position
+										{
+											position261, tokenIndex261 := position, tokenIndex
+											if !_rules[ruleScenario]() {
+												goto l262
+											}
+											if !_rules[ruleFetch]() {
+												goto l262
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l262
+											}
+											goto l261
+										l262:
+											position, tokenIndex = position261, tokenIndex261
+											{
+												switch buffer[position] {
+												case 'w':
+													if !_rules[ruleWorld]() {
+														goto l259
+													}
+													{
+														position264, tokenIndex264 := position, tokenIndex
+														{
+															position265, tokenIndex265 := position, tokenIndex
+															if !_rules[ruleFLAG]() {
+																goto l266
+															}
+															goto l265
+														l266:
+															position, tokenIndex = position265, tokenIndex265
+															if !_rules[ruleEND]() {
+																goto l259
+															}
+														}
+													l265:
+														position, tokenIndex = position264, tokenIndex264
+													}
+													{
+														add(ruleAction9, position)
+													}
+												case 'r':
+													if !_rules[ruleRel]() {
+														goto l259
+													}
+													if !_rules[ruleFetch]() {
+														goto l259
+													}
+													if !_rules[ruleDualIdentifier]() {
+														goto l259
+													}
+												case 's':
+													if !_rules[ruleStyle]() {
+														goto l259
+													}
+													if !_rules[ruleFetch]() {
+														goto l259
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l259
+													}
+												case 'v':
+													if !_rules[ruleView]() {
+														goto l259
+													}
+													if !_rules[ruleFetch]() {
+														goto l259
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l259
+													}
+												case 'n':
+													if !_rules[ruleNode]() {
+														goto l259
+													}
+													if !_rules[ruleFetch]() {
+														goto l259
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l259
+													}
+												default:
+													if !_rules[ruleItem]() {
+														goto l259
+													}
+													if !_rules[ruleFetch]() {
+														goto l259
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l259
+													}
+												}
+											}
+
+										}
+									l261:
+										add(ruleFetchQuery, position260)
+									}
+									goto l258
+								l259:
+									position, tokenIndex = position258, tokenIndex258
+									{
+										position269 := position
+										{
+											position270, tokenIndex270 := position, tokenIndex
+											if !_rules[ruleItem]() {
+												goto l271
+											}
+											if !_rules[ruleList]() {
+												goto l271
+											}
+											{
+												position272, tokenIndex272 := position, tokenIndex
+												if !_rules[ruleLimit]() {
+													goto l272
+												}
+												goto l273
+											l272:
+												position, tokenIndex = position272, tokenIndex272
+											}
+										l273:
+											{
+												position274 := position
+												if !_rules[ruleOWNER]() {
+													goto l271
+												}
+												if !_rules[ruleEQUALS]() {
+													goto l271
+												}
+												{
+													position275 := position
+													if !_rules[ruleStringLike]() {
+														goto l271
+													}
+													add(rulePegText, position275)
+												}
+												{
+													add(ruleAction59, position)
+												}
+												add(ruleOwnerFilter, position274)
+											}
+											goto l270
+										l271:
+											position, tokenIndex = position270, tokenIndex270
+											{
+												position278, tokenIndex278 := position, tokenIndex
+												if !_rules[ruleScenario]() {
+													goto l279
+												}
+												goto l278
+											l279:
+												position, tokenIndex = position278, tokenIndex278
+												{
+													switch buffer[position] {
+													case 's':
+														if !_rules[ruleStyle]() {
+															goto l277
+														}
+													case 'v':
+														if !_rules[ruleView]() {
+															goto l277
+														}
+													case 'n':
+														if !_rules[ruleNode]() {
+															goto l277
+														}
+													case 'w':
+														if !_rules[ruleWorld]() {
+															goto l277
+														}
+													case 'r':
+														if !_rules[ruleRel]() {
+															goto l277
+														}
+													default:
+														if !_rules[ruleItem]() {
+															goto l277
+														}
+													}
+												}
+
+											}
+										l278:
+											if !_rules[ruleList]() {
+												goto l277
+											}
+											{
+												position281, tokenIndex281 := position, tokenIndex
+												if !_rules[ruleLimit]() {
+													goto l281
+												}
+												goto l282
+											l281:
+												position, tokenIndex = position281, tokenIndex281
+											}
+										l282:
+											goto l270
+										l277:
+											position, tokenIndex = position270, tokenIndex270
+											if !_rules[ruleLayout]() {
+												goto l283
+											}
+											if !_rules[ruleList]() {
+												goto l283
+											}
+											if !_rules[rulePinView]() {
+												goto l283
+											}
+											goto l270
+										l283:
+											position, tokenIndex = position270, tokenIndex270
+											{
+												position285 := position
+												{
+													position286 := position
+													if buffer[position] != rune('t') {
+														goto l284
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l284
+													}
+													position++
+													if buffer[position] != rune('?') {
+														goto l284
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l284
+													}
+													add(ruleTO_QUERY, position286)
+												}
+												{
+													add(ruleAction154, position)
+												}
+												add(ruleToQuery, position285)
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l284
+											}
+											goto l270
+										l284:
+											position, tokenIndex = position270, tokenIndex270
+											{
+												position289 := position
+												{
+													position290 := position
+													if buffer[position] != rune('d') {
+														goto l288
+													}
+													position++
+													if buffer[position] != rune('a') {
+														goto l288
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l288
+													}
+													position++
+													if buffer[position] != rune('a') {
+														goto l288
+													}
+													position++
+													if buffer[position] != rune('f') {
+														goto l288
+													}
+													position++
+													if buffer[position] != rune('l') {
+														goto l288
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l288
+													}
+													position++
+													if buffer[position] != rune('w') {
+														goto l288
+													}
+													position++
+													if buffer[position] != rune('?') {
+														goto l288
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l288
+													}
+													add(ruleDATAFLOW_QUERY, position290)
+												}
+												{
+													add(ruleAction158, position)
+												}
+												add(ruleDataFlowQuery, position289)
+											}
+											{
+												position292 := position
+												if !_rules[ruleStringLike]() {
+													goto l288
+												}
+												add(rulePegText, position292)
+											}
+											{
+												add(ruleAction11, position)
+											}
+											goto l270
+										l288:
+											position, tokenIndex = position270, tokenIndex270
+											if !_rules[ruleDeployedQuery]() {
+												goto l294
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l294
+											}
+											if !_rules[ruleIN]() {
+												goto l294
+											}
+											if !_rules[ruleSecondIdentifier]() {
+												goto l294
+											}
+											goto l270
+										l294:
+											position, tokenIndex = position270, tokenIndex270
+											{
+												switch buffer[position] {
+												case 't':
+													{
+														position296 := position
+														{
+															position297 := position
+															if buffer[position] != rune('t') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('r') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l268
+															}
+															position++
+															if !_rules[rule_]() {
+																goto l268
+															}
+															add(ruleTREE, position297)
+														}
+														{
+															add(ruleAction169, position)
+														}
+														add(ruleTreeQuery, position296)
+													}
+													{
+														position299, tokenIndex299 := position, tokenIndex
+														{
+															position300, tokenIndex300 := position, tokenIndex
+															if !_rules[ruleFLAG]() {
+																goto l301
+															}
+															goto l300
+														l301:
+															position, tokenIndex = position300, tokenIndex300
+															if !_rules[ruleEND]() {
+																goto l268
+															}
+														}
+													l300:
+														position, tokenIndex = position299, tokenIndex299
+													}
+												case 'd':
+													if !_rules[ruleDeployedQuery]() {
+														goto l268
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l268
+													}
+												case 'c':
+													{
+														position302 := position
+														{
+															position303 := position
+															if buffer[position] != rune('c') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('r') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('o') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('s') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('s') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('i') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('n') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('g') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('s') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('?') {
+																goto l268
+															}
+															position++
+															if !_rules[rule_]() {
+																goto l268
+															}
+															add(ruleCROSSINGS_QUERY, position303)
+														}
+														{
+															add(ruleAction160, position)
+														}
+														add(ruleCrossingsQuery, position302)
+													}
+													{
+														position305, tokenIndex305 := position, tokenIndex
+														{
+															position306, tokenIndex306 := position, tokenIndex
+															if !_rules[ruleFLAG]() {
+																goto l307
+															}
+															goto l306
+														l307:
+															position, tokenIndex = position306, tokenIndex306
+															if !_rules[ruleEND]() {
+																goto l268
+															}
+														}
+													l306:
+														position, tokenIndex = position305, tokenIndex305
+													}
+												case 'o':
+													{
+														position308 := position
+														{
+															position309 := position
+															if buffer[position] != rune('o') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('w') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('n') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('r') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('s') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('?') {
+																goto l268
+															}
+															position++
+															if !_rules[rule_]() {
+																goto l268
+															}
+															add(ruleOWNERS_QUERY, position309)
+														}
+														{
+															add(ruleAction157, position)
+														}
+														add(ruleOwnersQuery, position308)
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l268
+													}
+												case 's':
+													{
+														position311 := position
+														{
+															position312 := position
+															if buffer[position] != rune('s') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('i') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('b') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('l') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('i') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('n') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('g') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('s') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('?') {
+																goto l268
+															}
+															position++
+															if !_rules[rule_]() {
+																goto l268
+															}
+															add(ruleSIBLINGS_QUERY, position312)
+														}
+														{
+															add(ruleAction156, position)
+														}
+														add(ruleSiblingsQuery, position311)
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l268
+													}
+												case 'a':
+													{
+														position314 := position
+														{
+															position315 := position
+															if buffer[position] != rune('a') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('n') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('c') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('s') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('t') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('o') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('r') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('s') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('?') {
+																goto l268
+															}
+															position++
+															if !_rules[rule_]() {
+																goto l268
+															}
+															add(ruleANCESTORS_QUERY, position315)
+														}
+														{
+															add(ruleAction155, position)
+														}
+														add(ruleAncestorsQuery, position314)
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l268
+													}
+												case 'f':
+													{
+														position317 := position
+														{
+															position318 := position
+															if buffer[position] != rune('f') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('r') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('o') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('m') {
+																goto l268
+															}
+															position++
+															if buffer[position] != rune('?') {
+																goto l268
+															}
+															position++
+															if !_rules[rule_]() {
+																goto l268
+															}
+															add(ruleFROM_QUERY, position318)
+														}
+														{
+															add(ruleAction153, position)
+														}
+														add(ruleFromQuery, position317)
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l268
+													}
+												case 'i':
+													if !_rules[ruleItem]() {
+														goto l268
+													}
+													if !_rules[ruleIN]() {
+														goto l268
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l268
+													}
+													{
+														add(ruleAction10, position)
+													}
+												default:
+													if !_rules[ruleLayout]() {
+														goto l268
+													}
+													if !_rules[ruleList]() {
+														goto l268
+													}
+												}
+											}
+
+										}
+									l270:
+										add(ruleListQuery, position269)
+									}
+									goto l258
+								l268:
+									position, tokenIndex = position258, tokenIndex258
+									{
+										position321 := position
+										{
+											position322, tokenIndex322 := position, tokenIndex
+											{
+												position324 := position
+												{
+													position325 := position
+													if buffer[position] != rune('i') {
+														goto l323
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l323
+													}
+													position++
+													if buffer[position] != rune('?') {
+														goto l323
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l323
+													}
+													add(ruleIN_QUERY, position325)
+												}
+												{
+													add(ruleAction152, position)
+												}
+												add(ruleInQuery, position324)
+											}
+											if !_rules[ruleDualIdentifier]() {
+												goto l323
+											}
+											goto l322
+										l323:
+											position, tokenIndex = position322, tokenIndex322
+											{
+												position328 := position
+												{
+													position329, tokenIndex329 := position, tokenIndex
+													{
+														position331 := position
+														if buffer[position] != rune('i') {
+															goto l330
+														}
+														position++
+														if buffer[position] != rune('t') {
+															goto l330
+														}
+														position++
+														if buffer[position] != rune('e') {
+															goto l330
+														}
+														position++
+														if buffer[position] != rune('m') {
+															goto l330
+														}
+														position++
+														if buffer[position] != rune('?') {
+															goto l330
+														}
+														position++
+														if !_rules[rule_]() {
+															goto l330
+														}
+														add(ruleITEM_EXISTS, position331)
+													}
+													goto l329
+												l330:
+													position, tokenIndex = position329, tokenIndex329
+													if !_rules[ruleItem]() {
+														goto l327
+													}
+													if !_rules[ruleExists]() {
+														goto l327
+													}
+												}
+											l329:
+												{
+													add(ruleAction133, position)
+												}
+												add(ruleItemExists, position328)
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l327
+											}
+											goto l322
+										l327:
+											position, tokenIndex = position322, tokenIndex322
+											{
+												position333 := position
+												{
+													position334, tokenIndex334 := position, tokenIndex
+													{
+														position336 := position
+														if buffer[position] != rune('r') {
+															goto l335
+														}
+														position++
+														if buffer[position] != rune('e') {
+															goto l335
+														}
+														position++
+														if buffer[position] != rune('l') {
+															goto l335
+														}
+														position++
+														if buffer[position] != rune('?') {
+															goto l335
+														}
+														position++
+														if !_rules[rule_]() {
+															goto l335
+														}
+														add(ruleREL_EXISTS, position336)
+													}
+													goto l334
+												l335:
+													position, tokenIndex = position334, tokenIndex334
+													if !_rules[ruleRel]() {
+														goto l256
+													}
+													if !_rules[ruleExists]() {
+														goto l256
+													}
+												}
+											l334:
+												{
+													add(ruleAction134, position)
+												}
+												add(ruleRelExists, position333)
+											}
+											if !_rules[ruleDualIdentifier]() {
+												goto l256
+											}
+										}
+									l322:
+										add(ruleExistsQuery, position321)
+									}
+								}
+							l258:
+								add(ruleQuery, position257)
+							}
+							goto l5
+						l256:
+							position, tokenIndex = position5, tokenIndex5
+							{
+								position338 := position
+								{
+									position339, tokenIndex339 := position, tokenIndex
+									{
+										position341 := position
+										{
+											position342, tokenIndex342 := position, tokenIndex
+											if !_rules[ruleScenario]() {
+												goto l343
+											}
+											if !_rules[ruleNotVerb]() {
+												goto l343
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l343
+											}
+											{
+												position344, tokenIndex344 := position, tokenIndex
+												if !_rules[ruleScenarioParams]() {
+													goto l344
+												}
+												goto l343
+											l344:
+												position, tokenIndex = position344, tokenIndex344
+											}
+											goto l342
+										l343:
+											position, tokenIndex = position342, tokenIndex342
+											{
+												switch buffer[position] {
+												case 's':
+													if !_rules[ruleStyle]() {
+														goto l340
+													}
+													if !_rules[ruleNotVerb]() {
+														goto l340
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l340
+													}
+													{
+														position346, tokenIndex346 := position, tokenIndex
+														if !_rules[ruleStyleParams]() {
+															goto l346
+														}
+														goto l340
+													l346:
+														position, tokenIndex = position346, tokenIndex346
+													}
+												case 'v':
+													if !_rules[ruleView]() {
+														goto l340
+													}
+													if !_rules[ruleNotVerb]() {
+														goto l340
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l340
+													}
+													{
+														position347, tokenIndex347 := position, tokenIndex
+														if !_rules[ruleViewParams]() {
+															goto l347
+														}
+														goto l340
+													l347:
+														position, tokenIndex = position347, tokenIndex347
+													}
+												case 'r':
+													if !_rules[ruleRel]() {
+														goto l340
+													}
+													if !_rules[ruleNotVerb]() {
+														goto l340
+													}
+													if !_rules[ruleDualIdentifier]() {
+														goto l340
+													}
+													{
+														position348, tokenIndex348 := position, tokenIndex
+														if !_rules[ruleRelParams]() {
+															goto l348
+														}
+														goto l340
+													l348:
+														position, tokenIndex = position348, tokenIndex348
+													}
+												default:
+													if !_rules[ruleItem]() {
+														goto l340
+													}
+													if !_rules[ruleNotVerb]() {
+														goto l340
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l340
+													}
+													{
+														position349, tokenIndex349 := position, tokenIndex
+														if !_rules[ruleItemParams]() {
+															goto l349
+														}
+														goto l340
+													l349:
+														position, tokenIndex = position349, tokenIndex349
+													}
+												}
+											}
+
+										}
+									l342:
+										add(ruleCreateOrFetch, position341)
+									}
+									{
+										add(ruleAction12, position)
+									}
+									goto l339
+								l340:
+									position, tokenIndex = position339, tokenIndex339
+									{
+										position351 := position
+										{
+											position352, tokenIndex352 := position, tokenIndex
+											if !_rules[ruleScenario]() {
+												goto l353
+											}
+											if !_rules[ruleNotVerb]() {
+												goto l353
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l353
+											}
+											if !_rules[ruleScenarioParams]() {
+												goto l353
+											}
+											goto l352
+										l353:
+											position, tokenIndex = position352, tokenIndex352
+											{
+												switch buffer[position] {
+												case 's':
+													if !_rules[ruleStyle]() {
+														goto l3
+													}
+													if !_rules[ruleNotVerb]() {
+														goto l3
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l3
+													}
+													if !_rules[ruleStyleParams]() {
+														goto l3
+													}
+												case 'v':
+													if !_rules[ruleView]() {
+														goto l3
+													}
+													if !_rules[ruleNotVerb]() {
+														goto l3
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l3
+													}
+													if !_rules[ruleViewParams]() {
+														goto l3
+													}
+												case 'n':
+													if !_rules[ruleNode]() {
+														goto l3
+													}
+													if !_rules[ruleNotVerb]() {
+														goto l3
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l3
+													}
+													if !_rules[ruleNodeParams]() {
+														goto l3
+													}
+												case 'r':
+													if !_rules[ruleRel]() {
+														goto l3
+													}
+													if !_rules[ruleNotVerb]() {
+														goto l3
+													}
+													if !_rules[ruleDualIdentifier]() {
+														goto l3
+													}
+													if !_rules[ruleRelParams]() {
+														goto l3
+													}
+												default:
+													if !_rules[ruleItem]() {
+														goto l3
+													}
+													if !_rules[ruleNotVerb]() {
+														goto l3
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l3
+													}
+													if !_rules[ruleItemParams]() {
+														goto l3
+													}
+												}
+											}
+
+										}
+									l352:
+										add(ruleCreateOrSet, position351)
+									}
+									{
+										add(ruleAction13, position)
+									}
+								}
+							l339:
+								add(ruleStateBound, position338)
+							}
+						}
+					l5:
+					l356:
+						{
+							position357, tokenIndex357 := position, tokenIndex
+							{
+								position358 := position
+								{
+									position359, tokenIndex359 := position, tokenIndex
+									{
+										position361 := position
+										if !_rules[ruleFLAG]() {
+											goto l360
+										}
+										{
+											position362 := position
+											if buffer[position] != rune('s') {
+												goto l360
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l360
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l360
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l360
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l360
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l360
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l360
+											}
+											add(ruleSTRICT, position362)
+										}
+										{
+											add(ruleAction187, position)
+										}
+										add(ruleStrictFlag, position361)
+									}
+									goto l359
+								l360:
+									position, tokenIndex = position359, tokenIndex359
+									{
+										position365 := position
+										if !_rules[ruleFLAG]() {
+											goto l364
+										}
+										{
+											position366 := position
+											if buffer[position] != rune('v') {
+												goto l364
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l364
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l364
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l364
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l364
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l364
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l364
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l364
+											}
+											add(ruleVERBOSE, position366)
+										}
+										{
+											add(ruleAction188, position)
+										}
+										add(ruleVerboseFlag, position365)
+									}
+									goto l359
+								l364:
+									position, tokenIndex = position359, tokenIndex359
+									{
+										position369 := position
+										if !_rules[ruleFLAG]() {
+											goto l368
+										}
+										{
+											position370 := position
+											if buffer[position] != rune('i') {
+												goto l368
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l368
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l368
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l368
+											}
+											add(ruleIDS, position370)
+										}
+										{
+											add(ruleAction189, position)
+										}
+										add(ruleIdsFlag, position369)
+									}
+									goto l359
+								l368:
+									position, tokenIndex = position359, tokenIndex359
+									{
+										position373 := position
+										if !_rules[ruleFLAG]() {
+											goto l372
+										}
+										{
+											position374 := position
+											if buffer[position] != rune('d') {
+												goto l372
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l372
+											}
+											position++
+											if buffer[position] != rune('y') {
+												goto l372
+											}
+											position++
+											if buffer[position] != rune('-') {
+												goto l372
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l372
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l372
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l372
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l372
+											}
+											add(ruleDRY_RUN, position374)
+										}
+										{
+											add(ruleAction190, position)
+										}
+										add(ruleDryRunFlag, position373)
+									}
+									goto l359
+								l372:
+									position, tokenIndex = position359, tokenIndex359
+									{
+										position377 := position
+										if !_rules[ruleFLAG]() {
+											goto l376
+										}
+										{
+											position378 := position
+											if buffer[position] != rune('c') {
+												goto l376
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l376
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l376
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l376
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l376
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l376
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l376
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l376
+											}
+											add(ruleCASCADE, position378)
+										}
+										{
+											add(ruleAction191, position)
+										}
+										add(ruleCascadeFlag, position377)
+									}
+									goto l359
+								l376:
+									position, tokenIndex = position359, tokenIndex359
+									{
+										position381 := position
+										if !_rules[ruleFLAG]() {
+											goto l380
+										}
+										{
+											position382 := position
+											if buffer[position] != rune('a') {
+												goto l380
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l380
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l380
+											}
+											position++
+											if buffer[position] != rune('-') {
+												goto l380
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l380
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l380
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l380
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l380
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l380
+											}
+											add(ruleALL_RELS, position382)
+										}
+										{
+											add(ruleAction192, position)
+										}
+										add(ruleAllRelsFlag, position381)
+									}
+									goto l359
+								l380:
+									position, tokenIndex = position359, tokenIndex359
+									{
+										position385 := position
+										if !_rules[ruleFLAG]() {
+											goto l384
+										}
+										if !_rules[ruleARCHIVED]() {
+											goto l384
+										}
+										if !_rules[rule_]() {
+											goto l384
+										}
+										{
+											add(ruleAction193, position)
+										}
+										add(ruleArchivedFlag, position385)
+									}
+									goto l359
+								l384:
+									position, tokenIndex = position359, tokenIndex359
+									{
+										position388 := position
+										if !_rules[ruleFLAG]() {
+											goto l387
+										}
+										{
+											position389 := position
+											if buffer[position] != rune('d') {
+												goto l387
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l387
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l387
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l387
+											}
+											position++
+											if buffer[position] != rune('h') {
+												goto l387
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l387
+											}
+											add(ruleDEPTH, position389)
+										}
+										{
+											position390 := position
+											if !_rules[ruleNumber]() {
+												goto l387
+											}
+											add(rulePegText, position390)
+										}
+										{
+											add(ruleAction194, position)
+										}
+										add(ruleDepthFlag, position388)
+									}
+									goto l359
+								l387:
+									position, tokenIndex = position359, tokenIndex359
+									{
+										position392 := position
+										if !_rules[ruleFLAG]() {
+											goto l357
+										}
+										if !_rules[ruleVIEW]() {
+											goto l357
+										}
+										{
+											position393 := position
+											if !_rules[ruleStringLike]() {
+												goto l357
+											}
+											add(rulePegText, position393)
+										}
+										{
+											add(ruleAction195, position)
+										}
+										add(ruleViewFlag, position392)
+									}
+								}
+							l359:
+								add(ruleFlag, position358)
+							}
+							goto l356
+						l357:
+							position, tokenIndex = position357, tokenIndex357
+						}
+						if !_rules[ruleEND]() {
+							goto l3
+						}
+						{
+							add(ruleAction1, position)
+						}
+						add(ruleCommand, position4)
+					}
+					goto l2
+				l3:
+					position, tokenIndex = position2, tokenIndex2
+					{
+						position397 := position
+						{
+							position398, tokenIndex398 := position, tokenIndex
+							{
+								position400 := position
+								{
+									position401, tokenIndex401 := position, tokenIndex
+									if !_rules[ruleWorldObject]() {
+										goto l402
+									}
+									goto l401
+								l402:
+									position, tokenIndex = position401, tokenIndex401
+									if !_rules[ruleTree]() {
+										goto l403
+									}
+									goto l401
+								l403:
+									position, tokenIndex = position401, tokenIndex401
+									{
+										position405 := position
+										{
+											position406 := position
+											if !_rules[rule_]() {
+												goto l404
+											}
+											if !_rules[ruleDELIMITER]() {
+												goto l404
+											}
+											if buffer[position] != rune('c') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('h') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('g') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l404
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l404
+											}
+											add(ruleBeginChanges, position406)
+										}
+										{
+											position407, tokenIndex407 := position, tokenIndex
+											{
+												position409 := position
+												if buffer[position] != rune('m') {
+													goto l407
+												}
+												position++
+												if buffer[position] != rune('a') {
+													goto l407
+												}
+												position++
+												if buffer[position] != rune('t') {
+													goto l407
+												}
+												position++
+												if buffer[position] != rune('c') {
+													goto l407
+												}
+												position++
+												if buffer[position] != rune('h') {
+													goto l407
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l407
+												}
+												position++
+												if buffer[position] != rune('d') {
+													goto l407
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l407
+												}
+											l410:
+												{
+													position411, tokenIndex411 := position, tokenIndex
+													{
+														position412 := position
+														{
+															position413, tokenIndex413 := position, tokenIndex
+															{
+																position414 := position
+																{
+																	position415, tokenIndex415 := position, tokenIndex
+																	{
+																		position417, tokenIndex417 := position, tokenIndex
+																		if buffer[position] != rune('c') {
+																			goto l418
+																		}
+																		position++
+																		if buffer[position] != rune('r') {
+																			goto l418
+																		}
+																		position++
+																		if buffer[position] != rune('e') {
+																			goto l418
+																		}
+																		position++
+																		if buffer[position] != rune('a') {
+																			goto l418
+																		}
+																		position++
+																		if buffer[position] != rune('t') {
+																			goto l418
+																		}
+																		position++
+																		if buffer[position] != rune('e') {
+																			goto l418
+																		}
+																		position++
+																		if buffer[position] != rune('d') {
+																			goto l418
+																		}
+																		position++
+																		goto l417
+																	l418:
+																		position, tokenIndex = position417, tokenIndex417
+																		{
+																			switch buffer[position] {
+																			case 'm':
+																				if buffer[position] != rune('m') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('o') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('v') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('e') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('d') {
+																					goto l416
+																				}
+																				position++
+																			case 'c':
+																				if buffer[position] != rune('c') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('h') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('a') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('n') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('g') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('e') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('d') {
+																					goto l416
+																				}
+																				position++
+																			default:
+																				if buffer[position] != rune('r') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('e') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('m') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('o') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('v') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('e') {
+																					goto l416
+																				}
+																				position++
+																				if buffer[position] != rune('d') {
+																					goto l416
+																				}
+																				position++
+																			}
+																		}
+
+																	}
+																l417:
+																	if !_rules[rule_]() {
+																		goto l416
+																	}
+																	goto l415
+																l416:
+																	position, tokenIndex = position415, tokenIndex415
+																	if buffer[position] != rune('e') {
+																		goto l413
+																	}
+																	position++
+																	if buffer[position] != rune('n') {
+																		goto l413
+																	}
+																	position++
+																	if buffer[position] != rune('d') {
+																		goto l413
+																	}
+																	position++
+																	if buffer[position] != rune('c') {
+																		goto l413
+																	}
+																	position++
+																	if buffer[position] != rune('h') {
+																		goto l413
+																	}
+																	position++
+																	if buffer[position] != rune('a') {
+																		goto l413
+																	}
+																	position++
+																	if buffer[position] != rune('n') {
+																		goto l413
+																	}
+																	position++
+																	if buffer[position] != rune('g') {
+																		goto l413
+																	}
+																	position++
+																	if buffer[position] != rune('e') {
+																		goto l413
+																	}
+																	position++
+																	if buffer[position] != rune('s') {
+																		goto l413
+																	}
+																	position++
+																	if !_rules[ruleDELIMITER]() {
+																		goto l413
+																	}
+																}
+															l415:
+																add(ruleChangeEnd, position414)
+															}
+															goto l411
+														l413:
+															position, tokenIndex = position413, tokenIndex413
+														}
+														{
+															position420 := position
+															if !_rules[ruleStringLike]() {
+																goto l411
+															}
+															add(rulePegText, position420)
+														}
+														{
+															add(ruleAction39, position)
+														}
+														add(ruleChangeMatchedId, position412)
+													}
+													goto l410
+												l411:
+													position, tokenIndex = position411, tokenIndex411
+												}
+												add(ruleChangeMatched, position409)
+											}
+											goto l408
+										l407:
+											position, tokenIndex = position407, tokenIndex407
+										}
+									l408:
+									l422:
+										{
+											position423, tokenIndex423 := position, tokenIndex
+											{
+												position424 := position
+												{
+													position425, tokenIndex425 := position, tokenIndex
+													if !_rules[ruleChangeAction]() {
+														goto l426
+													}
+													{
+														position427 := position
+														{
+															position428, tokenIndex428 := position, tokenIndex
+															if !_rules[ruleItem]() {
+																goto l429
+															}
+															if !_rules[ruleIdentifier]() {
+																goto l429
+															}
+															{
+																position430, tokenIndex430 := position, tokenIndex
+																if !_rules[ruleItemParams]() {
+																	goto l430
+																}
+																goto l431
+															l430:
+																position, tokenIndex = position430, tokenIndex430
+															}
+														l431:
+															goto l428
+														l429:
+															position, tokenIndex = position428, tokenIndex428
+															if !_rules[ruleRel]() {
+																goto l426
+															}
+															if !_rules[ruleDualIdentifier]() {
+																goto l426
+															}
+															{
+																position432, tokenIndex432 := position, tokenIndex
+																if !_rules[ruleRelParams]() {
+																	goto l432
+																}
+																goto l433
+															l432:
+																position, tokenIndex = position432, tokenIndex432
+															}
+														l433:
+														}
+													l428:
+														add(rulePegText, position427)
+													}
+													{
+														add(ruleAction40, position)
+													}
+													goto l425
+												l426:
+													position, tokenIndex = position425, tokenIndex425
+													if !_rules[ruleChangeAction]() {
+														goto l435
+													}
+													{
+														position436 := position
+														if !_rules[ruleWorld]() {
+															goto l435
+														}
+														if !_rules[ruleWorldSetParams]() {
+															goto l435
+														}
+														add(rulePegText, position436)
+													}
+													{
+														add(ruleAction41, position)
+													}
+													goto l425
+												l435:
+													position, tokenIndex = position425, tokenIndex425
+													if !_rules[ruleChangeAction]() {
+														goto l438
+													}
+													{
+														position439 := position
+														{
+															position440, tokenIndex440 := position, tokenIndex
+															if !_rules[ruleNode]() {
+																goto l441
+															}
+															if !_rules[ruleIdentifier]() {
+																goto l441
+															}
+															{
+																position442, tokenIndex442 := position, tokenIndex
+																if !_rules[ruleNodeParams]() {
+																	goto l442
+																}
+																goto l443
+															l442:
+																position, tokenIndex = position442, tokenIndex442
+															}
+														l443:
+															goto l440
+														l441:
+															position, tokenIndex = position440, tokenIndex440
+															if !_rules[ruleDeploy]() {
+																goto l438
+															}
+															if !_rules[ruleIdentifier]() {
+																goto l438
+															}
+															if !_rules[ruleTO]() {
+																goto l438
+															}
+															if !_rules[ruleSecondIdentifier]() {
+																goto l438
+															}
+														}
+													l440:
+														add(rulePegText, position439)
+													}
+													{
+														add(ruleAction42, position)
+													}
+													goto l425
+												l438:
+													position, tokenIndex = position425, tokenIndex425
+													if !_rules[ruleChangeAction]() {
+														goto l445
+													}
+													{
+														position446 := position
+														if !_rules[ruleScenario]() {
+															goto l445
+														}
+														if !_rules[ruleIdentifier]() {
+															goto l445
+														}
+														{
+															position447, tokenIndex447 := position, tokenIndex
+															if !_rules[ruleScenarioParams]() {
+																goto l447
+															}
+															goto l448
+														l447:
+															position, tokenIndex = position447, tokenIndex447
+														}
+													l448:
+														add(rulePegText, position446)
+													}
+													{
+														add(ruleAction43, position)
+													}
+													goto l425
+												l445:
+													position, tokenIndex = position425, tokenIndex425
+													if !_rules[ruleChangeAction]() {
+														goto l450
+													}
+													{
+														position451 := position
+														if !_rules[ruleView]() {
+															goto l450
+														}
+														if !_rules[ruleIdentifier]() {
+															goto l450
+														}
+														{
+															position452, tokenIndex452 := position, tokenIndex
+															if !_rules[ruleViewParams]() {
+																goto l452
+															}
+															goto l453
+														l452:
+															position, tokenIndex = position452, tokenIndex452
+														}
+													l453:
+														add(rulePegText, position451)
+													}
+													{
+														add(ruleAction44, position)
+													}
+													goto l425
+												l450:
+													position, tokenIndex = position425, tokenIndex425
+													if !_rules[ruleChangeAction]() {
+														goto l455
+													}
+													{
+														position456 := position
+														if !_rules[rulePin]() {
+															goto l455
+														}
+														if !_rules[rulePinTarget]() {
+															goto l455
+														}
+														if !_rules[rulePinParams]() {
+															goto l455
+														}
+														add(rulePegText, position456)
+													}
+													{
+														add(ruleAction45, position)
+													}
+													goto l425
+												l455:
+													position, tokenIndex = position425, tokenIndex425
+													if !_rules[ruleChangeAction]() {
+														goto l458
+													}
+													{
+														position459 := position
+														if !_rules[ruleStyle]() {
+															goto l458
+														}
+														if !_rules[ruleIdentifier]() {
+															goto l458
+														}
+														{
+															position460, tokenIndex460 := position, tokenIndex
+															if !_rules[ruleStyleParams]() {
+																goto l460
+															}
+															goto l461
+														l460:
+															position, tokenIndex = position460, tokenIndex460
+														}
+													l461:
+														add(rulePegText, position459)
+													}
+													{
+														add(ruleAction46, position)
+													}
+													goto l425
+												l458:
+													position, tokenIndex = position425, tokenIndex425
+													{
+														position463 := position
+														if buffer[position] != rune('m') {
+															goto l423
+														}
+														position++
+														if buffer[position] != rune('o') {
+															goto l423
+														}
+														position++
+														if buffer[position] != rune('v') {
+															goto l423
+														}
+														position++
+														if buffer[position] != rune('e') {
+															goto l423
+														}
+														position++
+														if buffer[position] != rune('d') {
+															goto l423
+														}
+														position++
+														if !_rules[rule_]() {
+															goto l423
+														}
+														{
+															position464 := position
+															if !_rules[ruleStringLike]() {
+																goto l423
+															}
+															add(rulePegText, position464)
+														}
+														{
+															add(ruleAction49, position)
+														}
+														add(ruleChangeMoved, position463)
+													}
+													if buffer[position] != rune('f') {
+														goto l423
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l423
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l423
+													}
+													position++
+													if buffer[position] != rune('m') {
+														goto l423
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l423
+													}
+													{
+														position466 := position
+														{
+															position467 := position
+															if !_rules[ruleStringLike]() {
+																goto l423
+															}
+															add(rulePegText, position467)
+														}
+														{
+															add(ruleAction50, position)
+														}
+														add(ruleChangeFrom, position466)
+													}
+													if buffer[position] != rune('t') {
+														goto l423
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l423
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l423
+													}
+													{
+														position469 := position
+														{
+															position470 := position
+															if !_rules[ruleStringLike]() {
+																goto l423
+															}
+															add(rulePegText, position470)
+														}
+														{
+															add(ruleAction51, position)
+														}
+														add(ruleChangeTo, position469)
+													}
+													{
+														add(ruleAction47, position)
+													}
+												}
+											l425:
+												add(ruleChange, position424)
+											}
+											goto l422
+										l423:
+											position, tokenIndex = position423, tokenIndex423
+										}
+										{
+											position473 := position
+											if !_rules[rule_]() {
+												goto l404
+											}
+											if buffer[position] != rune('e') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('h') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('g') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l404
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l404
+											}
+											position++
+											if !_rules[ruleDELIMITER]() {
+												goto l404
+											}
+											if !_rules[rule_]() {
+												goto l404
+											}
+											add(ruleEndChanges, position473)
+										}
+										{
+											add(ruleAction22, position)
+										}
+										add(ruleChangeSetObject, position405)
+									}
+									goto l401
+								l404:
+									position, tokenIndex = position401, tokenIndex401
+									{
+										position476 := position
+										{
+											position477 := position
+											if !_rules[rule_]() {
+												goto l475
+											}
+											if !_rules[ruleDELIMITER]() {
+												goto l475
+											}
+											if buffer[position] != rune('d') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('f') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('w') {
+												goto l475
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l475
+											}
+											add(ruleBeginDataFlow, position477)
+										}
+										{
+											position478 := position
+											if buffer[position] != rune('c') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l475
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l475
+											}
+											{
+												position479 := position
+												if !_rules[ruleStringLike]() {
+													goto l475
+												}
+												add(rulePegText, position479)
+											}
+											{
+												add(ruleAction52, position)
+											}
+											add(ruleFlowClass, position478)
+										}
+									l481:
+										{
+											position482, tokenIndex482 := position, tokenIndex
+											{
+												position483 := position
+												{
+													position484 := position
+													{
+														position485 := position
+														{
+															switch buffer[position] {
+															case 'e':
+																if buffer[position] != rune('e') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('x') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('t') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('e') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('r') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('n') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('a') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('l') {
+																	goto l482
+																}
+																position++
+															case 'r':
+																if buffer[position] != rune('r') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('e') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('a') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('c') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('h') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('e') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('d') {
+																	goto l482
+																}
+																position++
+															default:
+																if buffer[position] != rune('s') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('o') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('u') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('r') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('c') {
+																	goto l482
+																}
+																position++
+																if buffer[position] != rune('e') {
+																	goto l482
+																}
+																position++
+															}
+														}
+
+														add(rulePegText, position485)
+													}
+													if !_rules[rule_]() {
+														goto l482
+													}
+													{
+														add(ruleAction54, position)
+													}
+													add(ruleFlowKind, position484)
+												}
+												{
+													position488 := position
+													{
+														position489 := position
+														if !_rules[ruleStringLike]() {
+															goto l482
+														}
+														add(rulePegText, position489)
+													}
+													{
+														add(ruleAction55, position)
+													}
+													add(ruleFlowId, position488)
+												}
+												{
+													position491, tokenIndex491 := position, tokenIndex
+													if buffer[position] != rune('v') {
+														goto l491
+													}
+													position++
+													if buffer[position] != rune('i') {
+														goto l491
+													}
+													position++
+													if buffer[position] != rune('a') {
+														goto l491
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l491
+													}
+												l493:
+													{
+														position494, tokenIndex494 := position, tokenIndex
+														{
+															position495 := position
+															{
+																position496, tokenIndex496 := position, tokenIndex
+																{
+																	position497 := position
+																	{
+																		position498, tokenIndex498 := position, tokenIndex
+																		{
+																			switch buffer[position] {
+																			case 'e':
+																				if buffer[position] != rune('e') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('x') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('t') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('e') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('r') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('n') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('a') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('l') {
+																					goto l499
+																				}
+																				position++
+																			case 'r':
+																				if buffer[position] != rune('r') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('e') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('a') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('c') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('h') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('e') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('d') {
+																					goto l499
+																				}
+																				position++
+																			default:
+																				if buffer[position] != rune('s') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('o') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('u') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('r') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('c') {
+																					goto l499
+																				}
+																				position++
+																				if buffer[position] != rune('e') {
+																					goto l499
+																				}
+																				position++
+																			}
+																		}
+
+																		if !_rules[rule_]() {
+																			goto l499
+																		}
+																		goto l498
+																	l499:
+																		position, tokenIndex = position498, tokenIndex498
+																		if buffer[position] != rune('e') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('n') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('d') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('d') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('a') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('t') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('a') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('f') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('l') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('o') {
+																			goto l496
+																		}
+																		position++
+																		if buffer[position] != rune('w') {
+																			goto l496
+																		}
+																		position++
+																		if !_rules[ruleDELIMITER]() {
+																			goto l496
+																		}
+																	}
+																l498:
+																	add(ruleFlowEnd, position497)
+																}
+																goto l494
+															l496:
+																position, tokenIndex = position496, tokenIndex496
+															}
+															{
+																position501 := position
+																if !_rules[ruleStringLike]() {
+																	goto l494
+																}
+																add(rulePegText, position501)
+															}
+															{
+																add(ruleAction56, position)
+															}
+															add(ruleFlowPathRel, position495)
+														}
+														goto l493
+													l494:
+														position, tokenIndex = position494, tokenIndex494
+													}
+													goto l492
+												l491:
+													position, tokenIndex = position491, tokenIndex491
+												}
+											l492:
+												{
+													add(ruleAction53, position)
+												}
+												add(ruleFlowStep, position483)
+											}
+											goto l481
+										l482:
+											position, tokenIndex = position482, tokenIndex482
+										}
+										{
+											position504 := position
+											if !_rules[rule_]() {
+												goto l475
+											}
+											if buffer[position] != rune('e') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('f') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l475
+											}
+											position++
+											if buffer[position] != rune('w') {
+												goto l475
+											}
+											position++
+											if !_rules[ruleDELIMITER]() {
+												goto l475
+											}
+											if !_rules[rule_]() {
+												goto l475
+											}
+											add(ruleEndDataFlow, position504)
+										}
+										{
+											add(ruleAction23, position)
+										}
+										add(ruleDataFlowObject, position476)
+									}
+									goto l401
+								l475:
+									position, tokenIndex = position401, tokenIndex401
+									{
+										position509 := position
+										{
+											position510 := position
+											if !_rules[rule_]() {
+												goto l506
+											}
+											if !_rules[ruleDELIMITER]() {
+												goto l506
+											}
+											if buffer[position] != rune('d') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l506
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l506
+											}
+											add(ruleBeginDetail, position510)
+										}
+										{
+											position511 := position
+											{
+												position512 := position
+												if !_rules[ruleItem]() {
+													goto l506
+												}
+												if !_rules[ruleIdentifier]() {
+													goto l506
+												}
+												{
+													position513, tokenIndex513 := position, tokenIndex
+													if !_rules[ruleItemParams]() {
+														goto l513
+													}
+													goto l514
+												l513:
+													position, tokenIndex = position513, tokenIndex513
+												}
+											l514:
+												add(rulePegText, position512)
+											}
+											{
+												add(ruleAction31, position)
+											}
+											add(ruleDetailItem, position511)
+										}
+										{
+											position516, tokenIndex516 := position, tokenIndex
+											{
+												position518 := position
+												if buffer[position] != rune('p') {
+													goto l516
+												}
+												position++
+												if buffer[position] != rune('a') {
+													goto l516
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l516
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l516
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l516
+												}
+												position++
+												if buffer[position] != rune('t') {
+													goto l516
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l516
+												}
+												{
+													position519 := position
+													if !_rules[ruleStringLike]() {
+														goto l516
+													}
+													add(rulePegText, position519)
+												}
+												{
+													add(ruleAction35, position)
+												}
+												add(ruleDetailParent, position518)
+											}
+											goto l517
+										l516:
+											position, tokenIndex = position516, tokenIndex516
+										}
+									l517:
+										{
+											position521 := position
+											if buffer[position] != rune('c') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l506
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l506
+											}
+										l522:
+											{
+												position523, tokenIndex523 := position, tokenIndex
+												{
+													position524 := position
+													{
+														position525, tokenIndex525 := position, tokenIndex
+														{
+															position526 := position
+															{
+																position527, tokenIndex527 := position, tokenIndex
+																{
+																	position529, tokenIndex529 := position, tokenIndex
+																	if buffer[position] != rune('i') {
+																		goto l530
+																	}
+																	position++
+																	if buffer[position] != rune('n') {
+																		goto l530
+																	}
+																	position++
+																	if buffer[position] != rune('b') {
+																		goto l530
+																	}
+																	position++
+																	if buffer[position] != rune('o') {
+																		goto l530
+																	}
+																	position++
+																	if buffer[position] != rune('u') {
+																		goto l530
+																	}
+																	position++
+																	if buffer[position] != rune('n') {
+																		goto l530
+																	}
+																	position++
+																	if buffer[position] != rune('d') {
+																		goto l530
+																	}
+																	position++
+																	goto l529
+																l530:
+																	position, tokenIndex = position529, tokenIndex529
+																	if buffer[position] != rune('o') {
+																		goto l528
+																	}
+																	position++
+																	if buffer[position] != rune('u') {
+																		goto l528
+																	}
+																	position++
+																	if buffer[position] != rune('t') {
+																		goto l528
+																	}
+																	position++
+																	if buffer[position] != rune('b') {
+																		goto l528
+																	}
+																	position++
+																	if buffer[position] != rune('o') {
+																		goto l528
+																	}
+																	position++
+																	if buffer[position] != rune('u') {
+																		goto l528
+																	}
+																	position++
+																	if buffer[position] != rune('n') {
+																		goto l528
+																	}
+																	position++
+																	if buffer[position] != rune('d') {
+																		goto l528
+																	}
+																	position++
+																}
+															l529:
+																if !_rules[rule_]() {
+																	goto l528
+																}
+																if !_rules[ruleRel]() {
+																	goto l528
+																}
+																goto l527
+															l528:
+																position, tokenIndex = position527, tokenIndex527
+																if buffer[position] != rune('e') {
+																	goto l525
+																}
+																position++
+																if buffer[position] != rune('n') {
+																	goto l525
+																}
+																position++
+																if buffer[position] != rune('d') {
+																	goto l525
+																}
+																position++
+																if buffer[position] != rune('d') {
+																	goto l525
+																}
+																position++
+																if buffer[position] != rune('e') {
+																	goto l525
+																}
+																position++
+																if buffer[position] != rune('t') {
+																	goto l525
+																}
+																position++
+																if buffer[position] != rune('a') {
+																	goto l525
+																}
+																position++
+																if buffer[position] != rune('i') {
+																	goto l525
+																}
+																position++
+																if buffer[position] != rune('l') {
+																	goto l525
+																}
+																position++
+																if !_rules[ruleDELIMITER]() {
+																	goto l525
+																}
+															}
+														l527:
+															add(ruleDetailEnd, position526)
+														}
+														goto l523
+													l525:
+														position, tokenIndex = position525, tokenIndex525
+													}
+													{
+														position531 := position
+														if !_rules[ruleStringLike]() {
+															goto l523
+														}
+														add(rulePegText, position531)
+													}
+													{
+														add(ruleAction36, position)
+													}
+													add(ruleDetailComponent, position524)
+												}
+												goto l522
+											l523:
+												position, tokenIndex = position523, tokenIndex523
+											}
+											add(ruleDetailComponents, position521)
+										}
+									l533:
+										{
+											position534, tokenIndex534 := position, tokenIndex
+											{
+												position535 := position
+												{
+													position536, tokenIndex536 := position, tokenIndex
+													if buffer[position] != rune('i') {
+														goto l537
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l537
+													}
+													position++
+													if buffer[position] != rune('b') {
+														goto l537
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l537
+													}
+													position++
+													if buffer[position] != rune('u') {
+														goto l537
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l537
+													}
+													position++
+													if buffer[position] != rune('d') {
+														goto l537
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l537
+													}
+													{
+														position538 := position
+														if !_rules[ruleRel]() {
+															goto l537
+														}
+														if !_rules[ruleDualIdentifier]() {
+															goto l537
+														}
+														{
+															position539, tokenIndex539 := position, tokenIndex
+															if !_rules[ruleRelParams]() {
+																goto l539
+															}
+															goto l540
+														l539:
+															position, tokenIndex = position539, tokenIndex539
+														}
+													l540:
+														add(rulePegText, position538)
+													}
+													{
+														add(ruleAction37, position)
+													}
+													goto l536
+												l537:
+													position, tokenIndex = position536, tokenIndex536
+													if buffer[position] != rune('o') {
+														goto l534
+													}
+													position++
+													if buffer[position] != rune('u') {
+														goto l534
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l534
+													}
+													position++
+													if buffer[position] != rune('b') {
+														goto l534
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l534
+													}
+													position++
+													if buffer[position] != rune('u') {
+														goto l534
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l534
+													}
+													position++
+													if buffer[position] != rune('d') {
+														goto l534
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l534
+													}
+													{
+														position542 := position
+														if !_rules[ruleRel]() {
+															goto l534
+														}
+														if !_rules[ruleDualIdentifier]() {
+															goto l534
+														}
+														{
+															position543, tokenIndex543 := position, tokenIndex
+															if !_rules[ruleRelParams]() {
+																goto l543
+															}
+															goto l544
+														l543:
+															position, tokenIndex = position543, tokenIndex543
+														}
+													l544:
+														add(rulePegText, position542)
+													}
+													{
+														add(ruleAction38, position)
+													}
+												}
+											l536:
+												add(ruleDetailRel, position535)
+											}
+											goto l533
+										l534:
+											position, tokenIndex = position534, tokenIndex534
+										}
+										{
+											position546 := position
+											if !_rules[rule_]() {
+												goto l506
+											}
+											if buffer[position] != rune('e') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l506
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l506
+											}
+											position++
+											if !_rules[ruleDELIMITER]() {
+												goto l506
+											}
+											if !_rules[rule_]() {
+												goto l506
+											}
+											add(ruleEndDetail, position546)
+										}
+										{
+											add(ruleAction21, position)
+										}
+										add(ruleItemDetailObject, position509)
+									}
+								l507:
+									{
+										position508, tokenIndex508 := position, tokenIndex
+										{
+											position548 := position
+											{
+												position549 := position
+												if !_rules[rule_]() {
+													goto l508
+												}
+												if !_rules[ruleDELIMITER]() {
+													goto l508
+												}
+												if buffer[position] != rune('d') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('t') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('a') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('i') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('l') {
+													goto l508
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l508
+												}
+												add(ruleBeginDetail, position549)
+											}
+											{
+												position550 := position
+												{
+													position551 := position
+													if !_rules[ruleItem]() {
+														goto l508
+													}
+													if !_rules[ruleIdentifier]() {
+														goto l508
+													}
+													{
+														position552, tokenIndex552 := position, tokenIndex
+														if !_rules[ruleItemParams]() {
+															goto l552
+														}
+														goto l553
+													l552:
+														position, tokenIndex = position552, tokenIndex552
+													}
+												l553:
+													add(rulePegText, position551)
+												}
+												{
+													add(ruleAction31, position)
+												}
+												add(ruleDetailItem, position550)
+											}
+											{
+												position555, tokenIndex555 := position, tokenIndex
+												{
+													position557 := position
+													if buffer[position] != rune('p') {
+														goto l555
+													}
+													position++
+													if buffer[position] != rune('a') {
+														goto l555
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l555
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l555
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l555
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l555
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l555
+													}
+													{
+														position558 := position
+														if !_rules[ruleStringLike]() {
+															goto l555
+														}
+														add(rulePegText, position558)
+													}
+													{
+														add(ruleAction35, position)
+													}
+													add(ruleDetailParent, position557)
+												}
+												goto l556
+											l555:
+												position, tokenIndex = position555, tokenIndex555
+											}
+										l556:
+											{
+												position560 := position
+												if buffer[position] != rune('c') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('m') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('p') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('t') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l508
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l508
+												}
+											l561:
+												{
+													position562, tokenIndex562 := position, tokenIndex
+													{
+														position563 := position
+														{
+															position564, tokenIndex564 := position, tokenIndex
+															{
+																position565 := position
+																{
+																	position566, tokenIndex566 := position, tokenIndex
+																	{
+																		position568, tokenIndex568 := position, tokenIndex
+																		if buffer[position] != rune('i') {
+																			goto l569
+																		}
+																		position++
+																		if buffer[position] != rune('n') {
+																			goto l569
+																		}
+																		position++
+																		if buffer[position] != rune('b') {
+																			goto l569
+																		}
+																		position++
+																		if buffer[position] != rune('o') {
+																			goto l569
+																		}
+																		position++
+																		if buffer[position] != rune('u') {
+																			goto l569
+																		}
+																		position++
+																		if buffer[position] != rune('n') {
+																			goto l569
+																		}
+																		position++
+																		if buffer[position] != rune('d') {
+																			goto l569
+																		}
+																		position++
+																		goto l568
+																	l569:
+																		position, tokenIndex = position568, tokenIndex568
+																		if buffer[position] != rune('o') {
+																			goto l567
+																		}
+																		position++
+																		if buffer[position] != rune('u') {
+																			goto l567
+																		}
+																		position++
+																		if buffer[position] != rune('t') {
+																			goto l567
+																		}
+																		position++
+																		if buffer[position] != rune('b') {
+																			goto l567
+																		}
+																		position++
+																		if buffer[position] != rune('o') {
+																			goto l567
+																		}
+																		position++
+																		if buffer[position] != rune('u') {
+																			goto l567
+																		}
+																		position++
+																		if buffer[position] != rune('n') {
+																			goto l567
+																		}
+																		position++
+																		if buffer[position] != rune('d') {
+																			goto l567
+																		}
+																		position++
+																	}
+																l568:
+																	if !_rules[rule_]() {
+																		goto l567
+																	}
+																	if !_rules[ruleRel]() {
+																		goto l567
+																	}
+																	goto l566
+																l567:
+																	position, tokenIndex = position566, tokenIndex566
+																	if buffer[position] != rune('e') {
+																		goto l564
+																	}
+																	position++
+																	if buffer[position] != rune('n') {
+																		goto l564
+																	}
+																	position++
+																	if buffer[position] != rune('d') {
+																		goto l564
+																	}
+																	position++
+																	if buffer[position] != rune('d') {
+																		goto l564
+																	}
+																	position++
+																	if buffer[position] != rune('e') {
+																		goto l564
+																	}
+																	position++
+																	if buffer[position] != rune('t') {
+																		goto l564
+																	}
+																	position++
+																	if buffer[position] != rune('a') {
+																		goto l564
+																	}
+																	position++
+																	if buffer[position] != rune('i') {
+																		goto l564
+																	}
+																	position++
+																	if buffer[position] != rune('l') {
+																		goto l564
+																	}
+																	position++
+																	if !_rules[ruleDELIMITER]() {
+																		goto l564
+																	}
+																}
+															l566:
+																add(ruleDetailEnd, position565)
+															}
+															goto l562
+														l564:
+															position, tokenIndex = position564, tokenIndex564
+														}
+														{
+															position570 := position
+															if !_rules[ruleStringLike]() {
+																goto l562
+															}
+															add(rulePegText, position570)
+														}
+														{
+															add(ruleAction36, position)
+														}
+														add(ruleDetailComponent, position563)
+													}
+													goto l561
+												l562:
+													position, tokenIndex = position562, tokenIndex562
+												}
+												add(ruleDetailComponents, position560)
+											}
+										l572:
+											{
+												position573, tokenIndex573 := position, tokenIndex
+												{
+													position574 := position
+													{
+														position575, tokenIndex575 := position, tokenIndex
+														if buffer[position] != rune('i') {
+															goto l576
+														}
+														position++
+														if buffer[position] != rune('n') {
+															goto l576
+														}
+														position++
+														if buffer[position] != rune('b') {
+															goto l576
+														}
+														position++
+														if buffer[position] != rune('o') {
+															goto l576
+														}
+														position++
+														if buffer[position] != rune('u') {
+															goto l576
+														}
+														position++
+														if buffer[position] != rune('n') {
+															goto l576
+														}
+														position++
+														if buffer[position] != rune('d') {
+															goto l576
+														}
+														position++
+														if !_rules[rule_]() {
+															goto l576
+														}
+														{
+															position577 := position
+															if !_rules[ruleRel]() {
+																goto l576
+															}
+															if !_rules[ruleDualIdentifier]() {
+																goto l576
+															}
+															{
+																position578, tokenIndex578 := position, tokenIndex
+																if !_rules[ruleRelParams]() {
+																	goto l578
+																}
+																goto l579
+															l578:
+																position, tokenIndex = position578, tokenIndex578
+															}
+														l579:
+															add(rulePegText, position577)
+														}
+														{
+															add(ruleAction37, position)
+														}
+														goto l575
+													l576:
+														position, tokenIndex = position575, tokenIndex575
+														if buffer[position] != rune('o') {
+															goto l573
+														}
+														position++
+														if buffer[position] != rune('u') {
+															goto l573
+														}
+														position++
+														if buffer[position] != rune('t') {
+															goto l573
+														}
+														position++
+														if buffer[position] != rune('b') {
+															goto l573
+														}
+														position++
+														if buffer[position] != rune('o') {
+															goto l573
+														}
+														position++
+														if buffer[position] != rune('u') {
+															goto l573
+														}
+														position++
+														if buffer[position] != rune('n') {
+															goto l573
+														}
+														position++
+														if buffer[position] != rune('d') {
+															goto l573
+														}
+														position++
+														if !_rules[rule_]() {
+															goto l573
+														}
+														{
+															position581 := position
+															if !_rules[ruleRel]() {
+																goto l573
+															}
+															if !_rules[ruleDualIdentifier]() {
+																goto l573
+															}
+															{
+																position582, tokenIndex582 := position, tokenIndex
+																if !_rules[ruleRelParams]() {
+																	goto l582
+																}
+																goto l583
+															l582:
+																position, tokenIndex = position582, tokenIndex582
+															}
+														l583:
+															add(rulePegText, position581)
+														}
+														{
+															add(ruleAction38, position)
+														}
+													}
+												l575:
+													add(ruleDetailRel, position574)
+												}
+												goto l572
+											l573:
+												position, tokenIndex = position573, tokenIndex573
+											}
+											{
+												position585 := position
+												if !_rules[rule_]() {
+													goto l508
+												}
+												if buffer[position] != rune('e') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('d') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('d') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('t') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('a') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('i') {
+													goto l508
+												}
+												position++
+												if buffer[position] != rune('l') {
+													goto l508
+												}
+												position++
+												if !_rules[ruleDELIMITER]() {
+													goto l508
+												}
+												if !_rules[rule_]() {
+													goto l508
+												}
+												add(ruleEndDetail, position585)
+											}
+											{
+												add(ruleAction21, position)
+											}
+											add(ruleItemDetailObject, position548)
+										}
+										goto l507
+									l508:
+										position, tokenIndex = position508, tokenIndex508
+									}
+									goto l401
+								l506:
+									position, tokenIndex = position401, tokenIndex401
+									if !_rules[ruleItemObject]() {
+										goto l587
+									}
+								l588:
+									{
+										position589, tokenIndex589 := position, tokenIndex
+										if !_rules[ruleItemObject]() {
+											goto l589
+										}
+										goto l588
+									l589:
+										position, tokenIndex = position589, tokenIndex589
+									}
+									goto l401
+								l587:
+									position, tokenIndex = position401, tokenIndex401
+									if !_rules[ruleRelObject]() {
+										goto l590
+									}
+								l591:
+									{
+										position592, tokenIndex592 := position, tokenIndex
+										if !_rules[ruleRelObject]() {
+											goto l592
+										}
+										goto l591
+									l592:
+										position, tokenIndex = position592, tokenIndex592
+									}
+									goto l401
+								l590:
+									position, tokenIndex = position401, tokenIndex401
+									if !_rules[ruleNodeObject]() {
+										goto l593
+									}
+								l594:
+									{
+										position595, tokenIndex595 := position, tokenIndex
+										if !_rules[ruleNodeObject]() {
+											goto l595
+										}
+										goto l594
+									l595:
+										position, tokenIndex = position595, tokenIndex595
+									}
+									goto l401
+								l593:
+									position, tokenIndex = position401, tokenIndex401
+									if !_rules[ruleScenarioObject]() {
+										goto l596
+									}
+								l597:
+									{
+										position598, tokenIndex598 := position, tokenIndex
+										if !_rules[ruleScenarioObject]() {
+											goto l598
+										}
+										goto l597
+									l598:
+										position, tokenIndex = position598, tokenIndex598
+									}
+								l599:
+									{
+										position600, tokenIndex600 := position, tokenIndex
+										if !_rules[ruleStepObject]() {
+											goto l600
+										}
+										goto l599
+									l600:
+										position, tokenIndex = position600, tokenIndex600
+									}
+									goto l401
+								l596:
+									position, tokenIndex = position401, tokenIndex401
+									if !_rules[ruleViewObject]() {
+										goto l601
+									}
+								l602:
+									{
+										position603, tokenIndex603 := position, tokenIndex
+										if !_rules[ruleViewObject]() {
+											goto l603
+										}
+										goto l602
+									l603:
+										position, tokenIndex = position603, tokenIndex603
+									}
+									goto l401
+								l601:
+									position, tokenIndex = position401, tokenIndex401
+									if !_rules[rulePinObject]() {
+										goto l604
+									}
+								l605:
+									{
+										position606, tokenIndex606 := position, tokenIndex
+										if !_rules[rulePinObject]() {
+											goto l606
+										}
+										goto l605
+									l606:
+										position, tokenIndex = position606, tokenIndex606
+									}
+									goto l401
+								l604:
+									position, tokenIndex = position401, tokenIndex401
+									if !_rules[ruleStyleObject]() {
+										goto l607
+									}
+								l608:
+									{
+										position609, tokenIndex609 := position, tokenIndex
+										if !_rules[ruleStyleObject]() {
+											goto l609
+										}
+										goto l608
+									l609:
+										position, tokenIndex = position609, tokenIndex609
+									}
+									goto l401
+								l607:
+									position, tokenIndex = position401, tokenIndex401
+									{
+										position610 := position
+										{
+											position611 := position
+											{
+												position612, tokenIndex612 := position, tokenIndex
+												{
+													position613, tokenIndex613 := position, tokenIndex
+													if buffer[position] != rune('i') {
+														goto l614
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l614
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l614
+													}
+													position++
+													if buffer[position] != rune('m') {
+														goto l614
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l614
+													}
+													position++
+													goto l613
+												l614:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('r') {
+														goto l615
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l615
+													}
+													position++
+													if buffer[position] != rune('l') {
+														goto l615
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l615
+													}
+													position++
+													goto l613
+												l615:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('r') {
+														goto l616
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l616
+													}
+													position++
+													if buffer[position] != rune('l') {
+														goto l616
+													}
+													position++
+													goto l613
+												l616:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('n') {
+														goto l617
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l617
+													}
+													position++
+													if buffer[position] != rune('d') {
+														goto l617
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l617
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l617
+													}
+													position++
+													goto l613
+												l617:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('n') {
+														goto l618
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l618
+													}
+													position++
+													if buffer[position] != rune('d') {
+														goto l618
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l618
+													}
+													position++
+													goto l613
+												l618:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('s') {
+														goto l619
+													}
+													position++
+													if buffer[position] != rune('c') {
+														goto l619
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l619
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l619
+													}
+													position++
+													if buffer[position] != rune('a') {
+														goto l619
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l619
+													}
+													position++
+													if buffer[position] != rune('i') {
+														goto l619
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l619
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l619
+													}
+													position++
+													goto l613
+												l619:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('s') {
+														goto l620
+													}
+													position++
+													if buffer[position] != rune('c') {
+														goto l620
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l620
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l620
+													}
+													position++
+													if buffer[position] != rune('a') {
+														goto l620
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l620
+													}
+													position++
+													if buffer[position] != rune('i') {
+														goto l620
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l620
+													}
+													position++
+													goto l613
+												l620:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('s') {
+														goto l621
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l621
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l621
+													}
+													position++
+													if buffer[position] != rune('p') {
+														goto l621
+													}
+													position++
+													goto l613
+												l621:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('u') {
+														goto l622
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l622
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l622
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l622
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l622
+													}
+													position++
+													if buffer[position] != rune('p') {
+														goto l622
+													}
+													position++
+													goto l613
+												l622:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('v') {
+														goto l623
+													}
+													position++
+													if buffer[position] != rune('i') {
+														goto l623
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l623
+													}
+													position++
+													if buffer[position] != rune('w') {
+														goto l623
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l623
+													}
+													position++
+													goto l613
+												l623:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('s') {
+														goto l624
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l624
+													}
+													position++
+													if buffer[position] != rune('y') {
+														goto l624
+													}
+													position++
+													if buffer[position] != rune('l') {
+														goto l624
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l624
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l624
+													}
+													position++
+													goto l613
+												l624:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('u') {
+														goto l625
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l625
+													}
+													position++
+													if buffer[position] != rune('d') {
+														goto l625
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l625
+													}
+													position++
+													if buffer[position] != rune('p') {
+														goto l625
+													}
+													position++
+													if buffer[position] != rune('l') {
+														goto l625
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l625
+													}
+													position++
+													if buffer[position] != rune('y') {
+														goto l625
+													}
+													position++
+													goto l613
+												l625:
+													position, tokenIndex = position613, tokenIndex613
+													if buffer[position] != rune('t') {
+														goto l626
+													}
+													position++
+													if buffer[position] != rune('h') {
+														goto l626
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l626
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l626
+													}
+													position++
+													if buffer[position] != rune('a') {
+														goto l626
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l626
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l626
+													}
+													position++
+													goto l613
+												l626:
+													position, tokenIndex = position613, tokenIndex613
+													{
+														switch buffer[position] {
+														case 'r':
+															if buffer[position] != rune('r') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('d') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('o') {
+																goto l612
+															}
+															position++
+														case 'u':
+															if buffer[position] != rune('u') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('n') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('d') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('o') {
+																goto l612
+															}
+															position++
+														case 't':
+															if buffer[position] != rune('t') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('r') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+														case 'o':
+															if buffer[position] != rune('o') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('w') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('n') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('r') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('s') {
+																goto l612
+															}
+															position++
+														case 'd':
+															if buffer[position] != rune('d') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('p') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('l') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('o') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('y') {
+																goto l612
+															}
+															position++
+														case 'n':
+															if buffer[position] != rune('n') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('s') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('t') {
+																goto l612
+															}
+															position++
+														case 'f':
+															if buffer[position] != rune('f') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('r') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+														case 'p':
+															if buffer[position] != rune('p') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('i') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('n') {
+																goto l612
+															}
+															position++
+														case 'l':
+															if buffer[position] != rune('l') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('a') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('y') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('o') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('u') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('t') {
+																goto l612
+															}
+															position++
+														case 's':
+															if buffer[position] != rune('s') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('t') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('y') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('l') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+														case 'v':
+															if buffer[position] != rune('v') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('i') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('w') {
+																goto l612
+															}
+															position++
+														case 'i':
+															if buffer[position] != rune('i') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('t') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('e') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('m') {
+																goto l612
+															}
+															position++
+														default:
+															if buffer[position] != rune('w') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('o') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('r') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('l') {
+																goto l612
+															}
+															position++
+															if buffer[position] != rune('d') {
+																goto l612
+															}
+															position++
+														}
+													}
+
+												}
+											l613:
+												{
+													position628, tokenIndex628 := position, tokenIndex
+													{
+														switch buffer[position] {
+														case '.':
+															if buffer[position] != rune('.') {
+																goto l628
+															}
+															position++
+														case '_':
+															if buffer[position] != rune('_') {
+																goto l628
+															}
+															position++
+														case '-':
+															if buffer[position] != rune('-') {
+																goto l628
+															}
+															position++
+														case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+															if c := buffer[position]; c < rune('0') || c > rune('9') {
+																goto l628
+															}
+															position++
+														case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+															if c := buffer[position]; c < rune('A') || c > rune('Z') {
+																goto l628
+															}
+															position++
+														default:
+															if c := buffer[position]; c < rune('a') || c > rune('z') {
+																goto l628
+															}
+															position++
+														}
+													}
+
+													goto l612
+												l628:
+													position, tokenIndex = position628, tokenIndex628
+												}
+												goto l398
+											l612:
+												position, tokenIndex = position612, tokenIndex612
+											}
+											add(ruleNotStatement, position611)
+										}
+										{
+											position630 := position
+											{
+												position631 := position
+												if !_rules[ruleIdentifier]() {
+													goto l398
+												}
+											l632:
+												{
+													position633, tokenIndex633 := position, tokenIndex
+													if !_rules[ruleIdentifier]() {
+														goto l633
+													}
+													goto l632
+												l633:
+													position, tokenIndex = position633, tokenIndex633
+												}
+												add(rulePegText, position631)
+											}
+											{
+												add(ruleAction70, position)
+											}
+											add(ruleIdentifierList, position630)
+										}
+										{
+											add(ruleAction24, position)
+										}
+										add(ruleIdentifierListObject, position610)
+									}
+								}
+							l401:
+								add(ruleObjects, position400)
+							}
+							goto l399
+						l398:
+							position, tokenIndex = position398, tokenIndex398
+						}
+					l399:
+						if !_rules[rule_]() {
+							goto l396
+						}
+						if !_rules[ruleDELIMITER]() {
+							goto l396
+						}
+						if !_rules[ruleDELIMITER]() {
+							goto l396
+						}
+						if !_rules[rule_]() {
+							goto l396
+						}
+						if !_rules[ruleStatusObject]() {
+							goto l396
+						}
+						if !_rules[ruleEND]() {
+							goto l396
+						}
+						{
 							add(ruleAction0, position)
 						}
-						add(ruleResponse, position130)
+						add(ruleResponse, position397)
+					}
+					goto l2
+				l396:
+					position, tokenIndex = position2, tokenIndex2
+					{
+						switch buffer[position] {
+						case 't':
+							if !_rules[ruleTree]() {
+								goto l0
+							}
+						case '\t', '\n', '\r', ' ', '$':
+							if !_rules[ruleWorldObject]() {
+								goto l0
+							}
+						default:
+							if !_rules[ruleStatusObject]() {
+								goto l0
+							}
+						}
+					}
+
+				}
+			l2:
+				add(ruleValid, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 Response <- <(Objects? _ DELIMITER DELIMITER _ StatusObject END Action0)> */
+		nil,
+		/* 2 Command <- <(_ (Mutation / WorldMutation / TreeMutation / HistoryMutation / Query / StateBound) Flag* END Action1)> */
+		nil,
+		/* 3 Mutation <- <((Item Set Selector ItemParams) / (Item Clear Selector ItemKeys) / (Item Delete Selector) / (Item (Create / Set) Identifier ItemParams?) / (Item Clear Identifier ItemKeys) / (Item Delete Identifier) / (Rel (Create / Set) DualIdentifier RelParams?) / (Rel Clear DualIdentifier RelKeys) / (Rel Delete DualIdentifier) / (Item Copy Identifier TO <StringLike> Action2) / (Item Clone Identifier AS <StringLike> Action3) / (Item Merge Identifier INTO SecondIdentifier) / (Item Split Identifier INTO (NotAssign SecondIdentifier)+ (ASSIGN Assignment+)?) / (Item (Archive / Restore) Identifier) / (Node Create Identifier NodeParams?) / (Node Set Identifier NodeParams) / (Node Delete Identifier) / (Undeploy Identifier FROM SecondIdentifier) / (Scenario Create Identifier ScenarioParams?) / (Scenario Set Identifier ScenarioParams) / (Scenario Delete Identifier) / (Scenario Export Identifier <StringLike> Action6) / (Step Identifier SecondIdentifier SecondIdentifier StepParams) / (Step Identifier SecondIdentifier SecondIdentifier) / (View Create Identifier ViewParams?) / (View Set Identifier ViewParams) / (Style Create Identifier StyleParams?) / (Style Set Identifier StyleParams) / (Layout Pin PinTarget PinParams) / (Layout Unpin Identifier SecondIdentifier PinView) / (Layout Unpin Identifier PinView) / (Layout Unpin Identifier SecondIdentifier) / ((&('l') (Layout Unpin Identifier)) | (&('p') (Pin PinTarget PinParams)) | (&('s') (Style Delete Identifier)) | (&('v') (View Delete Identifier)) | (&('u') (Unstep Identifier <Number> Action7)) | (&('d') (Deploy Identifier TO SecondIdentifier)) | (&('n') (Node Export Identifier <StringLike> Action5)) | (&('o') (OwnersImport <StringLike> Action4)) | (&('r') (Rel (Link / Unlink) DualIdentifier LinkParams)) | (&('i') (Item (Link / Unlink) Identifier LinkParams))))> */
+		nil,
+		/* 4 WorldMutation <- <((World Set WorldSetParams) / (World Save Identifier?) / (ThreatsExport <StringLike> Action8) / (World Load Identifier) / (World New Identifier) / (World Use Identifier) / (World Open Identifier) / (World Close Identifier?))> */
+		nil,
+		/* 5 HistoryMutation <- <(Undo / Redo)> */
+		nil,
+		/* 6 TreeMutation <- <((Free Targets) / (Nest NestTargets _ IN NestParent))> */
+		nil,
+		/* 7 Query <- <(FetchQuery / ListQuery / ExistsQuery)> */
+		nil,
+		/* 8 FetchQuery <- <((Scenario Fetch Identifier) / ((&('w') (World &(FLAG / END) Action9)) | (&('r') (Rel Fetch DualIdentifier)) | (&('s') (Style Fetch Identifier)) | (&('v') (View Fetch Identifier)) | (&('n') (Node Fetch Identifier)) | (&('i') (Item Fetch Identifier))))> */
+		nil,
+		/* 9 ListQuery <- <((Item List Limit? OwnerFilter) / ((Scenario / ((&('s') Style) | (&('v') View) | (&('n') Node) | (&('w') World) | (&('r') Rel) | (&('i') Item))) List Limit?) / (Layout List PinView) / (ToQuery Identifier) / (DataFlowQuery <StringLike> Action11) / (DeployedQuery Identifier IN SecondIdentifier) / ((&('t') (TreeQuery &(FLAG / END))) | (&('d') (DeployedQuery Identifier)) | (&('c') (CrossingsQuery &(FLAG / END))) | (&('o') (OwnersQuery Identifier)) | (&('s') (SiblingsQuery Identifier)) | (&('a') (AncestorsQuery Identifier)) | (&('f') (FromQuery Identifier)) | (&('i') (Item IN Identifier Action10)) | (&('l') (Layout List))))> */
+		nil,
+		/* 10 ExistsQuery <- <((InQuery DualIdentifier) / (ItemExists Identifier) / (RelExists DualIdentifier))> */
+		nil,
+		/* 11 StateBound <- <((CreateOrFetch Action12) / (CreateOrSet Action13))> */
+		nil,
+		/* 12 CreateOrFetch <- <((Scenario NotVerb Identifier !ScenarioParams) / ((&('s') (Style NotVerb Identifier !StyleParams)) | (&('v') (View NotVerb Identifier !ViewParams)) | (&('r') (Rel NotVerb DualIdentifier !RelParams)) | (&('i') (Item NotVerb Identifier !ItemParams))))> */
+		nil,
+		/* 13 CreateOrSet <- <((Scenario NotVerb Identifier ScenarioParams) / ((&('s') (Style NotVerb Identifier StyleParams)) | (&('v') (View NotVerb Identifier ViewParams)) | (&('n') (Node NotVerb Identifier NodeParams)) | (&('r') (Rel NotVerb DualIdentifier RelParams)) | (&('i') (Item NotVerb Identifier ItemParams))))> */
+		nil,
+		/* 14 Objects <- <(WorldObject / Tree / ChangeSetObject / DataFlowObject / ItemDetailObject+ / ItemObject+ / RelObject+ / NodeObject+ / (ScenarioObject+ StepObject*) / ViewObject+ / PinObject+ / StyleObject+ / IdentifierListObject)> */
+		nil,
+		/* 15 WorldObject <- <(BeginWorld WorldParams Tree RelObject* NodeObject* DeployObject* ScenarioObject* StepObject* ViewObject* PinObject* StyleObject* EndWorld Action14)> */
+		func() bool {
+			position652, tokenIndex652 := position, tokenIndex
+			{
+				position653 := position
+				{
+					position654 := position
+					if !_rules[rule_]() {
+						goto l652
+					}
+					if !_rules[ruleDELIMITER]() {
+						goto l652
+					}
+					if !_rules[ruleWORLD]() {
+						goto l652
+					}
+					if !_rules[rule_]() {
+						goto l652
+					}
+					add(ruleBeginWorld, position654)
+				}
+				{
+					position655 := position
+					if !_rules[rule_]() {
+						goto l652
+					}
+					{
+						position656 := position
+						if !_rules[ruleVERSION]() {
+							goto l652
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l652
+						}
+						{
+							position657 := position
+							if !_rules[ruleNumber]() {
+								goto l652
+							}
+							add(rulePegText, position657)
+						}
+						{
+							add(ruleAction72, position)
+						}
+						add(ruleWorldParamVersion, position656)
+					}
+					if !_rules[rule_]() {
+						goto l652
+					}
+					{
+						position659 := position
+						if !_rules[ruleID]() {
+							goto l652
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l652
+						}
+						{
+							position660 := position
+							if !_rules[ruleStringLike]() {
+								goto l652
+							}
+							add(rulePegText, position660)
+						}
+						{
+							add(ruleAction73, position)
+						}
+						add(ruleWorldParamId, position659)
+					}
+					if !_rules[rule_]() {
+						goto l652
+					}
+					{
+						position662 := position
+						if !_rules[ruleNAME]() {
+							goto l652
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l652
+						}
+						{
+							position663 := position
+							{
+								position664, tokenIndex664 := position, tokenIndex
+								if !_rules[ruleStringLike]() {
+									goto l664
+								}
+								goto l665
+							l664:
+								position, tokenIndex = position664, tokenIndex664
+							}
+						l665:
+							add(rulePegText, position663)
+						}
+						{
+							add(ruleAction74, position)
+						}
+						add(ruleWorldParamName, position662)
+					}
+					if !_rules[rule_]() {
+						goto l652
+					}
+					{
+						position667 := position
+						if !_rules[ruleEXPANDED]() {
+							goto l652
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l652
+						}
+						{
+							position668 := position
+							{
+								position669, tokenIndex669 := position, tokenIndex
+								if !_rules[ruleStringLike]() {
+									goto l669
+								}
+								goto l670
+							l669:
+								position, tokenIndex = position669, tokenIndex669
+							}
+						l670:
+							add(rulePegText, position668)
+						}
+						{
+							add(ruleAction75, position)
+						}
+						add(ruleWorldParamExpanded, position667)
+					}
+					if !_rules[rule_]() {
+						goto l652
+					}
+					{
+						position672, tokenIndex672 := position, tokenIndex
+						{
+							position674 := position
+							if !_rules[ruleTHEME]() {
+								goto l672
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l672
+							}
+							{
+								position675 := position
+								if !_rules[ruleThemeName]() {
+									goto l672
+								}
+								add(rulePegText, position675)
+							}
+							{
+								add(ruleAction76, position)
+							}
+							add(ruleWorldParamTheme, position674)
+						}
+						if !_rules[rule_]() {
+							goto l672
+						}
+						goto l673
+					l672:
+						position, tokenIndex = position672, tokenIndex672
+					}
+				l673:
+					{
+						add(ruleAction71, position)
+					}
+					add(ruleWorldParams, position655)
+				}
+				if !_rules[ruleTree]() {
+					goto l652
+				}
+			l678:
+				{
+					position679, tokenIndex679 := position, tokenIndex
+					if !_rules[ruleRelObject]() {
+						goto l679
+					}
+					goto l678
+				l679:
+					position, tokenIndex = position679, tokenIndex679
+				}
+			l680:
+				{
+					position681, tokenIndex681 := position, tokenIndex
+					if !_rules[ruleNodeObject]() {
+						goto l681
+					}
+					goto l680
+				l681:
+					position, tokenIndex = position681, tokenIndex681
+				}
+			l682:
+				{
+					position683, tokenIndex683 := position, tokenIndex
+					{
+						position684 := position
+						{
+							position685 := position
+							if !_rules[ruleDeploy]() {
+								goto l683
+							}
+							if !_rules[ruleIdentifier]() {
+								goto l683
+							}
+							if !_rules[ruleTO]() {
+								goto l683
+							}
+							if !_rules[ruleSecondIdentifier]() {
+								goto l683
+							}
+							add(rulePegText, position685)
+						}
+						{
+							add(ruleAction18, position)
+						}
+						add(ruleDeployObject, position684)
+					}
+					goto l682
+				l683:
+					position, tokenIndex = position683, tokenIndex683
+				}
+			l687:
+				{
+					position688, tokenIndex688 := position, tokenIndex
+					if !_rules[ruleScenarioObject]() {
+						goto l688
+					}
+					goto l687
+				l688:
+					position, tokenIndex = position688, tokenIndex688
+				}
+			l689:
+				{
+					position690, tokenIndex690 := position, tokenIndex
+					if !_rules[ruleStepObject]() {
+						goto l690
+					}
+					goto l689
+				l690:
+					position, tokenIndex = position690, tokenIndex690
+				}
+			l691:
+				{
+					position692, tokenIndex692 := position, tokenIndex
+					if !_rules[ruleViewObject]() {
+						goto l692
+					}
+					goto l691
+				l692:
+					position, tokenIndex = position692, tokenIndex692
+				}
+			l693:
+				{
+					position694, tokenIndex694 := position, tokenIndex
+					if !_rules[rulePinObject]() {
+						goto l694
+					}
+					goto l693
+				l694:
+					position, tokenIndex = position694, tokenIndex694
+				}
+			l695:
+				{
+					position696, tokenIndex696 := position, tokenIndex
+					if !_rules[ruleStyleObject]() {
+						goto l696
+					}
+					goto l695
+				l696:
+					position, tokenIndex = position696, tokenIndex696
+				}
+				{
+					position697 := position
+					if !_rules[rule_]() {
+						goto l652
+					}
+					{
+						position698 := position
+						if buffer[position] != rune('e') {
+							goto l652
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l652
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l652
+						}
+						position++
+						if buffer[position] != rune('w') {
+							goto l652
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l652
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l652
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l652
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l652
+						}
+						position++
+						if !_rules[rule_]() {
+							goto l652
+						}
+						add(ruleENDWORLD, position698)
+					}
+					if !_rules[ruleDELIMITER]() {
+						goto l652
+					}
+					if !_rules[rule_]() {
+						goto l652
+					}
+					add(ruleEndWorld, position697)
+				}
+				{
+					add(ruleAction14, position)
+				}
+				add(ruleWorldObject, position653)
+			}
+			return true
+		l652:
+			position, tokenIndex = position652, tokenIndex652
+			return false
+		},
+		/* 16 ItemObject <- <(<(Item Identifier ItemParams?)> Action15)> */
+		func() bool {
+			position700, tokenIndex700 := position, tokenIndex
+			{
+				position701 := position
+				{
+					position702 := position
+					if !_rules[ruleItem]() {
+						goto l700
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l700
+					}
+					{
+						position703, tokenIndex703 := position, tokenIndex
+						if !_rules[ruleItemParams]() {
+							goto l703
+						}
+						goto l704
+					l703:
+						position, tokenIndex = position703, tokenIndex703
+					}
+				l704:
+					add(rulePegText, position702)
+				}
+				{
+					add(ruleAction15, position)
+				}
+				add(ruleItemObject, position701)
+			}
+			return true
+		l700:
+			position, tokenIndex = position700, tokenIndex700
+			return false
+		},
+		/* 17 RelObject <- <(<(Rel DualIdentifier RelParams?)> Action16)> */
+		func() bool {
+			position706, tokenIndex706 := position, tokenIndex
+			{
+				position707 := position
+				{
+					position708 := position
+					if !_rules[ruleRel]() {
+						goto l706
+					}
+					if !_rules[ruleDualIdentifier]() {
+						goto l706
+					}
+					{
+						position709, tokenIndex709 := position, tokenIndex
+						if !_rules[ruleRelParams]() {
+							goto l709
+						}
+						goto l710
+					l709:
+						position, tokenIndex = position709, tokenIndex709
+					}
+				l710:
+					add(rulePegText, position708)
+				}
+				{
+					add(ruleAction16, position)
+				}
+				add(ruleRelObject, position707)
+			}
+			return true
+		l706:
+			position, tokenIndex = position706, tokenIndex706
+			return false
+		},
+		/* 18 NodeObject <- <(<(Node Identifier NodeParams?)> Action17)> */
+		func() bool {
+			position712, tokenIndex712 := position, tokenIndex
+			{
+				position713 := position
+				{
+					position714 := position
+					if !_rules[ruleNode]() {
+						goto l712
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l712
+					}
+					{
+						position715, tokenIndex715 := position, tokenIndex
+						if !_rules[ruleNodeParams]() {
+							goto l715
+						}
+						goto l716
+					l715:
+						position, tokenIndex = position715, tokenIndex715
+					}
+				l716:
+					add(rulePegText, position714)
+				}
+				{
+					add(ruleAction17, position)
+				}
+				add(ruleNodeObject, position713)
+			}
+			return true
+		l712:
+			position, tokenIndex = position712, tokenIndex712
+			return false
+		},
+		/* 19 DeployObject <- <(<(Deploy Identifier TO SecondIdentifier)> Action18)> */
+		nil,
+		/* 20 ScenarioObject <- <(<(Scenario Identifier ScenarioParams?)> Action19)> */
+		func() bool {
+			position719, tokenIndex719 := position, tokenIndex
+			{
+				position720 := position
+				{
+					position721 := position
+					if !_rules[ruleScenario]() {
+						goto l719
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l719
+					}
+					{
+						position722, tokenIndex722 := position, tokenIndex
+						if !_rules[ruleScenarioParams]() {
+							goto l722
+						}
+						goto l723
+					l722:
+						position, tokenIndex = position722, tokenIndex722
+					}
+				l723:
+					add(rulePegText, position721)
+				}
+				{
+					add(ruleAction19, position)
+				}
+				add(ruleScenarioObject, position720)
+			}
+			return true
+		l719:
+			position, tokenIndex = position719, tokenIndex719
+			return false
+		},
+		/* 21 StepObject <- <(<(Step Identifier SecondIdentifier SecondIdentifier StepParams?)> Action20)> */
+		func() bool {
+			position725, tokenIndex725 := position, tokenIndex
+			{
+				position726 := position
+				{
+					position727 := position
+					if !_rules[ruleStep]() {
+						goto l725
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l725
+					}
+					if !_rules[ruleSecondIdentifier]() {
+						goto l725
+					}
+					if !_rules[ruleSecondIdentifier]() {
+						goto l725
+					}
+					{
+						position728, tokenIndex728 := position, tokenIndex
+						if !_rules[ruleStepParams]() {
+							goto l728
+						}
+						goto l729
+					l728:
+						position, tokenIndex = position728, tokenIndex728
+					}
+				l729:
+					add(rulePegText, position727)
+				}
+				{
+					add(ruleAction20, position)
+				}
+				add(ruleStepObject, position726)
+			}
+			return true
+		l725:
+			position, tokenIndex = position725, tokenIndex725
+			return false
+		},
+		/* 22 ItemDetailObject <- <(BeginDetail DetailItem DetailParent? DetailComponents DetailRel* EndDetail Action21)> */
+		nil,
+		/* 23 ChangeSetObject <- <(BeginChanges ChangeMatched? Change* EndChanges Action22)> */
+		nil,
+		/* 24 DataFlowObject <- <(BeginDataFlow FlowClass FlowStep* EndDataFlow Action23)> */
+		nil,
+		/* 25 IdentifierListObject <- <(NotStatement IdentifierList Action24)> */
+		nil,
+		/* 26 Tree <- <(<('t' 'r' 'e' 'e' '{' (Nil / ItemObject) (':' ':' '[') Tree* (']' '}'))> _ Action25)> */
+		func() bool {
+			position735, tokenIndex735 := position, tokenIndex
+			{
+				position736 := position
+				{
+					position737 := position
+					if buffer[position] != rune('t') {
+						goto l735
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l735
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l735
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l735
+					}
+					position++
+					if buffer[position] != rune('{') {
+						goto l735
+					}
+					position++
+					{
+						position738, tokenIndex738 := position, tokenIndex
+						{
+							position740 := position
+							if buffer[position] != rune('n') {
+								goto l739
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l739
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l739
+							}
+							position++
+							{
+								add(ruleAction26, position)
+							}
+							add(ruleNil, position740)
+						}
+						goto l738
+					l739:
+						position, tokenIndex = position738, tokenIndex738
+						if !_rules[ruleItemObject]() {
+							goto l735
+						}
+					}
+				l738:
+					if buffer[position] != rune(':') {
+						goto l735
+					}
+					position++
+					if buffer[position] != rune(':') {
+						goto l735
+					}
+					position++
+					if buffer[position] != rune('[') {
+						goto l735
+					}
+					position++
+				l742:
+					{
+						position743, tokenIndex743 := position, tokenIndex
+						if !_rules[ruleTree]() {
+							goto l743
+						}
+						goto l742
+					l743:
+						position, tokenIndex = position743, tokenIndex743
+					}
+					if buffer[position] != rune(']') {
+						goto l735
+					}
+					position++
+					if buffer[position] != rune('}') {
+						goto l735
+					}
+					position++
+					add(rulePegText, position737)
+				}
+				if !_rules[rule_]() {
+					goto l735
+				}
+				{
+					add(ruleAction25, position)
+				}
+				add(ruleTree, position736)
+			}
+			return true
+		l735:
+			position, tokenIndex = position735, tokenIndex735
+			return false
+		},
+		/* 27 Nil <- <('n' 'i' 'l' Action26)> */
+		nil,
+		/* 28 StatusObject <- <(ErrCode (ERROR / OK) StatusMessage StatusSuggestions? Action27)> */
+		func() bool {
+			position746, tokenIndex746 := position, tokenIndex
+			{
+				position747 := position
+				{
+					position748 := position
+					{
+						position749 := position
+						if !_rules[ruleNumber]() {
+							goto l746
+						}
+						add(rulePegText, position749)
+					}
+					{
+						add(ruleAction57, position)
+					}
+					add(ruleErrCode, position748)
+				}
+				{
+					position751, tokenIndex751 := position, tokenIndex
+					{
+						position753 := position
+						if buffer[position] != rune('e') {
+							goto l752
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l752
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l752
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l752
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l752
+						}
+						position++
+						if !_rules[rule_]() {
+							goto l752
+						}
+						add(ruleERROR, position753)
+					}
+					goto l751
+				l752:
+					position, tokenIndex = position751, tokenIndex751
+					{
+						position754 := position
+						if buffer[position] != rune('o') {
+							goto l746
+						}
+						position++
+						if buffer[position] != rune('k') {
+							goto l746
+						}
+						position++
+						if !_rules[rule_]() {
+							goto l746
+						}
+						add(ruleOK, position754)
+					}
+				}
+			l751:
+				{
+					position755 := position
+					{
+						position756 := position
+					l757:
+						{
+							position758, tokenIndex758 := position, tokenIndex
+							{
+								position759, tokenIndex759 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('g') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('g') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l759
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l759
+								}
+								position++
+								goto l758
+							l759:
+								position, tokenIndex = position759, tokenIndex759
+							}
+							if !_rules[ruleStringLike]() {
+								goto l758
+							}
+							goto l757
+						l758:
+							position, tokenIndex = position758, tokenIndex758
+						}
+						add(rulePegText, position756)
+					}
+					{
+						add(ruleAction28, position)
+					}
+					add(ruleStatusMessage, position755)
+				}
+				{
+					position761, tokenIndex761 := position, tokenIndex
+					{
+						position763 := position
+						if buffer[position] != rune('s') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('=') {
+							goto l761
+						}
+						position++
+						{
+							position764 := position
+							{
+								position765 := position
+								if !_rules[ruleStringLike]() {
+									goto l761
+								}
+								add(rulePegText, position765)
+							}
+							{
+								add(ruleAction29, position)
+							}
+							add(ruleStatusMissing, position764)
+						}
+						if buffer[position] != rune(':') {
+							goto l761
+						}
+						position++
+						if buffer[position] != rune('[') {
+							goto l761
+						}
+						position++
+						if !_rules[rule_]() {
+							goto l761
+						}
+					l767:
+						{
+							position768, tokenIndex768 := position, tokenIndex
+							{
+								position769 := position
+								{
+									position770 := position
+									if !_rules[ruleStringLike]() {
+										goto l768
+									}
+									add(rulePegText, position770)
+								}
+								{
+									add(ruleAction30, position)
+								}
+								add(ruleStatusSuggestion, position769)
+							}
+							goto l767
+						l768:
+							position, tokenIndex = position768, tokenIndex768
+						}
+						if buffer[position] != rune(']') {
+							goto l761
+						}
+						position++
+						if !_rules[rule_]() {
+							goto l761
+						}
+						add(ruleStatusSuggestions, position763)
+					}
+					goto l762
+				l761:
+					position, tokenIndex = position761, tokenIndex761
+				}
+			l762:
+				{
+					add(ruleAction27, position)
+				}
+				add(ruleStatusObject, position747)
+			}
+			return true
+		l746:
+			position, tokenIndex = position746, tokenIndex746
+			return false
+		},
+		/* 29 StatusMessage <- <(<(!('s' 'u' 'g' 'g' 'e' 's' 't' 'i' 'o' 'n' 's' '=') StringLike)*> Action28)> */
+		nil,
+		/* 30 StatusSuggestions <- <('s' 'u' 'g' 'g' 'e' 's' 't' 'i' 'o' 'n' 's' '=' StatusMissing (':' '[') _ StatusSuggestion* ']' _)> */
+		nil,
+		/* 31 StatusMissing <- <(<StringLike> Action29)> */
+		nil,
+		/* 32 StatusSuggestion <- <(<StringLike> Action30)> */
+		nil,
+		/* 33 DetailItem <- <(<(Item Identifier ItemParams?)> Action31)> */
+		nil,
+		/* 34 ViewObject <- <(<(View Identifier ViewParams?)> Action32)> */
+		func() bool {
+			position778, tokenIndex778 := position, tokenIndex
+			{
+				position779 := position
+				{
+					position780 := position
+					if !_rules[ruleView]() {
+						goto l778
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l778
+					}
+					{
+						position781, tokenIndex781 := position, tokenIndex
+						if !_rules[ruleViewParams]() {
+							goto l781
+						}
+						goto l782
+					l781:
+						position, tokenIndex = position781, tokenIndex781
+					}
+				l782:
+					add(rulePegText, position780)
+				}
+				{
+					add(ruleAction32, position)
+				}
+				add(ruleViewObject, position779)
+			}
+			return true
+		l778:
+			position, tokenIndex = position778, tokenIndex778
+			return false
+		},
+		/* 35 PinObject <- <(<(Pin PinTarget PinParams)> Action33)> */
+		func() bool {
+			position784, tokenIndex784 := position, tokenIndex
+			{
+				position785 := position
+				{
+					position786 := position
+					if !_rules[rulePin]() {
+						goto l784
+					}
+					if !_rules[rulePinTarget]() {
+						goto l784
+					}
+					if !_rules[rulePinParams]() {
+						goto l784
+					}
+					add(rulePegText, position786)
+				}
+				{
+					add(ruleAction33, position)
+				}
+				add(rulePinObject, position785)
+			}
+			return true
+		l784:
+			position, tokenIndex = position784, tokenIndex784
+			return false
+		},
+		/* 36 StyleObject <- <(<(Style Identifier StyleParams?)> Action34)> */
+		func() bool {
+			position788, tokenIndex788 := position, tokenIndex
+			{
+				position789 := position
+				{
+					position790 := position
+					if !_rules[ruleStyle]() {
+						goto l788
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l788
+					}
+					{
+						position791, tokenIndex791 := position, tokenIndex
+						if !_rules[ruleStyleParams]() {
+							goto l791
+						}
+						goto l792
+					l791:
+						position, tokenIndex = position791, tokenIndex791
+					}
+				l792:
+					add(rulePegText, position790)
+				}
+				{
+					add(ruleAction34, position)
+				}
+				add(ruleStyleObject, position789)
+			}
+			return true
+		l788:
+			position, tokenIndex = position788, tokenIndex788
+			return false
+		},
+		/* 37 DetailParent <- <('p' 'a' 'r' 'e' 'n' 't' _ <StringLike> Action35)> */
+		nil,
+		/* 38 DetailComponents <- <('c' 'o' 'm' 'p' 'o' 'n' 'e' 'n' 't' 's' _ DetailComponent*)> */
+		nil,
+		/* 39 DetailComponent <- <(!DetailEnd <StringLike> Action36)> */
+		nil,
+		/* 40 DetailRel <- <(('i' 'n' 'b' 'o' 'u' 'n' 'd' _ <(Rel DualIdentifier RelParams?)> Action37) / ('o' 'u' 't' 'b' 'o' 'u' 'n' 'd' _ <(Rel DualIdentifier RelParams?)> Action38))> */
+		nil,
+		/* 41 DetailEnd <- <(((('i' 'n' 'b' 'o' 'u' 'n' 'd') / ('o' 'u' 't' 'b' 'o' 'u' 'n' 'd')) _ Rel) / ('e' 'n' 'd' 'd' 'e' 't' 'a' 'i' 'l' DELIMITER))> */
+		nil,
+		/* 42 ChangeMatched <- <('m' 'a' 't' 'c' 'h' 'e' 'd' _ ChangeMatchedId*)> */
+		nil,
+		/* 43 ChangeMatchedId <- <(!ChangeEnd <StringLike> Action39)> */
+		nil,
+		/* 44 Change <- <((ChangeAction <((Item Identifier ItemParams?) / (Rel DualIdentifier RelParams?))> Action40) / (ChangeAction <(World WorldSetParams)> Action41) / (ChangeAction <((Node Identifier NodeParams?) / (Deploy Identifier TO SecondIdentifier))> Action42) / (ChangeAction <(Scenario Identifier ScenarioParams?)> Action43) / (ChangeAction <(View Identifier ViewParams?)> Action44) / (ChangeAction <(Pin PinTarget PinParams)> Action45) / (ChangeAction <(Style Identifier StyleParams?)> Action46) / (ChangeMoved ('f' 'r' 'o' 'm') _ ChangeFrom ('t' 'o') _ ChangeTo Action47))> */
+		nil,
+		/* 45 ChangeAction <- <(<(('c' 'r' 'e' 'a' 't' 'e' 'd') / ('r' 'e' 'm' 'o' 'v' 'e' 'd') / ('c' 'h' 'a' 'n' 'g' 'e' 'd'))> _ Action48)> */
+		func() bool {
+			position802, tokenIndex802 := position, tokenIndex
+			{
+				position803 := position
+				{
+					position804 := position
+					{
+						position805, tokenIndex805 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l806
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l806
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l806
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l806
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l806
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l806
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l806
+						}
+						position++
+						goto l805
+					l806:
+						position, tokenIndex = position805, tokenIndex805
+						if buffer[position] != rune('r') {
+							goto l807
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l807
+						}
+						position++
+						if buffer[position] != rune('m') {
+							goto l807
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l807
+						}
+						position++
+						if buffer[position] != rune('v') {
+							goto l807
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l807
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l807
+						}
+						position++
+						goto l805
+					l807:
+						position, tokenIndex = position805, tokenIndex805
+						if buffer[position] != rune('c') {
+							goto l802
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l802
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l802
+						}
+						position++
+						if buffer[position] != rune('n') {
+							goto l802
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l802
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l802
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l802
+						}
+						position++
+					}
+				l805:
+					add(rulePegText, position804)
+				}
+				if !_rules[rule_]() {
+					goto l802
+				}
+				{
+					add(ruleAction48, position)
+				}
+				add(ruleChangeAction, position803)
+			}
+			return true
+		l802:
+			position, tokenIndex = position802, tokenIndex802
+			return false
+		},
+		/* 46 ChangeMoved <- <('m' 'o' 'v' 'e' 'd' _ <StringLike> Action49)> */
+		nil,
+		/* 47 ChangeFrom <- <(<StringLike> Action50)> */
+		nil,
+		/* 48 ChangeTo <- <(<StringLike> Action51)> */
+		nil,
+		/* 49 ChangeEnd <- <(((('c' 'r' 'e' 'a' 't' 'e' 'd') / ((&('m') ('m' 'o' 'v' 'e' 'd')) | (&('c') ('c' 'h' 'a' 'n' 'g' 'e' 'd')) | (&('r') ('r' 'e' 'm' 'o' 'v' 'e' 'd')))) _) / ('e' 'n' 'd' 'c' 'h' 'a' 'n' 'g' 'e' 's' DELIMITER))> */
+		nil,
+		/* 50 FlowClass <- <('c' 'l' 'a' 's' 's' _ <StringLike> Action52)> */
+		nil,
+		/* 51 FlowStep <- <(FlowKind FlowId ('v' 'i' 'a' _ FlowPathRel*)? Action53)> */
+		nil,
+		/* 52 FlowKind <- <(<((&('e') ('e' 'x' 't' 'e' 'r' 'n' 'a' 'l')) | (&('r') ('r' 'e' 'a' 'c' 'h' 'e' 'd')) | (&('s') ('s' 'o' 'u' 'r' 'c' 'e')))> _ Action54)> */
+		nil,
+		/* 53 FlowId <- <(<StringLike> Action55)> */
+		nil,
+		/* 54 FlowPathRel <- <(!FlowEnd <StringLike> Action56)> */
+		nil,
+		/* 55 FlowEnd <- <((((&('e') ('e' 'x' 't' 'e' 'r' 'n' 'a' 'l')) | (&('r') ('r' 'e' 'a' 'c' 'h' 'e' 'd')) | (&('s') ('s' 'o' 'u' 'r' 'c' 'e'))) _) / ('e' 'n' 'd' 'd' 'a' 't' 'a' 'f' 'l' 'o' 'w' DELIMITER))> */
+		nil,
+		/* 56 ErrCode <- <(<Number> Action57)> */
+		nil,
+		/* 57 Limit <- <(<Number> Action58)> */
+		func() bool {
+			position820, tokenIndex820 := position, tokenIndex
+			{
+				position821 := position
+				{
+					position822 := position
+					if !_rules[ruleNumber]() {
+						goto l820
+					}
+					add(rulePegText, position822)
+				}
+				{
+					add(ruleAction58, position)
+				}
+				add(ruleLimit, position821)
+			}
+			return true
+		l820:
+			position, tokenIndex = position820, tokenIndex820
+			return false
+		},
+		/* 58 OwnerFilter <- <(OWNER EQUALS <StringLike> Action59)> */
+		nil,
+		/* 59 Identifier <- <(NotFlag <StringLike> Action60)> */
+		func() bool {
+			position825, tokenIndex825 := position, tokenIndex
+			{
+				position826 := position
+				if !_rules[ruleNotFlag]() {
+					goto l825
+				}
+				{
+					position827 := position
+					if !_rules[ruleStringLike]() {
+						goto l825
+					}
+					add(rulePegText, position827)
+				}
+				{
+					add(ruleAction60, position)
+				}
+				add(ruleIdentifier, position826)
+			}
+			return true
+		l825:
+			position, tokenIndex = position825, tokenIndex825
+			return false
+		},
+		/* 60 SecondIdentifier <- <(NotFlag <StringLike> Action61)> */
+		func() bool {
+			position829, tokenIndex829 := position, tokenIndex
+			{
+				position830 := position
+				if !_rules[ruleNotFlag]() {
+					goto l829
+				}
+				{
+					position831 := position
+					if !_rules[ruleStringLike]() {
+						goto l829
+					}
+					add(rulePegText, position831)
+				}
+				{
+					add(ruleAction61, position)
+				}
+				add(ruleSecondIdentifier, position830)
+			}
+			return true
+		l829:
+			position, tokenIndex = position829, tokenIndex829
+			return false
+		},
+		/* 61 Targets <- <(Selector / Target)+> */
+		nil,
+		/* 62 NestTargets <- <(Selector / (NotIn Target))+> */
+		nil,
+		/* 63 Target <- <(NotFlag <StringLike> Action62)> */
+		func() bool {
+			position835, tokenIndex835 := position, tokenIndex
+			{
+				position836 := position
+				if !_rules[ruleNotFlag]() {
+					goto l835
+				}
+				{
+					position837 := position
+					if !_rules[ruleStringLike]() {
+						goto l835
+					}
+					add(rulePegText, position837)
+				}
+				{
+					add(ruleAction62, position)
+				}
+				add(ruleTarget, position836)
+			}
+			return true
+		l835:
+			position, tokenIndex = position835, tokenIndex835
+			return false
+		},
+		/* 64 NestParent <- <(<StringLike> Action63)> */
+		nil,
+		/* 65 Selector <- <(<((&('i') InSelector) | (&('/') RegexSelector) | (&('"') GlobSelector))> Action64)> */
+		func() bool {
+			position840, tokenIndex840 := position, tokenIndex
+			{
+				position841 := position
+				{
+					position842 := position
+					{
+						switch buffer[position] {
+						case 'i':
+							{
+								position844 := position
+								{
+									position845 := position
+									if buffer[position] != rune('i') {
+										goto l840
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l840
+									}
+									position++
+									if buffer[position] != rune(':') {
+										goto l840
+									}
+									position++
+									add(ruleIN_SELECTOR, position845)
+								}
+								{
+									position846 := position
+									if !_rules[ruleStringLike]() {
+										goto l840
+									}
+									add(rulePegText, position846)
+								}
+								{
+									add(ruleAction67, position)
+								}
+								add(ruleInSelector, position844)
+							}
+						case '/':
+							{
+								position848 := position
+								if buffer[position] != rune('/') {
+									goto l840
+								}
+								position++
+								{
+									position849 := position
+									{
+										position852, tokenIndex852 := position, tokenIndex
+										if buffer[position] != rune('/') {
+											goto l852
+										}
+										position++
+										goto l840
+									l852:
+										position, tokenIndex = position852, tokenIndex852
+									}
+									if !matchDot() {
+										goto l840
+									}
+								l850:
+									{
+										position851, tokenIndex851 := position, tokenIndex
+										{
+											position853, tokenIndex853 := position, tokenIndex
+											if buffer[position] != rune('/') {
+												goto l853
+											}
+											position++
+											goto l851
+										l853:
+											position, tokenIndex = position853, tokenIndex853
+										}
+										if !matchDot() {
+											goto l851
+										}
+										goto l850
+									l851:
+										position, tokenIndex = position851, tokenIndex851
+									}
+									add(rulePegText, position849)
+								}
+								if buffer[position] != rune('/') {
+									goto l840
+								}
+								position++
+								if !_rules[rule_]() {
+									goto l840
+								}
+								{
+									add(ruleAction66, position)
+								}
+								add(ruleRegexSelector, position848)
+							}
+						default:
+							{
+								position855 := position
+								if !_rules[ruleQUOTE]() {
+									goto l840
+								}
+								{
+									position856 := position
+								l857:
+									{
+										position858, tokenIndex858 := position, tokenIndex
+										if !_rules[ruleGlobChar]() {
+											goto l858
+										}
+										goto l857
+									l858:
+										position, tokenIndex = position858, tokenIndex858
+									}
+									{
+										position859, tokenIndex859 := position, tokenIndex
+										if buffer[position] != rune('*') {
+											goto l860
+										}
+										position++
+										goto l859
+									l860:
+										position, tokenIndex = position859, tokenIndex859
+										if buffer[position] != rune('?') {
+											goto l840
+										}
+										position++
+									}
+								l859:
+								l861:
+									{
+										position862, tokenIndex862 := position, tokenIndex
+										{
+											position863, tokenIndex863 := position, tokenIndex
+											if !_rules[ruleGlobChar]() {
+												goto l864
+											}
+											goto l863
+										l864:
+											position, tokenIndex = position863, tokenIndex863
+											{
+												position865, tokenIndex865 := position, tokenIndex
+												if buffer[position] != rune('*') {
+													goto l866
+												}
+												position++
+												goto l865
+											l866:
+												position, tokenIndex = position865, tokenIndex865
+												if buffer[position] != rune('?') {
+													goto l862
+												}
+												position++
+											}
+										l865:
+										}
+									l863:
+										goto l861
+									l862:
+										position, tokenIndex = position862, tokenIndex862
+									}
+									add(rulePegText, position856)
+								}
+								if !_rules[ruleQUOTE]() {
+									goto l840
+								}
+								if !_rules[rule_]() {
+									goto l840
+								}
+								{
+									add(ruleAction65, position)
+								}
+								add(ruleGlobSelector, position855)
+							}
+						}
+					}
+
+					add(rulePegText, position842)
+				}
+				{
+					add(ruleAction64, position)
+				}
+				add(ruleSelector, position841)
+			}
+			return true
+		l840:
+			position, tokenIndex = position840, tokenIndex840
+			return false
+		},
+		/* 66 GlobSelector <- <(QUOTE <(GlobChar* ('*' / '?') (GlobChar / ('*' / '?'))*)> QUOTE _ Action65)> */
+		nil,
+		/* 67 GlobChar <- <((&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		func() bool {
+			position870, tokenIndex870 := position, tokenIndex
+			{
+				position871 := position
+				{
+					switch buffer[position] {
+					case '.':
+						if buffer[position] != rune('.') {
+							goto l870
+						}
+						position++
+					case '_':
+						if buffer[position] != rune('_') {
+							goto l870
+						}
+						position++
+					case '-':
+						if buffer[position] != rune('-') {
+							goto l870
+						}
+						position++
+					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l870
+						}
+						position++
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l870
+						}
+						position++
+					default:
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l870
+						}
+						position++
+					}
+				}
+
+				add(ruleGlobChar, position871)
+			}
+			return true
+		l870:
+			position, tokenIndex = position870, tokenIndex870
+			return false
+		},
+		/* 68 RegexSelector <- <('/' <(!'/' .)+> '/' _ Action66)> */
+		nil,
+		/* 69 InSelector <- <(IN_SELECTOR <StringLike> Action67)> */
+		nil,
+		/* 70 Assignment <- <(AssignmentKey '=' AssignmentValue)> */
+		nil,
+		/* 71 AssignmentKey <- <(<(Text / QuotedText)> Action68)> */
+		nil,
+		/* 72 AssignmentValue <- <(<StringLike> Action69)> */
+		nil,
+		/* 73 DualIdentifier <- <(Identifier SecondIdentifier)> */
+		func() bool {
+			position878, tokenIndex878 := position, tokenIndex
+			{
+				position879 := position
+				if !_rules[ruleIdentifier]() {
+					goto l878
+				}
+				if !_rules[ruleSecondIdentifier]() {
+					goto l878
+				}
+				add(ruleDualIdentifier, position879)
+			}
+			return true
+		l878:
+			position, tokenIndex = position878, tokenIndex878
+			return false
+		},
+		/* 74 PinTarget <- <((Identifier SecondIdentifier &PinParam) / Identifier)> */
+		func() bool {
+			position880, tokenIndex880 := position, tokenIndex
+			{
+				position881 := position
+				{
+					position882, tokenIndex882 := position, tokenIndex
+					if !_rules[ruleIdentifier]() {
+						goto l883
+					}
+					if !_rules[ruleSecondIdentifier]() {
+						goto l883
+					}
+					{
+						position884, tokenIndex884 := position, tokenIndex
+						if !_rules[rulePinParam]() {
+							goto l883
+						}
+						position, tokenIndex = position884, tokenIndex884
+					}
+					goto l882
+				l883:
+					position, tokenIndex = position882, tokenIndex882
+					if !_rules[ruleIdentifier]() {
+						goto l880
+					}
+				}
+			l882:
+				add(rulePinTarget, position881)
+			}
+			return true
+		l880:
+			position, tokenIndex = position880, tokenIndex880
+			return false
+		},
+		/* 75 IdentifierList <- <(<(Identifier Identifier*)> Action70)> */
+		nil,
+		/* 76 WorldParams <- <(_ WorldParamVersion _ WorldParamId _ WorldParamName _ WorldParamExpanded _ (WorldParamTheme _)? Action71)> */
+		nil,
+		/* 77 ItemParams <- <ItemParam+> */
+		func() bool {
+			position887, tokenIndex887 := position, tokenIndex
+			{
+				position888 := position
+				{
+					position891 := position
+					{
+						position892, tokenIndex892 := position, tokenIndex
+						if !_rules[ruleEXTERNAL]() {
+							goto l893
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l893
+						}
+						{
+							position894 := position
+							if !_rules[ruleBoolean]() {
+								goto l893
+							}
+							add(rulePegText, position894)
+						}
+						{
+							add(ruleAction82, position)
+						}
+						goto l892
+					l893:
+						position, tokenIndex = position892, tokenIndex892
+						if !_rules[ruleTYPE]() {
+							goto l896
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l896
+						}
+						{
+							position897 := position
+							{
+								position898 := position
+								{
+									position899, tokenIndex899 := position, tokenIndex
+									{
+										position901 := position
+										if buffer[position] != rune('d') {
+											goto l900
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l900
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l900
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l900
+										}
+										position++
+										if buffer[position] != rune('b') {
+											goto l900
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l900
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l900
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l900
+										}
+										position++
+										if !_rules[rule_]() {
+											goto l900
+										}
+										add(ruleDATABASE, position901)
+									}
+									goto l899
+								l900:
+									position, tokenIndex = position899, tokenIndex899
+									{
+										position903 := position
+										if buffer[position] != rune('b') {
+											goto l902
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l902
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l902
+										}
+										position++
+										if buffer[position] != rune('b') {
+											goto l902
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l902
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l902
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l902
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l902
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l902
+										}
+										position++
+										if !_rules[rule_]() {
+											goto l902
+										}
+										add(ruleBLOBSTORE, position903)
+									}
+									goto l899
+								l902:
+									position, tokenIndex = position899, tokenIndex899
+									{
+										switch buffer[position] {
+										case 'c':
+											{
+												position905 := position
+												if buffer[position] != rune('c') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('d') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l896
+												}
+												add(ruleCODE, position905)
+											}
+										case 'd':
+											{
+												position906 := position
+												if buffer[position] != rune('d') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('v') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('i') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('c') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l896
+												}
+												add(ruleDEVICE, position906)
+											}
+										case 's':
+											{
+												position907 := position
+												if buffer[position] != rune('s') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('v') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l896
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l896
+												}
+												add(ruleSERVER, position907)
+											}
+										case 'm':
+											{
+												position908 := position
+												if buffer[position] != rune('m') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('b') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('i') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('l') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l896
+												}
+												add(ruleMOBILE, position908)
+											}
+										case 'b':
+											{
+												position909 := position
+												if buffer[position] != rune('b') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('w') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l896
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l896
+												}
+												add(ruleBROWSER, position909)
+											}
+										case 'q':
+											{
+												position910 := position
+												if buffer[position] != rune('q') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('u') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('u') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l896
+												}
+												add(ruleQUEUE, position910)
+											}
+										default:
+											{
+												position911 := position
+												if buffer[position] != rune('p') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l896
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l896
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l896
+												}
+												add(rulePERSON, position911)
+											}
+										}
+									}
+
+								}
+							l899:
+								add(ruleItemType, position898)
+							}
+							add(rulePegText, position897)
+						}
+						{
+							add(ruleAction83, position)
+						}
+						goto l892
+					l896:
+						position, tokenIndex = position892, tokenIndex892
+						if !_rules[ruleSTATUS]() {
+							goto l913
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l913
+						}
+						{
+							position914 := position
+							if !_rules[ruleLifecycleStatus]() {
+								goto l913
+							}
+							add(rulePegText, position914)
+						}
+						{
+							add(ruleAction87, position)
+						}
+						goto l892
+					l913:
+						position, tokenIndex = position892, tokenIndex892
+						if !_rules[ruleARCHIVED]() {
+							goto l916
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l916
+						}
+						{
+							position917 := position
+							if !_rules[ruleBoolean]() {
+								goto l916
+							}
+							add(rulePegText, position917)
+						}
+						{
+							add(ruleAction88, position)
+						}
+						goto l892
+					l916:
+						position, tokenIndex = position892, tokenIndex892
+						if !_rules[ruleCONTACTS]() {
+							goto l919
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l919
+						}
+						{
+							position920 := position
+							if !_rules[ruleStringLike]() {
+								goto l919
+							}
+							add(rulePegText, position920)
+						}
+						{
+							add(ruleAction90, position)
+						}
+						goto l892
+					l919:
+						position, tokenIndex = position892, tokenIndex892
+						{
+							switch buffer[position] {
+							case 't':
+								if !_rules[ruleTAGS]() {
+									goto l887
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l887
+								}
+								{
+									position923 := position
+									if !_rules[ruleStringLike]() {
+										goto l887
+									}
+									add(rulePegText, position923)
+								}
+								{
+									add(ruleAction94, position)
+								}
+							case 'b':
+								if !_rules[ruleBOUNDARY]() {
+									goto l887
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l887
+								}
+								{
+									position925 := position
+									if !_rules[ruleStringLike]() {
+										goto l887
+									}
+									add(rulePegText, position925)
+								}
+								{
+									add(ruleAction93, position)
+								}
+							case 'c':
+								if !_rules[ruleCLASSIFICATION]() {
+									goto l887
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l887
+								}
+								{
+									position927 := position
+									if !_rules[ruleStringLike]() {
+										goto l887
+									}
+									add(rulePegText, position927)
+								}
+								{
+									add(ruleAction92, position)
+								}
+							case 's':
+								if !_rules[ruleSOURCE]() {
+									goto l887
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l887
+								}
+								{
+									position929 := position
+									if !_rules[ruleStringLike]() {
+										goto l887
+									}
+									add(rulePegText, position929)
+								}
+								{
+									add(ruleAction91, position)
+								}
+							case 'o':
+								if !_rules[ruleOWNER]() {
+									goto l887
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l887
+								}
+								{
+									position931 := position
+									if !_rules[ruleStringLike]() {
+										goto l887
+									}
+									add(rulePegText, position931)
+								}
+								{
+									add(ruleAction89, position)
+								}
+							case 'e':
+								if !_rules[ruleEXPANDED]() {
+									goto l887
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l887
+								}
+								{
+									position933 := position
+									if !_rules[ruleStringLike]() {
+										goto l887
+									}
+									add(rulePegText, position933)
+								}
+								{
+									add(ruleAction86, position)
+								}
+							case 'm':
+								if !_rules[ruleMECHANISM]() {
+									goto l887
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l887
+								}
+								{
+									position935 := position
+									if !_rules[ruleStringLike]() {
+										goto l887
+									}
+									add(rulePegText, position935)
+								}
+								{
+									add(ruleAction85, position)
+								}
+							case 'n':
+								if !_rules[ruleNAME]() {
+									goto l887
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l887
+								}
+								{
+									position937 := position
+									if !_rules[ruleStringLike]() {
+										goto l887
+									}
+									add(rulePegText, position937)
+								}
+								{
+									add(ruleAction84, position)
+								}
+							default:
+								if !_rules[ruleLinkParam]() {
+									goto l887
+								}
+							}
+						}
+
+					}
+				l892:
+					add(ruleItemParam, position891)
+				}
+			l889:
+				{
+					position890, tokenIndex890 := position, tokenIndex
+					{
+						position939 := position
+						{
+							position940, tokenIndex940 := position, tokenIndex
+							if !_rules[ruleEXTERNAL]() {
+								goto l941
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l941
+							}
+							{
+								position942 := position
+								if !_rules[ruleBoolean]() {
+									goto l941
+								}
+								add(rulePegText, position942)
+							}
+							{
+								add(ruleAction82, position)
+							}
+							goto l940
+						l941:
+							position, tokenIndex = position940, tokenIndex940
+							if !_rules[ruleTYPE]() {
+								goto l944
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l944
+							}
+							{
+								position945 := position
+								{
+									position946 := position
+									{
+										position947, tokenIndex947 := position, tokenIndex
+										{
+											position949 := position
+											if buffer[position] != rune('d') {
+												goto l948
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l948
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l948
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l948
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l948
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l948
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l948
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l948
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l948
+											}
+											add(ruleDATABASE, position949)
+										}
+										goto l947
+									l948:
+										position, tokenIndex = position947, tokenIndex947
+										{
+											position951 := position
+											if buffer[position] != rune('b') {
+												goto l950
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l950
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l950
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l950
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l950
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l950
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l950
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l950
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l950
+											}
+											position++
+											if !_rules[rule_]() {
+												goto l950
+											}
+											add(ruleBLOBSTORE, position951)
+										}
+										goto l947
+									l950:
+										position, tokenIndex = position947, tokenIndex947
+										{
+											switch buffer[position] {
+											case 'c':
+												{
+													position953 := position
+													if buffer[position] != rune('c') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('d') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l944
+													}
+													add(ruleCODE, position953)
+												}
+											case 'd':
+												{
+													position954 := position
+													if buffer[position] != rune('d') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('v') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('i') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('c') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l944
+													}
+													add(ruleDEVICE, position954)
+												}
+											case 's':
+												{
+													position955 := position
+													if buffer[position] != rune('s') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('v') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l944
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l944
+													}
+													add(ruleSERVER, position955)
+												}
+											case 'm':
+												{
+													position956 := position
+													if buffer[position] != rune('m') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('b') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('i') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('l') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l944
+													}
+													add(ruleMOBILE, position956)
+												}
+											case 'b':
+												{
+													position957 := position
+													if buffer[position] != rune('b') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('w') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l944
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l944
+													}
+													add(ruleBROWSER, position957)
+												}
+											case 'q':
+												{
+													position958 := position
+													if buffer[position] != rune('q') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('u') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('u') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l944
+													}
+													add(ruleQUEUE, position958)
+												}
+											default:
+												{
+													position959 := position
+													if buffer[position] != rune('p') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l944
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l944
+													}
+													add(rulePERSON, position959)
+												}
+											}
+										}
+
+									}
+								l947:
+									add(ruleItemType, position946)
+								}
+								add(rulePegText, position945)
+							}
+							{
+								add(ruleAction83, position)
+							}
+							goto l940
+						l944:
+							position, tokenIndex = position940, tokenIndex940
+							if !_rules[ruleSTATUS]() {
+								goto l961
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l961
+							}
+							{
+								position962 := position
+								if !_rules[ruleLifecycleStatus]() {
+									goto l961
+								}
+								add(rulePegText, position962)
+							}
+							{
+								add(ruleAction87, position)
+							}
+							goto l940
+						l961:
+							position, tokenIndex = position940, tokenIndex940
+							if !_rules[ruleARCHIVED]() {
+								goto l964
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l964
+							}
+							{
+								position965 := position
+								if !_rules[ruleBoolean]() {
+									goto l964
+								}
+								add(rulePegText, position965)
+							}
+							{
+								add(ruleAction88, position)
+							}
+							goto l940
+						l964:
+							position, tokenIndex = position940, tokenIndex940
+							if !_rules[ruleCONTACTS]() {
+								goto l967
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l967
+							}
+							{
+								position968 := position
+								if !_rules[ruleStringLike]() {
+									goto l967
+								}
+								add(rulePegText, position968)
+							}
+							{
+								add(ruleAction90, position)
+							}
+							goto l940
+						l967:
+							position, tokenIndex = position940, tokenIndex940
+							{
+								switch buffer[position] {
+								case 't':
+									if !_rules[ruleTAGS]() {
+										goto l890
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l890
+									}
+									{
+										position971 := position
+										if !_rules[ruleStringLike]() {
+											goto l890
+										}
+										add(rulePegText, position971)
+									}
+									{
+										add(ruleAction94, position)
+									}
+								case 'b':
+									if !_rules[ruleBOUNDARY]() {
+										goto l890
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l890
+									}
+									{
+										position973 := position
+										if !_rules[ruleStringLike]() {
+											goto l890
+										}
+										add(rulePegText, position973)
+									}
+									{
+										add(ruleAction93, position)
+									}
+								case 'c':
+									if !_rules[ruleCLASSIFICATION]() {
+										goto l890
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l890
+									}
+									{
+										position975 := position
+										if !_rules[ruleStringLike]() {
+											goto l890
+										}
+										add(rulePegText, position975)
+									}
+									{
+										add(ruleAction92, position)
+									}
+								case 's':
+									if !_rules[ruleSOURCE]() {
+										goto l890
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l890
+									}
+									{
+										position977 := position
+										if !_rules[ruleStringLike]() {
+											goto l890
+										}
+										add(rulePegText, position977)
+									}
+									{
+										add(ruleAction91, position)
+									}
+								case 'o':
+									if !_rules[ruleOWNER]() {
+										goto l890
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l890
+									}
+									{
+										position979 := position
+										if !_rules[ruleStringLike]() {
+											goto l890
+										}
+										add(rulePegText, position979)
+									}
+									{
+										add(ruleAction89, position)
+									}
+								case 'e':
+									if !_rules[ruleEXPANDED]() {
+										goto l890
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l890
+									}
+									{
+										position981 := position
+										if !_rules[ruleStringLike]() {
+											goto l890
+										}
+										add(rulePegText, position981)
+									}
+									{
+										add(ruleAction86, position)
+									}
+								case 'm':
+									if !_rules[ruleMECHANISM]() {
+										goto l890
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l890
+									}
+									{
+										position983 := position
+										if !_rules[ruleStringLike]() {
+											goto l890
+										}
+										add(rulePegText, position983)
+									}
+									{
+										add(ruleAction85, position)
+									}
+								case 'n':
+									if !_rules[ruleNAME]() {
+										goto l890
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l890
+									}
+									{
+										position985 := position
+										if !_rules[ruleStringLike]() {
+											goto l890
+										}
+										add(rulePegText, position985)
+									}
+									{
+										add(ruleAction84, position)
+									}
+								default:
+									if !_rules[ruleLinkParam]() {
+										goto l890
+									}
+								}
+							}
+
+						}
+					l940:
+						add(ruleItemParam, position939)
 					}
-					goto l2
-				l129:
-					position, tokenIndex = position2, tokenIndex2
+					goto l889
+				l890:
+					position, tokenIndex = position890, tokenIndex890
+				}
+				add(ruleItemParams, position888)
+			}
+			return true
+		l887:
+			position, tokenIndex = position887, tokenIndex887
+			return false
+		},
+		/* 78 RelParams <- <RelParam+> */
+		func() bool {
+			position987, tokenIndex987 := position, tokenIndex
+			{
+				position988 := position
+				{
+					position991 := position
 					{
-						switch buffer[position] {
-						case 't':
-							if !_rules[ruleTree]() {
-								goto l0
+						position992, tokenIndex992 := position, tokenIndex
+						if !_rules[ruleASYNC]() {
+							goto l993
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l993
+						}
+						{
+							position994 := position
+							if !_rules[ruleBoolean]() {
+								goto l993
 							}
-						case '\t', '\n', '\r', ' ', '$':
-							if !_rules[ruleWorldObject]() {
-								goto l0
+							add(rulePegText, position994)
+						}
+						{
+							add(ruleAction97, position)
+						}
+						goto l992
+					l993:
+						position, tokenIndex = position992, tokenIndex992
+						{
+							switch buffer[position] {
+							case 'c':
+								if !_rules[ruleCLASSIFICATION]() {
+									goto l987
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l987
+								}
+								{
+									position997 := position
+									if !_rules[ruleStringLike]() {
+										goto l987
+									}
+									add(rulePegText, position997)
+								}
+								{
+									add(ruleAction100, position)
+								}
+							case 's':
+								if !_rules[ruleSTATUS]() {
+									goto l987
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l987
+								}
+								{
+									position999 := position
+									if !_rules[ruleLifecycleStatus]() {
+										goto l987
+									}
+									add(rulePegText, position999)
+								}
+								{
+									add(ruleAction99, position)
+								}
+							case 'e':
+								if !_rules[ruleEXPANDED]() {
+									goto l987
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l987
+								}
+								{
+									position1001 := position
+									if !_rules[ruleStringLike]() {
+										goto l987
+									}
+									add(rulePegText, position1001)
+								}
+								{
+									add(ruleAction98, position)
+								}
+							case 'm':
+								if !_rules[ruleMECHANISM]() {
+									goto l987
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l987
+								}
+								{
+									position1003 := position
+									if !_rules[ruleStringLike]() {
+										goto l987
+									}
+									add(rulePegText, position1003)
+								}
+								{
+									add(ruleAction96, position)
+								}
+							case 'v':
+								if !_rules[ruleVERB]() {
+									goto l987
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l987
+								}
+								{
+									position1005 := position
+									if !_rules[ruleStringLike]() {
+										goto l987
+									}
+									add(rulePegText, position1005)
+								}
+								{
+									add(ruleAction95, position)
+								}
+							default:
+								if !_rules[ruleLinkParam]() {
+									goto l987
+								}
 							}
-						default:
-							if !_rules[ruleStatusObject]() {
-								goto l0
+						}
+
+					}
+				l992:
+					add(ruleRelParam, position991)
+				}
+			l989:
+				{
+					position990, tokenIndex990 := position, tokenIndex
+					{
+						position1007 := position
+						{
+							position1008, tokenIndex1008 := position, tokenIndex
+							if !_rules[ruleASYNC]() {
+								goto l1009
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1009
+							}
+							{
+								position1010 := position
+								if !_rules[ruleBoolean]() {
+									goto l1009
+								}
+								add(rulePegText, position1010)
+							}
+							{
+								add(ruleAction97, position)
+							}
+							goto l1008
+						l1009:
+							position, tokenIndex = position1008, tokenIndex1008
+							{
+								switch buffer[position] {
+								case 'c':
+									if !_rules[ruleCLASSIFICATION]() {
+										goto l990
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l990
+									}
+									{
+										position1013 := position
+										if !_rules[ruleStringLike]() {
+											goto l990
+										}
+										add(rulePegText, position1013)
+									}
+									{
+										add(ruleAction100, position)
+									}
+								case 's':
+									if !_rules[ruleSTATUS]() {
+										goto l990
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l990
+									}
+									{
+										position1015 := position
+										if !_rules[ruleLifecycleStatus]() {
+											goto l990
+										}
+										add(rulePegText, position1015)
+									}
+									{
+										add(ruleAction99, position)
+									}
+								case 'e':
+									if !_rules[ruleEXPANDED]() {
+										goto l990
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l990
+									}
+									{
+										position1017 := position
+										if !_rules[ruleStringLike]() {
+											goto l990
+										}
+										add(rulePegText, position1017)
+									}
+									{
+										add(ruleAction98, position)
+									}
+								case 'm':
+									if !_rules[ruleMECHANISM]() {
+										goto l990
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l990
+									}
+									{
+										position1019 := position
+										if !_rules[ruleStringLike]() {
+											goto l990
+										}
+										add(rulePegText, position1019)
+									}
+									{
+										add(ruleAction96, position)
+									}
+								case 'v':
+									if !_rules[ruleVERB]() {
+										goto l990
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l990
+									}
+									{
+										position1021 := position
+										if !_rules[ruleStringLike]() {
+											goto l990
+										}
+										add(rulePegText, position1021)
+									}
+									{
+										add(ruleAction95, position)
+									}
+								default:
+									if !_rules[ruleLinkParam]() {
+										goto l990
+									}
+								}
 							}
+
 						}
+					l1008:
+						add(ruleRelParam, position1007)
 					}
-
+					goto l989
+				l990:
+					position, tokenIndex = position990, tokenIndex990
 				}
-			l2:
-				add(ruleValid, position1)
+				add(ruleRelParams, position988)
 			}
 			return true
-		l0:
-			position, tokenIndex = position0, tokenIndex0
+		l987:
+			position, tokenIndex = position987, tokenIndex987
 			return false
 		},
-		/* 1 Response <- <(Objects? _ DELIMITER DELIMITER _ StatusObject END Action0)> */
-		nil,
-		/* 2 Command <- <(_ (Mutation / TreeMutation / Query / StateBound) Flag* END Action1)> */
-		nil,
-		/* 3 Mutation <- <((Item (Create / Set) Identifier ItemParams?) / (Item Clear Identifier ItemKeys) / (Item Delete Identifier) / (Rel (Create / Set) DualIdentifier RelParams?) / (Rel Clear DualIdentifier RelKeys) / (Rel Delete DualIdentifier))> */
-		nil,
-		/* 4 TreeMutation <- <((Free IdentifierList) / (Nest IdentifierList _ IN <StringLike> Action2))> */
-		nil,
-		/* 5 Query <- <(FetchQuery / ListQuery / ExistsQuery)> */
-		nil,
-		/* 6 FetchQuery <- <((&('w') (World Action3)) | (&('r') (Rel Fetch DualIdentifier)) | (&('i') (Item Fetch Identifier)))> */
-		nil,
-		/* 7 ListQuery <- <(((Item / Rel) List Limit?) / ((&('f') (FromQuery Identifier)) | (&('t') (ToQuery Identifier)) | (&('i') (Item IN Identifier Action4))))> */
-		nil,
-		/* 8 ExistsQuery <- <((InQuery DualIdentifier) / (ItemExists Identifier) / (RelExists DualIdentifier))> */
-		nil,
-		/* 9 StateBound <- <((CreateOrFetch Action5) / (CreateOrSet Action6))> */
-		nil,
-		/* 10 CreateOrFetch <- <((Item Identifier !ItemParams) / (Rel DualIdentifier !RelParams))> */
-		nil,
-		/* 11 CreateOrSet <- <((Item Identifier ItemParams) / (Rel DualIdentifier RelParams))> */
-		nil,
-		/* 12 Objects <- <(WorldObject / Tree / ItemObject+ / RelObject+ / IdentifierListObject)> */
-		nil,
-		/* 13 WorldObject <- <(BeginWorld WorldParams Tree RelObject* EndWorld Action7)> */
+		/* 79 NodeParams <- <NodeParam+> */
 		func() bool {
-			position159, tokenIndex159 := position, tokenIndex
+			position1023, tokenIndex1023 := position, tokenIndex
 			{
-				position160 := position
-				{
-					position161 := position
-					if !_rules[rule_]() {
-						goto l159
-					}
-					if !_rules[ruleDELIMITER]() {
-						goto l159
-					}
-					if !_rules[ruleWORLD]() {
-						goto l159
-					}
-					if !_rules[rule_]() {
-						goto l159
-					}
-					add(ruleBeginWorld, position161)
-				}
+				position1024 := position
 				{
-					position162 := position
-					if !_rules[rule_]() {
-						goto l159
-					}
+					position1027 := position
 					{
-						position163 := position
-						{
-							position164 := position
-							if buffer[position] != rune('v') {
-								goto l159
-							}
-							position++
-							if buffer[position] != rune('e') {
-								goto l159
+						switch buffer[position] {
+						case 'p':
+							{
+								position1029 := position
+								if buffer[position] != rune('p') {
+									goto l1023
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l1023
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1023
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1023
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1023
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1023
+								}
+								position++
+								add(rulePARENT, position1029)
 							}
-							position++
-							if buffer[position] != rune('r') {
-								goto l159
+							if !_rules[ruleEQUALS]() {
+								goto l1023
 							}
-							position++
-							if buffer[position] != rune('s') {
-								goto l159
+							{
+								position1030 := position
+								if !_rules[ruleStringLike]() {
+									goto l1023
+								}
+								add(rulePegText, position1030)
 							}
-							position++
-							if buffer[position] != rune('i') {
-								goto l159
+							{
+								add(ruleAction104, position)
 							}
-							position++
-							if buffer[position] != rune('o') {
-								goto l159
+						case 'm':
+							if !_rules[ruleMECHANISM]() {
+								goto l1023
 							}
-							position++
-							if buffer[position] != rune('n') {
-								goto l159
+							if !_rules[ruleEQUALS]() {
+								goto l1023
 							}
-							position++
-							add(ruleVERSION, position164)
-						}
-						if !_rules[ruleEQUALS]() {
-							goto l159
-						}
-						{
-							position165 := position
-							if !_rules[ruleNumber]() {
-								goto l159
+							{
+								position1032 := position
+								if !_rules[ruleStringLike]() {
+									goto l1023
+								}
+								add(rulePegText, position1032)
 							}
-							add(rulePegText, position165)
-						}
-						{
-							add(ruleAction20, position)
-						}
-						add(ruleWorldParamVersion, position163)
-					}
-					if !_rules[rule_]() {
-						goto l159
-					}
-					{
-						position167 := position
-						{
-							position168 := position
-							if buffer[position] != rune('i') {
-								goto l159
+							{
+								add(ruleAction103, position)
 							}
-							position++
-							if buffer[position] != rune('d') {
-								goto l159
+						case 'n':
+							if !_rules[ruleNAME]() {
+								goto l1023
 							}
-							position++
-							add(ruleID, position168)
-						}
-						if !_rules[ruleEQUALS]() {
-							goto l159
-						}
-						{
-							position169 := position
-							if !_rules[ruleStringLike]() {
-								goto l159
+							if !_rules[ruleEQUALS]() {
+								goto l1023
 							}
-							add(rulePegText, position169)
-						}
-						{
-							add(ruleAction21, position)
-						}
-						add(ruleWorldParamId, position167)
-					}
-					if !_rules[rule_]() {
-						goto l159
-					}
-					{
-						position171 := position
-						if !_rules[ruleNAME]() {
-							goto l159
-						}
-						if !_rules[ruleEQUALS]() {
-							goto l159
-						}
-						{
-							position172 := position
 							{
-								position173, tokenIndex173 := position, tokenIndex
+								position1034 := position
 								if !_rules[ruleStringLike]() {
-									goto l173
+									goto l1023
 								}
-								goto l174
-							l173:
-								position, tokenIndex = position173, tokenIndex173
+								add(rulePegText, position1034)
 							}
-						l174:
-							add(rulePegText, position172)
-						}
-						{
-							add(ruleAction22, position)
-						}
-						add(ruleWorldParamName, position171)
-					}
-					if !_rules[rule_]() {
-						goto l159
-					}
-					{
-						position176 := position
-						if !_rules[ruleEXPANDED]() {
-							goto l159
-						}
-						if !_rules[ruleEQUALS]() {
-							goto l159
-						}
-						{
-							position177 := position
 							{
-								position178, tokenIndex178 := position, tokenIndex
-								if !_rules[ruleStringLike]() {
-									goto l178
-								}
-								goto l179
-							l178:
-								position, tokenIndex = position178, tokenIndex178
+								add(ruleAction102, position)
 							}
-						l179:
-							add(rulePegText, position177)
-						}
-						{
-							add(ruleAction23, position)
-						}
-						add(ruleWorldParamExpanded, position176)
-					}
-					if !_rules[rule_]() {
-						goto l159
-					}
-					{
-						add(ruleAction19, position)
-					}
-					add(ruleWorldParams, position162)
-				}
-				if !_rules[ruleTree]() {
-					goto l159
-				}
-			l182:
-				{
-					position183, tokenIndex183 := position, tokenIndex
-					if !_rules[ruleRelObject]() {
-						goto l183
-					}
-					goto l182
-				l183:
-					position, tokenIndex = position183, tokenIndex183
-				}
-				{
-					position184 := position
-					if !_rules[rule_]() {
-						goto l159
-					}
-					if !_rules[ruleENDWORLD]() {
-						goto l159
-					}
-					if !_rules[ruleDELIMITER]() {
-						goto l159
-					}
-					if !_rules[rule_]() {
-						goto l159
-					}
-					add(ruleEndWorld, position184)
-				}
-				{
-					add(ruleAction7, position)
-				}
-				add(ruleWorldObject, position160)
-			}
-			return true
-		l159:
-			position, tokenIndex = position159, tokenIndex159
-			return false
-		},
-		/* 14 ItemObject <- <(<(Item Identifier ItemParams?)> Action8)> */
-		func() bool {
-			position186, tokenIndex186 := position, tokenIndex
-			{
-				position187 := position
-				{
-					position188 := position
-					if !_rules[ruleItem]() {
-						goto l186
-					}
-					if !_rules[ruleIdentifier]() {
-						goto l186
-					}
-					{
-						position189, tokenIndex189 := position, tokenIndex
-						if !_rules[ruleItemParams]() {
-							goto l189
-						}
-						goto l190
-					l189:
-						position, tokenIndex = position189, tokenIndex189
-					}
-				l190:
-					add(rulePegText, position188)
-				}
-				{
-					add(ruleAction8, position)
-				}
-				add(ruleItemObject, position187)
-			}
-			return true
-		l186:
-			position, tokenIndex = position186, tokenIndex186
-			return false
-		},
-		/* 15 RelObject <- <(<(Rel DualIdentifier RelParams?)> Action9)> */
-		func() bool {
-			position192, tokenIndex192 := position, tokenIndex
-			{
-				position193 := position
-				{
-					position194 := position
-					if !_rules[ruleRel]() {
-						goto l192
-					}
-					if !_rules[ruleDualIdentifier]() {
-						goto l192
-					}
-					{
-						position195, tokenIndex195 := position, tokenIndex
-						if !_rules[ruleRelParams]() {
-							goto l195
-						}
-						goto l196
-					l195:
-						position, tokenIndex = position195, tokenIndex195
-					}
-				l196:
-					add(rulePegText, position194)
-				}
-				{
-					add(ruleAction9, position)
-				}
-				add(ruleRelObject, position193)
-			}
-			return true
-		l192:
-			position, tokenIndex = position192, tokenIndex192
-			return false
-		},
-		/* 16 IdentifierListObject <- <(IdentifierList Action10)> */
-		nil,
-		/* 17 Tree <- <(<('t' 'r' 'e' 'e' '{' (Nil / ItemObject) (':' ':' '[') Tree* (']' '}'))> _ Action11)> */
-		func() bool {
-			position199, tokenIndex199 := position, tokenIndex
-			{
-				position200 := position
-				{
-					position201 := position
-					if buffer[position] != rune('t') {
-						goto l199
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l199
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l199
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l199
-					}
-					position++
-					if buffer[position] != rune('{') {
-						goto l199
-					}
-					position++
-					{
-						position202, tokenIndex202 := position, tokenIndex
-						{
-							position204 := position
-							if buffer[position] != rune('n') {
-								goto l203
+						default:
+							{
+								position1036 := position
+								if buffer[position] != rune('k') {
+									goto l1023
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l1023
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1023
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l1023
+								}
+								position++
+								add(ruleKIND, position1036)
 							}
-							position++
-							if buffer[position] != rune('i') {
-								goto l203
+							if !_rules[ruleEQUALS]() {
+								goto l1023
 							}
-							position++
-							if buffer[position] != rune('l') {
-								goto l203
+							{
+								position1037 := position
+								{
+									position1038 := position
+									{
+										switch buffer[position] {
+										case 'n':
+											{
+												position1040 := position
+												if buffer[position] != rune('n') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('d') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l1023
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l1023
+												}
+												add(ruleNODE_KIND, position1040)
+											}
+										case 'c':
+											{
+												position1041 := position
+												if buffer[position] != rune('c') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('l') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('u') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('t') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l1023
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l1023
+												}
+												add(ruleCLUSTER, position1041)
+											}
+										default:
+											{
+												position1042 := position
+												if buffer[position] != rune('e') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('v') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('i') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('r') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('o') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('m') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l1023
+												}
+												position++
+												if buffer[position] != rune('t') {
+													goto l1023
+												}
+												position++
+												if !_rules[rule_]() {
+													goto l1023
+												}
+												add(ruleENVIRONMENT, position1042)
+											}
+										}
+									}
+
+									add(ruleDeploymentKind, position1038)
+								}
+								add(rulePegText, position1037)
 							}
-							position++
 							{
-								add(ruleAction12, position)
+								add(ruleAction101, position)
 							}
-							add(ruleNil, position204)
-						}
-						goto l202
-					l203:
-						position, tokenIndex = position202, tokenIndex202
-						if !_rules[ruleItemObject]() {
-							goto l199
-						}
-					}
-				l202:
-					if buffer[position] != rune(':') {
-						goto l199
-					}
-					position++
-					if buffer[position] != rune(':') {
-						goto l199
-					}
-					position++
-					if buffer[position] != rune('[') {
-						goto l199
-					}
-					position++
-				l206:
-					{
-						position207, tokenIndex207 := position, tokenIndex
-						if !_rules[ruleTree]() {
-							goto l207
-						}
-						goto l206
-					l207:
-						position, tokenIndex = position207, tokenIndex207
-					}
-					if buffer[position] != rune(']') {
-						goto l199
-					}
-					position++
-					if buffer[position] != rune('}') {
-						goto l199
-					}
-					position++
-					add(rulePegText, position201)
-				}
-				if !_rules[rule_]() {
-					goto l199
-				}
-				{
-					add(ruleAction11, position)
-				}
-				add(ruleTree, position200)
-			}
-			return true
-		l199:
-			position, tokenIndex = position199, tokenIndex199
-			return false
-		},
-		/* 18 Nil <- <('n' 'i' 'l' Action12)> */
-		nil,
-		/* 19 StatusObject <- <(ErrCode (ERROR / OK) <StringLike*> Action13)> */
-		func() bool {
-			position210, tokenIndex210 := position, tokenIndex
-			{
-				position211 := position
-				{
-					position212 := position
-					{
-						position213 := position
-						if !_rules[ruleNumber]() {
-							goto l210
 						}
-						add(rulePegText, position213)
-					}
-					{
-						add(ruleAction14, position)
-					}
-					add(ruleErrCode, position212)
-				}
-				{
-					position215, tokenIndex215 := position, tokenIndex
-					if !_rules[ruleERROR]() {
-						goto l216
-					}
-					goto l215
-				l216:
-					position, tokenIndex = position215, tokenIndex215
-					if !_rules[ruleOK]() {
-						goto l210
 					}
+
+					add(ruleNodeParam, position1027)
 				}
-			l215:
+			l1025:
 				{
-					position217 := position
-				l218:
+					position1026, tokenIndex1026 := position, tokenIndex
 					{
-						position219, tokenIndex219 := position, tokenIndex
-						if !_rules[ruleStringLike]() {
-							goto l219
+						position1044 := position
+						{
+							switch buffer[position] {
+							case 'p':
+								{
+									position1046 := position
+									if buffer[position] != rune('p') {
+										goto l1026
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l1026
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l1026
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l1026
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l1026
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l1026
+									}
+									position++
+									add(rulePARENT, position1046)
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1026
+								}
+								{
+									position1047 := position
+									if !_rules[ruleStringLike]() {
+										goto l1026
+									}
+									add(rulePegText, position1047)
+								}
+								{
+									add(ruleAction104, position)
+								}
+							case 'm':
+								if !_rules[ruleMECHANISM]() {
+									goto l1026
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1026
+								}
+								{
+									position1049 := position
+									if !_rules[ruleStringLike]() {
+										goto l1026
+									}
+									add(rulePegText, position1049)
+								}
+								{
+									add(ruleAction103, position)
+								}
+							case 'n':
+								if !_rules[ruleNAME]() {
+									goto l1026
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1026
+								}
+								{
+									position1051 := position
+									if !_rules[ruleStringLike]() {
+										goto l1026
+									}
+									add(rulePegText, position1051)
+								}
+								{
+									add(ruleAction102, position)
+								}
+							default:
+								{
+									position1053 := position
+									if buffer[position] != rune('k') {
+										goto l1026
+									}
+									position++
+									if buffer[position] != rune('i') {
+										goto l1026
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l1026
+									}
+									position++
+									if buffer[position] != rune('d') {
+										goto l1026
+									}
+									position++
+									add(ruleKIND, position1053)
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1026
+								}
+								{
+									position1054 := position
+									{
+										position1055 := position
+										{
+											switch buffer[position] {
+											case 'n':
+												{
+													position1057 := position
+													if buffer[position] != rune('n') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('d') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l1026
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l1026
+													}
+													add(ruleNODE_KIND, position1057)
+												}
+											case 'c':
+												{
+													position1058 := position
+													if buffer[position] != rune('c') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('l') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('u') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('s') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l1026
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l1026
+													}
+													add(ruleCLUSTER, position1058)
+												}
+											default:
+												{
+													position1059 := position
+													if buffer[position] != rune('e') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('v') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('i') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('r') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('o') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('m') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('e') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('n') {
+														goto l1026
+													}
+													position++
+													if buffer[position] != rune('t') {
+														goto l1026
+													}
+													position++
+													if !_rules[rule_]() {
+														goto l1026
+													}
+													add(ruleENVIRONMENT, position1059)
+												}
+											}
+										}
+
+										add(ruleDeploymentKind, position1055)
+									}
+									add(rulePegText, position1054)
+								}
+								{
+									add(ruleAction101, position)
+								}
+							}
 						}
-						goto l218
-					l219:
-						position, tokenIndex = position219, tokenIndex219
-					}
-					add(rulePegText, position217)
-				}
-				{
-					add(ruleAction13, position)
-				}
-				add(ruleStatusObject, position211)
-			}
-			return true
-		l210:
-			position, tokenIndex = position210, tokenIndex210
-			return false
-		},
-		/* 20 ErrCode <- <(<Number> Action14)> */
-		nil,
-		/* 21 Limit <- <(<Number> Action15)> */
-		nil,
-		/* 22 Identifier <- <(!Keyword <StringLike> Action16)> */
-		func() bool {
-			position223, tokenIndex223 := position, tokenIndex
-			{
-				position224 := position
-				{
-					position225, tokenIndex225 := position, tokenIndex
-					if !_rules[ruleKeyword]() {
-						goto l225
-					}
-					goto l223
-				l225:
-					position, tokenIndex = position225, tokenIndex225
-				}
-				{
-					position226 := position
-					if !_rules[ruleStringLike]() {
-						goto l223
+
+						add(ruleNodeParam, position1044)
 					}
-					add(rulePegText, position226)
-				}
-				{
-					add(ruleAction16, position)
+					goto l1025
+				l1026:
+					position, tokenIndex = position1026, tokenIndex1026
 				}
-				add(ruleIdentifier, position224)
+				add(ruleNodeParams, position1024)
 			}
 			return true
-		l223:
-			position, tokenIndex = position223, tokenIndex223
+		l1023:
+			position, tokenIndex = position1023, tokenIndex1023
 			return false
 		},
-		/* 23 SecondIdentifier <- <(!Keyword &Identifier <StringLike> Action17)> */
-		nil,
-		/* 24 DualIdentifier <- <(Identifier SecondIdentifier)> */
+		/* 80 ScenarioParams <- <ScenarioParam+> */
 		func() bool {
-			position229, tokenIndex229 := position, tokenIndex
+			position1061, tokenIndex1061 := position, tokenIndex
 			{
-				position230 := position
-				if !_rules[ruleIdentifier]() {
-					goto l229
-				}
+				position1062 := position
 				{
-					position231 := position
-					{
-						position232, tokenIndex232 := position, tokenIndex
-						if !_rules[ruleKeyword]() {
-							goto l232
-						}
-						goto l229
-					l232:
-						position, tokenIndex = position232, tokenIndex232
+					position1065 := position
+					if !_rules[ruleNAME]() {
+						goto l1061
 					}
-					{
-						position233, tokenIndex233 := position, tokenIndex
-						if !_rules[ruleIdentifier]() {
-							goto l229
-						}
-						position, tokenIndex = position233, tokenIndex233
+					if !_rules[ruleEQUALS]() {
+						goto l1061
 					}
 					{
-						position234 := position
+						position1066 := position
 						if !_rules[ruleStringLike]() {
-							goto l229
+							goto l1061
 						}
-						add(rulePegText, position234)
+						add(rulePegText, position1066)
+					}
+					{
+						add(ruleAction105, position)
 					}
+					add(ruleScenarioParam, position1065)
+				}
+			l1063:
+				{
+					position1064, tokenIndex1064 := position, tokenIndex
 					{
-						add(ruleAction17, position)
+						position1068 := position
+						if !_rules[ruleNAME]() {
+							goto l1064
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l1064
+						}
+						{
+							position1069 := position
+							if !_rules[ruleStringLike]() {
+								goto l1064
+							}
+							add(rulePegText, position1069)
+						}
+						{
+							add(ruleAction105, position)
+						}
+						add(ruleScenarioParam, position1068)
 					}
-					add(ruleSecondIdentifier, position231)
+					goto l1063
+				l1064:
+					position, tokenIndex = position1064, tokenIndex1064
 				}
-				add(ruleDualIdentifier, position230)
+				add(ruleScenarioParams, position1062)
 			}
 			return true
-		l229:
-			position, tokenIndex = position229, tokenIndex229
+		l1061:
+			position, tokenIndex = position1061, tokenIndex1061
 			return false
 		},
-		/* 25 IdentifierList <- <(<(Identifier Identifier*)> Action18)> */
+		/* 81 StepParams <- <StepParam+> */
 		func() bool {
-			position236, tokenIndex236 := position, tokenIndex
+			position1071, tokenIndex1071 := position, tokenIndex
 			{
-				position237 := position
+				position1072 := position
 				{
-					position238 := position
-					if !_rules[ruleIdentifier]() {
-						goto l236
-					}
-				l239:
+					position1075 := position
 					{
-						position240, tokenIndex240 := position, tokenIndex
-						if !_rules[ruleIdentifier]() {
-							goto l240
+						position1076, tokenIndex1076 := position, tokenIndex
+						{
+							position1078 := position
+							if buffer[position] != rune('l') {
+								goto l1077
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1077
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l1077
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1077
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1077
+							}
+							position++
+							add(ruleLABEL, position1078)
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l1077
+						}
+						{
+							position1079 := position
+							if !_rules[ruleStringLike]() {
+								goto l1077
+							}
+							add(rulePegText, position1079)
+						}
+						{
+							add(ruleAction106, position)
+						}
+						goto l1076
+					l1077:
+						position, tokenIndex = position1076, tokenIndex1076
+						if !_rules[ruleASYNC]() {
+							goto l1081
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l1081
+						}
+						{
+							position1082 := position
+							if !_rules[ruleBoolean]() {
+								goto l1081
+							}
+							add(rulePegText, position1082)
+						}
+						{
+							add(ruleAction107, position)
+						}
+						goto l1076
+					l1081:
+						position, tokenIndex = position1076, tokenIndex1076
+						{
+							position1084 := position
+							if buffer[position] != rune('a') {
+								goto l1071
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1071
+							}
+							position++
+							add(ruleAT, position1084)
+						}
+						if !_rules[ruleEQUALS]() {
+							goto l1071
+						}
+						{
+							position1085 := position
+							if !_rules[ruleNumber]() {
+								goto l1071
+							}
+							add(rulePegText, position1085)
+						}
+						{
+							add(ruleAction108, position)
 						}
-						goto l239
-					l240:
-						position, tokenIndex = position240, tokenIndex240
 					}
-					add(rulePegText, position238)
+				l1076:
+					add(ruleStepParam, position1075)
 				}
+			l1073:
 				{
-					add(ruleAction18, position)
+					position1074, tokenIndex1074 := position, tokenIndex
+					{
+						position1087 := position
+						{
+							position1088, tokenIndex1088 := position, tokenIndex
+							{
+								position1090 := position
+								if buffer[position] != rune('l') {
+									goto l1089
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l1089
+								}
+								position++
+								if buffer[position] != rune('b') {
+									goto l1089
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1089
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l1089
+								}
+								position++
+								add(ruleLABEL, position1090)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1089
+							}
+							{
+								position1091 := position
+								if !_rules[ruleStringLike]() {
+									goto l1089
+								}
+								add(rulePegText, position1091)
+							}
+							{
+								add(ruleAction106, position)
+							}
+							goto l1088
+						l1089:
+							position, tokenIndex = position1088, tokenIndex1088
+							if !_rules[ruleASYNC]() {
+								goto l1093
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1093
+							}
+							{
+								position1094 := position
+								if !_rules[ruleBoolean]() {
+									goto l1093
+								}
+								add(rulePegText, position1094)
+							}
+							{
+								add(ruleAction107, position)
+							}
+							goto l1088
+						l1093:
+							position, tokenIndex = position1088, tokenIndex1088
+							{
+								position1096 := position
+								if buffer[position] != rune('a') {
+									goto l1074
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1074
+								}
+								position++
+								add(ruleAT, position1096)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1074
+							}
+							{
+								position1097 := position
+								if !_rules[ruleNumber]() {
+									goto l1074
+								}
+								add(rulePegText, position1097)
+							}
+							{
+								add(ruleAction108, position)
+							}
+						}
+					l1088:
+						add(ruleStepParam, position1087)
+					}
+					goto l1073
+				l1074:
+					position, tokenIndex = position1074, tokenIndex1074
 				}
-				add(ruleIdentifierList, position237)
+				add(ruleStepParams, position1072)
 			}
 			return true
-		l236:
-			position, tokenIndex = position236, tokenIndex236
+		l1071:
+			position, tokenIndex = position1071, tokenIndex1071
 			return false
 		},
-		/* 26 WorldParams <- <(_ WorldParamVersion _ WorldParamId _ WorldParamName _ WorldParamExpanded _ Action19)> */
-		nil,
-		/* 27 ItemParams <- <ItemParam+> */
+		/* 82 ViewParams <- <ViewParam+> */
 		func() bool {
-			position243, tokenIndex243 := position, tokenIndex
+			position1099, tokenIndex1099 := position, tokenIndex
 			{
-				position244 := position
+				position1100 := position
 				{
-					position247 := position
+					position1103 := position
 					{
-						position248, tokenIndex248 := position, tokenIndex
-						if !_rules[ruleEXTERNAL]() {
-							goto l249
+						position1104, tokenIndex1104 := position, tokenIndex
+						{
+							position1106 := position
+							if buffer[position] != rune('f') {
+								goto l1105
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1105
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1105
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1105
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1105
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1105
+							}
+							position++
+							add(ruleFILTER, position1106)
 						}
 						if !_rules[ruleEQUALS]() {
-							goto l249
+							goto l1105
 						}
 						{
-							position250 := position
-							if !_rules[ruleBoolean]() {
-								goto l249
+							position1107 := position
+							if !_rules[ruleStringLike]() {
+								goto l1105
 							}
-							add(rulePegText, position250)
+							add(rulePegText, position1107)
 						}
 						{
-							add(ruleAction24, position)
+							add(ruleAction111, position)
 						}
-						goto l248
-					l249:
-						position, tokenIndex = position248, tokenIndex248
+						goto l1104
+					l1105:
+						position, tokenIndex = position1104, tokenIndex1104
 						{
 							switch buffer[position] {
+							case 'h':
+								{
+									position1110 := position
+									if buffer[position] != rune('h') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('p') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('s') {
+										goto l1099
+									}
+									position++
+									add(ruleHOPS, position1110)
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1099
+								}
+								{
+									position1111 := position
+									if !_rules[ruleNumber]() {
+										goto l1099
+									}
+									add(rulePegText, position1111)
+								}
+								{
+									add(ruleAction113, position)
+								}
+							case 'f':
+								{
+									position1113 := position
+									if buffer[position] != rune('f') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('c') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('s') {
+										goto l1099
+									}
+									position++
+									add(ruleFOCUS, position1113)
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1099
+								}
+								{
+									position1114 := position
+									if !_rules[ruleStringLike]() {
+										goto l1099
+									}
+									add(rulePegText, position1114)
+								}
+								{
+									add(ruleAction112, position)
+								}
 							case 'e':
-								if !_rules[ruleEXPANDED]() {
-									goto l243
+								{
+									position1116 := position
+									if buffer[position] != rune('e') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('x') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('p') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l1099
+									}
+									position++
+									if buffer[position] != rune('d') {
+										goto l1099
+									}
+									position++
+									add(ruleEXPAND, position1116)
 								}
 								if !_rules[ruleEQUALS]() {
-									goto l243
+									goto l1099
 								}
 								{
-									position253 := position
+									position1117 := position
 									if !_rules[ruleStringLike]() {
-										goto l243
+										goto l1099
 									}
-									add(rulePegText, position253)
+									add(rulePegText, position1117)
 								}
 								{
-									add(ruleAction28, position)
+									add(ruleAction110, position)
 								}
-							case 'm':
-								if !_rules[ruleMECHANISM]() {
-									goto l243
+							default:
+								if !_rules[ruleNAME]() {
+									goto l1099
 								}
 								if !_rules[ruleEQUALS]() {
-									goto l243
+									goto l1099
 								}
 								{
-									position255 := position
+									position1119 := position
 									if !_rules[ruleStringLike]() {
-										goto l243
+										goto l1099
+									}
+									add(rulePegText, position1119)
+								}
+								{
+									add(ruleAction109, position)
+								}
+							}
+						}
+
+					}
+				l1104:
+					add(ruleViewParam, position1103)
+				}
+			l1101:
+				{
+					position1102, tokenIndex1102 := position, tokenIndex
+					{
+						position1121 := position
+						{
+							position1122, tokenIndex1122 := position, tokenIndex
+							{
+								position1124 := position
+								if buffer[position] != rune('f') {
+									goto l1123
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l1123
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l1123
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1123
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1123
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1123
+								}
+								position++
+								add(ruleFILTER, position1124)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1123
+							}
+							{
+								position1125 := position
+								if !_rules[ruleStringLike]() {
+									goto l1123
+								}
+								add(rulePegText, position1125)
+							}
+							{
+								add(ruleAction111, position)
+							}
+							goto l1122
+						l1123:
+							position, tokenIndex = position1122, tokenIndex1122
+							{
+								switch buffer[position] {
+								case 'h':
+									{
+										position1128 := position
+										if buffer[position] != rune('h') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('p') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l1102
+										}
+										position++
+										add(ruleHOPS, position1128)
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l1102
+									}
+									{
+										position1129 := position
+										if !_rules[ruleNumber]() {
+											goto l1102
+										}
+										add(rulePegText, position1129)
+									}
+									{
+										add(ruleAction113, position)
+									}
+								case 'f':
+									{
+										position1131 := position
+										if buffer[position] != rune('f') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('c') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('u') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l1102
+										}
+										position++
+										add(ruleFOCUS, position1131)
 									}
-									add(rulePegText, position255)
-								}
-								{
-									add(ruleAction27, position)
-								}
-							case 'n':
-								if !_rules[ruleNAME]() {
-									goto l243
-								}
-								if !_rules[ruleEQUALS]() {
-									goto l243
-								}
-								{
-									position257 := position
-									if !_rules[ruleStringLike]() {
-										goto l243
+									if !_rules[ruleEQUALS]() {
+										goto l1102
 									}
-									add(rulePegText, position257)
-								}
-								{
-									add(ruleAction26, position)
-								}
-							default:
-								if !_rules[ruleTYPE]() {
-									goto l243
-								}
-								if !_rules[ruleEQUALS]() {
-									goto l243
-								}
-								{
-									position259 := position
 									{
-										position260 := position
-										{
-											position261, tokenIndex261 := position, tokenIndex
-											{
-												position263 := position
-												if buffer[position] != rune('d') {
-													goto l262
-												}
-												position++
-												if buffer[position] != rune('a') {
-													goto l262
-												}
-												position++
-												if buffer[position] != rune('t') {
-													goto l262
-												}
-												position++
-												if buffer[position] != rune('a') {
-													goto l262
-												}
-												position++
-												if buffer[position] != rune('b') {
-													goto l262
-												}
-												position++
-												if buffer[position] != rune('a') {
-													goto l262
-												}
-												position++
-												if buffer[position] != rune('s') {
-													goto l262
-												}
-												position++
-												if buffer[position] != rune('e') {
-													goto l262
-												}
-												position++
-												if !_rules[rule_]() {
-													goto l262
-												}
-												add(ruleDATABASE, position263)
-											}
-											goto l261
-										l262:
-											position, tokenIndex = position261, tokenIndex261
-											{
-												position265 := position
-												if buffer[position] != rune('b') {
-													goto l264
-												}
-												position++
-												if buffer[position] != rune('l') {
-													goto l264
-												}
-												position++
-												if buffer[position] != rune('o') {
-													goto l264
-												}
-												position++
-												if buffer[position] != rune('b') {
-													goto l264
-												}
-												position++
-												if buffer[position] != rune('s') {
-													goto l264
-												}
-												position++
-												if buffer[position] != rune('t') {
-													goto l264
-												}
-												position++
-												if buffer[position] != rune('o') {
-													goto l264
-												}
-												position++
-												if buffer[position] != rune('r') {
-													goto l264
-												}
-												position++
-												if buffer[position] != rune('e') {
-													goto l264
-												}
-												position++
-												if !_rules[rule_]() {
-													goto l264
-												}
-												add(ruleBLOBSTORE, position265)
-											}
-											goto l261
-										l264:
-											position, tokenIndex = position261, tokenIndex261
-											{
-												switch buffer[position] {
-												case 'c':
-													{
-														position267 := position
-														if buffer[position] != rune('c') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('o') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('d') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if !_rules[rule_]() {
-															goto l243
-														}
-														add(ruleCODE, position267)
-													}
-												case 'd':
-													{
-														position268 := position
-														if buffer[position] != rune('d') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('v') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('i') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('c') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if !_rules[rule_]() {
-															goto l243
-														}
-														add(ruleDEVICE, position268)
-													}
-												case 's':
-													{
-														position269 := position
-														if buffer[position] != rune('s') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('r') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('v') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('r') {
-															goto l243
-														}
-														position++
-														if !_rules[rule_]() {
-															goto l243
-														}
-														add(ruleSERVER, position269)
-													}
-												case 'm':
-													{
-														position270 := position
-														if buffer[position] != rune('m') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('o') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('b') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('i') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('l') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if !_rules[rule_]() {
-															goto l243
-														}
-														add(ruleMOBILE, position270)
-													}
-												case 'b':
-													{
-														position271 := position
-														if buffer[position] != rune('b') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('r') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('o') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('w') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('s') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('r') {
-															goto l243
-														}
-														position++
-														if !_rules[rule_]() {
-															goto l243
-														}
-														add(ruleBROWSER, position271)
-													}
-												case 'q':
-													{
-														position272 := position
-														if buffer[position] != rune('q') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('u') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('u') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if !_rules[rule_]() {
-															goto l243
-														}
-														add(ruleQUEUE, position272)
-													}
-												default:
-													{
-														position273 := position
-														if buffer[position] != rune('p') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('r') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('s') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('o') {
-															goto l243
-														}
-														position++
-														if buffer[position] != rune('n') {
-															goto l243
-														}
-														position++
-														if !_rules[rule_]() {
-															goto l243
-														}
-														add(rulePERSON, position273)
-													}
-												}
-											}
-
+										position1132 := position
+										if !_rules[ruleStringLike]() {
+											goto l1102
+										}
+										add(rulePegText, position1132)
+									}
+									{
+										add(ruleAction112, position)
+									}
+								case 'e':
+									{
+										position1134 := position
+										if buffer[position] != rune('e') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('x') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('p') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l1102
+										}
+										position++
+										if buffer[position] != rune('d') {
+											goto l1102
 										}
-									l261:
-										add(ruleItemType, position260)
+										position++
+										add(ruleEXPAND, position1134)
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l1102
+									}
+									{
+										position1135 := position
+										if !_rules[ruleStringLike]() {
+											goto l1102
+										}
+										add(rulePegText, position1135)
+									}
+									{
+										add(ruleAction110, position)
+									}
+								default:
+									if !_rules[ruleNAME]() {
+										goto l1102
+									}
+									if !_rules[ruleEQUALS]() {
+										goto l1102
+									}
+									{
+										position1137 := position
+										if !_rules[ruleStringLike]() {
+											goto l1102
+										}
+										add(rulePegText, position1137)
+									}
+									{
+										add(ruleAction109, position)
 									}
-									add(rulePegText, position259)
-								}
-								{
-									add(ruleAction25, position)
 								}
 							}
-						}
 
+						}
+					l1122:
+						add(ruleViewParam, position1121)
+					}
+					goto l1101
+				l1102:
+					position, tokenIndex = position1102, tokenIndex1102
+				}
+				add(ruleViewParams, position1100)
+			}
+			return true
+		l1099:
+			position, tokenIndex = position1099, tokenIndex1099
+			return false
+		},
+		/* 83 PinParams <- <PinParam+> */
+		func() bool {
+			position1139, tokenIndex1139 := position, tokenIndex
+			{
+				position1140 := position
+				if !_rules[rulePinParam]() {
+					goto l1139
+				}
+			l1141:
+				{
+					position1142, tokenIndex1142 := position, tokenIndex
+					if !_rules[rulePinParam]() {
+						goto l1142
 					}
-				l248:
-					add(ruleItemParam, position247)
+					goto l1141
+				l1142:
+					position, tokenIndex = position1142, tokenIndex1142
 				}
-			l245:
+				add(rulePinParams, position1140)
+			}
+			return true
+		l1139:
+			position, tokenIndex = position1139, tokenIndex1139
+			return false
+		},
+		/* 84 StyleParams <- <StyleParam+> */
+		func() bool {
+			position1143, tokenIndex1143 := position, tokenIndex
+			{
+				position1144 := position
 				{
-					position246, tokenIndex246 := position, tokenIndex
+					position1147 := position
 					{
-						position275 := position
-						{
-							position276, tokenIndex276 := position, tokenIndex
-							if !_rules[ruleEXTERNAL]() {
-								goto l277
+						switch buffer[position] {
+						case 'l':
+							{
+								position1149 := position
+								if buffer[position] != rune('l') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1143
+								}
+								position++
+								add(ruleLINE, position1149)
 							}
 							if !_rules[ruleEQUALS]() {
-								goto l277
+								goto l1143
 							}
 							{
-								position278 := position
-								if !_rules[ruleBoolean]() {
-									goto l277
+								position1150 := position
+								if !_rules[ruleStringLike]() {
+									goto l1143
 								}
-								add(rulePegText, position278)
+								add(rulePegText, position1150)
 							}
 							{
-								add(ruleAction24, position)
+								add(ruleAction124, position)
 							}
-							goto l276
-						l277:
-							position, tokenIndex = position276, tokenIndex276
+						case 'b':
 							{
-								switch buffer[position] {
-								case 'e':
-									if !_rules[ruleEXPANDED]() {
-										goto l246
+								position1152 := position
+								if buffer[position] != rune('b') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1143
+								}
+								position++
+								add(ruleBORDER, position1152)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1143
+							}
+							{
+								position1153 := position
+								if !_rules[ruleStringLike]() {
+									goto l1143
+								}
+								add(rulePegText, position1153)
+							}
+							{
+								add(ruleAction123, position)
+							}
+						case 'c':
+							{
+								position1155 := position
+								if buffer[position] != rune('c') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1143
+								}
+								position++
+								add(ruleCOLOR, position1155)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1143
+							}
+							{
+								position1156 := position
+								if !_rules[ruleStringLike]() {
+									goto l1143
+								}
+								add(rulePegText, position1156)
+							}
+							{
+								add(ruleAction122, position)
+							}
+						case 's':
+							{
+								position1158 := position
+								if buffer[position] != rune('s') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1143
+								}
+								position++
+								add(ruleSHAPE, position1158)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1143
+							}
+							{
+								position1159 := position
+								if !_rules[ruleStringLike]() {
+									goto l1143
+								}
+								add(rulePegText, position1159)
+							}
+							{
+								add(ruleAction121, position)
+							}
+						case 'm':
+							{
+								position1161 := position
+								if buffer[position] != rune('m') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l1143
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l1143
+								}
+								position++
+								add(ruleMATCH, position1161)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1143
+							}
+							{
+								position1162 := position
+								if !_rules[ruleStringLike]() {
+									goto l1143
+								}
+								add(rulePegText, position1162)
+							}
+							{
+								add(ruleAction120, position)
+							}
+						default:
+							if !_rules[ruleNAME]() {
+								goto l1143
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1143
+							}
+							{
+								position1164 := position
+								if !_rules[ruleStringLike]() {
+									goto l1143
+								}
+								add(rulePegText, position1164)
+							}
+							{
+								add(ruleAction119, position)
+							}
+						}
+					}
+
+					add(ruleStyleParam, position1147)
+				}
+			l1145:
+				{
+					position1146, tokenIndex1146 := position, tokenIndex
+					{
+						position1166 := position
+						{
+							switch buffer[position] {
+							case 'l':
+								{
+									position1168 := position
+									if buffer[position] != rune('l') {
+										goto l1146
 									}
-									if !_rules[ruleEQUALS]() {
-										goto l246
+									position++
+									if buffer[position] != rune('i') {
+										goto l1146
 									}
-									{
-										position281 := position
-										if !_rules[ruleStringLike]() {
-											goto l246
-										}
-										add(rulePegText, position281)
+									position++
+									if buffer[position] != rune('n') {
+										goto l1146
 									}
-									{
-										add(ruleAction28, position)
+									position++
+									if buffer[position] != rune('e') {
+										goto l1146
 									}
-								case 'm':
-									if !_rules[ruleMECHANISM]() {
-										goto l246
+									position++
+									add(ruleLINE, position1168)
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1146
+								}
+								{
+									position1169 := position
+									if !_rules[ruleStringLike]() {
+										goto l1146
 									}
-									if !_rules[ruleEQUALS]() {
-										goto l246
+									add(rulePegText, position1169)
+								}
+								{
+									add(ruleAction124, position)
+								}
+							case 'b':
+								{
+									position1171 := position
+									if buffer[position] != rune('b') {
+										goto l1146
 									}
-									{
-										position283 := position
-										if !_rules[ruleStringLike]() {
-											goto l246
-										}
-										add(rulePegText, position283)
+									position++
+									if buffer[position] != rune('o') {
+										goto l1146
 									}
-									{
-										add(ruleAction27, position)
+									position++
+									if buffer[position] != rune('r') {
+										goto l1146
 									}
-								case 'n':
-									if !_rules[ruleNAME]() {
-										goto l246
+									position++
+									if buffer[position] != rune('d') {
+										goto l1146
 									}
-									if !_rules[ruleEQUALS]() {
-										goto l246
+									position++
+									if buffer[position] != rune('e') {
+										goto l1146
 									}
-									{
-										position285 := position
-										if !_rules[ruleStringLike]() {
-											goto l246
-										}
-										add(rulePegText, position285)
+									position++
+									if buffer[position] != rune('r') {
+										goto l1146
+									}
+									position++
+									add(ruleBORDER, position1171)
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1146
+								}
+								{
+									position1172 := position
+									if !_rules[ruleStringLike]() {
+										goto l1146
+									}
+									add(rulePegText, position1172)
+								}
+								{
+									add(ruleAction123, position)
+								}
+							case 'c':
+								{
+									position1174 := position
+									if buffer[position] != rune('c') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('l') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l1146
+									}
+									position++
+									add(ruleCOLOR, position1174)
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1146
+								}
+								{
+									position1175 := position
+									if !_rules[ruleStringLike]() {
+										goto l1146
+									}
+									add(rulePegText, position1175)
+								}
+								{
+									add(ruleAction122, position)
+								}
+							case 's':
+								{
+									position1177 := position
+									if buffer[position] != rune('s') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('h') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('p') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l1146
+									}
+									position++
+									add(ruleSHAPE, position1177)
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1146
+								}
+								{
+									position1178 := position
+									if !_rules[ruleStringLike]() {
+										goto l1146
+									}
+									add(rulePegText, position1178)
+								}
+								{
+									add(ruleAction121, position)
+								}
+							case 'm':
+								{
+									position1180 := position
+									if buffer[position] != rune('m') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l1146
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l1146
 									}
-									{
-										add(ruleAction26, position)
+									position++
+									if buffer[position] != rune('c') {
+										goto l1146
 									}
-								default:
-									if !_rules[ruleTYPE]() {
-										goto l246
+									position++
+									if buffer[position] != rune('h') {
+										goto l1146
 									}
-									if !_rules[ruleEQUALS]() {
-										goto l246
+									position++
+									add(ruleMATCH, position1180)
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1146
+								}
+								{
+									position1181 := position
+									if !_rules[ruleStringLike]() {
+										goto l1146
 									}
-									{
-										position287 := position
-										{
-											position288 := position
-											{
-												position289, tokenIndex289 := position, tokenIndex
-												{
-													position291 := position
-													if buffer[position] != rune('d') {
-														goto l290
-													}
-													position++
-													if buffer[position] != rune('a') {
-														goto l290
-													}
-													position++
-													if buffer[position] != rune('t') {
-														goto l290
-													}
-													position++
-													if buffer[position] != rune('a') {
-														goto l290
-													}
-													position++
-													if buffer[position] != rune('b') {
-														goto l290
-													}
-													position++
-													if buffer[position] != rune('a') {
-														goto l290
-													}
-													position++
-													if buffer[position] != rune('s') {
-														goto l290
-													}
-													position++
-													if buffer[position] != rune('e') {
-														goto l290
-													}
-													position++
-													if !_rules[rule_]() {
-														goto l290
-													}
-													add(ruleDATABASE, position291)
-												}
-												goto l289
-											l290:
-												position, tokenIndex = position289, tokenIndex289
-												{
-													position293 := position
-													if buffer[position] != rune('b') {
-														goto l292
-													}
-													position++
-													if buffer[position] != rune('l') {
-														goto l292
-													}
-													position++
-													if buffer[position] != rune('o') {
-														goto l292
-													}
-													position++
-													if buffer[position] != rune('b') {
-														goto l292
-													}
-													position++
-													if buffer[position] != rune('s') {
-														goto l292
-													}
-													position++
-													if buffer[position] != rune('t') {
-														goto l292
-													}
-													position++
-													if buffer[position] != rune('o') {
-														goto l292
-													}
-													position++
-													if buffer[position] != rune('r') {
-														goto l292
-													}
-													position++
-													if buffer[position] != rune('e') {
-														goto l292
-													}
-													position++
-													if !_rules[rule_]() {
-														goto l292
-													}
-													add(ruleBLOBSTORE, position293)
-												}
-												goto l289
-											l292:
-												position, tokenIndex = position289, tokenIndex289
-												{
-													switch buffer[position] {
-													case 'c':
-														{
-															position295 := position
-															if buffer[position] != rune('c') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('o') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('d') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if !_rules[rule_]() {
-																goto l246
-															}
-															add(ruleCODE, position295)
-														}
-													case 'd':
-														{
-															position296 := position
-															if buffer[position] != rune('d') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('v') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('i') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('c') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if !_rules[rule_]() {
-																goto l246
-															}
-															add(ruleDEVICE, position296)
-														}
-													case 's':
-														{
-															position297 := position
-															if buffer[position] != rune('s') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('r') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('v') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('r') {
-																goto l246
-															}
-															position++
-															if !_rules[rule_]() {
-																goto l246
-															}
-															add(ruleSERVER, position297)
-														}
-													case 'm':
-														{
-															position298 := position
-															if buffer[position] != rune('m') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('o') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('b') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('i') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('l') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if !_rules[rule_]() {
-																goto l246
-															}
-															add(ruleMOBILE, position298)
-														}
-													case 'b':
-														{
-															position299 := position
-															if buffer[position] != rune('b') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('r') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('o') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('w') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('s') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('r') {
-																goto l246
-															}
-															position++
-															if !_rules[rule_]() {
-																goto l246
-															}
-															add(ruleBROWSER, position299)
-														}
-													case 'q':
-														{
-															position300 := position
-															if buffer[position] != rune('q') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('u') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('u') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if !_rules[rule_]() {
-																goto l246
-															}
-															add(ruleQUEUE, position300)
-														}
-													default:
-														{
-															position301 := position
-															if buffer[position] != rune('p') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('e') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('r') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('s') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('o') {
-																goto l246
-															}
-															position++
-															if buffer[position] != rune('n') {
-																goto l246
-															}
-															position++
-															if !_rules[rule_]() {
-																goto l246
-															}
-															add(rulePERSON, position301)
-														}
-													}
-												}
+									add(rulePegText, position1181)
+								}
+								{
+									add(ruleAction120, position)
+								}
+							default:
+								if !_rules[ruleNAME]() {
+									goto l1146
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1146
+								}
+								{
+									position1183 := position
+									if !_rules[ruleStringLike]() {
+										goto l1146
+									}
+									add(rulePegText, position1183)
+								}
+								{
+									add(ruleAction119, position)
+								}
+							}
+						}
+
+						add(ruleStyleParam, position1166)
+					}
+					goto l1145
+				l1146:
+					position, tokenIndex = position1146, tokenIndex1146
+				}
+				add(ruleStyleParams, position1144)
+			}
+			return true
+		l1143:
+			position, tokenIndex = position1143, tokenIndex1143
+			return false
+		},
+		/* 85 LinkParams <- <LinkParam+> */
+		func() bool {
+			position1185, tokenIndex1185 := position, tokenIndex
+			{
+				position1186 := position
+				if !_rules[ruleLinkParam]() {
+					goto l1185
+				}
+			l1187:
+				{
+					position1188, tokenIndex1188 := position, tokenIndex
+					if !_rules[ruleLinkParam]() {
+						goto l1188
+					}
+					goto l1187
+				l1188:
+					position, tokenIndex = position1188, tokenIndex1188
+				}
+				add(ruleLinkParams, position1186)
+			}
+			return true
+		l1185:
+			position, tokenIndex = position1185, tokenIndex1185
+			return false
+		},
+		/* 86 WorldSetParams <- <WorldSetParam+> */
+		func() bool {
+			position1189, tokenIndex1189 := position, tokenIndex
+			{
+				position1190 := position
+				{
+					position1193 := position
+					{
+						switch buffer[position] {
+						case 'v':
+							if !_rules[ruleVERSION]() {
+								goto l1189
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1189
+							}
+							{
+								position1195 := position
+								if !_rules[ruleNumber]() {
+									goto l1189
+								}
+								add(rulePegText, position1195)
+							}
+							{
+								add(ruleAction81, position)
+							}
+						case 't':
+							if !_rules[ruleTHEME]() {
+								goto l1189
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1189
+							}
+							{
+								position1197 := position
+								if !_rules[ruleThemeName]() {
+									goto l1189
+								}
+								add(rulePegText, position1197)
+							}
+							{
+								add(ruleAction80, position)
+							}
+						case 'e':
+							if !_rules[ruleEXPANDED]() {
+								goto l1189
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1189
+							}
+							{
+								position1199 := position
+								if !_rules[ruleStringLike]() {
+									goto l1189
+								}
+								add(rulePegText, position1199)
+							}
+							{
+								add(ruleAction79, position)
+							}
+						case 'i':
+							if !_rules[ruleID]() {
+								goto l1189
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1189
+							}
+							{
+								position1201 := position
+								if !_rules[ruleStringLike]() {
+									goto l1189
+								}
+								add(rulePegText, position1201)
+							}
+							{
+								add(ruleAction78, position)
+							}
+						default:
+							if !_rules[ruleNAME]() {
+								goto l1189
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1189
+							}
+							{
+								position1203 := position
+								if !_rules[ruleStringLike]() {
+									goto l1189
+								}
+								add(rulePegText, position1203)
+							}
+							{
+								add(ruleAction77, position)
+							}
+						}
+					}
+
+					add(ruleWorldSetParam, position1193)
+				}
+			l1191:
+				{
+					position1192, tokenIndex1192 := position, tokenIndex
+					{
+						position1205 := position
+						{
+							switch buffer[position] {
+							case 'v':
+								if !_rules[ruleVERSION]() {
+									goto l1192
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1192
+								}
+								{
+									position1207 := position
+									if !_rules[ruleNumber]() {
+										goto l1192
+									}
+									add(rulePegText, position1207)
+								}
+								{
+									add(ruleAction81, position)
+								}
+							case 't':
+								if !_rules[ruleTHEME]() {
+									goto l1192
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1192
+								}
+								{
+									position1209 := position
+									if !_rules[ruleThemeName]() {
+										goto l1192
+									}
+									add(rulePegText, position1209)
+								}
+								{
+									add(ruleAction80, position)
+								}
+							case 'e':
+								if !_rules[ruleEXPANDED]() {
+									goto l1192
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1192
+								}
+								{
+									position1211 := position
+									if !_rules[ruleStringLike]() {
+										goto l1192
+									}
+									add(rulePegText, position1211)
+								}
+								{
+									add(ruleAction79, position)
+								}
+							case 'i':
+								if !_rules[ruleID]() {
+									goto l1192
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1192
+								}
+								{
+									position1213 := position
+									if !_rules[ruleStringLike]() {
+										goto l1192
+									}
+									add(rulePegText, position1213)
+								}
+								{
+									add(ruleAction78, position)
+								}
+							default:
+								if !_rules[ruleNAME]() {
+									goto l1192
+								}
+								if !_rules[ruleEQUALS]() {
+									goto l1192
+								}
+								{
+									position1215 := position
+									if !_rules[ruleStringLike]() {
+										goto l1192
+									}
+									add(rulePegText, position1215)
+								}
+								{
+									add(ruleAction77, position)
+								}
+							}
+						}
+
+						add(ruleWorldSetParam, position1205)
+					}
+					goto l1191
+				l1192:
+					position, tokenIndex = position1192, tokenIndex1192
+				}
+				add(ruleWorldSetParams, position1190)
+			}
+			return true
+		l1189:
+			position, tokenIndex = position1189, tokenIndex1189
+			return false
+		},
+		/* 87 WorldParamVersion <- <(VERSION EQUALS <Number> Action72)> */
+		nil,
+		/* 88 WorldParamId <- <(ID EQUALS <StringLike> Action73)> */
+		nil,
+		/* 89 WorldParamName <- <(NAME EQUALS <StringLike?> Action74)> */
+		nil,
+		/* 90 WorldParamExpanded <- <(EXPANDED EQUALS <StringLike?> Action75)> */
+		nil,
+		/* 91 WorldParamTheme <- <(THEME EQUALS <ThemeName> Action76)> */
+		nil,
+		/* 92 WorldSetParam <- <((&('v') (VERSION EQUALS <Number> Action81)) | (&('t') (THEME EQUALS <ThemeName> Action80)) | (&('e') (EXPANDED EQUALS <StringLike> Action79)) | (&('i') (ID EQUALS <StringLike> Action78)) | (&('n') (NAME EQUALS <StringLike> Action77)))> */
+		nil,
+		/* 93 ItemParam <- <((EXTERNAL EQUALS <Boolean> Action82) / (TYPE EQUALS <ItemType> Action83) / (STATUS EQUALS <LifecycleStatus> Action87) / (ARCHIVED EQUALS <Boolean> Action88) / (CONTACTS EQUALS <StringLike> Action90) / ((&('t') (TAGS EQUALS <StringLike> Action94)) | (&('b') (BOUNDARY EQUALS <StringLike> Action93)) | (&('c') (CLASSIFICATION EQUALS <StringLike> Action92)) | (&('s') (SOURCE EQUALS <StringLike> Action91)) | (&('o') (OWNER EQUALS <StringLike> Action89)) | (&('e') (EXPANDED EQUALS <StringLike> Action86)) | (&('m') (MECHANISM EQUALS <StringLike> Action85)) | (&('n') (NAME EQUALS <StringLike> Action84)) | (&('a' | 'd' | 'r') LinkParam)))> */
+		nil,
+		/* 94 RelParam <- <((ASYNC EQUALS <Boolean> Action97) / ((&('c') (CLASSIFICATION EQUALS <StringLike> Action100)) | (&('s') (STATUS EQUALS <LifecycleStatus> Action99)) | (&('e') (EXPANDED EQUALS <StringLike> Action98)) | (&('m') (MECHANISM EQUALS <StringLike> Action96)) | (&('v') (VERB EQUALS <StringLike> Action95)) | (&('a' | 'd' | 'r') LinkParam)))> */
+		nil,
+		/* 95 NodeParam <- <((&('p') (PARENT EQUALS <StringLike> Action104)) | (&('m') (MECHANISM EQUALS <StringLike> Action103)) | (&('n') (NAME EQUALS <StringLike> Action102)) | (&('k') (KIND EQUALS <DeploymentKind> Action101)))> */
+		nil,
+		/* 96 ScenarioParam <- <(NAME EQUALS <StringLike> Action105)> */
+		nil,
+		/* 97 StepParam <- <((LABEL EQUALS <StringLike> Action106) / (ASYNC EQUALS <Boolean> Action107) / (AT EQUALS <Number> Action108))> */
+		nil,
+		/* 98 ViewParam <- <((FILTER EQUALS <StringLike> Action111) / ((&('h') (HOPS EQUALS <Number> Action113)) | (&('f') (FOCUS EQUALS <StringLike> Action112)) | (&('e') (EXPAND EQUALS <StringLike> Action110)) | (&('n') (NAME EQUALS <StringLike> Action109))))> */
+		nil,
+		/* 99 PinParam <- <((WIDTH EQUALS <Decimal> Action116) / ((&('v') PinView) | (&('w') (WAYPOINTS EQUALS <StringLike> Action118)) | (&('h') (HEIGHT EQUALS <Decimal> Action117)) | (&('y') (Y EQUALS <Decimal> Action115)) | (&('x') (X EQUALS <Decimal> Action114))))> */
+		func() bool {
+			position1229, tokenIndex1229 := position, tokenIndex
+			{
+				position1230 := position
+				{
+					position1231, tokenIndex1231 := position, tokenIndex
+					{
+						position1233 := position
+						if buffer[position] != rune('w') {
+							goto l1232
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l1232
+						}
+						position++
+						if buffer[position] != rune('d') {
+							goto l1232
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l1232
+						}
+						position++
+						if buffer[position] != rune('h') {
+							goto l1232
+						}
+						position++
+						add(ruleWIDTH, position1233)
+					}
+					if !_rules[ruleEQUALS]() {
+						goto l1232
+					}
+					{
+						position1234 := position
+						if !_rules[ruleDecimal]() {
+							goto l1232
+						}
+						add(rulePegText, position1234)
+					}
+					{
+						add(ruleAction116, position)
+					}
+					goto l1231
+				l1232:
+					position, tokenIndex = position1231, tokenIndex1231
+					{
+						switch buffer[position] {
+						case 'v':
+							if !_rules[rulePinView]() {
+								goto l1229
+							}
+						case 'w':
+							{
+								position1237 := position
+								if buffer[position] != rune('w') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('y') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l1229
+								}
+								position++
+								add(ruleWAYPOINTS, position1237)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1229
+							}
+							{
+								position1238 := position
+								if !_rules[ruleStringLike]() {
+									goto l1229
+								}
+								add(rulePegText, position1238)
+							}
+							{
+								add(ruleAction118, position)
+							}
+						case 'h':
+							{
+								position1240 := position
+								if buffer[position] != rune('h') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('g') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l1229
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1229
+								}
+								position++
+								add(ruleHEIGHT, position1240)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1229
+							}
+							{
+								position1241 := position
+								if !_rules[ruleDecimal]() {
+									goto l1229
+								}
+								add(rulePegText, position1241)
+							}
+							{
+								add(ruleAction117, position)
+							}
+						case 'y':
+							{
+								position1243 := position
+								if buffer[position] != rune('y') {
+									goto l1229
+								}
+								position++
+								add(ruleY, position1243)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1229
+							}
+							{
+								position1244 := position
+								if !_rules[ruleDecimal]() {
+									goto l1229
+								}
+								add(rulePegText, position1244)
+							}
+							{
+								add(ruleAction115, position)
+							}
+						default:
+							{
+								position1246 := position
+								if buffer[position] != rune('x') {
+									goto l1229
+								}
+								position++
+								add(ruleX, position1246)
+							}
+							if !_rules[ruleEQUALS]() {
+								goto l1229
+							}
+							{
+								position1247 := position
+								if !_rules[ruleDecimal]() {
+									goto l1229
+								}
+								add(rulePegText, position1247)
+							}
+							{
+								add(ruleAction114, position)
+							}
+						}
+					}
+
+				}
+			l1231:
+				add(rulePinParam, position1230)
+			}
+			return true
+		l1229:
+			position, tokenIndex = position1229, tokenIndex1229
+			return false
+		},
+		/* 100 StyleParam <- <((&('l') (LINE EQUALS <StringLike> Action124)) | (&('b') (BORDER EQUALS <StringLike> Action123)) | (&('c') (COLOR EQUALS <StringLike> Action122)) | (&('s') (SHAPE EQUALS <StringLike> Action121)) | (&('m') (MATCH EQUALS <StringLike> Action120)) | (&('n') (NAME EQUALS <StringLike> Action119)))> */
+		nil,
+		/* 101 PinView <- <(VIEW EQUALS <StringLike> Action125)> */
+		func() bool {
+			position1250, tokenIndex1250 := position, tokenIndex
+			{
+				position1251 := position
+				if !_rules[ruleVIEW]() {
+					goto l1250
+				}
+				if !_rules[ruleEQUALS]() {
+					goto l1250
+				}
+				{
+					position1252 := position
+					if !_rules[ruleStringLike]() {
+						goto l1250
+					}
+					add(rulePegText, position1252)
+				}
+				{
+					add(ruleAction125, position)
+				}
+				add(rulePinView, position1251)
+			}
+			return true
+		l1250:
+			position, tokenIndex = position1250, tokenIndex1250
+			return false
+		},
+		/* 102 LinkParam <- <(LinkKind EQUALS LinkTarget)> */
+		func() bool {
+			position1254, tokenIndex1254 := position, tokenIndex
+			{
+				position1255 := position
+				{
+					position1256 := position
+					{
+						position1257 := position
+						{
+							position1258, tokenIndex1258 := position, tokenIndex
+							{
+								position1260 := position
+								if buffer[position] != rune('r') {
+									goto l1259
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l1259
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1259
+								}
+								position++
+								if buffer[position] != rune('b') {
+									goto l1259
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l1259
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l1259
+								}
+								position++
+								if buffer[position] != rune('k') {
+									goto l1259
+								}
+								position++
+								add(ruleRUNBOOK, position1260)
+							}
+							goto l1258
+						l1259:
+							position, tokenIndex = position1258, tokenIndex1258
+							{
+								position1262 := position
+								if buffer[position] != rune('a') {
+									goto l1261
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l1261
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1261
+								}
+								position++
+								add(ruleADR, position1262)
+							}
+							goto l1258
+						l1261:
+							position, tokenIndex = position1258, tokenIndex1258
+							{
+								switch buffer[position] {
+								case 'a':
+									{
+										position1264 := position
+										if buffer[position] != rune('a') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('p') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('-') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('p') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('c') {
+											goto l1254
+										}
+										position++
+										add(ruleAPI_SPEC, position1264)
+									}
+								case 'r':
+									{
+										position1265 := position
+										if buffer[position] != rune('r') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('p') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l1254
+										}
+										position++
+										add(ruleREPO, position1265)
+									}
+								default:
+									{
+										position1266 := position
+										if buffer[position] != rune('d') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('h') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('b') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l1254
+										}
+										position++
+										if buffer[position] != rune('d') {
+											goto l1254
+										}
+										position++
+										add(ruleDASHBOARD, position1266)
+									}
+								}
+							}
+
+						}
+					l1258:
+						add(rulePegText, position1257)
+					}
+					{
+						add(ruleAction126, position)
+					}
+					add(ruleLinkKind, position1256)
+				}
+				if !_rules[ruleEQUALS]() {
+					goto l1254
+				}
+				{
+					position1268 := position
+					{
+						position1269 := position
+						if !_rules[ruleStringLike]() {
+							goto l1254
+						}
+						add(rulePegText, position1269)
+					}
+					{
+						add(ruleAction127, position)
+					}
+					add(ruleLinkTarget, position1268)
+				}
+				add(ruleLinkParam, position1255)
+			}
+			return true
+		l1254:
+			position, tokenIndex = position1254, tokenIndex1254
+			return false
+		},
+		/* 103 LinkKind <- <(<(RUNBOOK / ADR / ((&('a') API_SPEC) | (&('r') REPO) | (&('d') DASHBOARD)))> Action126)> */
+		nil,
+		/* 104 LinkTarget <- <(<StringLike> Action127)> */
+		nil,
+		/* 105 ItemKeys <- <ItemKey+> */
+		func() bool {
+			position1273, tokenIndex1273 := position, tokenIndex
+			{
+				position1274 := position
+				{
+					position1277 := position
+					{
+						position1278 := position
+						{
+							position1279, tokenIndex1279 := position, tokenIndex
+							if !_rules[ruleTYPE]() {
+								goto l1280
+							}
+							goto l1279
+						l1280:
+							position, tokenIndex = position1279, tokenIndex1279
+							if !_rules[ruleEXTERNAL]() {
+								goto l1281
+							}
+							goto l1279
+						l1281:
+							position, tokenIndex = position1279, tokenIndex1279
+							if !_rules[ruleSTATUS]() {
+								goto l1282
+							}
+							goto l1279
+						l1282:
+							position, tokenIndex = position1279, tokenIndex1279
+							if !_rules[ruleCONTACTS]() {
+								goto l1283
+							}
+							goto l1279
+						l1283:
+							position, tokenIndex = position1279, tokenIndex1279
+							{
+								switch buffer[position] {
+								case 't':
+									if !_rules[ruleTAGS]() {
+										goto l1273
+									}
+								case 'b':
+									if !_rules[ruleBOUNDARY]() {
+										goto l1273
+									}
+								case 'c':
+									if !_rules[ruleCLASSIFICATION]() {
+										goto l1273
+									}
+								case 'l':
+									if !_rules[ruleLINKS]() {
+										goto l1273
+									}
+								case 's':
+									if !_rules[ruleSOURCE]() {
+										goto l1273
+									}
+								case 'o':
+									if !_rules[ruleOWNER]() {
+										goto l1273
+									}
+								case 'a':
+									if !_rules[ruleARCHIVED]() {
+										goto l1273
+									}
+								case 'e':
+									if !_rules[ruleEXPANDED]() {
+										goto l1273
+									}
+								case 'm':
+									if !_rules[ruleMECHANISM]() {
+										goto l1273
+									}
+								default:
+									if !_rules[ruleNAME]() {
+										goto l1273
+									}
+								}
+							}
+
+						}
+					l1279:
+						add(rulePegText, position1278)
+					}
+					if !_rules[rule_]() {
+						goto l1273
+					}
+					{
+						add(ruleAction128, position)
+					}
+					add(ruleItemKey, position1277)
+				}
+			l1275:
+				{
+					position1276, tokenIndex1276 := position, tokenIndex
+					{
+						position1286 := position
+						{
+							position1287 := position
+							{
+								position1288, tokenIndex1288 := position, tokenIndex
+								if !_rules[ruleTYPE]() {
+									goto l1289
+								}
+								goto l1288
+							l1289:
+								position, tokenIndex = position1288, tokenIndex1288
+								if !_rules[ruleEXTERNAL]() {
+									goto l1290
+								}
+								goto l1288
+							l1290:
+								position, tokenIndex = position1288, tokenIndex1288
+								if !_rules[ruleSTATUS]() {
+									goto l1291
+								}
+								goto l1288
+							l1291:
+								position, tokenIndex = position1288, tokenIndex1288
+								if !_rules[ruleCONTACTS]() {
+									goto l1292
+								}
+								goto l1288
+							l1292:
+								position, tokenIndex = position1288, tokenIndex1288
+								{
+									switch buffer[position] {
+									case 't':
+										if !_rules[ruleTAGS]() {
+											goto l1276
+										}
+									case 'b':
+										if !_rules[ruleBOUNDARY]() {
+											goto l1276
+										}
+									case 'c':
+										if !_rules[ruleCLASSIFICATION]() {
+											goto l1276
+										}
+									case 'l':
+										if !_rules[ruleLINKS]() {
+											goto l1276
+										}
+									case 's':
+										if !_rules[ruleSOURCE]() {
+											goto l1276
+										}
+									case 'o':
+										if !_rules[ruleOWNER]() {
+											goto l1276
+										}
+									case 'a':
+										if !_rules[ruleARCHIVED]() {
+											goto l1276
+										}
+									case 'e':
+										if !_rules[ruleEXPANDED]() {
+											goto l1276
+										}
+									case 'm':
+										if !_rules[ruleMECHANISM]() {
+											goto l1276
+										}
+									default:
+										if !_rules[ruleNAME]() {
+											goto l1276
+										}
+									}
+								}
+
+							}
+						l1288:
+							add(rulePegText, position1287)
+						}
+						if !_rules[rule_]() {
+							goto l1276
+						}
+						{
+							add(ruleAction128, position)
+						}
+						add(ruleItemKey, position1286)
+					}
+					goto l1275
+				l1276:
+					position, tokenIndex = position1276, tokenIndex1276
+				}
+				add(ruleItemKeys, position1274)
+			}
+			return true
+		l1273:
+			position, tokenIndex = position1273, tokenIndex1273
+			return false
+		},
+		/* 106 RelKeys <- <RelKey+> */
+		nil,
+		/* 107 ItemKey <- <(<(TYPE / EXTERNAL / STATUS / CONTACTS / ((&('t') TAGS) | (&('b') BOUNDARY) | (&('c') CLASSIFICATION) | (&('l') LINKS) | (&('s') SOURCE) | (&('o') OWNER) | (&('a') ARCHIVED) | (&('e') EXPANDED) | (&('m') MECHANISM) | (&('n') NAME)))> _ Action128)> */
+		nil,
+		/* 108 RelKey <- <(<((&('c') CLASSIFICATION) | (&('l') LINKS) | (&('s') STATUS) | (&('e') EXPANDED) | (&('a') ASYNC) | (&('m') MECHANISM) | (&('v') VERB))> _ Action129)> */
+		nil,
+		/* 109 StringLike <- <(<(Text / QuotedText)> _ Action130)> */
+		func() bool {
+			position1298, tokenIndex1298 := position, tokenIndex
+			{
+				position1299 := position
+				{
+					position1300 := position
+					{
+						position1301, tokenIndex1301 := position, tokenIndex
+						if !_rules[ruleText]() {
+							goto l1302
+						}
+						goto l1301
+					l1302:
+						position, tokenIndex = position1301, tokenIndex1301
+						if !_rules[ruleQuotedText]() {
+							goto l1298
+						}
+					}
+				l1301:
+					add(rulePegText, position1300)
+				}
+				if !_rules[rule_]() {
+					goto l1298
+				}
+				{
+					add(ruleAction130, position)
+				}
+				add(ruleStringLike, position1299)
+			}
+			return true
+		l1298:
+			position, tokenIndex = position1298, tokenIndex1298
+			return false
+		},
+		/* 110 Number <- <(<[0-9]+> _ Action131)> */
+		func() bool {
+			position1304, tokenIndex1304 := position, tokenIndex
+			{
+				position1305 := position
+				{
+					position1306 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1304
+					}
+					position++
+				l1307:
+					{
+						position1308, tokenIndex1308 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1308
+						}
+						position++
+						goto l1307
+					l1308:
+						position, tokenIndex = position1308, tokenIndex1308
+					}
+					add(rulePegText, position1306)
+				}
+				if !_rules[rule_]() {
+					goto l1304
+				}
+				{
+					add(ruleAction131, position)
+				}
+				add(ruleNumber, position1305)
+			}
+			return true
+		l1304:
+			position, tokenIndex = position1304, tokenIndex1304
+			return false
+		},
+		/* 111 Decimal <- <(<('-'? [0-9]+ ('.' [0-9]+)?)> _)> */
+		func() bool {
+			position1310, tokenIndex1310 := position, tokenIndex
+			{
+				position1311 := position
+				{
+					position1312 := position
+					{
+						position1313, tokenIndex1313 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l1313
+						}
+						position++
+						goto l1314
+					l1313:
+						position, tokenIndex = position1313, tokenIndex1313
+					}
+				l1314:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1310
+					}
+					position++
+				l1315:
+					{
+						position1316, tokenIndex1316 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1316
+						}
+						position++
+						goto l1315
+					l1316:
+						position, tokenIndex = position1316, tokenIndex1316
+					}
+					{
+						position1317, tokenIndex1317 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l1317
+						}
+						position++
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1317
+						}
+						position++
+					l1319:
+						{
+							position1320, tokenIndex1320 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1320
+							}
+							position++
+							goto l1319
+						l1320:
+							position, tokenIndex = position1320, tokenIndex1320
+						}
+						goto l1318
+					l1317:
+						position, tokenIndex = position1317, tokenIndex1317
+					}
+				l1318:
+					add(rulePegText, position1312)
+				}
+				if !_rules[rule_]() {
+					goto l1310
+				}
+				add(ruleDecimal, position1311)
+			}
+			return true
+		l1310:
+			position, tokenIndex = position1310, tokenIndex1310
+			return false
+		},
+		/* 112 Boolean <- <(<(TRUE / FALSE)> Action132)> */
+		func() bool {
+			position1321, tokenIndex1321 := position, tokenIndex
+			{
+				position1322 := position
+				{
+					position1323 := position
+					{
+						position1324, tokenIndex1324 := position, tokenIndex
+						{
+							position1326 := position
+							if buffer[position] != rune('t') {
+								goto l1325
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1325
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l1325
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1325
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l1325
+							}
+							add(ruleTRUE, position1326)
+						}
+						goto l1324
+					l1325:
+						position, tokenIndex = position1324, tokenIndex1324
+						{
+							position1327 := position
+							if buffer[position] != rune('f') {
+								goto l1321
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1321
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1321
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l1321
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1321
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l1321
+							}
+							add(ruleFALSE, position1327)
+						}
+					}
+				l1324:
+					add(rulePegText, position1323)
+				}
+				{
+					add(ruleAction132, position)
+				}
+				add(ruleBoolean, position1322)
+			}
+			return true
+		l1321:
+			position, tokenIndex = position1321, tokenIndex1321
+			return false
+		},
+		/* 113 Text <- <TextChar+> */
+		func() bool {
+			position1329, tokenIndex1329 := position, tokenIndex
+			{
+				position1330 := position
+				if !_rules[ruleTextChar]() {
+					goto l1329
+				}
+			l1331:
+				{
+					position1332, tokenIndex1332 := position, tokenIndex
+					if !_rules[ruleTextChar]() {
+						goto l1332
+					}
+					goto l1331
+				l1332:
+					position, tokenIndex = position1332, tokenIndex1332
+				}
+				add(ruleText, position1330)
+			}
+			return true
+		l1329:
+			position, tokenIndex = position1329, tokenIndex1329
+			return false
+		},
+		/* 114 TextChar <- <((&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		func() bool {
+			position1333, tokenIndex1333 := position, tokenIndex
+			{
+				position1334 := position
+				{
+					switch buffer[position] {
+					case '.':
+						if buffer[position] != rune('.') {
+							goto l1333
+						}
+						position++
+					case '_':
+						if buffer[position] != rune('_') {
+							goto l1333
+						}
+						position++
+					case '-':
+						if buffer[position] != rune('-') {
+							goto l1333
+						}
+						position++
+					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1333
+						}
+						position++
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1333
+						}
+						position++
+					default:
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1333
+						}
+						position++
+					}
+				}
 
-											}
-										l289:
-											add(ruleItemType, position288)
-										}
-										add(rulePegText, position287)
-									}
-									{
-										add(ruleAction25, position)
-									}
-								}
+				add(ruleTextChar, position1334)
+			}
+			return true
+		l1333:
+			position, tokenIndex = position1333, tokenIndex1333
+			return false
+		},
+		/* 115 QuotedText <- <(QUOTE ((&(' ') ' ') | (&('`') '`') | (&('\'') '\'') | (&('>') '>') | (&('<') '<') | (&('?') '?') | (&('/') '/') | (&(':') ':') | (&(';') ';') | (&('~') '~') | (&('=') '=') | (&('+') '+') | (&(']') ']') | (&('[') '[') | (&(')') ')') | (&('(') '(') | (&('*') '*') | (&('&') '&') | (&('^') '^') | (&('%') '%') | (&('$') '$') | (&('#') '#') | (&('@') '@') | (&('!') '!') | (&(',') ',') | (&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))* QUOTE)> */
+		func() bool {
+			position1336, tokenIndex1336 := position, tokenIndex
+			{
+				position1337 := position
+				if !_rules[ruleQUOTE]() {
+					goto l1336
+				}
+			l1338:
+				{
+					position1339, tokenIndex1339 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case ' ':
+							if buffer[position] != rune(' ') {
+								goto l1339
+							}
+							position++
+						case '`':
+							if buffer[position] != rune('`') {
+								goto l1339
+							}
+							position++
+						case '\'':
+							if buffer[position] != rune('\'') {
+								goto l1339
+							}
+							position++
+						case '>':
+							if buffer[position] != rune('>') {
+								goto l1339
+							}
+							position++
+						case '<':
+							if buffer[position] != rune('<') {
+								goto l1339
+							}
+							position++
+						case '?':
+							if buffer[position] != rune('?') {
+								goto l1339
+							}
+							position++
+						case '/':
+							if buffer[position] != rune('/') {
+								goto l1339
+							}
+							position++
+						case ':':
+							if buffer[position] != rune(':') {
+								goto l1339
+							}
+							position++
+						case ';':
+							if buffer[position] != rune(';') {
+								goto l1339
+							}
+							position++
+						case '~':
+							if buffer[position] != rune('~') {
+								goto l1339
+							}
+							position++
+						case '=':
+							if buffer[position] != rune('=') {
+								goto l1339
+							}
+							position++
+						case '+':
+							if buffer[position] != rune('+') {
+								goto l1339
+							}
+							position++
+						case ']':
+							if buffer[position] != rune(']') {
+								goto l1339
+							}
+							position++
+						case '[':
+							if buffer[position] != rune('[') {
+								goto l1339
+							}
+							position++
+						case ')':
+							if buffer[position] != rune(')') {
+								goto l1339
+							}
+							position++
+						case '(':
+							if buffer[position] != rune('(') {
+								goto l1339
+							}
+							position++
+						case '*':
+							if buffer[position] != rune('*') {
+								goto l1339
+							}
+							position++
+						case '&':
+							if buffer[position] != rune('&') {
+								goto l1339
+							}
+							position++
+						case '^':
+							if buffer[position] != rune('^') {
+								goto l1339
+							}
+							position++
+						case '%':
+							if buffer[position] != rune('%') {
+								goto l1339
+							}
+							position++
+						case '$':
+							if buffer[position] != rune('$') {
+								goto l1339
+							}
+							position++
+						case '#':
+							if buffer[position] != rune('#') {
+								goto l1339
+							}
+							position++
+						case '@':
+							if buffer[position] != rune('@') {
+								goto l1339
+							}
+							position++
+						case '!':
+							if buffer[position] != rune('!') {
+								goto l1339
+							}
+							position++
+						case ',':
+							if buffer[position] != rune(',') {
+								goto l1339
+							}
+							position++
+						case '.':
+							if buffer[position] != rune('.') {
+								goto l1339
+							}
+							position++
+						case '_':
+							if buffer[position] != rune('_') {
+								goto l1339
+							}
+							position++
+						case '-':
+							if buffer[position] != rune('-') {
+								goto l1339
+							}
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1339
+							}
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l1339
 							}
+							position++
+						default:
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l1339
+							}
+							position++
+						}
+					}
 
+					goto l1338
+				l1339:
+					position, tokenIndex = position1339, tokenIndex1339
+				}
+				if !_rules[ruleQUOTE]() {
+					goto l1336
+				}
+				add(ruleQuotedText, position1337)
+			}
+			return true
+		l1336:
+			position, tokenIndex = position1336, tokenIndex1336
+			return false
+		},
+		/* 116 ItemExists <- <((ITEM_EXISTS / (Item Exists)) Action133)> */
+		nil,
+		/* 117 RelExists <- <((REL_EXISTS / (Rel Exists)) Action134)> */
+		nil,
+		/* 118 World <- <(WORLD Action135)> */
+		func() bool {
+			position1343, tokenIndex1343 := position, tokenIndex
+			{
+				position1344 := position
+				if !_rules[ruleWORLD]() {
+					goto l1343
+				}
+				{
+					add(ruleAction135, position)
+				}
+				add(ruleWorld, position1344)
+			}
+			return true
+		l1343:
+			position, tokenIndex = position1343, tokenIndex1343
+			return false
+		},
+		/* 119 Node <- <(NODE Action136)> */
+		func() bool {
+			position1346, tokenIndex1346 := position, tokenIndex
+			{
+				position1347 := position
+				{
+					position1348 := position
+					if buffer[position] != rune('n') {
+						goto l1346
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1346
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l1346
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1346
+					}
+					position++
+					{
+						position1349, tokenIndex1349 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1349
+						}
+						position++
+						goto l1350
+					l1349:
+						position, tokenIndex = position1349, tokenIndex1349
+					}
+				l1350:
+					{
+						position1351, tokenIndex1351 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1351
+						}
+						goto l1346
+					l1351:
+						position, tokenIndex = position1351, tokenIndex1351
+					}
+					if !_rules[rule_]() {
+						goto l1346
+					}
+					add(ruleNODE, position1348)
+				}
+				{
+					add(ruleAction136, position)
+				}
+				add(ruleNode, position1347)
+			}
+			return true
+		l1346:
+			position, tokenIndex = position1346, tokenIndex1346
+			return false
+		},
+		/* 120 Scenario <- <(SCENARIO Action137)> */
+		func() bool {
+			position1353, tokenIndex1353 := position, tokenIndex
+			{
+				position1354 := position
+				{
+					position1355 := position
+					if buffer[position] != rune('s') {
+						goto l1353
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l1353
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1353
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1353
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l1353
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l1353
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1353
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1353
+					}
+					position++
+					{
+						position1356, tokenIndex1356 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1356
+						}
+						position++
+						goto l1357
+					l1356:
+						position, tokenIndex = position1356, tokenIndex1356
+					}
+				l1357:
+					{
+						position1358, tokenIndex1358 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1358
+						}
+						goto l1353
+					l1358:
+						position, tokenIndex = position1358, tokenIndex1358
+					}
+					if !_rules[rule_]() {
+						goto l1353
+					}
+					add(ruleSCENARIO, position1355)
+				}
+				{
+					add(ruleAction137, position)
+				}
+				add(ruleScenario, position1354)
+			}
+			return true
+		l1353:
+			position, tokenIndex = position1353, tokenIndex1353
+			return false
+		},
+		/* 121 View <- <(VIEW Action138)> */
+		func() bool {
+			position1360, tokenIndex1360 := position, tokenIndex
+			{
+				position1361 := position
+				if !_rules[ruleVIEW]() {
+					goto l1360
+				}
+				{
+					add(ruleAction138, position)
+				}
+				add(ruleView, position1361)
+			}
+			return true
+		l1360:
+			position, tokenIndex = position1360, tokenIndex1360
+			return false
+		},
+		/* 122 Style <- <(STYLE Action139)> */
+		func() bool {
+			position1363, tokenIndex1363 := position, tokenIndex
+			{
+				position1364 := position
+				{
+					position1365 := position
+					if buffer[position] != rune('s') {
+						goto l1363
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1363
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l1363
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1363
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1363
+					}
+					position++
+					{
+						position1366, tokenIndex1366 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1366
+						}
+						position++
+						goto l1367
+					l1366:
+						position, tokenIndex = position1366, tokenIndex1366
+					}
+				l1367:
+					{
+						position1368, tokenIndex1368 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1368
+						}
+						goto l1363
+					l1368:
+						position, tokenIndex = position1368, tokenIndex1368
+					}
+					if !_rules[rule_]() {
+						goto l1363
+					}
+					add(ruleSTYLE, position1365)
+				}
+				{
+					add(ruleAction139, position)
+				}
+				add(ruleStyle, position1364)
+			}
+			return true
+		l1363:
+			position, tokenIndex = position1363, tokenIndex1363
+			return false
+		},
+		/* 123 Layout <- <(LAYOUT Action140)> */
+		func() bool {
+			position1370, tokenIndex1370 := position, tokenIndex
+			{
+				position1371 := position
+				{
+					position1372 := position
+					if buffer[position] != rune('l') {
+						goto l1370
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l1370
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l1370
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1370
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l1370
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1370
+					}
+					position++
+					{
+						position1373, tokenIndex1373 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1373
+						}
+						goto l1370
+					l1373:
+						position, tokenIndex = position1373, tokenIndex1373
+					}
+					if !_rules[rule_]() {
+						goto l1370
+					}
+					add(ruleLAYOUT, position1372)
+				}
+				{
+					add(ruleAction140, position)
+				}
+				add(ruleLayout, position1371)
+			}
+			return true
+		l1370:
+			position, tokenIndex = position1370, tokenIndex1370
+			return false
+		},
+		/* 124 Item <- <(ITEM Action141)> */
+		func() bool {
+			position1375, tokenIndex1375 := position, tokenIndex
+			{
+				position1376 := position
+				{
+					position1377 := position
+					if buffer[position] != rune('i') {
+						goto l1375
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1375
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1375
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l1375
+					}
+					position++
+					{
+						position1378, tokenIndex1378 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1378
+						}
+						position++
+						goto l1379
+					l1378:
+						position, tokenIndex = position1378, tokenIndex1378
+					}
+				l1379:
+					{
+						position1380, tokenIndex1380 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1380
+						}
+						goto l1375
+					l1380:
+						position, tokenIndex = position1380, tokenIndex1380
+					}
+					if !_rules[rule_]() {
+						goto l1375
+					}
+					add(ruleITEM, position1377)
+				}
+				{
+					add(ruleAction141, position)
+				}
+				add(ruleItem, position1376)
+			}
+			return true
+		l1375:
+			position, tokenIndex = position1375, tokenIndex1375
+			return false
+		},
+		/* 125 Rel <- <(REL Action142)> */
+		func() bool {
+			position1382, tokenIndex1382 := position, tokenIndex
+			{
+				position1383 := position
+				{
+					position1384 := position
+					if buffer[position] != rune('r') {
+						goto l1382
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1382
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1382
+					}
+					position++
+					{
+						position1385, tokenIndex1385 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1385
+						}
+						position++
+						goto l1386
+					l1385:
+						position, tokenIndex = position1385, tokenIndex1385
+					}
+				l1386:
+					{
+						position1387, tokenIndex1387 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1387
+						}
+						goto l1382
+					l1387:
+						position, tokenIndex = position1387, tokenIndex1387
+					}
+					if !_rules[rule_]() {
+						goto l1382
+					}
+					add(ruleREL, position1384)
+				}
+				{
+					add(ruleAction142, position)
+				}
+				add(ruleRel, position1383)
+			}
+			return true
+		l1382:
+			position, tokenIndex = position1382, tokenIndex1382
+			return false
+		},
+		/* 126 Create <- <(CREATE Action143)> */
+		func() bool {
+			position1389, tokenIndex1389 := position, tokenIndex
+			{
+				position1390 := position
+				{
+					position1391 := position
+					if buffer[position] != rune('c') {
+						goto l1389
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l1389
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1389
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l1389
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1389
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1389
+					}
+					position++
+					{
+						position1392, tokenIndex1392 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1392
+						}
+						goto l1389
+					l1392:
+						position, tokenIndex = position1392, tokenIndex1392
+					}
+					if !_rules[rule_]() {
+						goto l1389
+					}
+					add(ruleCREATE, position1391)
+				}
+				{
+					add(ruleAction143, position)
+				}
+				add(ruleCreate, position1390)
+			}
+			return true
+		l1389:
+			position, tokenIndex = position1389, tokenIndex1389
+			return false
+		},
+		/* 127 Fetch <- <(FETCH Action144)> */
+		func() bool {
+			position1394, tokenIndex1394 := position, tokenIndex
+			{
+				position1395 := position
+				{
+					position1396 := position
+					if buffer[position] != rune('f') {
+						goto l1394
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1394
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1394
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l1394
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l1394
+					}
+					position++
+					{
+						position1397, tokenIndex1397 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1397
+						}
+						goto l1394
+					l1397:
+						position, tokenIndex = position1397, tokenIndex1397
+					}
+					if !_rules[rule_]() {
+						goto l1394
+					}
+					add(ruleFETCH, position1396)
+				}
+				{
+					add(ruleAction144, position)
+				}
+				add(ruleFetch, position1395)
+			}
+			return true
+		l1394:
+			position, tokenIndex = position1394, tokenIndex1394
+			return false
+		},
+		/* 128 Set <- <(SET Action145)> */
+		func() bool {
+			position1399, tokenIndex1399 := position, tokenIndex
+			{
+				position1400 := position
+				{
+					position1401 := position
+					if buffer[position] != rune('s') {
+						goto l1399
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1399
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1399
+					}
+					position++
+					{
+						position1402, tokenIndex1402 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1402
+						}
+						goto l1399
+					l1402:
+						position, tokenIndex = position1402, tokenIndex1402
+					}
+					if !_rules[rule_]() {
+						goto l1399
+					}
+					add(ruleSET, position1401)
+				}
+				{
+					add(ruleAction145, position)
+				}
+				add(ruleSet, position1400)
+			}
+			return true
+		l1399:
+			position, tokenIndex = position1399, tokenIndex1399
+			return false
+		},
+		/* 129 Clear <- <(CLEAR Action146)> */
+		func() bool {
+			position1404, tokenIndex1404 := position, tokenIndex
+			{
+				position1405 := position
+				{
+					position1406 := position
+					if buffer[position] != rune('c') {
+						goto l1404
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1404
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1404
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l1404
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l1404
+					}
+					position++
+					{
+						position1407, tokenIndex1407 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1407
+						}
+						goto l1404
+					l1407:
+						position, tokenIndex = position1407, tokenIndex1407
+					}
+					if !_rules[rule_]() {
+						goto l1404
+					}
+					add(ruleCLEAR, position1406)
+				}
+				{
+					add(ruleAction146, position)
+				}
+				add(ruleClear, position1405)
+			}
+			return true
+		l1404:
+			position, tokenIndex = position1404, tokenIndex1404
+			return false
+		},
+		/* 130 Delete <- <(DELETE Action147)> */
+		func() bool {
+			position1409, tokenIndex1409 := position, tokenIndex
+			{
+				position1410 := position
+				{
+					position1411 := position
+					if buffer[position] != rune('d') {
+						goto l1409
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1409
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1409
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1409
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1409
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1409
+					}
+					position++
+					{
+						position1412, tokenIndex1412 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1412
+						}
+						goto l1409
+					l1412:
+						position, tokenIndex = position1412, tokenIndex1412
+					}
+					if !_rules[rule_]() {
+						goto l1409
+					}
+					add(ruleDELETE, position1411)
+				}
+				{
+					add(ruleAction147, position)
+				}
+				add(ruleDelete, position1410)
+			}
+			return true
+		l1409:
+			position, tokenIndex = position1409, tokenIndex1409
+			return false
+		},
+		/* 131 List <- <(LIST Action148)> */
+		func() bool {
+			position1414, tokenIndex1414 := position, tokenIndex
+			{
+				position1415 := position
+				{
+					position1416 := position
+					if buffer[position] != rune('l') {
+						goto l1414
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1414
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1414
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1414
+					}
+					position++
+					{
+						position1417, tokenIndex1417 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1417
 						}
-					l276:
-						add(ruleItemParam, position275)
+						goto l1414
+					l1417:
+						position, tokenIndex = position1417, tokenIndex1417
 					}
-					goto l245
-				l246:
-					position, tokenIndex = position246, tokenIndex246
+					if !_rules[rule_]() {
+						goto l1414
+					}
+					add(ruleLIST, position1416)
+				}
+				{
+					add(ruleAction148, position)
 				}
-				add(ruleItemParams, position244)
+				add(ruleList, position1415)
 			}
 			return true
-		l243:
-			position, tokenIndex = position243, tokenIndex243
+		l1414:
+			position, tokenIndex = position1414, tokenIndex1414
 			return false
 		},
-		/* 28 RelParams <- <RelParam+> */
+		/* 132 Nest <- <(NEST Action149)> */
+		nil,
+		/* 133 Free <- <(FREE Action150)> */
+		nil,
+		/* 134 Exists <- <(EXISTS Action151)> */
 		func() bool {
-			position303, tokenIndex303 := position, tokenIndex
+			position1421, tokenIndex1421 := position, tokenIndex
 			{
-				position304 := position
+				position1422 := position
 				{
-					position307 := position
+					position1423 := position
+					if buffer[position] != rune('e') {
+						goto l1421
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l1421
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1421
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1421
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1421
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1421
+					}
+					position++
 					{
-						switch buffer[position] {
-						case 'e':
-							if !_rules[ruleEXPANDED]() {
-								goto l303
-							}
-							if !_rules[ruleEQUALS]() {
-								goto l303
-							}
-							{
-								position309 := position
-								if !_rules[ruleStringLike]() {
-									goto l303
-								}
-								add(rulePegText, position309)
-							}
-							{
-								add(ruleAction32, position)
-							}
-						case 'a':
-							if !_rules[ruleASYNC]() {
-								goto l303
-							}
-							if !_rules[ruleEQUALS]() {
-								goto l303
-							}
-							{
-								position311 := position
-								if !_rules[ruleBoolean]() {
-									goto l303
-								}
-								add(rulePegText, position311)
-							}
-							{
-								add(ruleAction31, position)
-							}
-						case 'm':
-							if !_rules[ruleMECHANISM]() {
-								goto l303
-							}
-							if !_rules[ruleEQUALS]() {
-								goto l303
-							}
-							{
-								position313 := position
-								if !_rules[ruleStringLike]() {
-									goto l303
-								}
-								add(rulePegText, position313)
-							}
-							{
-								add(ruleAction30, position)
-							}
-						default:
-							if !_rules[ruleVERB]() {
-								goto l303
-							}
-							if !_rules[ruleEQUALS]() {
-								goto l303
-							}
-							{
-								position315 := position
-								if !_rules[ruleStringLike]() {
-									goto l303
-								}
-								add(rulePegText, position315)
-							}
-							{
-								add(ruleAction29, position)
-							}
+						position1424, tokenIndex1424 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1424
 						}
+						goto l1421
+					l1424:
+						position, tokenIndex = position1424, tokenIndex1424
 					}
-
-					add(ruleRelParam, position307)
+					if !_rules[rule_]() {
+						goto l1421
+					}
+					add(ruleEXISTS, position1423)
+				}
+				{
+					add(ruleAction151, position)
+				}
+				add(ruleExists, position1422)
+			}
+			return true
+		l1421:
+			position, tokenIndex = position1421, tokenIndex1421
+			return false
+		},
+		/* 135 InQuery <- <(IN_QUERY Action152)> */
+		nil,
+		/* 136 FromQuery <- <(FROM_QUERY Action153)> */
+		nil,
+		/* 137 ToQuery <- <(TO_QUERY Action154)> */
+		nil,
+		/* 138 AncestorsQuery <- <(ANCESTORS_QUERY Action155)> */
+		nil,
+		/* 139 SiblingsQuery <- <(SIBLINGS_QUERY Action156)> */
+		nil,
+		/* 140 OwnersQuery <- <(OWNERS_QUERY Action157)> */
+		nil,
+		/* 141 DataFlowQuery <- <(DATAFLOW_QUERY Action158)> */
+		nil,
+		/* 142 OwnersImport <- <(OWNERS IMPORT Action159)> */
+		nil,
+		/* 143 CrossingsQuery <- <(CROSSINGS_QUERY Action160)> */
+		nil,
+		/* 144 ThreatsExport <- <(THREATS EXPORT Action161)> */
+		nil,
+		/* 145 DeployedQuery <- <(DEPLOYED_QUERY Action162)> */
+		func() bool {
+			position1436, tokenIndex1436 := position, tokenIndex
+			{
+				position1437 := position
+				{
+					position1438 := position
+					if buffer[position] != rune('d') {
+						goto l1436
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1436
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l1436
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1436
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1436
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l1436
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1436
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l1436
+					}
+					position++
+					if buffer[position] != rune('?') {
+						goto l1436
+					}
+					position++
+					if !_rules[rule_]() {
+						goto l1436
+					}
+					add(ruleDEPLOYED_QUERY, position1438)
+				}
+				{
+					add(ruleAction162, position)
+				}
+				add(ruleDeployedQuery, position1437)
+			}
+			return true
+		l1436:
+			position, tokenIndex = position1436, tokenIndex1436
+			return false
+		},
+		/* 146 Deploy <- <(DEPLOY Action163)> */
+		func() bool {
+			position1440, tokenIndex1440 := position, tokenIndex
+			{
+				position1441 := position
+				{
+					position1442 := position
+					if buffer[position] != rune('d') {
+						goto l1440
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1440
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l1440
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1440
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1440
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l1440
+					}
+					position++
+					{
+						position1443, tokenIndex1443 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1443
+						}
+						goto l1440
+					l1443:
+						position, tokenIndex = position1443, tokenIndex1443
+					}
+					if !_rules[rule_]() {
+						goto l1440
+					}
+					add(ruleDEPLOY, position1442)
+				}
+				{
+					add(ruleAction163, position)
 				}
-			l305:
+				add(ruleDeploy, position1441)
+			}
+			return true
+		l1440:
+			position, tokenIndex = position1440, tokenIndex1440
+			return false
+		},
+		/* 147 Undeploy <- <(UNDEPLOY Action164)> */
+		nil,
+		/* 148 Step <- <(STEP Action165)> */
+		func() bool {
+			position1446, tokenIndex1446 := position, tokenIndex
+			{
+				position1447 := position
 				{
-					position306, tokenIndex306 := position, tokenIndex
+					position1448 := position
+					if buffer[position] != rune('s') {
+						goto l1446
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1446
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1446
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l1446
+					}
+					position++
 					{
-						position317 := position
-						{
-							switch buffer[position] {
-							case 'e':
-								if !_rules[ruleEXPANDED]() {
-									goto l306
-								}
-								if !_rules[ruleEQUALS]() {
-									goto l306
-								}
-								{
-									position319 := position
-									if !_rules[ruleStringLike]() {
-										goto l306
-									}
-									add(rulePegText, position319)
-								}
-								{
-									add(ruleAction32, position)
-								}
-							case 'a':
-								if !_rules[ruleASYNC]() {
-									goto l306
-								}
-								if !_rules[ruleEQUALS]() {
-									goto l306
-								}
-								{
-									position321 := position
-									if !_rules[ruleBoolean]() {
-										goto l306
-									}
-									add(rulePegText, position321)
-								}
-								{
-									add(ruleAction31, position)
-								}
-							case 'm':
-								if !_rules[ruleMECHANISM]() {
-									goto l306
-								}
-								if !_rules[ruleEQUALS]() {
-									goto l306
-								}
-								{
-									position323 := position
-									if !_rules[ruleStringLike]() {
-										goto l306
-									}
-									add(rulePegText, position323)
-								}
-								{
-									add(ruleAction30, position)
-								}
-							default:
-								if !_rules[ruleVERB]() {
-									goto l306
-								}
-								if !_rules[ruleEQUALS]() {
-									goto l306
-								}
-								{
-									position325 := position
-									if !_rules[ruleStringLike]() {
-										goto l306
-									}
-									add(rulePegText, position325)
-								}
-								{
-									add(ruleAction29, position)
-								}
-							}
+						position1449, tokenIndex1449 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1449
 						}
-
-						add(ruleRelParam, position317)
+						goto l1446
+					l1449:
+						position, tokenIndex = position1449, tokenIndex1449
+					}
+					if !_rules[rule_]() {
+						goto l1446
+					}
+					add(ruleSTEP, position1448)
+				}
+				{
+					add(ruleAction165, position)
+				}
+				add(ruleStep, position1447)
+			}
+			return true
+		l1446:
+			position, tokenIndex = position1446, tokenIndex1446
+			return false
+		},
+		/* 149 Unstep <- <(UNSTEP Action166)> */
+		nil,
+		/* 150 Pin <- <(PIN Action167)> */
+		func() bool {
+			position1452, tokenIndex1452 := position, tokenIndex
+			{
+				position1453 := position
+				{
+					position1454 := position
+					if buffer[position] != rune('p') {
+						goto l1452
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1452
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1452
 					}
-					goto l305
-				l306:
-					position, tokenIndex = position306, tokenIndex306
+					position++
+					{
+						position1455, tokenIndex1455 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1455
+						}
+						goto l1452
+					l1455:
+						position, tokenIndex = position1455, tokenIndex1455
+					}
+					if !_rules[rule_]() {
+						goto l1452
+					}
+					add(rulePIN, position1454)
+				}
+				{
+					add(ruleAction167, position)
+				}
+				add(rulePin, position1453)
+			}
+			return true
+		l1452:
+			position, tokenIndex = position1452, tokenIndex1452
+			return false
+		},
+		/* 151 Unpin <- <(UNPIN Action168)> */
+		func() bool {
+			position1457, tokenIndex1457 := position, tokenIndex
+			{
+				position1458 := position
+				{
+					position1459 := position
+					if buffer[position] != rune('u') {
+						goto l1457
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1457
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l1457
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1457
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1457
+					}
+					position++
+					{
+						position1460, tokenIndex1460 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1460
+						}
+						goto l1457
+					l1460:
+						position, tokenIndex = position1460, tokenIndex1460
+					}
+					if !_rules[rule_]() {
+						goto l1457
+					}
+					add(ruleUNPIN, position1459)
+				}
+				{
+					add(ruleAction168, position)
 				}
-				add(ruleRelParams, position304)
+				add(ruleUnpin, position1458)
 			}
 			return true
-		l303:
-			position, tokenIndex = position303, tokenIndex303
+		l1457:
+			position, tokenIndex = position1457, tokenIndex1457
 			return false
 		},
-		/* 29 WorldParamVersion <- <(VERSION EQUALS <Number> Action20)> */
+		/* 152 TreeQuery <- <(TREE Action169)> */
+		nil,
+		/* 153 Undo <- <(UNDO Action170)> */
+		nil,
+		/* 154 Redo <- <(REDO Action171)> */
+		nil,
+		/* 155 Save <- <(SAVE Action172)> */
 		nil,
-		/* 30 WorldParamId <- <(ID EQUALS <StringLike> Action21)> */
+		/* 156 Load <- <(LOAD Action173)> */
 		nil,
-		/* 31 WorldParamName <- <(NAME EQUALS <StringLike?> Action22)> */
+		/* 157 New <- <(NEW Action174)> */
 		nil,
-		/* 32 WorldParamExpanded <- <(EXPANDED EQUALS <StringLike?> Action23)> */
+		/* 158 Use <- <(USE Action175)> */
 		nil,
-		/* 33 ItemParam <- <((EXTERNAL EQUALS <Boolean> Action24) / ((&('e') (EXPANDED EQUALS <StringLike> Action28)) | (&('m') (MECHANISM EQUALS <StringLike> Action27)) | (&('n') (NAME EQUALS <StringLike> Action26)) | (&('t') (TYPE EQUALS <ItemType> Action25))))> */
+		/* 159 Open <- <(OPEN Action176)> */
 		nil,
-		/* 34 RelParam <- <((&('e') (EXPANDED EQUALS <StringLike> Action32)) | (&('a') (ASYNC EQUALS <Boolean> Action31)) | (&('m') (MECHANISM EQUALS <StringLike> Action30)) | (&('v') (VERB EQUALS <StringLike> Action29)))> */
+		/* 160 Close <- <(CLOSE Action177)> */
 		nil,
-		/* 35 ItemKeys <- <ItemKey+> */
+		/* 161 Copy <- <(COPY Action178)> */
 		nil,
-		/* 36 RelKeys <- <RelKey+> */
+		/* 162 Clone <- <(CLONE Action179)> */
 		nil,
-		/* 37 ItemKey <- <(<(EXTERNAL / ((&('e') EXPANDED) | (&('m') MECHANISM) | (&('t') TYPE) | (&('n') NAME)))> _ Action33)> */
+		/* 163 Merge <- <(MERGE Action180)> */
 		nil,
-		/* 38 RelKey <- <(<((&('e') EXPANDED) | (&('a') ASYNC) | (&('m') MECHANISM) | (&('v') VERB))> _ Action34)> */
+		/* 164 Split <- <(SPLIT Action181)> */
 		nil,
-		/* 39 StringLike <- <(<(Text / QuotedText)> _ Action35)> */
+		/* 165 Archive <- <(ARCHIVE Action182)> */
+		nil,
+		/* 166 Restore <- <(RESTORE Action183)> */
+		nil,
+		/* 167 Link <- <(LINK Action184)> */
 		func() bool {
-			position337, tokenIndex337 := position, tokenIndex
+			position1477, tokenIndex1477 := position, tokenIndex
 			{
-				position338 := position
+				position1478 := position
 				{
-					position339 := position
+					position1479 := position
+					if buffer[position] != rune('l') {
+						goto l1477
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1477
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1477
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l1477
+					}
+					position++
 					{
-						position340, tokenIndex340 := position, tokenIndex
-						{
-							position342 := position
-							{
-								switch buffer[position] {
-								case '_':
-									if buffer[position] != rune('_') {
-										goto l341
-									}
-									position++
-								case '-':
-									if buffer[position] != rune('-') {
-										goto l341
-									}
-									position++
-								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l341
-									}
-									position++
-								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-									if c := buffer[position]; c < rune('A') || c > rune('Z') {
-										goto l341
-									}
-									position++
-								default:
-									if c := buffer[position]; c < rune('a') || c > rune('z') {
-										goto l341
-									}
-									position++
-								}
-							}
-
-						l343:
-							{
-								position344, tokenIndex344 := position, tokenIndex
-								{
-									switch buffer[position] {
-									case '_':
-										if buffer[position] != rune('_') {
-											goto l344
-										}
-										position++
-									case '-':
-										if buffer[position] != rune('-') {
-											goto l344
-										}
-										position++
-									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l344
-										}
-										position++
-									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-										if c := buffer[position]; c < rune('A') || c > rune('Z') {
-											goto l344
-										}
-										position++
-									default:
-										if c := buffer[position]; c < rune('a') || c > rune('z') {
-											goto l344
-										}
-										position++
-									}
-								}
-
-								goto l343
-							l344:
-								position, tokenIndex = position344, tokenIndex344
-							}
-							add(ruleText, position342)
-						}
-						goto l340
-					l341:
-						position, tokenIndex = position340, tokenIndex340
-						{
-							position347 := position
-							if !_rules[ruleQUOTE]() {
-								goto l337
-							}
-						l348:
-							{
-								position349, tokenIndex349 := position, tokenIndex
-								{
-									switch buffer[position] {
-									case ' ':
-										if buffer[position] != rune(' ') {
-											goto l349
-										}
-										position++
-									case ':':
-										if buffer[position] != rune(':') {
-											goto l349
-										}
-										position++
-									case ';':
-										if buffer[position] != rune(';') {
-											goto l349
-										}
-										position++
-									case '~':
-										if buffer[position] != rune('~') {
-											goto l349
-										}
-										position++
-									case '=':
-										if buffer[position] != rune('=') {
-											goto l349
-										}
-										position++
-									case '+':
-										if buffer[position] != rune('+') {
-											goto l349
-										}
-										position++
-									case ']':
-										if buffer[position] != rune(']') {
-											goto l349
-										}
-										position++
-									case '[':
-										if buffer[position] != rune('[') {
-											goto l349
-										}
-										position++
-									case ')':
-										if buffer[position] != rune(')') {
-											goto l349
-										}
-										position++
-									case '(':
-										if buffer[position] != rune('(') {
-											goto l349
-										}
-										position++
-									case '*':
-										if buffer[position] != rune('*') {
-											goto l349
-										}
-										position++
-									case '&':
-										if buffer[position] != rune('&') {
-											goto l349
-										}
-										position++
-									case '^':
-										if buffer[position] != rune('^') {
-											goto l349
-										}
-										position++
-									case '%':
-										if buffer[position] != rune('%') {
-											goto l349
-										}
-										position++
-									case '$':
-										if buffer[position] != rune('$') {
-											goto l349
-										}
-										position++
-									case '#':
-										if buffer[position] != rune('#') {
-											goto l349
-										}
-										position++
-									case '@':
-										if buffer[position] != rune('@') {
-											goto l349
-										}
-										position++
-									case '!':
-										if buffer[position] != rune('!') {
-											goto l349
-										}
-										position++
-									case ',':
-										if buffer[position] != rune(',') {
-											goto l349
-										}
-										position++
-									case '.':
-										if buffer[position] != rune('.') {
-											goto l349
-										}
-										position++
-									case '_':
-										if buffer[position] != rune('_') {
-											goto l349
-										}
-										position++
-									case '-':
-										if buffer[position] != rune('-') {
-											goto l349
-										}
-										position++
-									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l349
-										}
-										position++
-									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-										if c := buffer[position]; c < rune('A') || c > rune('Z') {
-											goto l349
-										}
-										position++
-									default:
-										if c := buffer[position]; c < rune('a') || c > rune('z') {
-											goto l349
-										}
-										position++
-									}
-								}
-
-								goto l348
-							l349:
-								position, tokenIndex = position349, tokenIndex349
-							}
-							if !_rules[ruleQUOTE]() {
-								goto l337
-							}
-							add(ruleQuotedText, position347)
+						position1480, tokenIndex1480 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1480
 						}
+						goto l1477
+					l1480:
+						position, tokenIndex = position1480, tokenIndex1480
 					}
-				l340:
-					add(rulePegText, position339)
-				}
-				if !_rules[rule_]() {
-					goto l337
+					if !_rules[rule_]() {
+						goto l1477
+					}
+					add(ruleLINK, position1479)
 				}
 				{
-					add(ruleAction35, position)
+					add(ruleAction184, position)
 				}
-				add(ruleStringLike, position338)
+				add(ruleLink, position1478)
 			}
 			return true
-		l337:
-			position, tokenIndex = position337, tokenIndex337
+		l1477:
+			position, tokenIndex = position1477, tokenIndex1477
 			return false
 		},
-		/* 40 Number <- <(<[0-9]+> _ Action36)> */
+		/* 168 Unlink <- <(UNLINK Action185)> */
 		func() bool {
-			position352, tokenIndex352 := position, tokenIndex
+			position1482, tokenIndex1482 := position, tokenIndex
 			{
-				position353 := position
+				position1483 := position
 				{
-					position354 := position
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l352
+					position1484 := position
+					if buffer[position] != rune('u') {
+						goto l1482
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1482
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l1482
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1482
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l1482
+					}
+					position++
+					if buffer[position] != rune('k') {
+						goto l1482
 					}
 					position++
-				l355:
 					{
-						position356, tokenIndex356 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l356
+						position1485, tokenIndex1485 := position, tokenIndex
+						if !_rules[ruleTextChar]() {
+							goto l1485
+						}
+						goto l1482
+					l1485:
+						position, tokenIndex = position1485, tokenIndex1485
+					}
+					if !_rules[rule_]() {
+						goto l1482
+					}
+					add(ruleUNLINK, position1484)
+				}
+				{
+					add(ruleAction185, position)
+				}
+				add(ruleUnlink, position1483)
+			}
+			return true
+		l1482:
+			position, tokenIndex = position1482, tokenIndex1482
+			return false
+		},
+		/* 169 Export <- <(EXPORT Action186)> */
+		func() bool {
+			position1487, tokenIndex1487 := position, tokenIndex
+			{
+				position1488 := position
+				if !_rules[ruleEXPORT]() {
+					goto l1487
+				}
+				{
+					add(ruleAction186, position)
+				}
+				add(ruleExport, position1488)
+			}
+			return true
+		l1487:
+			position, tokenIndex = position1487, tokenIndex1487
+			return false
+		},
+		/* 170 Flag <- <(StrictFlag / VerboseFlag / IdsFlag / DryRunFlag / CascadeFlag / AllRelsFlag / ArchivedFlag / DepthFlag / ViewFlag)> */
+		nil,
+		/* 171 StrictFlag <- <(FLAG STRICT Action187)> */
+		nil,
+		/* 172 VerboseFlag <- <(FLAG VERBOSE Action188)> */
+		nil,
+		/* 173 IdsFlag <- <(FLAG IDS Action189)> */
+		nil,
+		/* 174 DryRunFlag <- <(FLAG DRY_RUN Action190)> */
+		nil,
+		/* 175 CascadeFlag <- <(FLAG CASCADE Action191)> */
+		nil,
+		/* 176 AllRelsFlag <- <(FLAG ALL_RELS Action192)> */
+		nil,
+		/* 177 ArchivedFlag <- <(FLAG ARCHIVED _ Action193)> */
+		nil,
+		/* 178 DepthFlag <- <(FLAG DEPTH <Number> Action194)> */
+		nil,
+		/* 179 ViewFlag <- <(FLAG VIEW <StringLike> Action195)> */
+		nil,
+		/* 180 BeginWorld <- <(_ DELIMITER WORLD _)> */
+		nil,
+		/* 181 EndWorld <- <(_ ENDWORLD DELIMITER _)> */
+		nil,
+		/* 182 BeginDetail <- <(_ DELIMITER ('d' 'e' 't' 'a' 'i' 'l') _)> */
+		nil,
+		/* 183 EndDetail <- <(_ ('e' 'n' 'd' 'd' 'e' 't' 'a' 'i' 'l') DELIMITER _)> */
+		nil,
+		/* 184 BeginChanges <- <(_ DELIMITER ('c' 'h' 'a' 'n' 'g' 'e' 's') _)> */
+		nil,
+		/* 185 EndChanges <- <(_ ('e' 'n' 'd' 'c' 'h' 'a' 'n' 'g' 'e' 's') DELIMITER _)> */
+		nil,
+		/* 186 BeginDataFlow <- <(_ DELIMITER ('d' 'a' 't' 'a' 'f' 'l' 'o' 'w') _)> */
+		nil,
+		/* 187 EndDataFlow <- <(_ ('e' 'n' 'd' 'd' 'a' 't' 'a' 'f' 'l' 'o' 'w') DELIMITER _)> */
+		nil,
+		/* 188 ItemType <- <(DATABASE / BLOBSTORE / ((&('c') CODE) | (&('d') DEVICE) | (&('s') SERVER) | (&('m') MOBILE) | (&('b') BROWSER) | (&('q') QUEUE) | (&('p') PERSON)))> */
+		nil,
+		/* 189 LifecycleStatus <- <((&('r') RETIRED) | (&('d') DEPRECATED) | (&('a') ACTIVE) | (&('p') PLANNED))> */
+		func() bool {
+			position1509, tokenIndex1509 := position, tokenIndex
+			{
+				position1510 := position
+				{
+					switch buffer[position] {
+					case 'r':
+						{
+							position1512 := position
+							if buffer[position] != rune('r') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l1509
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l1509
+							}
+							add(ruleRETIRED, position1512)
+						}
+					case 'd':
+						{
+							position1513 := position
+							if buffer[position] != rune('d') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l1509
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l1509
+							}
+							add(ruleDEPRECATED, position1513)
+						}
+					case 'a':
+						{
+							position1514 := position
+							if buffer[position] != rune('a') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('v') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1509
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l1509
+							}
+							add(ruleACTIVE, position1514)
+						}
+					default:
+						{
+							position1515 := position
+							if buffer[position] != rune('p') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l1509
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l1509
+							}
+							position++
+							if !_rules[rule_]() {
+								goto l1509
+							}
+							add(rulePLANNED, position1515)
 						}
-						position++
-						goto l355
-					l356:
-						position, tokenIndex = position356, tokenIndex356
 					}
-					add(rulePegText, position354)
-				}
-				if !_rules[rule_]() {
-					goto l352
-				}
-				{
-					add(ruleAction36, position)
 				}
-				add(ruleNumber, position353)
+
+				add(ruleLifecycleStatus, position1510)
 			}
 			return true
-		l352:
-			position, tokenIndex = position352, tokenIndex352
+		l1509:
+			position, tokenIndex = position1509, tokenIndex1509
 			return false
 		},
-		/* 41 Boolean <- <(<(TRUE / FALSE)> Action37)> */
+		/* 190 DeploymentKind <- <((&('n') NODE_KIND) | (&('c') CLUSTER) | (&('e') ENVIRONMENT))> */
+		nil,
+		/* 191 ThemeName <- <((&('p') PRINT) | (&('d') DARK) | (&('c') C4))> */
 		func() bool {
-			position358, tokenIndex358 := position, tokenIndex
+			position1517, tokenIndex1517 := position, tokenIndex
 			{
-				position359 := position
+				position1518 := position
 				{
-					position360 := position
-					{
-						position361, tokenIndex361 := position, tokenIndex
+					switch buffer[position] {
+					case 'p':
 						{
-							position363 := position
-							if buffer[position] != rune('t') {
-								goto l362
+							position1520 := position
+							if buffer[position] != rune('p') {
+								goto l1517
 							}
 							position++
 							if buffer[position] != rune('r') {
-								goto l362
+								goto l1517
 							}
 							position++
-							if buffer[position] != rune('u') {
-								goto l362
+							if buffer[position] != rune('i') {
+								goto l1517
 							}
 							position++
-							if buffer[position] != rune('e') {
-								goto l362
+							if buffer[position] != rune('n') {
+								goto l1517
 							}
 							position++
+							if buffer[position] != rune('t') {
+								goto l1517
+							}
+							position++
+							{
+								position1521, tokenIndex1521 := position, tokenIndex
+								if !_rules[ruleTextChar]() {
+									goto l1521
+								}
+								goto l1517
+							l1521:
+								position, tokenIndex = position1521, tokenIndex1521
+							}
 							if !_rules[rule_]() {
-								goto l362
+								goto l1517
 							}
-							add(ruleTRUE, position363)
+							add(rulePRINT, position1520)
 						}
-						goto l361
-					l362:
-						position, tokenIndex = position361, tokenIndex361
+					case 'd':
 						{
-							position364 := position
-							if buffer[position] != rune('f') {
-								goto l358
+							position1522 := position
+							if buffer[position] != rune('d') {
+								goto l1517
 							}
 							position++
 							if buffer[position] != rune('a') {
-								goto l358
+								goto l1517
 							}
 							position++
-							if buffer[position] != rune('l') {
-								goto l358
+							if buffer[position] != rune('r') {
+								goto l1517
 							}
 							position++
-							if buffer[position] != rune('s') {
-								goto l358
+							if buffer[position] != rune('k') {
+								goto l1517
 							}
 							position++
-							if buffer[position] != rune('e') {
-								goto l358
+							{
+								position1523, tokenIndex1523 := position, tokenIndex
+								if !_rules[ruleTextChar]() {
+									goto l1523
+								}
+								goto l1517
+							l1523:
+								position, tokenIndex = position1523, tokenIndex1523
+							}
+							if !_rules[rule_]() {
+								goto l1517
+							}
+							add(ruleDARK, position1522)
+						}
+					default:
+						{
+							position1524 := position
+							if buffer[position] != rune('c') {
+								goto l1517
+							}
+							position++
+							if buffer[position] != rune('4') {
+								goto l1517
 							}
 							position++
+							{
+								position1525, tokenIndex1525 := position, tokenIndex
+								if !_rules[ruleTextChar]() {
+									goto l1525
+								}
+								goto l1517
+							l1525:
+								position, tokenIndex = position1525, tokenIndex1525
+							}
 							if !_rules[rule_]() {
-								goto l358
+								goto l1517
+							}
+							add(ruleC4, position1524)
+						}
+					}
+				}
+
+				add(ruleThemeName, position1518)
+			}
+			return true
+		l1517:
+			position, tokenIndex = position1517, tokenIndex1517
+			return false
+		},
+		/* 192 NotStatement <- <!((('i' 't' 'e' 'm' 's') / ('r' 'e' 'l' 's') / ('r' 'e' 'l') / ('n' 'o' 'd' 'e' 's') / ('n' 'o' 'd' 'e') / ('s' 'c' 'e' 'n' 'a' 'r' 'i' 'o' 's') / ('s' 'c' 'e' 'n' 'a' 'r' 'i' 'o') / ('s' 't' 'e' 'p') / ('u' 'n' 's' 't' 'e' 'p') / ('v' 'i' 'e' 'w' 's') / ('s' 't' 'y' 'l' 'e' 's') / ('u' 'n' 'd' 'e' 'p' 'l' 'o' 'y') / ('t' 'h' 'r' 'e' 'a' 't' 's') / ((&('r') ('r' 'e' 'd' 'o')) | (&('u') ('u' 'n' 'd' 'o')) | (&('t') ('t' 'r' 'e' 'e')) | (&('o') ('o' 'w' 'n' 'e' 'r' 's')) | (&('d') ('d' 'e' 'p' 'l' 'o' 'y')) | (&('n') ('n' 'e' 's' 't')) | (&('f') ('f' 'r' 'e' 'e')) | (&('p') ('p' 'i' 'n')) | (&('l') ('l' 'a' 'y' 'o' 'u' 't')) | (&('s') ('s' 't' 'y' 'l' 'e')) | (&('v') ('v' 'i' 'e' 'w')) | (&('i') ('i' 't' 'e' 'm')) | (&('w') ('w' 'o' 'r' 'l' 'd')))) !((&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])))> */
+		nil,
+		/* 193 NotVerb <- <!((('c' 'r' 'e' 'a' 't' 'e') / ('s' 'e' 't') / ('c' 'l' 'e' 'a' 'r') / ('l' 'i' 's' 't') / ('e' 'x' 'i' 's' 't' 's') / ('c' 'o' 'p' 'y') / ((&('i') ('i' 'n')) | (&('e') ('e' 'x' 'p' 'o' 'r' 't')) | (&('u') ('u' 'n' 'l' 'i' 'n' 'k')) | (&('l') ('l' 'i' 'n' 'k')) | (&('r') ('r' 'e' 's' 't' 'o' 'r' 'e')) | (&('a') ('a' 'r' 'c' 'h' 'i' 'v' 'e')) | (&('s') ('s' 'p' 'l' 'i' 't')) | (&('m') ('m' 'e' 'r' 'g' 'e')) | (&('c') ('c' 'l' 'o' 'n' 'e')) | (&('f') ('f' 'e' 't' 'c' 'h')) | (&('d') ('d' 'e' 'l' 'e' 't' 'e')))) !((&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])))> */
+		func() bool {
+			position1527, tokenIndex1527 := position, tokenIndex
+			{
+				position1528 := position
+				{
+					position1529, tokenIndex1529 := position, tokenIndex
+					{
+						position1530, tokenIndex1530 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1531
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l1531
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l1531
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l1531
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l1531
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l1531
+						}
+						position++
+						goto l1530
+					l1531:
+						position, tokenIndex = position1530, tokenIndex1530
+						if buffer[position] != rune('s') {
+							goto l1532
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l1532
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l1532
+						}
+						position++
+						goto l1530
+					l1532:
+						position, tokenIndex = position1530, tokenIndex1530
+						if buffer[position] != rune('c') {
+							goto l1533
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l1533
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l1533
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l1533
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l1533
+						}
+						position++
+						goto l1530
+					l1533:
+						position, tokenIndex = position1530, tokenIndex1530
+						if buffer[position] != rune('l') {
+							goto l1534
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l1534
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l1534
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l1534
+						}
+						position++
+						goto l1530
+					l1534:
+						position, tokenIndex = position1530, tokenIndex1530
+						if buffer[position] != rune('e') {
+							goto l1535
+						}
+						position++
+						if buffer[position] != rune('x') {
+							goto l1535
+						}
+						position++
+						if buffer[position] != rune('i') {
+							goto l1535
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l1535
+						}
+						position++
+						if buffer[position] != rune('t') {
+							goto l1535
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l1535
+						}
+						position++
+						goto l1530
+					l1535:
+						position, tokenIndex = position1530, tokenIndex1530
+						if buffer[position] != rune('c') {
+							goto l1536
+						}
+						position++
+						if buffer[position] != rune('o') {
+							goto l1536
+						}
+						position++
+						if buffer[position] != rune('p') {
+							goto l1536
+						}
+						position++
+						if buffer[position] != rune('y') {
+							goto l1536
+						}
+						position++
+						goto l1530
+					l1536:
+						position, tokenIndex = position1530, tokenIndex1530
+						{
+							switch buffer[position] {
+							case 'i':
+								if buffer[position] != rune('i') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1529
+								}
+								position++
+							case 'e':
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('x') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1529
+								}
+								position++
+							case 'u':
+								if buffer[position] != rune('u') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('k') {
+									goto l1529
+								}
+								position++
+							case 'l':
+								if buffer[position] != rune('l') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('k') {
+									goto l1529
+								}
+								position++
+							case 'r':
+								if buffer[position] != rune('r') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+							case 'a':
+								if buffer[position] != rune('a') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('v') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+							case 's':
+								if buffer[position] != rune('s') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1529
+								}
+								position++
+							case 'm':
+								if buffer[position] != rune('m') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('g') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+							case 'c':
+								if buffer[position] != rune('c') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+							case 'f':
+								if buffer[position] != rune('f') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l1529
+								}
+								position++
+							default:
+								if buffer[position] != rune('d') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l1529
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l1529
+								}
+								position++
+							}
+						}
+
+					}
+				l1530:
+					{
+						position1538, tokenIndex1538 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '.':
+								if buffer[position] != rune('.') {
+									goto l1538
+								}
+								position++
+							case '_':
+								if buffer[position] != rune('_') {
+									goto l1538
+								}
+								position++
+							case '-':
+								if buffer[position] != rune('-') {
+									goto l1538
+								}
+								position++
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1538
+								}
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								if c := buffer[position]; c < rune('A') || c > rune('Z') {
+									goto l1538
+								}
+								position++
+							default:
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l1538
+								}
+								position++
 							}
-							add(ruleFALSE, position364)
 						}
+
+						goto l1529
+					l1538:
+						position, tokenIndex = position1538, tokenIndex1538
 					}
-				l361:
-					add(rulePegText, position360)
-				}
-				{
-					add(ruleAction37, position)
-				}
-				add(ruleBoolean, position359)
-			}
-			return true
-		l358:
-			position, tokenIndex = position358, tokenIndex358
-			return false
-		},
-		/* 42 Text <- <((&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
-		nil,
-		/* 43 QuotedText <- <(QUOTE ((&(' ') ' ') | (&(':') ':') | (&(';') ';') | (&('~') '~') | (&('=') '=') | (&('+') '+') | (&(']') ']') | (&('[') '[') | (&(')') ')') | (&('(') '(') | (&('*') '*') | (&('&') '&') | (&('^') '^') | (&('%') '%') | (&('$') '$') | (&('#') '#') | (&('@') '@') | (&('!') '!') | (&(',') ',') | (&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))* QUOTE)> */
-		nil,
-		/* 44 ItemExists <- <((ITEM_EXISTS / (Item Exists)) Action38)> */
-		nil,
-		/* 45 RelExists <- <((REL_EXISTS / (Rel Exists)) Action39)> */
-		nil,
-		/* 46 World <- <(WORLD Action40)> */
-		nil,
-		/* 47 Item <- <(ITEM Action41)> */
-		func() bool {
-			position371, tokenIndex371 := position, tokenIndex
-			{
-				position372 := position
-				if !_rules[ruleITEM]() {
-					goto l371
-				}
-				{
-					add(ruleAction41, position)
-				}
-				add(ruleItem, position372)
-			}
-			return true
-		l371:
-			position, tokenIndex = position371, tokenIndex371
-			return false
-		},
-		/* 48 Rel <- <(REL Action42)> */
-		func() bool {
-			position374, tokenIndex374 := position, tokenIndex
-			{
-				position375 := position
-				if !_rules[ruleREL]() {
-					goto l374
-				}
-				{
-					add(ruleAction42, position)
-				}
-				add(ruleRel, position375)
-			}
-			return true
-		l374:
-			position, tokenIndex = position374, tokenIndex374
-			return false
-		},
-		/* 49 Create <- <(CREATE Action43)> */
-		func() bool {
-			position377, tokenIndex377 := position, tokenIndex
-			{
-				position378 := position
-				if !_rules[ruleCREATE]() {
-					goto l377
-				}
-				{
-					add(ruleAction43, position)
-				}
-				add(ruleCreate, position378)
-			}
-			return true
-		l377:
-			position, tokenIndex = position377, tokenIndex377
-			return false
-		},
-		/* 50 Fetch <- <(FETCH Action44)> */
-		func() bool {
-			position380, tokenIndex380 := position, tokenIndex
-			{
-				position381 := position
-				if !_rules[ruleFETCH]() {
-					goto l380
-				}
-				{
-					add(ruleAction44, position)
-				}
-				add(ruleFetch, position381)
-			}
-			return true
-		l380:
-			position, tokenIndex = position380, tokenIndex380
-			return false
-		},
-		/* 51 Set <- <(SET Action45)> */
-		func() bool {
-			position383, tokenIndex383 := position, tokenIndex
-			{
-				position384 := position
-				if !_rules[ruleSET]() {
-					goto l383
-				}
-				{
-					add(ruleAction45, position)
-				}
-				add(ruleSet, position384)
-			}
-			return true
-		l383:
-			position, tokenIndex = position383, tokenIndex383
-			return false
-		},
-		/* 52 Clear <- <(CLEAR Action46)> */
-		func() bool {
-			position386, tokenIndex386 := position, tokenIndex
-			{
-				position387 := position
-				if !_rules[ruleCLEAR]() {
-					goto l386
-				}
-				{
-					add(ruleAction46, position)
-				}
-				add(ruleClear, position387)
-			}
-			return true
-		l386:
-			position, tokenIndex = position386, tokenIndex386
-			return false
-		},
-		/* 53 Delete <- <(DELETE Action47)> */
-		func() bool {
-			position389, tokenIndex389 := position, tokenIndex
-			{
-				position390 := position
-				if !_rules[ruleDELETE]() {
-					goto l389
-				}
-				{
-					add(ruleAction47, position)
+					goto l1527
+				l1529:
+					position, tokenIndex = position1529, tokenIndex1529
 				}
-				add(ruleDelete, position390)
+				add(ruleNotVerb, position1528)
 			}
 			return true
-		l389:
-			position, tokenIndex = position389, tokenIndex389
+		l1527:
+			position, tokenIndex = position1527, tokenIndex1527
 			return false
 		},
-		/* 54 List <- <(LIST Action48)> */
-		nil,
-		/* 55 Nest <- <(NEST Action49)> */
+		/* 194 NotIn <- <!('i' 'n' !((&(':') ':') | (&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])))> */
 		nil,
-		/* 56 Free <- <(FREE Action50)> */
+		/* 195 NotAssign <- <!('a' 's' 's' 'i' 'g' 'n' !((&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])))> */
 		nil,
-		/* 57 Exists <- <(EXISTS Action51)> */
+		/* 196 NotFlag <- <!'-'> */
 		func() bool {
-			position395, tokenIndex395 := position, tokenIndex
+			position1542, tokenIndex1542 := position, tokenIndex
 			{
-				position396 := position
-				if !_rules[ruleEXISTS]() {
-					goto l395
-				}
+				position1543 := position
 				{
-					add(ruleAction51, position)
-				}
-				add(ruleExists, position396)
-			}
-			return true
-		l395:
-			position, tokenIndex = position395, tokenIndex395
-			return false
-		},
-		/* 58 InQuery <- <(IN_QUERY Action52)> */
-		nil,
-		/* 59 FromQuery <- <(FROM_QUERY Action53)> */
-		nil,
-		/* 60 ToQuery <- <(TO_QUERY Action54)> */
-		nil,
-		/* 61 Flag <- <(StrictFlag / VerboseFlag / IdsFlag)> */
-		nil,
-		/* 62 StrictFlag <- <(FLAG STRICT Action55)> */
-		nil,
-		/* 63 VerboseFlag <- <(FLAG VERBOSE Action56)> */
-		nil,
-		/* 64 IdsFlag <- <(FLAG IDS Action57)> */
-		nil,
-		/* 65 BeginWorld <- <(_ DELIMITER WORLD _)> */
-		nil,
-		/* 66 EndWorld <- <(_ ENDWORLD DELIMITER _)> */
-		nil,
-		/* 67 ItemType <- <(DATABASE / BLOBSTORE / ((&('c') CODE) | (&('d') DEVICE) | (&('s') SERVER) | (&('m') MOBILE) | (&('b') BROWSER) | (&('q') QUEUE) | (&('p') PERSON)))> */
-		nil,
-		/* 68 Keyword <- <(ENDWORLD / ERROR / ITEM / ITEM_EXISTS / REL / FROM_QUERY / IN / CREATE / FETCH / ((&('$') DELIMITER) | (&('-') FLAG) | (&('n') NEST) | (&('f') FREE) | (&('e') EXISTS) | (&('l') LIST) | (&('c') CLEAR) | (&('s') SET) | (&('d') DELETE) | (&('i') IN_QUERY) | (&('t') TO_QUERY) | (&('r') REL_EXISTS) | (&('o') OK) | (&('w') WORLD)))> */
-		func() bool {
-			position408, tokenIndex408 := position, tokenIndex
-			{
-				position409 := position
-				{
-					position410, tokenIndex410 := position, tokenIndex
-					if !_rules[ruleENDWORLD]() {
-						goto l411
-					}
-					goto l410
-				l411:
-					position, tokenIndex = position410, tokenIndex410
-					if !_rules[ruleERROR]() {
-						goto l412
-					}
-					goto l410
-				l412:
-					position, tokenIndex = position410, tokenIndex410
-					if !_rules[ruleITEM]() {
-						goto l413
-					}
-					goto l410
-				l413:
-					position, tokenIndex = position410, tokenIndex410
-					if !_rules[ruleITEM_EXISTS]() {
-						goto l414
-					}
-					goto l410
-				l414:
-					position, tokenIndex = position410, tokenIndex410
-					if !_rules[ruleREL]() {
-						goto l415
-					}
-					goto l410
-				l415:
-					position, tokenIndex = position410, tokenIndex410
-					if !_rules[ruleFROM_QUERY]() {
-						goto l416
-					}
-					goto l410
-				l416:
-					position, tokenIndex = position410, tokenIndex410
-					if !_rules[ruleIN]() {
-						goto l417
-					}
-					goto l410
-				l417:
-					position, tokenIndex = position410, tokenIndex410
-					if !_rules[ruleCREATE]() {
-						goto l418
-					}
-					goto l410
-				l418:
-					position, tokenIndex = position410, tokenIndex410
-					if !_rules[ruleFETCH]() {
-						goto l419
-					}
-					goto l410
-				l419:
-					position, tokenIndex = position410, tokenIndex410
-					{
-						switch buffer[position] {
-						case '$':
-							if !_rules[ruleDELIMITER]() {
-								goto l408
-							}
-						case '-':
-							if !_rules[ruleFLAG]() {
-								goto l408
-							}
-						case 'n':
-							if !_rules[ruleNEST]() {
-								goto l408
-							}
-						case 'f':
-							if !_rules[ruleFREE]() {
-								goto l408
-							}
-						case 'e':
-							if !_rules[ruleEXISTS]() {
-								goto l408
-							}
-						case 'l':
-							if !_rules[ruleLIST]() {
-								goto l408
-							}
-						case 'c':
-							if !_rules[ruleCLEAR]() {
-								goto l408
-							}
-						case 's':
-							if !_rules[ruleSET]() {
-								goto l408
-							}
-						case 'd':
-							if !_rules[ruleDELETE]() {
-								goto l408
-							}
-						case 'i':
-							if !_rules[ruleIN_QUERY]() {
-								goto l408
-							}
-						case 't':
-							if !_rules[ruleTO_QUERY]() {
-								goto l408
-							}
-						case 'r':
-							if !_rules[ruleREL_EXISTS]() {
-								goto l408
-							}
-						case 'o':
-							if !_rules[ruleOK]() {
-								goto l408
-							}
-						default:
-							if !_rules[ruleWORLD]() {
-								goto l408
-							}
-						}
+					position1544, tokenIndex1544 := position, tokenIndex
+					if buffer[position] != rune('-') {
+						goto l1544
 					}
-
+					position++
+					goto l1542
+				l1544:
+					position, tokenIndex = position1544, tokenIndex1544
 				}
-			l410:
-				add(ruleKeyword, position409)
+				add(ruleNotFlag, position1543)
 			}
 			return true
-		l408:
-			position, tokenIndex = position408, tokenIndex408
+		l1542:
+			position, tokenIndex = position1542, tokenIndex1542
 			return false
 		},
-		/* 69 WORLD <- <('w' 'o' 'r' 'l' 'd' _)> */
+		/* 197 WORLD <- <('w' 'o' 'r' 'l' 'd' !TextChar _)> */
 		func() bool {
-			position421, tokenIndex421 := position, tokenIndex
+			position1545, tokenIndex1545 := position, tokenIndex
 			{
-				position422 := position
+				position1546 := position
 				if buffer[position] != rune('w') {
-					goto l421
+					goto l1545
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l421
+					goto l1545
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l421
+					goto l1545
 				}
 				position++
 				if buffer[position] != rune('l') {
-					goto l421
+					goto l1545
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l421
+					goto l1545
 				}
 				position++
+				{
+					position1547, tokenIndex1547 := position, tokenIndex
+					if !_rules[ruleTextChar]() {
+						goto l1547
+					}
+					goto l1545
+				l1547:
+					position, tokenIndex = position1547, tokenIndex1547
+				}
 				if !_rules[rule_]() {
-					goto l421
+					goto l1545
 				}
-				add(ruleWORLD, position422)
+				add(ruleWORLD, position1546)
 			}
 			return true
-		l421:
-			position, tokenIndex = position421, tokenIndex421
+		l1545:
+			position, tokenIndex = position1545, tokenIndex1545
 			return false
 		},
-		/* 70 ENDWORLD <- <('e' 'n' 'd' 'w' 'o' 'r' 'l' 'd' _)> */
+		/* 198 ENDWORLD <- <('e' 'n' 'd' 'w' 'o' 'r' 'l' 'd' _)> */
+		nil,
+		/* 199 ERROR <- <('e' 'r' 'r' 'o' 'r' _)> */
+		nil,
+		/* 200 OK <- <('o' 'k' _)> */
+		nil,
+		/* 201 ITEM <- <('i' 't' 'e' 'm' 's'? !TextChar _)> */
+		nil,
+		/* 202 ITEM_EXISTS <- <('i' 't' 'e' 'm' '?' _)> */
+		nil,
+		/* 203 REL <- <('r' 'e' 'l' 's'? !TextChar _)> */
+		nil,
+		/* 204 REL_EXISTS <- <('r' 'e' 'l' '?' _)> */
+		nil,
+		/* 205 FROM_QUERY <- <('f' 'r' 'o' 'm' '?' _)> */
+		nil,
+		/* 206 TO_QUERY <- <('t' 'o' '?' _)> */
+		nil,
+		/* 207 IN <- <('i' 'n' !TextChar _)> */
 		func() bool {
-			position423, tokenIndex423 := position, tokenIndex
+			position1557, tokenIndex1557 := position, tokenIndex
 			{
-				position424 := position
-				if buffer[position] != rune('e') {
-					goto l423
+				position1558 := position
+				if buffer[position] != rune('i') {
+					goto l1557
 				}
 				position++
 				if buffer[position] != rune('n') {
-					goto l423
-				}
-				position++
-				if buffer[position] != rune('d') {
-					goto l423
-				}
-				position++
-				if buffer[position] != rune('w') {
-					goto l423
-				}
-				position++
-				if buffer[position] != rune('o') {
-					goto l423
-				}
-				position++
-				if buffer[position] != rune('r') {
-					goto l423
-				}
-				position++
-				if buffer[position] != rune('l') {
-					goto l423
+					goto l1557
 				}
 				position++
-				if buffer[position] != rune('d') {
-					goto l423
+				{
+					position1559, tokenIndex1559 := position, tokenIndex
+					if !_rules[ruleTextChar]() {
+						goto l1559
+					}
+					goto l1557
+				l1559:
+					position, tokenIndex = position1559, tokenIndex1559
 				}
-				position++
 				if !_rules[rule_]() {
-					goto l423
+					goto l1557
 				}
-				add(ruleENDWORLD, position424)
+				add(ruleIN, position1558)
 			}
 			return true
-		l423:
-			position, tokenIndex = position423, tokenIndex423
+		l1557:
+			position, tokenIndex = position1557, tokenIndex1557
 			return false
 		},
-		/* 71 ERROR <- <('e' 'r' 'r' 'o' 'r' _)> */
+		/* 208 IN_SELECTOR <- <('i' 'n' ':')> */
+		nil,
+		/* 209 IN_QUERY <- <('i' 'n' '?' _)> */
+		nil,
+		/* 210 ANCESTORS_QUERY <- <('a' 'n' 'c' 'e' 's' 't' 'o' 'r' 's' '?' _)> */
+		nil,
+		/* 211 SIBLINGS_QUERY <- <('s' 'i' 'b' 'l' 'i' 'n' 'g' 's' '?' _)> */
+		nil,
+		/* 212 OWNERS_QUERY <- <('o' 'w' 'n' 'e' 'r' 's' '?' _)> */
+		nil,
+		/* 213 DATAFLOW_QUERY <- <('d' 'a' 't' 'a' 'f' 'l' 'o' 'w' '?' _)> */
+		nil,
+		/* 214 CROSSINGS_QUERY <- <('c' 'r' 'o' 's' 's' 'i' 'n' 'g' 's' '?' _)> */
+		nil,
+		/* 215 DEPLOYED_QUERY <- <('d' 'e' 'p' 'l' 'o' 'y' 'e' 'd' '?' _)> */
+		nil,
+		/* 216 OWNERS <- <('o' 'w' 'n' 'e' 'r' 's' !TextChar _)> */
+		nil,
+		/* 217 IMPORT <- <('i' 'm' 'p' 'o' 'r' 't' !TextChar _)> */
+		nil,
+		/* 218 THREATS <- <('t' 'h' 'r' 'e' 'a' 't' 's' !TextChar _)> */
+		nil,
+		/* 219 EXPORT <- <('e' 'x' 'p' 'o' 'r' 't' !TextChar _)> */
 		func() bool {
-			position425, tokenIndex425 := position, tokenIndex
+			position1571, tokenIndex1571 := position, tokenIndex
 			{
-				position426 := position
+				position1572 := position
 				if buffer[position] != rune('e') {
-					goto l425
+					goto l1571
 				}
 				position++
-				if buffer[position] != rune('r') {
-					goto l425
+				if buffer[position] != rune('x') {
+					goto l1571
 				}
 				position++
-				if buffer[position] != rune('r') {
-					goto l425
+				if buffer[position] != rune('p') {
+					goto l1571
 				}
 				position++
 				if buffer[position] != rune('o') {
-					goto l425
+					goto l1571
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l425
-				}
-				position++
-				if !_rules[rule_]() {
-					goto l425
-				}
-				add(ruleERROR, position426)
-			}
-			return true
-		l425:
-			position, tokenIndex = position425, tokenIndex425
-			return false
-		},
-		/* 72 OK <- <('o' 'k' _)> */
-		func() bool {
-			position427, tokenIndex427 := position, tokenIndex
-			{
-				position428 := position
-				if buffer[position] != rune('o') {
-					goto l427
-				}
-				position++
-				if buffer[position] != rune('k') {
-					goto l427
-				}
-				position++
-				if !_rules[rule_]() {
-					goto l427
-				}
-				add(ruleOK, position428)
-			}
-			return true
-		l427:
-			position, tokenIndex = position427, tokenIndex427
-			return false
-		},
-		/* 73 ITEM <- <('i' 't' 'e' 'm' 's'? _)> */
-		func() bool {
-			position429, tokenIndex429 := position, tokenIndex
-			{
-				position430 := position
-				if buffer[position] != rune('i') {
-					goto l429
+					goto l1571
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l429
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l429
-				}
-				position++
-				if buffer[position] != rune('m') {
-					goto l429
+					goto l1571
 				}
 				position++
 				{
-					position431, tokenIndex431 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l431
+					position1573, tokenIndex1573 := position, tokenIndex
+					if !_rules[ruleTextChar]() {
+						goto l1573
 					}
-					position++
-					goto l432
-				l431:
-					position, tokenIndex = position431, tokenIndex431
+					goto l1571
+				l1573:
+					position, tokenIndex = position1573, tokenIndex1573
 				}
-			l432:
 				if !_rules[rule_]() {
-					goto l429
+					goto l1571
 				}
-				add(ruleITEM, position430)
+				add(ruleEXPORT, position1572)
 			}
 			return true
-		l429:
-			position, tokenIndex = position429, tokenIndex429
+		l1571:
+			position, tokenIndex = position1571, tokenIndex1571
 			return false
 		},
-		/* 74 ITEM_EXISTS <- <('i' 't' 'e' 'm' '?' _)> */
+		/* 220 NODE <- <('n' 'o' 'd' 'e' 's'? !TextChar _)> */
+		nil,
+		/* 221 DEPLOY <- <('d' 'e' 'p' 'l' 'o' 'y' !TextChar _)> */
+		nil,
+		/* 222 UNDEPLOY <- <('u' 'n' 'd' 'e' 'p' 'l' 'o' 'y' !TextChar _)> */
+		nil,
+		/* 223 FROM <- <('f' 'r' 'o' 'm' !TextChar _)> */
+		nil,
+		/* 224 SCENARIO <- <('s' 'c' 'e' 'n' 'a' 'r' 'i' 'o' 's'? !TextChar _)> */
+		nil,
+		/* 225 STEP <- <('s' 't' 'e' 'p' !TextChar _)> */
+		nil,
+		/* 226 UNSTEP <- <('u' 'n' 's' 't' 'e' 'p' !TextChar _)> */
+		nil,
+		/* 227 LAYOUT <- <('l' 'a' 'y' 'o' 'u' 't' !TextChar _)> */
+		nil,
+		/* 228 PIN <- <('p' 'i' 'n' !TextChar _)> */
+		nil,
+		/* 229 UNPIN <- <('u' 'n' 'p' 'i' 'n' !TextChar _)> */
+		nil,
+		/* 230 STYLE <- <('s' 't' 'y' 'l' 'e' 's'? !TextChar _)> */
+		nil,
+		/* 231 TREE <- <('t' 'r' 'e' 'e' _)> */
+		nil,
+		/* 232 CREATE <- <('c' 'r' 'e' 'a' 't' 'e' !TextChar _)> */
+		nil,
+		/* 233 DELETE <- <('d' 'e' 'l' 'e' 't' 'e' !TextChar _)> */
+		nil,
+		/* 234 SET <- <('s' 'e' 't' !TextChar _)> */
+		nil,
+		/* 235 CLEAR <- <('c' 'l' 'e' 'a' 'r' !TextChar _)> */
+		nil,
+		/* 236 FETCH <- <('f' 'e' 't' 'c' 'h' !TextChar _)> */
+		nil,
+		/* 237 LIST <- <('l' 'i' 's' 't' !TextChar _)> */
+		nil,
+		/* 238 EXISTS <- <('e' 'x' 'i' 's' 't' 's' !TextChar _)> */
+		nil,
+		/* 239 FREE <- <('f' 'r' 'e' 'e' !TextChar _)> */
+		nil,
+		/* 240 NEST <- <('n' 'e' 's' 't' !TextChar _)> */
+		nil,
+		/* 241 SAVE <- <('s' 'a' 'v' 'e' !TextChar _)> */
+		nil,
+		/* 242 LOAD <- <('l' 'o' 'a' 'd' !TextChar _)> */
+		nil,
+		/* 243 NEW <- <('n' 'e' 'w' !TextChar _)> */
+		nil,
+		/* 244 USE <- <('u' 's' 'e' !TextChar _)> */
+		nil,
+		/* 245 OPEN <- <('o' 'p' 'e' 'n' !TextChar _)> */
+		nil,
+		/* 246 CLOSE <- <('c' 'l' 'o' 's' 'e' !TextChar _)> */
+		nil,
+		/* 247 UNDO <- <('u' 'n' 'd' 'o' !TextChar _)> */
+		nil,
+		/* 248 REDO <- <('r' 'e' 'd' 'o' !TextChar _)> */
+		nil,
+		/* 249 COPY <- <('c' 'o' 'p' 'y' !TextChar _)> */
+		nil,
+		/* 250 CLONE <- <('c' 'l' 'o' 'n' 'e' !TextChar _)> */
+		nil,
+		/* 251 MERGE <- <('m' 'e' 'r' 'g' 'e' !TextChar _)> */
+		nil,
+		/* 252 SPLIT <- <('s' 'p' 'l' 'i' 't' !TextChar _)> */
+		nil,
+		/* 253 ARCHIVE <- <('a' 'r' 'c' 'h' 'i' 'v' 'e' !TextChar _)> */
+		nil,
+		/* 254 RESTORE <- <('r' 'e' 's' 't' 'o' 'r' 'e' !TextChar _)> */
+		nil,
+		/* 255 LINK <- <('l' 'i' 'n' 'k' !TextChar _)> */
+		nil,
+		/* 256 UNLINK <- <('u' 'n' 'l' 'i' 'n' 'k' !TextChar _)> */
+		nil,
+		/* 257 TO <- <('t' 'o' !TextChar _)> */
 		func() bool {
-			position433, tokenIndex433 := position, tokenIndex
+			position1611, tokenIndex1611 := position, tokenIndex
 			{
-				position434 := position
-				if buffer[position] != rune('i') {
-					goto l433
-				}
-				position++
+				position1612 := position
 				if buffer[position] != rune('t') {
-					goto l433
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l433
+					goto l1611
 				}
 				position++
-				if buffer[position] != rune('m') {
-					goto l433
+				if buffer[position] != rune('o') {
+					goto l1611
 				}
 				position++
-				if buffer[position] != rune('?') {
-					goto l433
+				{
+					position1613, tokenIndex1613 := position, tokenIndex
+					if !_rules[ruleTextChar]() {
+						goto l1613
+					}
+					goto l1611
+				l1613:
+					position, tokenIndex = position1613, tokenIndex1613
 				}
-				position++
 				if !_rules[rule_]() {
-					goto l433
+					goto l1611
 				}
-				add(ruleITEM_EXISTS, position434)
+				add(ruleTO, position1612)
 			}
 			return true
-		l433:
-			position, tokenIndex = position433, tokenIndex433
+		l1611:
+			position, tokenIndex = position1611, tokenIndex1611
 			return false
 		},
-		/* 75 REL <- <('r' 'e' 'l' 's'? _)> */
+		/* 258 AS <- <('a' 's' !TextChar _)> */
+		nil,
+		/* 259 INTO <- <('i' 'n' 't' 'o' !TextChar _)> */
 		func() bool {
-			position435, tokenIndex435 := position, tokenIndex
+			position1615, tokenIndex1615 := position, tokenIndex
 			{
-				position436 := position
-				if buffer[position] != rune('r') {
-					goto l435
+				position1616 := position
+				if buffer[position] != rune('i') {
+					goto l1615
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l435
+				if buffer[position] != rune('n') {
+					goto l1615
 				}
 				position++
-				if buffer[position] != rune('l') {
-					goto l435
+				if buffer[position] != rune('t') {
+					goto l1615
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1615
 				}
 				position++
 				{
-					position437, tokenIndex437 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l437
+					position1617, tokenIndex1617 := position, tokenIndex
+					if !_rules[ruleTextChar]() {
+						goto l1617
 					}
-					position++
-					goto l438
-				l437:
-					position, tokenIndex = position437, tokenIndex437
+					goto l1615
+				l1617:
+					position, tokenIndex = position1617, tokenIndex1617
 				}
-			l438:
 				if !_rules[rule_]() {
-					goto l435
+					goto l1615
 				}
-				add(ruleREL, position436)
+				add(ruleINTO, position1616)
 			}
 			return true
-		l435:
-			position, tokenIndex = position435, tokenIndex435
+		l1615:
+			position, tokenIndex = position1615, tokenIndex1615
 			return false
 		},
-		/* 76 REL_EXISTS <- <('r' 'e' 'l' '?' _)> */
+		/* 260 ASSIGN <- <('a' 's' 's' 'i' 'g' 'n' !TextChar _)> */
+		nil,
+		/* 261 TRUE <- <('t' 'r' 'u' 'e' _)> */
+		nil,
+		/* 262 FALSE <- <('f' 'a' 'l' 's' 'e' _)> */
+		nil,
+		/* 263 EXTERNAL <- <('e' 'x' 't' 'e' 'r' 'n' 'a' 'l')> */
 		func() bool {
-			position439, tokenIndex439 := position, tokenIndex
+			position1621, tokenIndex1621 := position, tokenIndex
 			{
-				position440 := position
-				if buffer[position] != rune('r') {
-					goto l439
-				}
-				position++
+				position1622 := position
 				if buffer[position] != rune('e') {
-					goto l439
+					goto l1621
 				}
 				position++
-				if buffer[position] != rune('l') {
-					goto l439
+				if buffer[position] != rune('x') {
+					goto l1621
 				}
 				position++
-				if buffer[position] != rune('?') {
-					goto l439
+				if buffer[position] != rune('t') {
+					goto l1621
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l439
-				}
-				add(ruleREL_EXISTS, position440)
-			}
-			return true
-		l439:
-			position, tokenIndex = position439, tokenIndex439
-			return false
-		},
-		/* 77 FROM_QUERY <- <('f' 'r' 'o' 'm' '?' _)> */
-		func() bool {
-			position441, tokenIndex441 := position, tokenIndex
-			{
-				position442 := position
-				if buffer[position] != rune('f') {
-					goto l441
+				if buffer[position] != rune('e') {
+					goto l1621
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l441
+					goto l1621
 				}
 				position++
-				if buffer[position] != rune('o') {
-					goto l441
+				if buffer[position] != rune('n') {
+					goto l1621
 				}
 				position++
-				if buffer[position] != rune('m') {
-					goto l441
+				if buffer[position] != rune('a') {
+					goto l1621
 				}
 				position++
-				if buffer[position] != rune('?') {
-					goto l441
+				if buffer[position] != rune('l') {
+					goto l1621
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l441
-				}
-				add(ruleFROM_QUERY, position442)
+				add(ruleEXTERNAL, position1622)
 			}
 			return true
-		l441:
-			position, tokenIndex = position441, tokenIndex441
+		l1621:
+			position, tokenIndex = position1621, tokenIndex1621
 			return false
 		},
-		/* 78 TO_QUERY <- <('t' 'o' '?' _)> */
+		/* 264 NAME <- <('n' 'a' 'm' 'e')> */
 		func() bool {
-			position443, tokenIndex443 := position, tokenIndex
+			position1623, tokenIndex1623 := position, tokenIndex
 			{
-				position444 := position
-				if buffer[position] != rune('t') {
-					goto l443
+				position1624 := position
+				if buffer[position] != rune('n') {
+					goto l1623
 				}
 				position++
-				if buffer[position] != rune('o') {
-					goto l443
+				if buffer[position] != rune('a') {
+					goto l1623
 				}
 				position++
-				if buffer[position] != rune('?') {
-					goto l443
+				if buffer[position] != rune('m') {
+					goto l1623
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l443
+				if buffer[position] != rune('e') {
+					goto l1623
 				}
-				add(ruleTO_QUERY, position444)
+				position++
+				add(ruleNAME, position1624)
 			}
 			return true
-		l443:
-			position, tokenIndex = position443, tokenIndex443
+		l1623:
+			position, tokenIndex = position1623, tokenIndex1623
 			return false
 		},
-		/* 79 IN <- <('i' 'n' _)> */
+		/* 265 TYPE <- <('t' 'y' 'p' 'e')> */
 		func() bool {
-			position445, tokenIndex445 := position, tokenIndex
+			position1625, tokenIndex1625 := position, tokenIndex
 			{
-				position446 := position
-				if buffer[position] != rune('i') {
-					goto l445
+				position1626 := position
+				if buffer[position] != rune('t') {
+					goto l1625
 				}
 				position++
-				if buffer[position] != rune('n') {
-					goto l445
+				if buffer[position] != rune('y') {
+					goto l1625
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l445
+				if buffer[position] != rune('p') {
+					goto l1625
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1625
 				}
-				add(ruleIN, position446)
+				position++
+				add(ruleTYPE, position1626)
 			}
 			return true
-		l445:
-			position, tokenIndex = position445, tokenIndex445
+		l1625:
+			position, tokenIndex = position1625, tokenIndex1625
 			return false
 		},
-		/* 80 IN_QUERY <- <('i' 'n' '?' _)> */
+		/* 266 VERB <- <('v' 'e' 'r' 'b')> */
 		func() bool {
-			position447, tokenIndex447 := position, tokenIndex
+			position1627, tokenIndex1627 := position, tokenIndex
 			{
-				position448 := position
-				if buffer[position] != rune('i') {
-					goto l447
+				position1628 := position
+				if buffer[position] != rune('v') {
+					goto l1627
 				}
 				position++
-				if buffer[position] != rune('n') {
-					goto l447
+				if buffer[position] != rune('e') {
+					goto l1627
 				}
 				position++
-				if buffer[position] != rune('?') {
-					goto l447
+				if buffer[position] != rune('r') {
+					goto l1627
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l447
+				if buffer[position] != rune('b') {
+					goto l1627
 				}
-				add(ruleIN_QUERY, position448)
+				position++
+				add(ruleVERB, position1628)
 			}
 			return true
-		l447:
-			position, tokenIndex = position447, tokenIndex447
+		l1627:
+			position, tokenIndex = position1627, tokenIndex1627
 			return false
 		},
-		/* 81 CREATE <- <('c' 'r' 'e' 'a' 't' 'e' _)> */
+		/* 267 MECHANISM <- <('m' 'e' 'c' 'h' 'a' 'n' 'i' 's' 'm')> */
 		func() bool {
-			position449, tokenIndex449 := position, tokenIndex
+			position1629, tokenIndex1629 := position, tokenIndex
 			{
-				position450 := position
-				if buffer[position] != rune('c') {
-					goto l449
+				position1630 := position
+				if buffer[position] != rune('m') {
+					goto l1629
 				}
 				position++
-				if buffer[position] != rune('r') {
-					goto l449
+				if buffer[position] != rune('e') {
+					goto l1629
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l449
+				if buffer[position] != rune('c') {
+					goto l1629
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l1629
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l449
+					goto l1629
 				}
 				position++
-				if buffer[position] != rune('t') {
-					goto l449
+				if buffer[position] != rune('n') {
+					goto l1629
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l449
+				if buffer[position] != rune('i') {
+					goto l1629
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l449
+				if buffer[position] != rune('s') {
+					goto l1629
 				}
-				add(ruleCREATE, position450)
+				position++
+				if buffer[position] != rune('m') {
+					goto l1629
+				}
+				position++
+				add(ruleMECHANISM, position1630)
 			}
 			return true
-		l449:
-			position, tokenIndex = position449, tokenIndex449
+		l1629:
+			position, tokenIndex = position1629, tokenIndex1629
 			return false
 		},
-		/* 82 DELETE <- <('d' 'e' 'l' 'e' 't' 'e' _)> */
+		/* 268 ASYNC <- <('a' 's' 'y' 'n' 'c')> */
 		func() bool {
-			position451, tokenIndex451 := position, tokenIndex
+			position1631, tokenIndex1631 := position, tokenIndex
 			{
-				position452 := position
-				if buffer[position] != rune('d') {
-					goto l451
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l451
+				position1632 := position
+				if buffer[position] != rune('a') {
+					goto l1631
 				}
 				position++
-				if buffer[position] != rune('l') {
-					goto l451
+				if buffer[position] != rune('s') {
+					goto l1631
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l451
+				if buffer[position] != rune('y') {
+					goto l1631
 				}
 				position++
-				if buffer[position] != rune('t') {
-					goto l451
+				if buffer[position] != rune('n') {
+					goto l1631
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l451
+				if buffer[position] != rune('c') {
+					goto l1631
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l451
-				}
-				add(ruleDELETE, position452)
+				add(ruleASYNC, position1632)
 			}
 			return true
-		l451:
-			position, tokenIndex = position451, tokenIndex451
+		l1631:
+			position, tokenIndex = position1631, tokenIndex1631
 			return false
 		},
-		/* 83 SET <- <('s' 'e' 't' _)> */
+		/* 269 EXPANDED <- <('e' 'x' 'p' 'a' 'n' 'd' 'e' 'd')> */
 		func() bool {
-			position453, tokenIndex453 := position, tokenIndex
+			position1633, tokenIndex1633 := position, tokenIndex
 			{
-				position454 := position
-				if buffer[position] != rune('s') {
-					goto l453
+				position1634 := position
+				if buffer[position] != rune('e') {
+					goto l1633
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l453
+				if buffer[position] != rune('x') {
+					goto l1633
 				}
 				position++
-				if buffer[position] != rune('t') {
-					goto l453
+				if buffer[position] != rune('p') {
+					goto l1633
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l453
+				if buffer[position] != rune('a') {
+					goto l1633
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1633
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l1633
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1633
 				}
-				add(ruleSET, position454)
+				position++
+				if buffer[position] != rune('d') {
+					goto l1633
+				}
+				position++
+				add(ruleEXPANDED, position1634)
 			}
 			return true
-		l453:
-			position, tokenIndex = position453, tokenIndex453
+		l1633:
+			position, tokenIndex = position1633, tokenIndex1633
 			return false
 		},
-		/* 84 CLEAR <- <('c' 'l' 'e' 'a' 'r' _)> */
+		/* 270 ARCHIVED <- <('a' 'r' 'c' 'h' 'i' 'v' 'e' 'd')> */
 		func() bool {
-			position455, tokenIndex455 := position, tokenIndex
+			position1635, tokenIndex1635 := position, tokenIndex
 			{
-				position456 := position
+				position1636 := position
+				if buffer[position] != rune('a') {
+					goto l1635
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1635
+				}
+				position++
 				if buffer[position] != rune('c') {
-					goto l455
+					goto l1635
 				}
 				position++
-				if buffer[position] != rune('l') {
-					goto l455
+				if buffer[position] != rune('h') {
+					goto l1635
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l455
+				if buffer[position] != rune('i') {
+					goto l1635
 				}
 				position++
-				if buffer[position] != rune('a') {
-					goto l455
+				if buffer[position] != rune('v') {
+					goto l1635
 				}
 				position++
-				if buffer[position] != rune('r') {
-					goto l455
+				if buffer[position] != rune('e') {
+					goto l1635
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l455
+				if buffer[position] != rune('d') {
+					goto l1635
 				}
-				add(ruleCLEAR, position456)
+				position++
+				add(ruleARCHIVED, position1636)
 			}
 			return true
-		l455:
-			position, tokenIndex = position455, tokenIndex455
+		l1635:
+			position, tokenIndex = position1635, tokenIndex1635
 			return false
 		},
-		/* 85 FETCH <- <('f' 'e' 't' 'c' 'h' _)> */
+		/* 271 STATUS <- <('s' 't' 'a' 't' 'u' 's')> */
 		func() bool {
-			position457, tokenIndex457 := position, tokenIndex
+			position1637, tokenIndex1637 := position, tokenIndex
 			{
-				position458 := position
-				if buffer[position] != rune('f') {
-					goto l457
+				position1638 := position
+				if buffer[position] != rune('s') {
+					goto l1637
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l457
+				if buffer[position] != rune('t') {
+					goto l1637
 				}
 				position++
-				if buffer[position] != rune('t') {
-					goto l457
+				if buffer[position] != rune('a') {
+					goto l1637
 				}
 				position++
-				if buffer[position] != rune('c') {
-					goto l457
+				if buffer[position] != rune('t') {
+					goto l1637
 				}
 				position++
-				if buffer[position] != rune('h') {
-					goto l457
+				if buffer[position] != rune('u') {
+					goto l1637
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l457
+				if buffer[position] != rune('s') {
+					goto l1637
 				}
-				add(ruleFETCH, position458)
+				position++
+				add(ruleSTATUS, position1638)
 			}
 			return true
-		l457:
-			position, tokenIndex = position457, tokenIndex457
+		l1637:
+			position, tokenIndex = position1637, tokenIndex1637
 			return false
 		},
-		/* 86 LIST <- <('l' 'i' 's' 't' _)> */
+		/* 272 OWNER <- <('o' 'w' 'n' 'e' 'r')> */
 		func() bool {
-			position459, tokenIndex459 := position, tokenIndex
+			position1639, tokenIndex1639 := position, tokenIndex
 			{
-				position460 := position
-				if buffer[position] != rune('l') {
-					goto l459
+				position1640 := position
+				if buffer[position] != rune('o') {
+					goto l1639
 				}
 				position++
-				if buffer[position] != rune('i') {
-					goto l459
+				if buffer[position] != rune('w') {
+					goto l1639
 				}
 				position++
-				if buffer[position] != rune('s') {
-					goto l459
+				if buffer[position] != rune('n') {
+					goto l1639
 				}
 				position++
-				if buffer[position] != rune('t') {
-					goto l459
+				if buffer[position] != rune('e') {
+					goto l1639
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l459
+				if buffer[position] != rune('r') {
+					goto l1639
 				}
-				add(ruleLIST, position460)
+				position++
+				add(ruleOWNER, position1640)
 			}
 			return true
-		l459:
-			position, tokenIndex = position459, tokenIndex459
+		l1639:
+			position, tokenIndex = position1639, tokenIndex1639
 			return false
 		},
-		/* 87 EXISTS <- <('e' 'x' 'i' 's' 't' 's' _)> */
+		/* 273 CONTACTS <- <('c' 'o' 'n' 't' 'a' 'c' 't' 's')> */
 		func() bool {
-			position461, tokenIndex461 := position, tokenIndex
+			position1641, tokenIndex1641 := position, tokenIndex
 			{
-				position462 := position
-				if buffer[position] != rune('e') {
-					goto l461
+				position1642 := position
+				if buffer[position] != rune('c') {
+					goto l1641
 				}
 				position++
-				if buffer[position] != rune('x') {
-					goto l461
+				if buffer[position] != rune('o') {
+					goto l1641
 				}
 				position++
-				if buffer[position] != rune('i') {
-					goto l461
+				if buffer[position] != rune('n') {
+					goto l1641
 				}
 				position++
-				if buffer[position] != rune('s') {
-					goto l461
+				if buffer[position] != rune('t') {
+					goto l1641
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1641
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l1641
 				}
 				position++
 				if buffer[position] != rune('t') {
-					goto l461
+					goto l1641
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l461
+					goto l1641
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l461
-				}
-				add(ruleEXISTS, position462)
+				add(ruleCONTACTS, position1642)
 			}
 			return true
-		l461:
-			position, tokenIndex = position461, tokenIndex461
+		l1641:
+			position, tokenIndex = position1641, tokenIndex1641
 			return false
 		},
-		/* 88 FREE <- <('f' 'r' 'e' 'e' _)> */
+		/* 274 SOURCE <- <('s' 'o' 'u' 'r' 'c' 'e')> */
 		func() bool {
-			position463, tokenIndex463 := position, tokenIndex
+			position1643, tokenIndex1643 := position, tokenIndex
 			{
-				position464 := position
-				if buffer[position] != rune('f') {
-					goto l463
+				position1644 := position
+				if buffer[position] != rune('s') {
+					goto l1643
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1643
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l1643
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l463
+					goto l1643
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l463
+				if buffer[position] != rune('c') {
+					goto l1643
 				}
 				position++
 				if buffer[position] != rune('e') {
-					goto l463
+					goto l1643
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l463
-				}
-				add(ruleFREE, position464)
+				add(ruleSOURCE, position1644)
 			}
 			return true
-		l463:
-			position, tokenIndex = position463, tokenIndex463
+		l1643:
+			position, tokenIndex = position1643, tokenIndex1643
 			return false
 		},
-		/* 89 NEST <- <('n' 'e' 's' 't' _)> */
+		/* 275 LINKS <- <('l' 'i' 'n' 'k' 's')> */
 		func() bool {
-			position465, tokenIndex465 := position, tokenIndex
+			position1645, tokenIndex1645 := position, tokenIndex
 			{
-				position466 := position
-				if buffer[position] != rune('n') {
-					goto l465
+				position1646 := position
+				if buffer[position] != rune('l') {
+					goto l1645
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l465
+				if buffer[position] != rune('i') {
+					goto l1645
 				}
 				position++
-				if buffer[position] != rune('s') {
-					goto l465
+				if buffer[position] != rune('n') {
+					goto l1645
 				}
 				position++
-				if buffer[position] != rune('t') {
-					goto l465
+				if buffer[position] != rune('k') {
+					goto l1645
 				}
 				position++
-				if !_rules[rule_]() {
-					goto l465
+				if buffer[position] != rune('s') {
+					goto l1645
 				}
-				add(ruleNEST, position466)
+				position++
+				add(ruleLINKS, position1646)
 			}
 			return true
-		l465:
-			position, tokenIndex = position465, tokenIndex465
+		l1645:
+			position, tokenIndex = position1645, tokenIndex1645
 			return false
 		},
-		/* 90 TRUE <- <('t' 'r' 'u' 'e' _)> */
-		nil,
-		/* 91 FALSE <- <('f' 'a' 'l' 's' 'e' _)> */
-		nil,
-		/* 92 EXTERNAL <- <('e' 'x' 't' 'e' 'r' 'n' 'a' 'l')> */
+		/* 276 CLASSIFICATION <- <('c' 'l' 'a' 's' 's' 'i' 'f' 'i' 'c' 'a' 't' 'i' 'o' 'n')> */
 		func() bool {
-			position469, tokenIndex469 := position, tokenIndex
+			position1647, tokenIndex1647 := position, tokenIndex
 			{
-				position470 := position
-				if buffer[position] != rune('e') {
-					goto l469
+				position1648 := position
+				if buffer[position] != rune('c') {
+					goto l1647
 				}
 				position++
-				if buffer[position] != rune('x') {
-					goto l469
+				if buffer[position] != rune('l') {
+					goto l1647
 				}
 				position++
-				if buffer[position] != rune('t') {
-					goto l469
+				if buffer[position] != rune('a') {
+					goto l1647
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l469
+				if buffer[position] != rune('s') {
+					goto l1647
 				}
 				position++
-				if buffer[position] != rune('r') {
-					goto l469
+				if buffer[position] != rune('s') {
+					goto l1647
 				}
 				position++
-				if buffer[position] != rune('n') {
-					goto l469
+				if buffer[position] != rune('i') {
+					goto l1647
 				}
 				position++
-				if buffer[position] != rune('a') {
-					goto l469
+				if buffer[position] != rune('f') {
+					goto l1647
 				}
 				position++
-				if buffer[position] != rune('l') {
-					goto l469
+				if buffer[position] != rune('i') {
+					goto l1647
 				}
 				position++
-				add(ruleEXTERNAL, position470)
-			}
-			return true
-		l469:
-			position, tokenIndex = position469, tokenIndex469
-			return false
-		},
-		/* 93 NAME <- <('n' 'a' 'm' 'e')> */
-		func() bool {
-			position471, tokenIndex471 := position, tokenIndex
-			{
-				position472 := position
-				if buffer[position] != rune('n') {
-					goto l471
+				if buffer[position] != rune('c') {
+					goto l1647
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l471
+					goto l1647
 				}
 				position++
-				if buffer[position] != rune('m') {
-					goto l471
+				if buffer[position] != rune('t') {
+					goto l1647
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l471
+				if buffer[position] != rune('i') {
+					goto l1647
 				}
 				position++
-				add(ruleNAME, position472)
+				if buffer[position] != rune('o') {
+					goto l1647
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1647
+				}
+				position++
+				add(ruleCLASSIFICATION, position1648)
 			}
 			return true
-		l471:
-			position, tokenIndex = position471, tokenIndex471
+		l1647:
+			position, tokenIndex = position1647, tokenIndex1647
 			return false
 		},
-		/* 94 TYPE <- <('t' 'y' 'p' 'e')> */
+		/* 277 BOUNDARY <- <('b' 'o' 'u' 'n' 'd' 'a' 'r' 'y')> */
 		func() bool {
-			position473, tokenIndex473 := position, tokenIndex
+			position1649, tokenIndex1649 := position, tokenIndex
 			{
-				position474 := position
-				if buffer[position] != rune('t') {
-					goto l473
+				position1650 := position
+				if buffer[position] != rune('b') {
+					goto l1649
 				}
 				position++
-				if buffer[position] != rune('y') {
-					goto l473
+				if buffer[position] != rune('o') {
+					goto l1649
 				}
 				position++
-				if buffer[position] != rune('p') {
-					goto l473
+				if buffer[position] != rune('u') {
+					goto l1649
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l473
+				if buffer[position] != rune('n') {
+					goto l1649
 				}
 				position++
-				add(ruleTYPE, position474)
-			}
-			return true
-		l473:
-			position, tokenIndex = position473, tokenIndex473
-			return false
-		},
-		/* 95 VERB <- <('v' 'e' 'r' 'b')> */
-		func() bool {
-			position475, tokenIndex475 := position, tokenIndex
-			{
-				position476 := position
-				if buffer[position] != rune('v') {
-					goto l475
+				if buffer[position] != rune('d') {
+					goto l1649
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l475
+				if buffer[position] != rune('a') {
+					goto l1649
 				}
 				position++
 				if buffer[position] != rune('r') {
-					goto l475
+					goto l1649
 				}
 				position++
-				if buffer[position] != rune('b') {
-					goto l475
+				if buffer[position] != rune('y') {
+					goto l1649
 				}
 				position++
-				add(ruleVERB, position476)
+				add(ruleBOUNDARY, position1650)
 			}
 			return true
-		l475:
-			position, tokenIndex = position475, tokenIndex475
+		l1649:
+			position, tokenIndex = position1649, tokenIndex1649
 			return false
 		},
-		/* 96 MECHANISM <- <('m' 'e' 'c' 'h' 'a' 'n' 'i' 's' 'm')> */
+		/* 278 TAGS <- <('t' 'a' 'g' 's')> */
 		func() bool {
-			position477, tokenIndex477 := position, tokenIndex
+			position1651, tokenIndex1651 := position, tokenIndex
 			{
-				position478 := position
-				if buffer[position] != rune('m') {
-					goto l477
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l477
-				}
-				position++
-				if buffer[position] != rune('c') {
-					goto l477
-				}
-				position++
-				if buffer[position] != rune('h') {
-					goto l477
+				position1652 := position
+				if buffer[position] != rune('t') {
+					goto l1651
 				}
 				position++
 				if buffer[position] != rune('a') {
-					goto l477
+					goto l1651
 				}
 				position++
-				if buffer[position] != rune('n') {
-					goto l477
-				}
-				position++
-				if buffer[position] != rune('i') {
-					goto l477
+				if buffer[position] != rune('g') {
+					goto l1651
 				}
 				position++
 				if buffer[position] != rune('s') {
-					goto l477
+					goto l1651
 				}
 				position++
-				if buffer[position] != rune('m') {
-					goto l477
-				}
-				position++
-				add(ruleMECHANISM, position478)
+				add(ruleTAGS, position1652)
 			}
 			return true
-		l477:
-			position, tokenIndex = position477, tokenIndex477
+		l1651:
+			position, tokenIndex = position1651, tokenIndex1651
 			return false
 		},
-		/* 97 ASYNC <- <('a' 's' 'y' 'n' 'c')> */
+		/* 279 EXPAND <- <('e' 'x' 'p' 'a' 'n' 'd')> */
+		nil,
+		/* 280 FILTER <- <('f' 'i' 'l' 't' 'e' 'r')> */
+		nil,
+		/* 281 FOCUS <- <('f' 'o' 'c' 'u' 's')> */
+		nil,
+		/* 282 HOPS <- <('h' 'o' 'p' 's')> */
+		nil,
+		/* 283 X <- <'x'> */
+		nil,
+		/* 284 Y <- <'y'> */
+		nil,
+		/* 285 WIDTH <- <('w' 'i' 'd' 't' 'h')> */
+		nil,
+		/* 286 HEIGHT <- <('h' 'e' 'i' 'g' 'h' 't')> */
+		nil,
+		/* 287 WAYPOINTS <- <('w' 'a' 'y' 'p' 'o' 'i' 'n' 't' 's')> */
+		nil,
+		/* 288 THEME <- <('t' 'h' 'e' 'm' 'e')> */
 		func() bool {
-			position479, tokenIndex479 := position, tokenIndex
+			position1662, tokenIndex1662 := position, tokenIndex
 			{
-				position480 := position
-				if buffer[position] != rune('a') {
-					goto l479
+				position1663 := position
+				if buffer[position] != rune('t') {
+					goto l1662
 				}
 				position++
-				if buffer[position] != rune('s') {
-					goto l479
+				if buffer[position] != rune('h') {
+					goto l1662
 				}
 				position++
-				if buffer[position] != rune('y') {
-					goto l479
+				if buffer[position] != rune('e') {
+					goto l1662
 				}
 				position++
-				if buffer[position] != rune('n') {
-					goto l479
+				if buffer[position] != rune('m') {
+					goto l1662
 				}
 				position++
-				if buffer[position] != rune('c') {
-					goto l479
+				if buffer[position] != rune('e') {
+					goto l1662
 				}
 				position++
-				add(ruleASYNC, position480)
+				add(ruleTHEME, position1663)
 			}
 			return true
-		l479:
-			position, tokenIndex = position479, tokenIndex479
+		l1662:
+			position, tokenIndex = position1662, tokenIndex1662
 			return false
 		},
-		/* 98 EXPANDED <- <('e' 'x' 'p' 'a' 'n' 'd' 'e' 'd')> */
+		/* 289 MATCH <- <('m' 'a' 't' 'c' 'h')> */
+		nil,
+		/* 290 SHAPE <- <('s' 'h' 'a' 'p' 'e')> */
+		nil,
+		/* 291 COLOR <- <('c' 'o' 'l' 'o' 'r')> */
+		nil,
+		/* 292 BORDER <- <('b' 'o' 'r' 'd' 'e' 'r')> */
+		nil,
+		/* 293 LINE <- <('l' 'i' 'n' 'e')> */
+		nil,
+		/* 294 KIND <- <('k' 'i' 'n' 'd')> */
+		nil,
+		/* 295 PARENT <- <('p' 'a' 'r' 'e' 'n' 't')> */
+		nil,
+		/* 296 LABEL <- <('l' 'a' 'b' 'e' 'l')> */
+		nil,
+		/* 297 AT <- <('a' 't')> */
+		nil,
+		/* 298 RUNBOOK <- <('r' 'u' 'n' 'b' 'o' 'o' 'k')> */
+		nil,
+		/* 299 DASHBOARD <- <('d' 'a' 's' 'h' 'b' 'o' 'a' 'r' 'd')> */
+		nil,
+		/* 300 REPO <- <('r' 'e' 'p' 'o')> */
+		nil,
+		/* 301 ADR <- <('a' 'd' 'r')> */
+		nil,
+		/* 302 API_SPEC <- <('a' 'p' 'i' '-' 's' 'p' 'e' 'c')> */
+		nil,
+		/* 303 VERSION <- <('v' 'e' 'r' 's' 'i' 'o' 'n')> */
 		func() bool {
-			position481, tokenIndex481 := position, tokenIndex
+			position1678, tokenIndex1678 := position, tokenIndex
 			{
-				position482 := position
+				position1679 := position
+				if buffer[position] != rune('v') {
+					goto l1678
+				}
+				position++
 				if buffer[position] != rune('e') {
-					goto l481
+					goto l1678
 				}
 				position++
-				if buffer[position] != rune('x') {
-					goto l481
+				if buffer[position] != rune('r') {
+					goto l1678
 				}
 				position++
-				if buffer[position] != rune('p') {
-					goto l481
+				if buffer[position] != rune('s') {
+					goto l1678
 				}
 				position++
-				if buffer[position] != rune('a') {
-					goto l481
+				if buffer[position] != rune('i') {
+					goto l1678
 				}
 				position++
-				if buffer[position] != rune('n') {
-					goto l481
+				if buffer[position] != rune('o') {
+					goto l1678
 				}
 				position++
-				if buffer[position] != rune('d') {
-					goto l481
+				if buffer[position] != rune('n') {
+					goto l1678
 				}
 				position++
-				if buffer[position] != rune('e') {
-					goto l481
+				add(ruleVERSION, position1679)
+			}
+			return true
+		l1678:
+			position, tokenIndex = position1678, tokenIndex1678
+			return false
+		},
+		/* 304 ID <- <('i' 'd')> */
+		func() bool {
+			position1680, tokenIndex1680 := position, tokenIndex
+			{
+				position1681 := position
+				if buffer[position] != rune('i') {
+					goto l1680
 				}
 				position++
 				if buffer[position] != rune('d') {
-					goto l481
+					goto l1680
 				}
 				position++
-				add(ruleEXPANDED, position482)
+				add(ruleID, position1681)
 			}
 			return true
-		l481:
-			position, tokenIndex = position481, tokenIndex481
+		l1680:
+			position, tokenIndex = position1680, tokenIndex1680
 			return false
 		},
-		/* 99 VERSION <- <('v' 'e' 'r' 's' 'i' 'o' 'n')> */
+		/* 305 ENVIRONMENT <- <('e' 'n' 'v' 'i' 'r' 'o' 'n' 'm' 'e' 'n' 't' _)> */
+		nil,
+		/* 306 CLUSTER <- <('c' 'l' 'u' 's' 't' 'e' 'r' _)> */
+		nil,
+		/* 307 NODE_KIND <- <('n' 'o' 'd' 'e' _)> */
+		nil,
+		/* 308 PERSON <- <('p' 'e' 'r' 's' 'o' 'n' _)> */
+		nil,
+		/* 309 DATABASE <- <('d' 'a' 't' 'a' 'b' 'a' 's' 'e' _)> */
+		nil,
+		/* 310 QUEUE <- <('q' 'u' 'e' 'u' 'e' _)> */
+		nil,
+		/* 311 BLOBSTORE <- <('b' 'l' 'o' 'b' 's' 't' 'o' 'r' 'e' _)> */
+		nil,
+		/* 312 BROWSER <- <('b' 'r' 'o' 'w' 's' 'e' 'r' _)> */
 		nil,
-		/* 100 ID <- <('i' 'd')> */
+		/* 313 MOBILE <- <('m' 'o' 'b' 'i' 'l' 'e' _)> */
 		nil,
-		/* 101 PERSON <- <('p' 'e' 'r' 's' 'o' 'n' _)> */
+		/* 314 SERVER <- <('s' 'e' 'r' 'v' 'e' 'r' _)> */
 		nil,
-		/* 102 DATABASE <- <('d' 'a' 't' 'a' 'b' 'a' 's' 'e' _)> */
+		/* 315 DEVICE <- <('d' 'e' 'v' 'i' 'c' 'e' _)> */
 		nil,
-		/* 103 QUEUE <- <('q' 'u' 'e' 'u' 'e' _)> */
+		/* 316 CODE <- <('c' 'o' 'd' 'e' _)> */
 		nil,
-		/* 104 BLOBSTORE <- <('b' 'l' 'o' 'b' 's' 't' 'o' 'r' 'e' _)> */
+		/* 317 PLANNED <- <('p' 'l' 'a' 'n' 'n' 'e' 'd' _)> */
 		nil,
-		/* 105 BROWSER <- <('b' 'r' 'o' 'w' 's' 'e' 'r' _)> */
+		/* 318 ACTIVE <- <('a' 'c' 't' 'i' 'v' 'e' _)> */
 		nil,
-		/* 106 MOBILE <- <('m' 'o' 'b' 'i' 'l' 'e' _)> */
+		/* 319 DEPRECATED <- <('d' 'e' 'p' 'r' 'e' 'c' 'a' 't' 'e' 'd' _)> */
 		nil,
-		/* 107 SERVER <- <('s' 'e' 'r' 'v' 'e' 'r' _)> */
+		/* 320 RETIRED <- <('r' 'e' 't' 'i' 'r' 'e' 'd' _)> */
 		nil,
-		/* 108 DEVICE <- <('d' 'e' 'v' 'i' 'c' 'e' _)> */
+		/* 321 C4 <- <('c' '4' !TextChar _)> */
 		nil,
-		/* 109 CODE <- <('c' 'o' 'd' 'e' _)> */
+		/* 322 DARK <- <('d' 'a' 'r' 'k' !TextChar _)> */
 		nil,
-		/* 110 DELIMITER <- <('$' '$')> */
+		/* 323 PRINT <- <('p' 'r' 'i' 'n' 't' !TextChar _)> */
+		nil,
+		/* 324 DELIMITER <- <('$' '$')> */
 		func() bool {
-			position494, tokenIndex494 := position, tokenIndex
+			position1701, tokenIndex1701 := position, tokenIndex
 			{
-				position495 := position
+				position1702 := position
 				if buffer[position] != rune('$') {
-					goto l494
+					goto l1701
 				}
 				position++
 				if buffer[position] != rune('$') {
-					goto l494
+					goto l1701
 				}
 				position++
-				add(ruleDELIMITER, position495)
+				add(ruleDELIMITER, position1702)
 			}
 			return true
-		l494:
-			position, tokenIndex = position494, tokenIndex494
+		l1701:
+			position, tokenIndex = position1701, tokenIndex1701
 			return false
 		},
-		/* 111 QUOTE <- <'"'> */
+		/* 325 QUOTE <- <'"'> */
 		func() bool {
-			position496, tokenIndex496 := position, tokenIndex
+			position1703, tokenIndex1703 := position, tokenIndex
 			{
-				position497 := position
+				position1704 := position
 				if buffer[position] != rune('"') {
-					goto l496
+					goto l1703
 				}
 				position++
-				add(ruleQUOTE, position497)
+				add(ruleQUOTE, position1704)
 			}
 			return true
-		l496:
-			position, tokenIndex = position496, tokenIndex496
+		l1703:
+			position, tokenIndex = position1703, tokenIndex1703
 			return false
 		},
-		/* 112 EQUALS <- <'='> */
+		/* 326 EQUALS <- <'='> */
 		func() bool {
-			position498, tokenIndex498 := position, tokenIndex
+			position1705, tokenIndex1705 := position, tokenIndex
 			{
-				position499 := position
+				position1706 := position
 				if buffer[position] != rune('=') {
-					goto l498
+					goto l1705
 				}
 				position++
-				add(ruleEQUALS, position499)
+				add(ruleEQUALS, position1706)
 			}
 			return true
-		l498:
-			position, tokenIndex = position498, tokenIndex498
+		l1705:
+			position, tokenIndex = position1705, tokenIndex1705
 			return false
 		},
-		/* 113 FLAG <- <('-' '-'?)> */
+		/* 327 FLAG <- <('-' '-'?)> */
 		func() bool {
-			position500, tokenIndex500 := position, tokenIndex
+			position1707, tokenIndex1707 := position, tokenIndex
 			{
-				position501 := position
+				position1708 := position
 				if buffer[position] != rune('-') {
-					goto l500
+					goto l1707
 				}
 				position++
 				{
-					position502, tokenIndex502 := position, tokenIndex
+					position1709, tokenIndex1709 := position, tokenIndex
 					if buffer[position] != rune('-') {
-						goto l502
+						goto l1709
 					}
 					position++
-					goto l503
-				l502:
-					position, tokenIndex = position502, tokenIndex502
+					goto l1710
+				l1709:
+					position, tokenIndex = position1709, tokenIndex1709
 				}
-			l503:
-				add(ruleFLAG, position501)
+			l1710:
+				add(ruleFLAG, position1708)
 			}
 			return true
-		l500:
-			position, tokenIndex = position500, tokenIndex500
+		l1707:
+			position, tokenIndex = position1707, tokenIndex1707
 			return false
 		},
-		/* 114 STRICT <- <('s' 't' 'r' 'i' 'c' 't' _)> */
+		/* 328 STRICT <- <('s' 't' 'r' 'i' 'c' 't' _)> */
+		nil,
+		/* 329 VERBOSE <- <('v' 'e' 'r' 'b' 'o' 's' 'e' _)> */
+		nil,
+		/* 330 IDS <- <('i' 'd' 's' _)> */
+		nil,
+		/* 331 DRY_RUN <- <('d' 'r' 'y' '-' 'r' 'u' 'n' _)> */
 		nil,
-		/* 115 VERBOSE <- <('v' 'e' 'r' 'b' 'o' 's' 'e' _)> */
+		/* 332 CASCADE <- <('c' 'a' 's' 'c' 'a' 'd' 'e' _)> */
 		nil,
-		/* 116 IDS <- <('i' 'd' 's' _)> */
+		/* 333 ALL_RELS <- <('a' 'l' 'l' '-' 'r' 'e' 'l' 's' _)> */
 		nil,
-		/* 117 _ <- <Whitespace*> */
+		/* 334 DEPTH <- <('d' 'e' 'p' 't' 'h' _)> */
+		nil,
+		/* 335 VIEW <- <('v' 'i' 'e' 'w' 's'? !TextChar _)> */
+		func() bool {
+			position1718, tokenIndex1718 := position, tokenIndex
+			{
+				position1719 := position
+				if buffer[position] != rune('v') {
+					goto l1718
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1718
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1718
+				}
+				position++
+				if buffer[position] != rune('w') {
+					goto l1718
+				}
+				position++
+				{
+					position1720, tokenIndex1720 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1720
+					}
+					position++
+					goto l1721
+				l1720:
+					position, tokenIndex = position1720, tokenIndex1720
+				}
+			l1721:
+				{
+					position1722, tokenIndex1722 := position, tokenIndex
+					if !_rules[ruleTextChar]() {
+						goto l1722
+					}
+					goto l1718
+				l1722:
+					position, tokenIndex = position1722, tokenIndex1722
+				}
+				if !_rules[rule_]() {
+					goto l1718
+				}
+				add(ruleVIEW, position1719)
+			}
+			return true
+		l1718:
+			position, tokenIndex = position1718, tokenIndex1718
+			return false
+		},
+		/* 336 _ <- <Whitespace*> */
 		func() bool {
 			{
-				position508 := position
-			l509:
+				position1724 := position
+			l1725:
 				{
-					position510, tokenIndex510 := position, tokenIndex
+					position1726, tokenIndex1726 := position, tokenIndex
 					{
-						position511 := position
+						position1727 := position
 						{
 							switch buffer[position] {
 							case '\t':
 								if buffer[position] != rune('\t') {
-									goto l510
+									goto l1726
 								}
 								position++
 							case ' ':
 								if buffer[position] != rune(' ') {
-									goto l510
+									goto l1726
 								}
 								position++
 							default:
 								{
-									position513 := position
+									position1729 := position
 									{
-										position514, tokenIndex514 := position, tokenIndex
+										position1730, tokenIndex1730 := position, tokenIndex
 										if buffer[position] != rune('\r') {
-											goto l515
+											goto l1731
 										}
 										position++
 										if buffer[position] != rune('\n') {
-											goto l515
+											goto l1731
 										}
 										position++
-										goto l514
-									l515:
-										position, tokenIndex = position514, tokenIndex514
+										goto l1730
+									l1731:
+										position, tokenIndex = position1730, tokenIndex1730
 										if buffer[position] != rune('\n') {
-											goto l516
+											goto l1732
 										}
 										position++
-										goto l514
-									l516:
-										position, tokenIndex = position514, tokenIndex514
+										goto l1730
+									l1732:
+										position, tokenIndex = position1730, tokenIndex1730
 										if buffer[position] != rune('\r') {
-											goto l510
+											goto l1726
 										}
 										position++
 									}
-								l514:
-									add(ruleEOL, position513)
+								l1730:
+									add(ruleEOL, position1729)
 								}
 							}
 						}
 
-						add(ruleWhitespace, position511)
+						add(ruleWhitespace, position1727)
 					}
-					goto l509
-				l510:
-					position, tokenIndex = position510, tokenIndex510
+					goto l1725
+				l1726:
+					position, tokenIndex = position1726, tokenIndex1726
 				}
-				add(rule_, position508)
+				add(rule_, position1724)
 			}
 			return true
 		},
-		/* 118 Whitespace <- <((&('\t') '\t') | (&(' ') ' ') | (&('\n' | '\r') EOL))> */
+		/* 337 Whitespace <- <((&('\t') '\t') | (&(' ') ' ') | (&('\n' | '\r') EOL))> */
 		nil,
-		/* 119 EOL <- <(('\r' '\n') / '\n' / '\r')> */
+		/* 338 EOL <- <(('\r' '\n') / '\n' / '\r')> */
 		nil,
-		/* 120 END <- <!.> */
+		/* 339 END <- <!.> */
 		func() bool {
-			position519, tokenIndex519 := position, tokenIndex
+			position1735, tokenIndex1735 := position, tokenIndex
 			{
-				position520 := position
+				position1736 := position
 				{
-					position521, tokenIndex521 := position, tokenIndex
+					position1737, tokenIndex1737 := position, tokenIndex
 					if !matchDot() {
-						goto l521
+						goto l1737
 					}
-					goto l519
-				l521:
-					position, tokenIndex = position521, tokenIndex521
+					goto l1735
+				l1737:
+					position, tokenIndex = position1737, tokenIndex1737
 				}
-				add(ruleEND, position520)
+				add(ruleEND, position1736)
 			}
 			return true
-		l519:
-			position, tokenIndex = position519, tokenIndex519
+		l1735:
+			position, tokenIndex = position1735, tokenIndex1735
 			return false
 		},
-		/* 122 Action0 <- <{
+		/* 341 Action0 <- <{
 		   p.StmtType = "Response"
 		 }> */
 		nil,
-		/* 123 Action1 <- <{
+		/* 342 Action1 <- <{
 		   p.StmtType = "Command"
 		   p.InputAttributes.Raw = p.Buffer
 		 }> */
 		nil,
 		nil,
-		/* 125 Action2 <- <{ p.InputAttributes.SecondaryIds = append(p.InputAttributes.SecondaryIds, cleanString(text)) }> */
+		/* 344 Action2 <- <{ p.InputAttributes.SecondaryIds = append(p.InputAttributes.SecondaryIds, cleanString(text)) }> */
+		nil,
+		/* 345 Action3 <- <{ p.InputAttributes.SecondaryIds = append(p.InputAttributes.SecondaryIds, cleanString(text)) }> */
+		nil,
+		/* 346 Action4 <- <{ p.InputAttributes.Params["path"] = cleanString(text) }> */
+		nil,
+		/* 347 Action5 <- <{ p.InputAttributes.Params["path"] = cleanString(text) }> */
+		nil,
+		/* 348 Action6 <- <{ p.InputAttributes.Params["path"] = cleanString(text) }> */
+		nil,
+		/* 349 Action7 <- <{ p.InputAttributes.Params["at"] = cleanString(text) }> */
+		nil,
+		/* 350 Action8 <- <{ p.InputAttributes.Params["path"] = cleanString(text) }> */
+		nil,
+		/* 351 Action9 <- <{ p.InputAttributes.Verb = "fetch" }> */
 		nil,
-		/* 126 Action3 <- <{ p.InputAttributes.Verb = "fetch" }> */
+		/* 352 Action10 <- <{ p.InputAttributes.Verb = "in" }> */
 		nil,
-		/* 127 Action4 <- <{ p.InputAttributes.Verb = "list" }> */
+		/* 353 Action11 <- <{ p.InputAttributes.Params["class"] = cleanString(text) }> */
 		nil,
-		/* 128 Action5 <- <{ p.InputAttributes.Verb = "create-or-fetch" }> */
+		/* 354 Action12 <- <{ p.InputAttributes.Verb = "create-or-fetch" }> */
 		nil,
-		/* 129 Action6 <- <{ p.InputAttributes.Verb = "create-or-set" }> */
+		/* 355 Action13 <- <{ p.InputAttributes.Verb = "create-or-set" }> */
 		nil,
-		/* 130 Action7 <- <{
+		/* 356 Action14 <- <{
 		   p.StmtType = "WorldObject"; p.Response.Object.Type = "world"
-		   p.Response.Object.Repr = strings.Join(append([]string{p.WorldParams["paramString"], p.TreeString}, p.RelStrings...), "\n")
+		   lines := append([]string{p.WorldParams["paramString"], p.TreeString}, p.RelStrings...)
+		   lines = append(append(lines, p.NodeStrings...), p.DeployStrings...)
+		   lines = append(append(lines, p.ScenarioStrings...), p.StepStrings...)
+		   lines = append(append(lines, p.ViewStrings...), p.PinStrings...)
+		   lines = append(lines, p.StyleStrings...)
+		   p.Response.Object.Repr = strings.Join(lines, "\n")
 		 }> */
 		nil,
-		/* 131 Action8 <- <{
+		/* 357 Action15 <- <{
 		   p.Response.Object.Type = "item"; p.Response.Object.Repr = strings.TrimSpace(text); p.ItemStrings = append(p.ItemStrings, strings.TrimSpace(text))
 		   p.currentId = p.InputAttributes.ResourceId
 		   p.nodeStack = append(p.nodeStack, Node{Id: p.currentId, Children: []Node{}})
 		 }> */
 		nil,
-		/* 132 Action9 <- <{ p.Response.Object.Type = "rel"; p.Response.Object.Repr = strings.TrimSpace(text); p.RelStrings = append(p.RelStrings, strings.TrimSpace(text)) }> */
+		/* 358 Action16 <- <{ p.Response.Object.Type = "rel"; p.Response.Object.Repr = strings.TrimSpace(text); p.RelStrings = append(p.RelStrings, strings.TrimSpace(text)) }> */
+		nil,
+		/* 359 Action17 <- <{ p.Response.Object.Type = "node"; p.Response.Object.Repr = strings.TrimSpace(text); p.NodeStrings = append(p.NodeStrings, strings.TrimSpace(text)) }> */
+		nil,
+		/* 360 Action18 <- <{ p.DeployStrings = append(p.DeployStrings, strings.TrimSpace(text)) }> */
+		nil,
+		/* 361 Action19 <- <{ p.Response.Object.Type = "scenario"; p.Response.Object.Repr = strings.TrimSpace(text); p.ScenarioStrings = append(p.ScenarioStrings, strings.TrimSpace(text)) }> */
+		nil,
+		/* 362 Action20 <- <{
+		   p.Response.Object.Repr = strings.TrimSpace(p.Response.Object.Repr + "\n" + strings.TrimSpace(text)); p.StepStrings = append(p.StepStrings, strings.TrimSpace(text))
+		 }> */
+		nil,
+		/* 363 Action21 <- <{
+		   p.Details = append(p.Details, p.detail)
+		   p.Response.Object.Type = "detail"; b, _ := json.Marshal(p.Details); p.Response.Object.Repr = string(b)
+		 }> */
+		nil,
+		/* 364 Action22 <- <{
+		   p.Response.Object.Type = "changes"; b, _ := json.Marshal(p.Changes); p.Response.Object.Repr = string(b)
+		 }> */
+		nil,
+		/* 365 Action23 <- <{
+		   p.Response.Object.Type = "dataflow"; b, _ := json.Marshal(p.DataFlow); p.Response.Object.Repr = string(b)
+		 }> */
 		nil,
-		/* 133 Action10 <- <{ p.Response.Object.Type = "ids"; b, _ := json.Marshal(p.InputAttributes.ResourceIds); p.Response.Object.Repr = string(b) }> */
+		/* 366 Action24 <- <{ p.Response.Object.Type = "ids"; b, _ := json.Marshal(p.InputAttributes.ResourceIds); p.Response.Object.Repr = string(b) }> */
 		nil,
-		/* 134 Action11 <- <{
+		/* 367 Action25 <- <{
 		   p.StmtType = "Tree"; p.Response.Object.Type = "tree"; p.Response.Object.Repr = text; p.TreeString = text
 		   if len(p.nodeStack) > 0 {
 		     node := p.nodeStack[len(p.nodeStack)-1]
@@ -5358,27 +18281,102 @@ func (p *Parser) Init(options ...func(*Parser) error) error {
 		   }
 		 }> */
 		nil,
-		/* 135 Action12 <- <{
+		/* 368 Action26 <- <{
 		   p.currentId = "nil"
 		   p.nodeStack = append(p.nodeStack, Node{Id: p.currentId, Children: []Node{}})
 		 }> */
 		nil,
-		/* 136 Action13 <- <{
+		/* 369 Action27 <- <{
 		   p.StmtType = "Status"
-		   p.Response.Status.Message = cleanString(text)
 		 }> */
 		nil,
-		/* 137 Action14 <- <{ p.Response.Status.Code = p.number }> */
+		/* 370 Action28 <- <{ p.Response.Status.Message = cleanString(text) }> */
+		nil,
+		/* 371 Action29 <- <{ p.Response.Status.Missing = cleanString(text) }> */
+		nil,
+		/* 372 Action30 <- <{ p.Response.Status.Suggestions = append(p.Response.Status.Suggestions, cleanString(text)) }> */
+		nil,
+		/* 373 Action31 <- <{ p.detail = ItemDetail{Item: strings.TrimSpace(text), Components: []string{}, Inbound: []string{}, Outbound: []string{}} }> */
+		nil,
+		/* 374 Action32 <- <{ p.Response.Object.Type = "view"; p.Response.Object.Repr = strings.TrimSpace(text); p.ViewStrings = append(p.ViewStrings, strings.TrimSpace(text)) }> */
+		nil,
+		/* 375 Action33 <- <{ p.Response.Object.Type = "pin"; p.Response.Object.Repr = strings.TrimSpace(p.Response.Object.Repr + "\n" + strings.TrimSpace(text)); p.PinStrings = append(p.PinStrings, strings.TrimSpace(text)) }> */
+		nil,
+		/* 376 Action34 <- <{ p.Response.Object.Type = "style"; p.Response.Object.Repr = strings.TrimSpace(text); p.StyleStrings = append(p.StyleStrings, strings.TrimSpace(text)) }> */
+		nil,
+		/* 377 Action35 <- <{ p.detail.Parent = cleanString(text) }> */
+		nil,
+		/* 378 Action36 <- <{ p.detail.Components = append(p.detail.Components, cleanString(text)) }> */
+		nil,
+		/* 379 Action37 <- <{ p.detail.Inbound = append(p.detail.Inbound, strings.TrimSpace(text)) }> */
+		nil,
+		/* 380 Action38 <- <{ p.detail.Outbound = append(p.detail.Outbound, strings.TrimSpace(text)) }> */
+		nil,
+		/* 381 Action39 <- <{ p.Changes.Matched = append(p.Changes.Matched, cleanString(text)) }> */
+		nil,
+		/* 382 Action40 <- <{ p.change.Object = strings.TrimSpace(text); p.Changes.Changes = append(p.Changes.Changes, p.change) }> */
+		nil,
+		/* 383 Action41 <- <{ p.change.Object = strings.TrimSpace(text); p.Changes.Changes = append(p.Changes.Changes, p.change) }> */
+		nil,
+		/* 384 Action42 <- <{ p.change.Object = strings.TrimSpace(text); p.Changes.Changes = append(p.Changes.Changes, p.change) }> */
+		nil,
+		/* 385 Action43 <- <{ p.change.Object = strings.TrimSpace(text); p.Changes.Changes = append(p.Changes.Changes, p.change) }> */
+		nil,
+		/* 386 Action44 <- <{ p.change.Object = strings.TrimSpace(text); p.Changes.Changes = append(p.Changes.Changes, p.change) }> */
+		nil,
+		/* 387 Action45 <- <{ p.change.Object = strings.TrimSpace(text); p.Changes.Changes = append(p.Changes.Changes, p.change) }> */
+		nil,
+		/* 388 Action46 <- <{ p.change.Object = strings.TrimSpace(text); p.Changes.Changes = append(p.Changes.Changes, p.change) }> */
+		nil,
+		/* 389 Action47 <- <{ p.Changes.Changes = append(p.Changes.Changes, p.change) }> */
+		nil,
+		/* 390 Action48 <- <{ p.change = Change{Action: text} }> */
+		nil,
+		/* 391 Action49 <- <{ p.change = Change{Action: "moved", Object: cleanString(text)} }> */
+		nil,
+		/* 392 Action50 <- <{ p.change.From = cleanString(text) }> */
+		nil,
+		/* 393 Action51 <- <{ p.change.To = cleanString(text) }> */
+		nil,
+		/* 394 Action52 <- <{ p.DataFlow.Class = cleanString(text) }> */
+		nil,
+		/* 395 Action53 <- <{ p.DataFlow.Steps = append(p.DataFlow.Steps, p.flowStep) }> */
+		nil,
+		/* 396 Action54 <- <{ p.flowStep = FlowStep{Kind: text, Path: []string{}} }> */
+		nil,
+		/* 397 Action55 <- <{ p.flowStep.Id = cleanString(text) }> */
+		nil,
+		/* 398 Action56 <- <{ p.flowStep.Path = append(p.flowStep.Path, cleanString(text)) }> */
+		nil,
+		/* 399 Action57 <- <{ p.Response.Status.Code = p.number }> */
 		nil,
-		/* 138 Action15 <- <{ p.InputAttributes.Params["limit"] = cleanString(text) }> */
+		/* 400 Action58 <- <{ p.InputAttributes.Params["limit"] = cleanString(text) }> */
 		nil,
-		/* 139 Action16 <- <{ p.InputAttributes.ResourceId = cleanString(text) }> */
+		/* 401 Action59 <- <{ p.InputAttributes.Params["owner"] = cleanString(text) }> */
 		nil,
-		/* 140 Action17 <- <{
+		/* 402 Action60 <- <{ p.InputAttributes.ResourceId = cleanString(text) }> */
+		nil,
+		/* 403 Action61 <- <{
 		   p.InputAttributes.SecondaryIds = append(p.InputAttributes.SecondaryIds, cleanString(text))
 		 }> */
 		nil,
-		/* 141 Action18 <- <{
+		/* 404 Action62 <- <{ p.InputAttributes.ResourceIds = append(p.InputAttributes.ResourceIds, cleanString(text)) }> */
+		nil,
+		/* 405 Action63 <- <{ p.InputAttributes.SecondaryIds = append(p.InputAttributes.SecondaryIds, cleanString(text)) }> */
+		nil,
+		/* 406 Action64 <- <{ p.InputAttributes.Selectors[len(p.InputAttributes.Selectors)-1].Text = strings.TrimSpace(text) }> */
+		nil,
+		/* 407 Action65 <- <{ p.InputAttributes.Selectors = append(p.InputAttributes.Selectors, Selector{Kind: "glob", Pattern: text}) }> */
+		nil,
+		/* 408 Action66 <- <{ p.InputAttributes.Selectors = append(p.InputAttributes.Selectors, Selector{Kind: "regex", Pattern: text}) }> */
+		nil,
+		/* 409 Action67 <- <{ p.InputAttributes.Selectors = append(p.InputAttributes.Selectors, Selector{Kind: "in", Pattern: cleanString(text)}) }> */
+		nil,
+		/* 410 Action68 <- <{ p.currentId = cleanString(text) }> */
+		nil,
+		/* 411 Action69 <- <{ p.InputAttributes.Assignments[p.currentId] = cleanString(text) }> */
+		nil,
+		/* 412 Action70 <- <{
 		   p.InputAttributes.ResourceId = ""
 		   ids := strings.Fields(text)
 		   for _, id := range ids {
@@ -5386,85 +18384,260 @@ func (p *Parser) Init(options ...func(*Parser) error) error {
 		   }
 		 }> */
 		nil,
-		/* 142 Action19 <- <{
+		/* 413 Action71 <- <{
 		   p.WorldParams["paramString"] = fmt.Sprintf("version=%s\nid=%s\nname=%s\nexpanded=%s", p.WorldParams["version"], p.WorldParams["id"], p.WorldParams["name"], p.WorldParams["expanded"])
+		   if theme, ok := p.WorldParams["theme"]; ok {
+		     p.WorldParams["paramString"] += "\ntheme=" + theme
+		   }
 		 }> */
 		nil,
-		/* 143 Action20 <- <{ p.WorldParams["version"] = cleanString(text) }> */
+		/* 414 Action72 <- <{ p.WorldParams["version"] = cleanString(text) }> */
+		nil,
+		/* 415 Action73 <- <{ p.WorldParams["id"] = cleanString(text) }> */
+		nil,
+		/* 416 Action74 <- <{ p.WorldParams["name"] = strings.TrimSpace(text) }> */
+		nil,
+		/* 417 Action75 <- <{ p.WorldParams["expanded"] = strings.TrimSpace(text) }> */
+		nil,
+		/* 418 Action76 <- <{ p.WorldParams["theme"] = strings.TrimSpace(text) }> */
+		nil,
+		/* 419 Action77 <- <{ p.Params["name"] = cleanString(text) }> */
+		nil,
+		/* 420 Action78 <- <{ p.Params["id"] = cleanString(text) }> */
+		nil,
+		/* 421 Action79 <- <{ p.Params["expanded"] = cleanString(text) }> */
+		nil,
+		/* 422 Action80 <- <{ p.Params["theme"] = cleanString(text) }> */
+		nil,
+		/* 423 Action81 <- <{ p.Params["version"] = cleanString(text) }> */
+		nil,
+		/* 424 Action82 <- <{ p.Params["external"] = cleanString(text) }> */
+		nil,
+		/* 425 Action83 <- <{ p.Params["type"] = cleanString(text) }> */
+		nil,
+		/* 426 Action84 <- <{ p.Params["name"] = cleanString(text) }> */
+		nil,
+		/* 427 Action85 <- <{ p.Params["mechanism"] = cleanString(text) }> */
+		nil,
+		/* 428 Action86 <- <{ p.Params["expanded"] = cleanString(text) }> */
+		nil,
+		/* 429 Action87 <- <{ p.Params["status"] = cleanString(text) }> */
+		nil,
+		/* 430 Action88 <- <{ p.Params["archived"] = cleanString(text) }> */
+		nil,
+		/* 431 Action89 <- <{ p.Params["owner"] = cleanString(text) }> */
+		nil,
+		/* 432 Action90 <- <{ p.Params["contacts"] = cleanString(text) }> */
+		nil,
+		/* 433 Action91 <- <{ p.Params["source"] = cleanString(text) }> */
+		nil,
+		/* 434 Action92 <- <{ p.Params["classification"] = cleanString(text) }> */
+		nil,
+		/* 435 Action93 <- <{ p.Params["boundary"] = cleanString(text) }> */
+		nil,
+		/* 436 Action94 <- <{ p.Params["tags"] = cleanString(text) }> */
+		nil,
+		/* 437 Action95 <- <{ p.Params["verb"] = cleanString(text) }> */
+		nil,
+		/* 438 Action96 <- <{ p.Params["mechanism"] = cleanString(text) }> */
+		nil,
+		/* 439 Action97 <- <{ p.Params["async"] = cleanString(text) }> */
+		nil,
+		/* 440 Action98 <- <{ p.Params["expanded"] = cleanString(text) }> */
+		nil,
+		/* 441 Action99 <- <{ p.Params["status"] = cleanString(text) }> */
+		nil,
+		/* 442 Action100 <- <{ p.Params["classification"] = cleanString(text) }> */
+		nil,
+		/* 443 Action101 <- <{ p.Params["kind"] = cleanString(text) }> */
+		nil,
+		/* 444 Action102 <- <{ p.Params["name"] = cleanString(text) }> */
+		nil,
+		/* 445 Action103 <- <{ p.Params["mechanism"] = cleanString(text) }> */
+		nil,
+		/* 446 Action104 <- <{ p.Params["parent"] = cleanString(text) }> */
+		nil,
+		/* 447 Action105 <- <{ p.Params["name"] = cleanString(text) }> */
+		nil,
+		/* 448 Action106 <- <{ p.Params["label"] = cleanString(text) }> */
+		nil,
+		/* 449 Action107 <- <{ p.Params["async"] = cleanString(text) }> */
+		nil,
+		/* 450 Action108 <- <{ p.Params["at"] = cleanString(text) }> */
+		nil,
+		/* 451 Action109 <- <{ p.Params["name"] = cleanString(text) }> */
+		nil,
+		/* 452 Action110 <- <{ p.Params["expand"] = cleanString(text) }> */
+		nil,
+		/* 453 Action111 <- <{ p.Params["filter"] = cleanString(text) }> */
+		nil,
+		/* 454 Action112 <- <{ p.Params["focus"] = cleanString(text) }> */
+		nil,
+		/* 455 Action113 <- <{ p.Params["hops"] = cleanString(text) }> */
+		nil,
+		/* 456 Action114 <- <{ p.Params["x"] = cleanString(text) }> */
+		nil,
+		/* 457 Action115 <- <{ p.Params["y"] = cleanString(text) }> */
+		nil,
+		/* 458 Action116 <- <{ p.Params["width"] = cleanString(text) }> */
+		nil,
+		/* 459 Action117 <- <{ p.Params["height"] = cleanString(text) }> */
+		nil,
+		/* 460 Action118 <- <{ p.Params["waypoints"] = cleanString(text) }> */
+		nil,
+		/* 461 Action119 <- <{ p.Params["name"] = cleanString(text) }> */
+		nil,
+		/* 462 Action120 <- <{ p.Params["match"] = cleanString(text) }> */
+		nil,
+		/* 463 Action121 <- <{ p.Params["shape"] = cleanString(text) }> */
+		nil,
+		/* 464 Action122 <- <{ p.Params["color"] = cleanString(text) }> */
+		nil,
+		/* 465 Action123 <- <{ p.Params["border"] = cleanString(text) }> */
+		nil,
+		/* 466 Action124 <- <{ p.Params["line"] = cleanString(text) }> */
+		nil,
+		/* 467 Action125 <- <{ p.Params["layout-view"] = cleanString(text) }> */
+		nil,
+		/* 468 Action126 <- <{ p.linkKind = text }> */
+		nil,
+		/* 469 Action127 <- <{ p.InputAttributes.Links = append(p.InputAttributes.Links, Link{Kind: p.linkKind, Target: cleanString(text)}) }> */
+		nil,
+		/* 470 Action128 <- <{ p.InputAttributes.Params[cleanString(text)] = "" }> */
+		nil,
+		/* 471 Action129 <- <{ p.InputAttributes.Params[cleanString(text)] = "" }> */
+		nil,
+		/* 472 Action130 <- <{ p.text = cleanString(text) }> */
+		nil,
+		/* 473 Action131 <- <{ n, _ := strconv.Atoi(text); p.number = n }> */
+		nil,
+		/* 474 Action132 <- <{ p.bool = text == "true" }> */
+		nil,
+		/* 475 Action133 <- <{ p.InputAttributes.ResourceType = "item"; p.InputAttributes.Verb = "exists" }> */
+		nil,
+		/* 476 Action134 <- <{ p.InputAttributes.ResourceType = "rel"; p.InputAttributes.Verb = "exists" }> */
+		nil,
+		/* 477 Action135 <- <{ p.InputAttributes.ResourceType = "world" }> */
+		nil,
+		/* 478 Action136 <- <{ p.InputAttributes.ResourceType = "node" }> */
+		nil,
+		/* 479 Action137 <- <{ p.InputAttributes.ResourceType = "scenario" }> */
+		nil,
+		/* 480 Action138 <- <{ p.InputAttributes.ResourceType = "view" }> */
+		nil,
+		/* 481 Action139 <- <{ p.InputAttributes.ResourceType = "style" }> */
+		nil,
+		/* 482 Action140 <- <{ p.InputAttributes.ResourceType = "layout" }> */
+		nil,
+		/* 483 Action141 <- <{ p.InputAttributes.ResourceType = "item" }> */
+		nil,
+		/* 484 Action142 <- <{ p.InputAttributes.ResourceType = "rel" }> */
+		nil,
+		/* 485 Action143 <- <{ p.InputAttributes.Verb = "create" }> */
+		nil,
+		/* 486 Action144 <- <{ p.InputAttributes.Verb = "fetch" }> */
+		nil,
+		/* 487 Action145 <- <{ p.InputAttributes.Verb = "set" }> */
+		nil,
+		/* 488 Action146 <- <{ p.InputAttributes.Verb = "clear" }> */
+		nil,
+		/* 489 Action147 <- <{ p.InputAttributes.Verb = "delete" }> */
+		nil,
+		/* 490 Action148 <- <{ p.InputAttributes.Verb = "list" }> */
+		nil,
+		/* 491 Action149 <- <{ p.InputAttributes.Verb = "nest"; p.InputAttributes.ResourceType = "item" }> */
+		nil,
+		/* 492 Action150 <- <{ p.InputAttributes.Verb = "free"; p.InputAttributes.ResourceType = "item" }> */
+		nil,
+		/* 493 Action151 <- <{ p.InputAttributes.Verb = "exists" }> */
+		nil,
+		/* 494 Action152 <- <{ p.InputAttributes.Verb = "in?"; p.InputAttributes.ResourceType = "item" }> */
+		nil,
+		/* 495 Action153 <- <{ p.InputAttributes.Verb = "from?"; p.InputAttributes.ResourceType = "rel" }> */
+		nil,
+		/* 496 Action154 <- <{ p.InputAttributes.Verb = "to?"; p.InputAttributes.ResourceType = "rel" }> */
+		nil,
+		/* 497 Action155 <- <{ p.InputAttributes.Verb = "ancestors?"; p.InputAttributes.ResourceType = "item" }> */
+		nil,
+		/* 498 Action156 <- <{ p.InputAttributes.Verb = "siblings?"; p.InputAttributes.ResourceType = "item" }> */
+		nil,
+		/* 499 Action157 <- <{ p.InputAttributes.Verb = "owners?"; p.InputAttributes.ResourceType = "item" }> */
+		nil,
+		/* 500 Action158 <- <{ p.InputAttributes.Verb = "dataflow?"; p.InputAttributes.ResourceType = "item" }> */
 		nil,
-		/* 144 Action21 <- <{ p.WorldParams["id"] = cleanString(text) }> */
+		/* 501 Action159 <- <{ p.InputAttributes.Verb = "import-owners"; p.InputAttributes.ResourceType = "item" }> */
 		nil,
-		/* 145 Action22 <- <{ p.WorldParams["name"] = strings.TrimSpace(text) }> */
+		/* 502 Action160 <- <{ p.InputAttributes.Verb = "crossings?"; p.InputAttributes.ResourceType = "rel" }> */
 		nil,
-		/* 146 Action23 <- <{ p.WorldParams["expanded"] = strings.TrimSpace(text) }> */
+		/* 503 Action161 <- <{ p.InputAttributes.Verb = "export-threats"; p.InputAttributes.ResourceType = "world" }> */
 		nil,
-		/* 147 Action24 <- <{ p.Params["external"] = cleanString(text) }> */
+		/* 504 Action162 <- <{ p.InputAttributes.Verb = "deployed?"; p.InputAttributes.ResourceType = "item" }> */
 		nil,
-		/* 148 Action25 <- <{ p.Params["type"] = cleanString(text) }> */
+		/* 505 Action163 <- <{ p.InputAttributes.Verb = "deploy"; p.InputAttributes.ResourceType = "item" }> */
 		nil,
-		/* 149 Action26 <- <{ p.Params["name"] = cleanString(text) }> */
+		/* 506 Action164 <- <{ p.InputAttributes.Verb = "undeploy"; p.InputAttributes.ResourceType = "item" }> */
 		nil,
-		/* 150 Action27 <- <{ p.Params["mechanism"] = cleanString(text) }> */
+		/* 507 Action165 <- <{ p.InputAttributes.Verb = "step"; p.InputAttributes.ResourceType = "scenario" }> */
 		nil,
-		/* 151 Action28 <- <{ p.Params["expanded"] = cleanString(text) }> */
+		/* 508 Action166 <- <{ p.InputAttributes.Verb = "unstep"; p.InputAttributes.ResourceType = "scenario" }> */
 		nil,
-		/* 152 Action29 <- <{ p.Params["verb"] = cleanString(text) }> */
+		/* 509 Action167 <- <{ p.InputAttributes.Verb = "pin"; p.InputAttributes.ResourceType = "layout" }> */
 		nil,
-		/* 153 Action30 <- <{ p.Params["mechanism"] = cleanString(text) }> */
+		/* 510 Action168 <- <{ p.InputAttributes.Verb = "unpin"; p.InputAttributes.ResourceType = "layout" }> */
 		nil,
-		/* 154 Action31 <- <{ p.Params["async"] = cleanString(text) }> */
+		/* 511 Action169 <- <{ p.InputAttributes.Verb = "tree"; p.InputAttributes.ResourceType = "item" }> */
 		nil,
-		/* 155 Action32 <- <{ p.Params["expanded"] = cleanString(text) }> */
+		/* 512 Action170 <- <{ p.InputAttributes.Verb = "undo"; p.InputAttributes.ResourceType = "world" }> */
 		nil,
-		/* 156 Action33 <- <{ p.InputAttributes.Params[cleanString(text)] = "" }> */
+		/* 513 Action171 <- <{ p.InputAttributes.Verb = "redo"; p.InputAttributes.ResourceType = "world" }> */
 		nil,
-		/* 157 Action34 <- <{ p.InputAttributes.Params[cleanString(text)] = "" }> */
+		/* 514 Action172 <- <{ p.InputAttributes.Verb = "save" }> */
 		nil,
-		/* 158 Action35 <- <{ p.text = cleanString(text) }> */
+		/* 515 Action173 <- <{ p.InputAttributes.Verb = "load" }> */
 		nil,
-		/* 159 Action36 <- <{ n, _ := strconv.Atoi(text); p.number = n }> */
+		/* 516 Action174 <- <{ p.InputAttributes.Verb = "new" }> */
 		nil,
-		/* 160 Action37 <- <{ p.bool = text == "true" }> */
+		/* 517 Action175 <- <{ p.InputAttributes.Verb = "use" }> */
 		nil,
-		/* 161 Action38 <- <{ p.InputAttributes.ResourceType = "item"; p.InputAttributes.Verb = "exists" }> */
+		/* 518 Action176 <- <{ p.InputAttributes.Verb = "open" }> */
 		nil,
-		/* 162 Action39 <- <{ p.InputAttributes.ResourceType = "rel"; p.InputAttributes.Verb = "exists" }> */
+		/* 519 Action177 <- <{ p.InputAttributes.Verb = "close" }> */
 		nil,
-		/* 163 Action40 <- <{ p.InputAttributes.ResourceType = "world" }> */
+		/* 520 Action178 <- <{ p.InputAttributes.Verb = "copy" }> */
 		nil,
-		/* 164 Action41 <- <{ p.InputAttributes.ResourceType = "item" }> */
+		/* 521 Action179 <- <{ p.InputAttributes.Verb = "clone" }> */
 		nil,
-		/* 165 Action42 <- <{ p.InputAttributes.ResourceType = "rel" }> */
+		/* 522 Action180 <- <{ p.InputAttributes.Verb = "merge" }> */
 		nil,
-		/* 166 Action43 <- <{ p.InputAttributes.Verb = "create" }> */
+		/* 523 Action181 <- <{ p.InputAttributes.Verb = "split" }> */
 		nil,
-		/* 167 Action44 <- <{ p.InputAttributes.Verb = "fetch" }> */
+		/* 524 Action182 <- <{ p.InputAttributes.Verb = "archive" }> */
 		nil,
-		/* 168 Action45 <- <{ p.InputAttributes.Verb = "set" }> */
+		/* 525 Action183 <- <{ p.InputAttributes.Verb = "restore" }> */
 		nil,
-		/* 169 Action46 <- <{ p.InputAttributes.Verb = "clear" }> */
+		/* 526 Action184 <- <{ p.InputAttributes.Verb = "link" }> */
 		nil,
-		/* 170 Action47 <- <{ p.InputAttributes.Verb = "delete" }> */
+		/* 527 Action185 <- <{ p.InputAttributes.Verb = "unlink" }> */
 		nil,
-		/* 171 Action48 <- <{ p.InputAttributes.Verb = "list" }> */
+		/* 528 Action186 <- <{ p.InputAttributes.Verb = "export" }> */
 		nil,
-		/* 172 Action49 <- <{ p.InputAttributes.Verb = "nest"; p.InputAttributes.ResourceType = "item" }> */
+		/* 529 Action187 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "strict") }> */
 		nil,
-		/* 173 Action50 <- <{ p.InputAttributes.Verb = "free"; p.InputAttributes.ResourceType = "item" }> */
+		/* 530 Action188 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "verbose") }> */
 		nil,
-		/* 174 Action51 <- <{ p.InputAttributes.Verb = "exists" }> */
+		/* 531 Action189 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "ids") }> */
 		nil,
-		/* 175 Action52 <- <{ p.InputAttributes.Verb = "in?"; p.InputAttributes.ResourceType = "item" }> */
+		/* 532 Action190 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "dry-run") }> */
 		nil,
-		/* 176 Action53 <- <{ p.InputAttributes.Verb = "from?"; p.InputAttributes.ResourceType = "rel" }> */
+		/* 533 Action191 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "cascade") }> */
 		nil,
-		/* 177 Action54 <- <{ p.InputAttributes.Verb = "to?"; p.InputAttributes.ResourceType = "rel" }> */
+		/* 534 Action192 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "all-rels") }> */
 		nil,
-		/* 178 Action55 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "strict") }> */
+		/* 535 Action193 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "archived") }> */
 		nil,
-		/* 179 Action56 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "verbose") }> */
+		/* 536 Action194 <- <{ p.InputAttributes.Params["depth"] = cleanString(text) }> */
 		nil,
-		/* 180 Action57 <- <{ p.InputAttributes.Flags = append(p.InputAttributes.Flags, "ids") }> */
+		/* 537 Action195 <- <{ p.InputAttributes.Params["view"] = cleanString(text) }> */
 		nil,
 	}
 	p.rules = _rules
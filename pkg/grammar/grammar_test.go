@@ -5,6 +5,7 @@ import (
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/williamflynt/topolith/pkg/errors"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -13,6 +14,7 @@ import (
 
 var simpleTree = "tree{nil::[tree{item \"2\"::[tree{item \"1\"::[]}]} tree{item \"3\"::[]}]}"
 var simpleWorld = "version=1\nid=1\nname=worldname\nexpanded=\"this is expanded data\"\ntree{nil::[tree{item \"2\"::[tree{item \"1\"::[]}]} tree{item \"3\"::[]}]}\nrel \"3\" \"2\"\nrel \"1\" \"2\""
+var styledWorld = "version=1\nid=1\nname=worldname\nexpanded=\"\"\ntheme=dark\ntree{nil::[tree{item \"1\"::[]}]}\nstyle \"db\" match=\"type:database\" shape=\"cylinder\""
 
 var testCommands = []struct {
 	In  string
@@ -33,8 +35,8 @@ var testCommands = []struct {
 	{In: "nest abc123 def456 in ghi789", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{"abc123", "def456"}, SecondaryIds: []string{"ghi789"}, Verb: "nest", Params: map[string]string{}, Flags: []string{}}},
 	{In: "item fetch abc123", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "fetch", Params: map[string]string{}, Flags: []string{}}},
 	{In: "rel fetch abc123 def456", Err: false, Out: InputAttributes{ResourceType: "rel", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{"def456"}, Verb: "fetch", Params: map[string]string{}, Flags: []string{}}},
-	{In: "item in abc123", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{}, Flags: []string{}}},
-	{In: "items in abc123", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{}, Flags: []string{}}},
+	{In: "item in abc123", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "in", Params: map[string]string{}, Flags: []string{}}},
+	{In: "items in abc123", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "in", Params: map[string]string{}, Flags: []string{}}},
 	{In: "world", Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "fetch", Params: map[string]string{}, Flags: []string{}}},
 	{In: "item list 10", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{"limit": "10"}, Flags: []string{}}},
 	{In: "items list 10", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{"limit": "10"}, Flags: []string{}}},
@@ -49,6 +51,84 @@ var testCommands = []struct {
 	{In: "item abc123 name=John", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create-or-set", Params: map[string]string{"name": "John"}, Flags: []string{}}},
 	{In: "rel abc123 def456 verb=likes", Err: false, Out: InputAttributes{ResourceType: "rel", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{"def456"}, Verb: "create-or-set", Params: map[string]string{"verb": "likes"}, Flags: []string{}}},
 	{In: "item create abc123 -strict --verbose", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create", Params: map[string]string{}, Flags: []string{"strict", "verbose"}}},
+	{In: "item create newsletter", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "newsletter", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create", Params: map[string]string{}, Flags: []string{}}},
+	{In: "nest inbox settings in loader", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{"inbox", "settings"}, SecondaryIds: []string{"loader"}, Verb: "nest", Params: map[string]string{}, Flags: []string{}}},
+	{In: `world set name="My World" expanded=abc`, Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "set", Params: map[string]string{"name": "My World", "expanded": "abc"}, Flags: []string{}}},
+	{In: "world save", Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "save", Params: map[string]string{}, Flags: []string{}}},
+	{In: `world save "/tmp/my.world"`, Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "/tmp/my.world", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "save", Params: map[string]string{}, Flags: []string{}}},
+	{In: "world load abc123", Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "load", Params: map[string]string{}, Flags: []string{}}},
+	{In: "world new abc123", Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "new", Params: map[string]string{}, Flags: []string{}}},
+	{In: "undo", Err: false, Out: InputAttributes{ResourceType: "world", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "undo", Params: map[string]string{}, Flags: []string{}}},
+	{In: "redo", Err: false, Out: InputAttributes{ResourceType: "world", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "redo", Params: map[string]string{}, Flags: []string{}}},
+	{In: "world list", Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{}, Flags: []string{}}},
+	{In: "world use abc123", Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "use", Params: map[string]string{}, Flags: []string{}}},
+	{In: "world open abc123", Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "open", Params: map[string]string{}, Flags: []string{}}},
+	{In: "world close", Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "close", Params: map[string]string{}, Flags: []string{}}},
+	{In: "item in abc123 --depth 2", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "in", Params: map[string]string{"depth": "2"}, Flags: []string{}}},
+	{In: "ancestors? abc123", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "ancestors?", Params: map[string]string{}, Flags: []string{}}},
+	{In: "siblings? abc123 --ids", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "siblings?", Params: map[string]string{}, Flags: []string{"ids"}}},
+	{In: "rel create payments.api orders.db", Err: false, Out: InputAttributes{ResourceType: "rel", ResourceId: "payments.api", ResourceIds: []string{}, SecondaryIds: []string{"orders.db"}, Verb: "create", Params: map[string]string{}, Flags: []string{}}},
+	{In: "item fetch item.db", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "item.db", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "fetch", Params: map[string]string{}, Flags: []string{}}},
+	{In: `item set "*-svc" mechanism=Go`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "set", Params: map[string]string{"mechanism": "Go"}, Flags: []string{}, Selectors: []Selector{{Kind: "glob", Pattern: "*-svc", Text: `"*-svc"`}}}},
+	{In: "item delete /^tmp-/ --dry-run", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "delete", Params: map[string]string{}, Flags: []string{"dry-run"}, Selectors: []Selector{{Kind: "regex", Pattern: "^tmp-", Text: "/^tmp-/"}}}},
+	{In: `nest "legacy-*" app in:old in legacy`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{"app"}, SecondaryIds: []string{"legacy"}, Verb: "nest", Params: map[string]string{}, Flags: []string{}, Selectors: []Selector{{Kind: "glob", Pattern: "legacy-*", Text: `"legacy-*"`}, {Kind: "in", Pattern: "old", Text: "in:old"}}}},
+	{In: "item clear in:vendor external", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "clear", Params: map[string]string{"external": ""}, Flags: []string{}, Selectors: []Selector{{Kind: "in", Pattern: "vendor", Text: "in:vendor"}}}},
+	{In: "item delete abc123 --cascade", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "delete", Params: map[string]string{}, Flags: []string{"cascade"}}},
+	{In: "item clone payments as eu- --all-rels", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "payments", ResourceIds: []string{}, SecondaryIds: []string{"eu-"}, Verb: "clone", Params: map[string]string{}, Flags: []string{"all-rels"}}},
+	{In: "item merge orders-api into orders", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "orders-api", ResourceIds: []string{}, SecondaryIds: []string{"orders"}, Verb: "merge", Params: map[string]string{}, Flags: []string{}}},
+	{In: "item split backend into api worker", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "backend", ResourceIds: []string{}, SecondaryIds: []string{"api", "worker"}, Verb: "split", Params: map[string]string{}, Flags: []string{}}},
+	{In: `item split backend into api worker assign db=worker "the queue"=api --dry-run`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "backend", ResourceIds: []string{}, SecondaryIds: []string{"api", "worker"}, Verb: "split", Params: map[string]string{}, Flags: []string{"dry-run"}, Assignments: map[string]string{"db": "worker", "the queue": "api"}}},
+	// Keywords are only keywords where they could be one, so they are IDs everywhere else.
+	{In: "item create link", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "link", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create", Params: map[string]string{}, Flags: []string{}}},
+	{In: "item clone as as eu-", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "as", ResourceIds: []string{}, SecondaryIds: []string{"eu-"}, Verb: "clone", Params: map[string]string{}, Flags: []string{}}},
+	{In: `nest "in" link in into`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{"in", "link"}, SecondaryIds: []string{"into"}, Verb: "nest", Params: map[string]string{}, Flags: []string{}}},
+	{In: "item split merge into as assign link=as", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "merge", ResourceIds: []string{}, SecondaryIds: []string{"as"}, Verb: "split", Params: map[string]string{}, Flags: []string{}, Assignments: map[string]string{"link": "as"}}},
+	{In: `item "list" name=List`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "list", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create-or-set", Params: map[string]string{"name": "List"}, Flags: []string{}}},
+	{In: "item archive legacy", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "legacy", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "archive", Params: map[string]string{}, Flags: []string{}}},
+	{In: "item restore legacy", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "legacy", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "restore", Params: map[string]string{}, Flags: []string{}}},
+	{In: "item list --archived", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{}, Flags: []string{"archived"}}},
+	{In: "item archived archived=true", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "archived", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create-or-set", Params: map[string]string{"archived": "true"}, Flags: []string{}}},
+	{In: "item set x status=deprecated", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "x", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "set", Params: map[string]string{"status": "deprecated"}, Flags: []string{}}},
+	{In: "item clear x status", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "x", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "clear", Params: map[string]string{"status": ""}, Flags: []string{}}},
+	{In: "rel create a b status=planned async=true", Err: false, Out: InputAttributes{ResourceType: "rel", ResourceId: "a", ResourceIds: []string{}, SecondaryIds: []string{"b"}, Verb: "create", Params: map[string]string{"status": "planned", "async": "true"}, Flags: []string{}}},
+	{In: "rel list --view to-be --ids", Err: false, Out: InputAttributes{ResourceType: "rel", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{"view": "to-be"}, Flags: []string{"ids"}}},
+	{In: `item set api owner=payments contacts="@acme/payments,alice@acme.com" source="src/payments/api"`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "set", Params: map[string]string{"owner": "payments", "contacts": "@acme/payments,alice@acme.com", "source": "src/payments/api"}, Flags: []string{}}},
+	{In: "item clear api owner contacts", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "clear", Params: map[string]string{"owner": "", "contacts": ""}, Flags: []string{}}},
+	{In: "item list owner=payments --ids", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{"owner": "payments"}, Flags: []string{"ids"}}},
+	{In: "owners? payments.api", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "payments.api", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "owners?", Params: map[string]string{}, Flags: []string{}}},
+	{In: `owners import ".github/CODEOWNERS" --dry-run`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "import-owners", Params: map[string]string{"path": ".github/CODEOWNERS"}, Flags: []string{"dry-run"}}},
+	{In: `item link api runbook="https://wiki.acme.com/pay" adr="docs/adr/0001.md" adr="docs/adr/0002.md"`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "link", Params: map[string]string{}, Flags: []string{}, Links: []Link{{Kind: "runbook", Target: "https://wiki.acme.com/pay"}, {Kind: "adr", Target: "docs/adr/0001.md"}, {Kind: "adr", Target: "docs/adr/0002.md"}}}},
+	{In: `rel unlink api db dashboard="https://grafana/d/db"`, Err: false, Out: InputAttributes{ResourceType: "rel", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{"db"}, Verb: "unlink", Params: map[string]string{}, Flags: []string{}, Links: []Link{{Kind: "dashboard", Target: "https://grafana/d/db"}}}},
+	{In: `item set api name=API api-spec="api/openapi.yaml"`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "set", Params: map[string]string{"name": "API"}, Flags: []string{}, Links: []Link{{Kind: "api-spec", Target: "api/openapi.yaml"}}}},
+	{In: "rel clear api db links", Err: false, Out: InputAttributes{ResourceType: "rel", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{"db"}, Verb: "clear", Params: map[string]string{"links": ""}, Flags: []string{}}},
+	{In: `item set db classification="pii,pci"`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "db", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "set", Params: map[string]string{"classification": "pii,pci"}, Flags: []string{}}},
+	{In: "rel clear api db classification", Err: false, Out: InputAttributes{ResourceType: "rel", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{"db"}, Verb: "clear", Params: map[string]string{"classification": ""}, Flags: []string{}}},
+	{In: "dataflow? pii --view to-be", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "dataflow?", Params: map[string]string{"class": "pii", "view": "to-be"}, Flags: []string{}}},
+	{In: "item set gateway boundary=dmz", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "gateway", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "set", Params: map[string]string{"boundary": "dmz"}, Flags: []string{}}},
+	{In: "item clear gateway boundary", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "gateway", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "clear", Params: map[string]string{"boundary": ""}, Flags: []string{}}},
+	{In: "crossings? --ids", Err: false, Out: InputAttributes{ResourceType: "rel", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "crossings?", Params: map[string]string{}, Flags: []string{"ids"}}},
+	{In: `threats export "docs/threats.md" --view as-is`, Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "export-threats", Params: map[string]string{"path": "docs/threats.md", "view": "as-is"}, Flags: []string{}}},
+	{In: `scenario create checkout name="Checkout flow"`, Err: false, Out: InputAttributes{ResourceType: "scenario", ResourceId: "checkout", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create", Params: map[string]string{"name": "Checkout flow"}, Flags: []string{}}},
+	{In: `step checkout web api label="POST /orders" async=true at=2`, Err: false, Out: InputAttributes{ResourceType: "scenario", ResourceId: "checkout", ResourceIds: []string{}, SecondaryIds: []string{"web", "api"}, Verb: "step", Params: map[string]string{"label": "POST /orders", "async": "true", "at": "2"}, Flags: []string{}}},
+	{In: "step checkout api web", Err: false, Out: InputAttributes{ResourceType: "scenario", ResourceId: "checkout", ResourceIds: []string{}, SecondaryIds: []string{"api", "web"}, Verb: "step", Params: map[string]string{}, Flags: []string{}}},
+	{In: "unstep checkout 3", Err: false, Out: InputAttributes{ResourceType: "scenario", ResourceId: "checkout", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "unstep", Params: map[string]string{"at": "3"}, Flags: []string{}}},
+	{In: `scenario export checkout "docs/checkout.mmd"`, Err: false, Out: InputAttributes{ResourceType: "scenario", ResourceId: "checkout", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "export", Params: map[string]string{"path": "docs/checkout.mmd"}, Flags: []string{}}},
+	{In: `item set api tags="pci,edge"`, Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "set", Params: map[string]string{"tags": "pci,edge"}, Flags: []string{}}},
+	{In: `view create exec-summary expand="payments,orders" filter="type:server,tag:pci" focus=api hops=2`, Err: false, Out: InputAttributes{ResourceType: "view", ResourceId: "exec-summary", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create", Params: map[string]string{"expand": "payments,orders", "filter": "type:server,tag:pci", "focus": "api", "hops": "2"}, Flags: []string{}}},
+	{In: "views list --ids", Err: false, Out: InputAttributes{ResourceType: "view", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{}, Flags: []string{"ids"}}},
+	{In: "tree --view exec-summary", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "tree", Params: map[string]string{"view": "exec-summary"}, Flags: []string{}}},
+	{In: "tree --depth 1", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "tree", Params: map[string]string{"depth": "1"}, Flags: []string{}}},
+	{In: "layout pin api x=120 y=40", Err: false, Out: InputAttributes{ResourceType: "layout", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "pin", Params: map[string]string{"x": "120", "y": "40"}, Flags: []string{}}},
+	{In: `pin "api" x=-12.5 y=40 width=200 height=80 view="slides"`, Err: false, Out: InputAttributes{ResourceType: "layout", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "pin", Params: map[string]string{"x": "-12.5", "y": "40", "width": "200", "height": "80", "layout-view": "slides"}, Flags: []string{}}},
+	{In: `layout pin api db waypoints="120,40 200,80"`, Err: false, Out: InputAttributes{ResourceType: "layout", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{"db"}, Verb: "pin", Params: map[string]string{"waypoints": "120,40 200,80"}, Flags: []string{}}},
+	{In: "layout unpin api db view=slides", Err: false, Out: InputAttributes{ResourceType: "layout", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{"db"}, Verb: "unpin", Params: map[string]string{"layout-view": "slides"}, Flags: []string{}}},
+	{In: "layout unpin api", Err: false, Out: InputAttributes{ResourceType: "layout", ResourceId: "api", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "unpin", Params: map[string]string{}, Flags: []string{}}},
+	{In: "layout list view=slides", Err: false, Out: InputAttributes{ResourceType: "layout", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{"layout-view": "slides"}, Flags: []string{}}},
+	{In: `style create db match="type:database" shape=cylinder color="#438DD5" border=dashed`, Err: false, Out: InputAttributes{ResourceType: "style", ResourceId: "db", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create", Params: map[string]string{"match": "type:database", "shape": "cylinder", "color": "#438DD5", "border": "dashed"}, Flags: []string{}}},
+	{In: `style "async" name="Async" match="async:true" line=dotted`, Err: false, Out: InputAttributes{ResourceType: "style", ResourceId: "async", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "create-or-set", Params: map[string]string{"name": "Async", "match": "async:true", "line": "dotted"}, Flags: []string{}}},
+	{In: "styles list --ids", Err: false, Out: InputAttributes{ResourceType: "style", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "list", Params: map[string]string{}, Flags: []string{"ids"}}},
+	{In: "world set theme=dark", Err: false, Out: InputAttributes{ResourceType: "world", ResourceId: "", ResourceIds: []string{}, SecondaryIds: []string{}, Verb: "set", Params: map[string]string{"theme": "dark"}, Flags: []string{}}},
+	{In: "item copy abc123 to def456", Err: false, Out: InputAttributes{ResourceType: "item", ResourceId: "abc123", ResourceIds: []string{}, SecondaryIds: []string{"def456"}, Verb: "copy", Params: map[string]string{}, Flags: []string{}}},
 }
 
 var testResponses = []struct {
@@ -59,8 +139,17 @@ var testResponses = []struct {
 	{In: "item abc123\n$$$$\n200 ok \"all ok\"", Err: false, Out: Response{Object: ResponseObject{Type: "item", Repr: `item abc123`}, Status: ResponseStatus{Code: 200, Message: "all ok"}}},
 	{In: "rel abc123 \"def456\" verb=\"writes to\" async=false\n$$$$\n200 ok \"all ok\"", Err: false, Out: Response{Object: ResponseObject{Type: "rel", Repr: `rel abc123 "def456" verb="writes to" async=false`}, Status: ResponseStatus{Code: 200, Message: "all ok"}}},
 	{In: "$$world\n" + simpleWorld + "\nendworld$$" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "world", Repr: simpleWorld}, Status: ResponseStatus{Code: 200, Message: ""}}},
+	{In: "$$world\n" + styledWorld + "\nendworld$$" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "world", Repr: styledWorld}, Status: ResponseStatus{Code: 200, Message: ""}}},
 	{In: "a b c d" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "ids", Repr: `["a","b","c","d"]`}, Status: ResponseStatus{Code: 200, Message: ""}}},
 	{In: "1 2 3 4" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "ids", Repr: `["1","2","3","4"]`}, Status: ResponseStatus{Code: 200, Message: ""}}},
+	{In: "$$changes\nmatched \"tmp-1\" \"tmp-2\"\nremoved item \"tmp-1\"\nchanged item \"api\" name=\"API\"\nmoved \"tmp-2\" from \"tmp-1\" to \"\"\nremoved rel \"api\" \"tmp-1\" verb=\"calls\"\nendchanges$$" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "changes", Repr: `{"matched":["tmp-1","tmp-2"],"changes":[{"action":"removed","object":"item \"tmp-1\""},{"action":"changed","object":"item \"api\" name=\"API\""},{"action":"moved","object":"tmp-2","from":"tmp-1"},{"action":"removed","object":"rel \"api\" \"tmp-1\" verb=\"calls\""}]}`}, Status: ResponseStatus{Code: 200, Message: ""}}},
+	{In: "$$dataflow\nclass \"pii\"\nsource \"db\"\nreached \"svc\"\nreached \"api\" via \"api::db\"\nexternal \"crm\" via \"api::db\" \"api::crm\"\nenddataflow$$" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "dataflow", Repr: `{"class":"pii","steps":[{"kind":"source","id":"db","path":[]},{"kind":"reached","id":"svc","path":[]},{"kind":"reached","id":"api","path":["api::db"]},{"kind":"external","id":"crm","path":["api::db","api::crm"]}]}`}, Status: ResponseStatus{Code: 200, Message: ""}}},
+	{In: "$$changes\nendchanges$$" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "changes", Repr: `{"matched":[],"changes":[]}`}, Status: ResponseStatus{Code: 200, Message: ""}}},
+	{In: "scenario \"checkout\" name=\"Checkout\"\nstep \"checkout\" \"web\" \"api\" label=\"POST /orders\"\nstep \"checkout\" \"api\" \"queue\" async=true" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "scenario", Repr: "scenario \"checkout\" name=\"Checkout\"\nstep \"checkout\" \"web\" \"api\" label=\"POST /orders\"\nstep \"checkout\" \"api\" \"queue\" async=true"}, Status: ResponseStatus{Code: 200, Message: ""}}},
+	{In: "view \"exec-summary\" expand=\"\" filter=\"status:as-is\"\nview \"pci\" filter=\"tag:pci\"" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "view", Repr: "view \"pci\" filter=\"tag:pci\""}, Status: ResponseStatus{Code: 200, Message: ""}}},
+	{In: "pin \"api\" x=120 y=40\npin \"api\" \"db\" waypoints=\"120,40 200,80\" view=\"slides\"" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "pin", Repr: "pin \"api\" x=120 y=40\npin \"api\" \"db\" waypoints=\"120,40 200,80\" view=\"slides\""}, Status: ResponseStatus{Code: 200, Message: ""}}},
+	{In: "style \"db\" match=\"type:database\" shape=\"cylinder\"\nstyle \"pci\" name=\"PCI\" match=\"tag:pci\" border=\"bold\"" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "style", Repr: "style \"pci\" name=\"PCI\" match=\"tag:pci\" border=\"bold\""}, Status: ResponseStatus{Code: 200, Message: ""}}},
+	{In: "$$detail\nitem \"api\" name=\"API\"\nparent \"svc\"\ncomponents \"handler\" \"inbound-queue\"\ninbound rel \"web\" \"api\" verb=\"calls\"\noutbound rel \"api\" \"db\"\nenddetail$$\n$$detail\nitem \"db\"\ncomponents\ninbound rel \"api\" \"db\"\nenddetail$$" + "\n$$$$\n200 ok ", Err: false, Out: Response{Object: ResponseObject{Type: "detail", Repr: `[{"item":"item \"api\" name=\"API\"","parent":"svc","components":["handler","inbound-queue"],"inbound":["rel \"web\" \"api\" verb=\"calls\""],"outbound":["rel \"api\" \"db\""]},{"item":"item \"db\"","parent":"","components":[],"inbound":["rel \"api\" \"db\""],"outbound":[]}]`}, Status: ResponseStatus{Code: 200, Message: ""}}},
 }
 
 var testTrees = []struct {
@@ -112,6 +201,15 @@ func TestCommands(t *testing.T) {
 				fmt.Println(fmt.Sprintf("PARSED: %v\nEXPECT: %v", p.InputAttributes.SecondaryIds, c.Out.SecondaryIds))
 				t.Errorf("expected secondary ids: '%v', but got: '%v'", c.Out.SecondaryIds, p.InputAttributes.SecondaryIds)
 			}
+			if (len(p.InputAttributes.Selectors) > 0 || len(c.Out.Selectors) > 0) && !reflect.DeepEqual(p.InputAttributes.Selectors, c.Out.Selectors) {
+				t.Errorf("expected selectors: '%v', but got: '%v'", c.Out.Selectors, p.InputAttributes.Selectors)
+			}
+			if (len(p.InputAttributes.Assignments) > 0 || len(c.Out.Assignments) > 0) && !reflect.DeepEqual(p.InputAttributes.Assignments, c.Out.Assignments) {
+				t.Errorf("expected assignments: '%v', but got: '%v'", c.Out.Assignments, p.InputAttributes.Assignments)
+			}
+			if (len(p.InputAttributes.Links) > 0 || len(c.Out.Links) > 0) && !reflect.DeepEqual(p.InputAttributes.Links, c.Out.Links) {
+				t.Errorf("expected links: '%v', but got: '%v'", c.Out.Links, p.InputAttributes.Links)
+			}
 			if p.InputAttributes.Verb != c.Out.Verb {
 				t.Errorf("expected verb: '%s', but got: '%s'", c.Out.Verb, p.InputAttributes.Verb)
 			}
@@ -231,3 +329,84 @@ func TestStandaloneErrors(t *testing.T) {
 		t.Errorf("expected StmtType to be 'Status', but got: '%s'", p.StmtType)
 	}
 }
+
+var testParseErrors = []struct {
+	In       string
+	Message  string
+	Line     string
+	Column   string
+	Token    string
+	Expected string
+}{
+	{"item create abc mechanism Go", "line 1 col 26: expected `=` after `mechanism`", "1", "26", "Go", "`=`"},
+	{"item create a type=blah", "line 1 col 20: expected `person`, `database`, `queue`, `blobstore`, `browser`, `mobile`, `server`, `device` or `code` after `type=`", "1", "20", "blah", "`person`, `database`, `queue`, `blobstore`, `browser`, `mobile`, `server`, `device`, `code`"},
+	{"rel create a", "line 1 col 13: expected identifier after `a`", "1", "13", "end of input", "identifier"},
+	{"nest a b c", "line 1 col 11: expected `in`, `in:` or identifier after `c`", "1", "11", "end of input", "`in`, `in:`, identifier"},
+	{"item create", "line 1 col 12: expected identifier after `create`", "1", "12", "end of input", "identifier"},
+	{"world set\nfoo", "line 2 col 1: expected `name`, `expanded`, `theme`, `version` or `id` after `set`", "2", "1", "foo", "`name`, `expanded`, `theme`, `version`, `id`"},
+	{"view create v fcus=a", "line 1 col 15: expected end of input, `name`, `expand`, `filter`, `focus`, `hops`, `--archived`, `--strict`, `--verbose`, `--ids`, `--dry-run`, `--cascade`, `--all-rels`, `--depth` or `--view` after `v`", "1", "15", "fcus=a", "end of input, `name`, `expand`, `filter`, `focus`, `hops`, `--archived`, `--strict`, `--verbose`, `--ids`, `--dry-run`, `--cascade`, `--all-rels`, `--depth`, `--view`"},
+	{`item set a expanded="pipe|x"`, "line 1 col 26: unexpected character U+007C in a quoted value", "1", "26", "|", ""},
+	{`item create "a\b"`, "line 1 col 15: unexpected character U+005C in a quoted value", "1", "15", `\`, ""},
+	{"layout pin a b=1", "line 1 col 15: expected `x`, `y`, `width`, `height`, `waypoints`, `view` or identifier after `b`", "1", "15", "=1", "`x`, `y`, `width`, `height`, `waypoints`, `view`, identifier"},
+}
+
+// TestGrammarRules checks that the embedded grammar.peg is the one the Parser was generated from, since we read the expected tokens from it.
+func TestGrammarRules(t *testing.T) {
+	names, _ := parsePeg(grammarPeg)
+	known := make(map[string]bool)
+	for _, name := range names {
+		known[name] = true
+	}
+	for _, name := range rul3s {
+		if name != "Unknown" && !strings.HasPrefix(name, "Action") && name != "PegText" && !known[name] {
+			t.Errorf("rule %s of the Parser is not in grammar.peg", name)
+		}
+	}
+	if len(follows[flagToken]) == 0 {
+		t.Errorf("expected the names of Flags to follow %s", flagToken)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for i, c := range testParseErrors {
+		t.Run(fmt.Sprintf("TestParseErrors-%d", i), func(t *testing.T) {
+			_, err := Parse(c.In)
+			te, ok := err.(errors.TopolithError)
+			if !ok {
+				t.Fatalf("expected TopolithError for %q, got: %v", c.In, err)
+			}
+			if te.Code != errors.TopolithErrorInvalid {
+				t.Errorf("expected code %d, got %d", errors.TopolithErrorInvalid, te.Code)
+			}
+			if te.Message != c.Message {
+				t.Errorf("expected message:\n%s\ngot:\n%s", c.Message, te.Message)
+			}
+			expectedData := []errors.KvPair{{Key: "line", Value: c.Line}, {Key: "column", Value: c.Column}, {Key: "token", Value: c.Token}, {Key: "expected", Value: c.Expected}}
+			if !reflect.DeepEqual(te.Data, expectedData) {
+				t.Errorf("expected data %v, got %v", expectedData, te.Data)
+			}
+			// The error must survive as a response, so clients can show it.
+			p, err := Parse(te.String())
+			if err != nil || p.Response.Status.Message != "Invalid input: "+c.Message {
+				t.Errorf("error did not round trip as a status: %v, %q", err, p.Response.Status.Message)
+			}
+		})
+	}
+}
+
+func TestStatusSuggestions(t *testing.T) {
+	testErr := errors.New("could not find Item").UseCode(errors.TopolithErrorNotFound).WithSuggestions("apx", "api", "my app").WithError(errors.New("inner error"))
+	p, err := Parse(testErr.String())
+	if err != nil {
+		t.Fatalf("error parsing error string: %v", err)
+	}
+	if p.Response.Status.Message != "Not found: could not find Item" {
+		t.Errorf("expected status message without suggestions, got: '%s'", p.Response.Status.Message)
+	}
+	if p.Response.Status.Missing != "apx" {
+		t.Errorf("expected missing 'apx', got: '%s'", p.Response.Status.Missing)
+	}
+	if !reflect.DeepEqual(p.Response.Status.Suggestions, []string{"api", "my app"}) {
+		t.Errorf("expected suggestions [api my app], got: %v", p.Response.Status.Suggestions)
+	}
+}
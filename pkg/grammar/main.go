@@ -1,6 +1,10 @@
 package grammar
 
-import "strings"
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
 
 // TODO(wf 27 May 2024): We shouldn't be setting raw strings
 //  (ex: `Flags` -> []Flag)
@@ -14,6 +18,57 @@ type Node struct {
 	Children []Node
 }
 
+// ItemDetail is a struct that holds the verbose representation of an Item, parsed from a detail object.
+// The Item and its Rel are grammar-compatible strings.
+type ItemDetail struct {
+	Item       string   `json:"item"`
+	Parent     string   `json:"parent"`     // Parent is the ID of the parent Item, or empty if the Item is at the root.
+	Components []string `json:"components"` // Components are the IDs of the child Items.
+	Inbound    []string `json:"inbound"`    // Inbound are the Rel to the Item.
+	Outbound   []string `json:"outbound"`   // Outbound are the Rel from the Item.
+}
+
+// Selector matches Items by a pattern, rather than a literal ID.
+type Selector struct {
+	Kind    string `json:"kind"`    // Kind is how we match the Pattern: "glob" or "regex" on the Item ID, or "in" for everything under the Item with the Pattern as ID.
+	Pattern string `json:"pattern"` // Pattern is the glob, regex, or ID.
+	Text    string `json:"text"`    // Text is the Selector as written in the input (ex: `"*-svc"`).
+}
+
+// Link is a link to something about an Item or Rel, outside the World.
+type Link struct {
+	Kind   string `json:"kind"`   // Kind is what the link is to: "runbook", "dashboard", "repo", "adr" or "api-spec".
+	Target string `json:"target"` // Target is the URL or local path.
+}
+
+// ChangeSet is a struct that holds what a Command would change, parsed from a changes object.
+// The Items and Rel are grammar-compatible strings.
+type ChangeSet struct {
+	Matched []string `json:"matched"` // Matched are the IDs that the Selectors of the Command matched.
+	Changes []Change `json:"changes"`
+}
+
+// DataFlow is a struct that holds where classified data can go, parsed from a dataflow object.
+type DataFlow struct {
+	Class string     `json:"class"`
+	Steps []FlowStep `json:"steps"`
+}
+
+// FlowStep is an Item that could receive classified data in a DataFlow.
+type FlowStep struct {
+	Kind string   `json:"kind"` // Kind is one of "source", "reached" or "external", for data crossing into an External Item.
+	Id   string   `json:"id"`   // Id is the ID of the Item.
+	Path []string `json:"path"` // Path are the IDs of the Rel that the data takes from a source to the Item.
+}
+
+// Change is a single change in a ChangeSet.
+type Change struct {
+	Action string `json:"action"`         // Action is one of "created", "removed", "changed" or "moved".
+	Object string `json:"object"`         // Object is the Item or Rel, or the ID of the Item that moved.
+	From   string `json:"from,omitempty"` // From is the ID of the parent before a move, or empty for the root.
+	To     string `json:"to,omitempty"`   // To is the ID of the parent after a move, or empty for the root.
+}
+
 // InputAttributes is a struct that holds information from the parsed input to the CLI.
 type InputAttributes struct {
 	ResourceType string            `json:"resourceType"`
@@ -23,6 +78,9 @@ type InputAttributes struct {
 	Verb         string            `json:"verb"`
 	Params       map[string]string `json:"params"`
 	Flags        []string          `json:"flags"`
+	Selectors    []Selector        `json:"selectors"`   // Selectors match many Items at once, in place of or alongside the IDs.
+	Assignments  map[string]string `json:"assignments"` // Assignments map IDs to the Items they go to, such as when splitting an Item.
+	Links        []Link            `json:"links"`       // Links are the links in the params, in order. A kind may repeat.
 
 	Raw string `json:"raw"`
 }
@@ -39,8 +97,10 @@ type ResponseObject struct {
 }
 
 type ResponseStatus struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Code        int      `json:"code"`
+	Message     string   `json:"message"`
+	Missing     string   `json:"missing"`     // Missing is the value that wasn't found, if we have Suggestions for it.
+	Suggestions []string `json:"suggestions"` // Suggestions are the closest known values to Missing, best first.
 }
 
 // Parse function to validate and pull information from the input to the CLI.
@@ -53,21 +113,67 @@ func Parse(s string) (*Parser, error) {
 			Verb:         "",
 			Params:       make(map[string]string),
 			Flags:        make([]string, 0),
+			Selectors:    make([]Selector, 0),
+			Assignments:  make(map[string]string),
+			Links:        make([]Link, 0),
 		},
 		ItemStrings: make([]string, 0),
 		RelStrings:  make([]string, 0),
 		WorldParams: map[string]string{},
+		Changes:     ChangeSet{Matched: make([]string, 0), Changes: make([]Change, 0)},
+		DataFlow:    DataFlow{Steps: make([]FlowStep, 0)},
 	}
 	if err := p.Init(); err != nil {
 		return p, err
 	}
 	if err := p.Parse(); err != nil {
-		return p, err
+		return p, toParseError(p, err)
 	}
 	p.Execute()
 	return p, nil
 }
 
+// Span is where some text starts and ends in a command, as byte offsets.
+type Span struct {
+	Start int
+	End   int
+}
+
+// idRules are the rules that parse the ID of an Item or other resource in a command, rather than a Param or Selector.
+var idRules = map[pegRule]bool{
+	ruleIdentifier: true, ruleSecondIdentifier: true, ruleTarget: true, ruleNestParent: true, ruleAssignmentKey: true, ruleAssignmentValue: true,
+}
+
+// IdSpans returns where each ID appears in the command, with any quotes, in order.
+// It returns nil if the command doesn't parse.
+func IdSpans(command string) []Span {
+	p := &Parser{Buffer: command}
+	if err := p.Init(); err != nil {
+		return nil
+	}
+	if err := p.Parse(); err != nil {
+		return nil
+	}
+	// The Parser counts runes, so find the byte offset of each.
+	offsets := make([]int, 0, len(command)+1)
+	for i := range command {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(command))
+
+	spans := make([]Span, 0)
+	for _, t := range p.Tokens() {
+		if !idRules[t.pegRule] {
+			continue
+		}
+		start, end := offsets[t.begin], offsets[t.end]
+		end = start + len(strings.TrimRightFunc(command[start:end], unicode.IsSpace))
+		spans = append(spans, Span{Start: start, End: end})
+	}
+	slices.SortFunc(spans, func(a, b Span) int { return a.Start - b.Start })
+	return spans
+}
+
 // --- INTERNAL ---
 
 func cleanString(s string) string {
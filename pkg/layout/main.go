@@ -0,0 +1,340 @@
+package layout
+
+import (
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/world"
+	"slices"
+	"strings"
+)
+
+// Direction is the way the layers of a Layout run.
+type Direction int
+
+const (
+	TopToBottom Direction = iota // TopToBottom puts each layer below the last, so Rel point down.
+	LeftToRight                  // LeftToRight puts each layer right of the last, so Rel point right.
+)
+
+// Routing is the shape of the Route between boxes.
+type Routing int
+
+const (
+	Orthogonal Routing = iota // Orthogonal Route only have horizontal and vertical segments.
+	Spline                    // Spline Route are smooth curves, as a polyline with enough points to draw them.
+)
+
+// Node is a box to lay out. A Node with components is a cluster, sized to fit them.
+type Node struct {
+	Id     string
+	Parent string  // Parent is the ID of the cluster the Node is in, or empty at the root.
+	Width  float64 // Width is the width of the box, or Options.NodeWidth if zero. It is ignored for a cluster.
+	Height float64 // Height is the height of the box, or Options.NodeHeight if zero. It is ignored for a cluster.
+}
+
+// Edge is a connection between Node to route.
+type Edge struct {
+	From string
+	To   string
+}
+
+// Graph is what to lay out. Use FromWorld for the Graph of a world.World.
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+// Options are the sizes and choices for Arrange. Use DefaultOptions and change what you need.
+type Options struct {
+	Direction      Direction
+	Routing        Routing
+	NodeWidth      float64 // NodeWidth is the width of a Node without one.
+	NodeHeight     float64 // NodeHeight is the height of a Node without one.
+	NodeGap        float64 // NodeGap is the space between boxes in a layer.
+	LayerGap       float64 // LayerGap is the space between layers.
+	ClusterPadding float64 // ClusterPadding is the space between a cluster and its components.
+	ClusterLabel   float64 // ClusterLabel is the space above the components of a cluster, for its label.
+	Sweeps         int     // Sweeps is how many times to go over the layers to take out crossings.
+	Previous       *Layout // Previous is an earlier Layout of the Graph. Boxes keep their order from it where that doesn't add crossings, so small edits make small changes.
+}
+
+func DefaultOptions() Options {
+	return Options{
+		NodeWidth:      160,
+		NodeHeight:     80,
+		NodeGap:        40,
+		LayerGap:       60,
+		ClusterPadding: 20,
+		ClusterLabel:   24,
+		Sweeps:         8,
+	}
+}
+
+// Point is a position, where X grows to the right and Y grows down.
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Box is a positioned Node.
+type Box struct {
+	Id      string  `json:"id"`
+	Parent  string  `json:"parent"`  // Parent is the ID of the cluster the Box is in, or empty at the root.
+	X       float64 `json:"x"`       // X is the left of the Box.
+	Y       float64 `json:"y"`       // Y is the top of the Box.
+	Width   float64 `json:"width"`   // Width is the width of the Box.
+	Height  float64 `json:"height"`  // Height is the height of the Box.
+	Cluster bool    `json:"cluster"` // Cluster is a boolean that represents whether the Box has components, drawn inside it.
+}
+
+// Center returns the center of the Box.
+func (b Box) Center() Point {
+	return Point{X: b.X + b.Width/2, Y: b.Y + b.Height/2}
+}
+
+// Route is a routed Edge: a polyline from the border of its From Box to the border of its To Box.
+type Route struct {
+	From   string  `json:"from"`
+	To     string  `json:"to"`
+	Points []Point `json:"points"`
+}
+
+// Layout is the result of Arrange: a Box for each Node, sorted by ID, and a Route for each Edge, in order.
+type Layout struct {
+	Boxes  []Box   `json:"boxes"`
+	Routes []Route `json:"routes"`
+	Width  float64 `json:"width"`  // Width is the width of everything in the Layout, which starts at 0.
+	Height float64 `json:"height"` // Height is the height of everything in the Layout, which starts at 0.
+}
+
+// Box returns the Box with the given ID. The okay boolean is false if there isn't one.
+func (l Layout) Box(id string) (Box, bool) {
+	i := slices.IndexFunc(l.Boxes, func(b Box) bool { return b.Id == id })
+	if i < 0 {
+		return Box{}, false
+	}
+	return l.Boxes[i], true
+}
+
+// FromWorld returns the Graph of the Items and Rel in the world.World, with the Tree as clusters. Archived Items and Rel are left out.
+func FromWorld(w world.World) Graph {
+	items := w.ItemList(0)
+	slices.SortFunc(items, func(a, b world.Item) int { return strings.Compare(a.Id, b.Id) })
+	g := Graph{Nodes: make([]Node, len(items)), Edges: make([]Edge, 0)}
+	for i, item := range items {
+		parent, _ := w.Parent(item.Id)
+		g.Nodes[i] = Node{Id: item.Id, Parent: parent}
+	}
+	rels := w.RelList(0)
+	slices.SortFunc(rels, func(a, b world.Rel) int {
+		return strings.Compare(a.From.Id+world.RelIdSeparator+a.To.Id, b.From.Id+world.RelIdSeparator+b.To.Id)
+	})
+	for _, rel := range rels {
+		g.Edges = append(g.Edges, Edge{From: rel.From.Id, To: rel.To.Id})
+	}
+	return g
+}
+
+// Arrange lays out the Graph in layers, in the manner of Sugiyama: it breaks cycles, puts each Node in a layer,
+// orders each layer to take out crossings, then places the boxes and routes the Edges between them.
+//
+// Each cluster is laid out on its own, inside out, then as a single box among its siblings.
+// An Edge between components of different clusters counts as one between the clusters where they meet.
+func Arrange(g Graph, opts Options) (Layout, error) {
+	a, err := newArranger(g, opts)
+	if err != nil {
+		return Layout{}, err
+	}
+	width, height := a.measure("")
+	a.place("", Point{})
+
+	l := Layout{Boxes: make([]Box, 0, len(g.Nodes)), Routes: a.routes(g.Edges), Width: width, Height: height}
+	for _, n := range g.Nodes {
+		l.Boxes = append(l.Boxes, a.boxes[n.Id])
+	}
+	slices.SortFunc(l.Boxes, func(a, b Box) int { return strings.Compare(a.Id, b.Id) })
+	if opts.Direction == LeftToRight {
+		l = transpose(l)
+	}
+	return l, nil
+}
+
+// arranger holds the state of Arrange. Everything in it is top to bottom; we transpose at the end for LeftToRight.
+type arranger struct {
+	opts     Options
+	nodes    map[string]Node
+	children map[string][]string  // children are the IDs of the components of each cluster, sorted, with the root as "".
+	prev     map[string]float64   // prev is the center of each Box across the layers in Options.Previous.
+	levels   map[string]placement // levels are the placement of the components of each cluster, with the root as "".
+	origins  map[string]Point     // origins are where the components of each cluster start, with the root as "".
+	boxes    map[string]Box
+}
+
+func newArranger(g Graph, opts Options) (*arranger, error) {
+	a := &arranger{
+		opts:     opts,
+		nodes:    make(map[string]Node),
+		children: make(map[string][]string),
+		prev:     make(map[string]float64),
+		levels:   make(map[string]placement),
+		origins:  make(map[string]Point),
+		boxes:    make(map[string]Box),
+	}
+	for _, n := range g.Nodes {
+		if n.Id == "" {
+			return nil, errors.New("node id cannot be empty").UseCode(errors.TopolithErrorInvalid)
+		}
+		if _, ok := a.nodes[n.Id]; ok {
+			return nil, errors.New("duplicate node").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "id", Value: n.Id})
+		}
+		if n.Width == 0 {
+			n.Width = opts.NodeWidth
+		}
+		if n.Height == 0 {
+			n.Height = opts.NodeHeight
+		}
+		if opts.Direction == LeftToRight {
+			n.Width, n.Height = n.Height, n.Width
+		}
+		a.nodes[n.Id] = n
+	}
+	for _, n := range g.Nodes {
+		if _, ok := a.nodes[n.Parent]; n.Parent != "" && !ok {
+			return nil, errors.New("parent not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: n.Id}, errors.KvPair{Key: "parent", Value: n.Parent})
+		}
+		a.children[n.Parent] = append(a.children[n.Parent], n.Id)
+	}
+	for _, ids := range a.children {
+		slices.Sort(ids)
+	}
+	for _, n := range g.Nodes {
+		// A Node that can't reach the root is in a cycle of parents.
+		seen := map[string]bool{}
+		for id := n.Id; id != ""; id = a.nodes[id].Parent {
+			if seen[id] {
+				return nil, errors.New("node is its own ancestor").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "id", Value: n.Id})
+			}
+			seen[id] = true
+		}
+	}
+	for _, e := range g.Edges {
+		for _, id := range []string{e.From, e.To} {
+			if _, ok := a.nodes[id]; !ok {
+				return nil, errors.New("edge node not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: id})
+			}
+		}
+	}
+	if opts.Previous != nil {
+		for _, b := range opts.Previous.Boxes {
+			if opts.Direction == LeftToRight {
+				a.prev[b.Id] = b.Center().Y
+			} else {
+				a.prev[b.Id] = b.Center().X
+			}
+		}
+	}
+	a.lift(g.Edges)
+	return a, nil
+}
+
+// lift groups the Edges by the cluster where their ends meet, as Edges between its components.
+func (a *arranger) lift(edges []Edge) {
+	for _, e := range edges {
+		level, from, to, ok := a.meet(e.From, e.To)
+		if !ok {
+			continue
+		}
+		p := a.levels[level]
+		if p.edges == nil {
+			p.edges = make([][2]string, 0)
+		}
+		if !slices.Contains(p.edges, [2]string{from, to}) {
+			p.edges = append(p.edges, [2]string{from, to})
+		}
+		a.levels[level] = p
+	}
+}
+
+// meet returns the cluster where the Node with the given IDs meet, with the components of it that each is in.
+// The okay boolean is false if one Node is in the other, or they are the same.
+func (a *arranger) meet(fromId, toId string) (string, string, string, bool) {
+	fromChain, toChain := a.ancestry(fromId), a.ancestry(toId)
+	for i, id := range fromChain {
+		j := slices.Index(toChain, id)
+		if j < 0 {
+			continue
+		}
+		if i == 0 || j == 0 {
+			return "", "", "", false
+		}
+		return id, fromChain[i-1], toChain[j-1], true
+	}
+	return "", "", "", false
+}
+
+// ancestry returns the ID of the Node, then each cluster it is in, up to and including the root as "".
+func (a *arranger) ancestry(id string) []string {
+	chain := []string{id}
+	for id != "" {
+		id = a.nodes[id].Parent
+		chain = append(chain, id)
+	}
+	return chain
+}
+
+// measure lays out the components of the cluster with the given ID, and returns the size they take.
+// Each cluster among them is measured first, so it can be laid out as a single box.
+func (a *arranger) measure(id string) (float64, float64) {
+	members := a.children[id]
+	sizes := make(map[string]size, len(members))
+	for _, member := range members {
+		if len(a.children[member]) == 0 {
+			sizes[member] = size{a.nodes[member].Width, a.nodes[member].Height}
+			continue
+		}
+		w, h := a.measure(member)
+		labelX, labelY := a.label()
+		sizes[member] = size{w + 2*a.opts.ClusterPadding + labelX, h + 2*a.opts.ClusterPadding + labelY}
+	}
+	p := layer(members, sizes, a.levels[id].edges, a.prev, a.opts)
+	a.levels[id] = p
+	return p.width, p.height
+}
+
+// place sets the Box of each component of the cluster with the given ID, from where its components start.
+func (a *arranger) place(id string, origin Point) {
+	a.origins[id] = origin
+	p := a.levels[id]
+	for _, member := range a.children[id] {
+		at := p.positions[member]
+		box := Box{Id: member, Parent: id, X: origin.X + at.X, Y: origin.Y + at.Y, Width: p.sizes[member].width, Height: p.sizes[member].height}
+		if len(a.children[member]) > 0 {
+			box.Cluster = true
+			labelX, labelY := a.label()
+			a.place(member, Point{X: box.X + a.opts.ClusterPadding + labelX, Y: box.Y + a.opts.ClusterPadding + labelY})
+		}
+		a.boxes[member] = box
+	}
+}
+
+// label returns the space for the label of a cluster, across and along the layers.
+// The label goes above the components, so it is along the layers for LeftToRight, before we transpose.
+func (a *arranger) label() (float64, float64) {
+	if a.opts.Direction == LeftToRight {
+		return a.opts.ClusterLabel, 0
+	}
+	return 0, a.opts.ClusterLabel
+}
+
+// transpose swaps X and Y throughout the Layout.
+func transpose(l Layout) Layout {
+	for i, b := range l.Boxes {
+		l.Boxes[i].X, l.Boxes[i].Y, l.Boxes[i].Width, l.Boxes[i].Height = b.Y, b.X, b.Height, b.Width
+	}
+	for _, r := range l.Routes {
+		for i, p := range r.Points {
+			r.Points[i] = Point{X: p.Y, Y: p.X}
+		}
+	}
+	l.Width, l.Height = l.Height, l.Width
+	return l
+}
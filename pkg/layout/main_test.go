@@ -0,0 +1,183 @@
+package layout
+
+import (
+	"github.com/williamflynt/topolith/pkg/world"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func layoutWorld() world.World {
+	w := world.CreateWorld("shop")
+	w.ItemCreate("user", world.ItemParams{Type: strPtr("person")})
+	w.ItemCreate("payments", world.ItemParams{})
+	w.ItemCreate("api", world.ItemParams{Type: strPtr("server")})
+	w.ItemCreate("db", world.ItemParams{Type: strPtr("database")})
+	w.ItemCreate("mail", world.ItemParams{})
+	w.ItemCreate("audit", world.ItemParams{})
+	w.Nest("api", "payments")
+	w.Nest("db", "payments")
+	w.RelCreate("user", "api", world.RelParams{})
+	w.RelCreate("api", "db", world.RelParams{})
+	w.RelCreate("api", "mail", world.RelParams{})
+	w.RelCreate("user", "audit", world.RelParams{})
+	w.RelCreate("mail", "user", world.RelParams{})
+	return w
+}
+
+func TestArrange(t *testing.T) {
+	g := FromWorld(layoutWorld())
+	if len(g.Nodes) != 6 || len(g.Edges) != 5 {
+		t.Fatalf("expected 6 nodes and 5 edges from the world, got %v", g)
+	}
+
+	for _, c := range []struct {
+		Name      string
+		Direction Direction
+		Routing   Routing
+	}{
+		{"top to bottom", TopToBottom, Orthogonal},
+		{"left to right", LeftToRight, Orthogonal},
+		{"spline", TopToBottom, Spline},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			opts := DefaultOptions()
+			opts.Direction, opts.Routing = c.Direction, c.Routing
+			l, err := Arrange(g, opts)
+			if err != nil {
+				t.Fatalf("error arranging: %v", err)
+			}
+			again, _ := Arrange(g, opts)
+			if !reflect.DeepEqual(l, again) {
+				t.Fatalf("expected the same layout each time")
+			}
+			for _, b := range l.Boxes {
+				if b.X < 0 || b.Y < 0 || b.X+b.Width > l.Width || b.Y+b.Height > l.Height {
+					t.Errorf("expected %s inside the layout, got %v", b.Id, b)
+				}
+				if b.Parent != "" {
+					p, _ := l.Box(b.Parent)
+					if !p.Cluster || b.X < p.X || b.Y < p.Y || b.X+b.Width > p.X+p.Width || b.Y+b.Height > p.Y+p.Height {
+						t.Errorf("expected %s inside %s, got %v in %v", b.Id, p.Id, b, p)
+					}
+				}
+				for _, other := range l.Boxes {
+					if other.Id != b.Id && other.Parent == b.Parent && overlap(b, other) {
+						t.Errorf("expected %s and %s not to overlap, got %v and %v", b.Id, other.Id, b, other)
+					}
+				}
+			}
+			for _, r := range l.Routes {
+				from, _ := l.Box(r.From)
+				to, _ := l.Box(r.To)
+				if len(r.Points) < 2 || !onBorder(from, r.Points[0]) || !onBorder(to, r.Points[len(r.Points)-1]) {
+					t.Errorf("expected the route from %s to %s to run between their borders, got %v", r.From, r.To, r.Points)
+				}
+				if c.Routing != Orthogonal {
+					continue
+				}
+				for i := 1; i < len(r.Points); i++ {
+					if a, b := r.Points[i-1], r.Points[i]; a.X != b.X && a.Y != b.Y {
+						t.Errorf("expected only straight segments from %s to %s, got %v", r.From, r.To, r.Points)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestArrangeCrossings(t *testing.T) {
+	// By ID, a and b are above c and d, and both edges cross; a good order has none.
+	g := Graph{
+		Nodes: []Node{{Id: "a"}, {Id: "b"}, {Id: "c"}, {Id: "d"}, {Id: "e"}},
+		Edges: []Edge{{"a", "d"}, {"b", "c"}, {"c", "e"}, {"d", "e"}},
+	}
+	l, err := Arrange(g, DefaultOptions())
+	if err != nil {
+		t.Fatalf("error arranging: %v", err)
+	}
+	a, _ := l.Box("a")
+	b, _ := l.Box("b")
+	c, _ := l.Box("c")
+	d, _ := l.Box("d")
+	if (a.X < b.X) != (d.X < c.X) {
+		t.Fatalf("expected no crossing, got a %v, b %v, c %v, d %v", a, b, c, d)
+	}
+	if e, _ := l.Box("e"); e.Y <= c.Y || c.Y <= a.Y {
+		t.Fatalf("expected three layers, got %v", l.Boxes)
+	}
+}
+
+func TestArrangeStable(t *testing.T) {
+	g := FromWorld(layoutWorld())
+	opts := DefaultOptions()
+	first, _ := Arrange(g, opts)
+
+	// A small edit: one more Node at the bottom.
+	g.Nodes = append(g.Nodes, Node{Id: "archive"})
+	g.Edges = append(g.Edges, Edge{From: "db", To: "archive"})
+	opts.Previous = &first
+	second, err := Arrange(g, opts)
+	if err != nil {
+		t.Fatalf("error arranging: %v", err)
+	}
+	for _, row := range [][2]string{{"api", "mail"}, {"user", "payments"}} {
+		a1, _ := first.Box(row[0])
+		b1, _ := first.Box(row[1])
+		a2, _ := second.Box(row[0])
+		b2, _ := second.Box(row[1])
+		if (a1.X < b1.X) != (a2.X < b2.X) {
+			t.Errorf("expected %s and %s to keep their order, got %v and %v", row[0], row[1], a2, b2)
+		}
+	}
+}
+
+func TestArrangeCycle(t *testing.T) {
+	g := Graph{
+		Nodes: []Node{{Id: "a"}, {Id: "b"}, {Id: "c"}},
+		Edges: []Edge{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"a", "a"}},
+	}
+	l, err := Arrange(g, DefaultOptions())
+	if err != nil {
+		t.Fatalf("error arranging: %v", err)
+	}
+	for _, r := range l.Routes {
+		from, _ := l.Box(r.From)
+		to, _ := l.Box(r.To)
+		if !onBorder(from, r.Points[0]) || !onBorder(to, r.Points[len(r.Points)-1]) {
+			t.Errorf("expected the route from %s to %s to run from %s, got %v", r.From, r.To, r.From, r.Points)
+		}
+	}
+}
+
+func TestArrangeErrors(t *testing.T) {
+	for _, c := range []struct {
+		Name  string
+		Graph Graph
+	}{
+		{"empty id", Graph{Nodes: []Node{{Id: ""}}}},
+		{"duplicate", Graph{Nodes: []Node{{Id: "a"}, {Id: "a"}}}},
+		{"unknown parent", Graph{Nodes: []Node{{Id: "a", Parent: "b"}}}},
+		{"parent cycle", Graph{Nodes: []Node{{Id: "a", Parent: "b"}, {Id: "b", Parent: "a"}}}},
+		{"unknown edge node", Graph{Nodes: []Node{{Id: "a"}}, Edges: []Edge{{"a", "b"}}}},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			if _, err := Arrange(c.Graph, DefaultOptions()); err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
+
+func overlap(a, b Box) bool {
+	return a.X < b.X+b.Width && b.X < a.X+a.Width && a.Y < b.Y+b.Height && b.Y < a.Y+a.Height
+}
+
+func onBorder(b Box, p Point) bool {
+	near := func(x, y float64) bool { return math.Abs(x-y) < 1e-6 }
+	inX := p.X >= b.X-1e-6 && p.X <= b.X+b.Width+1e-6
+	inY := p.Y >= b.Y-1e-6 && p.Y <= b.Y+b.Height+1e-6
+	return (inX && (near(p.Y, b.Y) || near(p.Y, b.Y+b.Height))) || (inY && (near(p.X, b.X) || near(p.X, b.X+b.Width)))
+}
+
+func strPtr(s string) *string { return &s }
@@ -0,0 +1,206 @@
+package layout
+
+import (
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/world"
+	"math"
+	"slices"
+)
+
+// ArrangeView lays out the world.World as the saved world.View with the given ID shows it, or the whole World for an empty ID.
+// Arrange lays out everything, then pinned Items move to their world.Pin with their components, and pinned Rel run through their waypoints.
+// Clusters grow to fit pinned components, and Rel to or from a moved Item are routed straight to it.
+// A pinned Item may be left of or above 0, so the Width and Height of the Layout are to its right and bottom edges.
+func ArrangeView(w world.World, view string, opts Options) (Layout, error) {
+	viewed := w
+	if view != "" {
+		v, ok := w.ViewFetch(view)
+		if !ok {
+			return Layout{}, errors.New("view not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: view})
+		}
+		var err error
+		if viewed, err = world.ApplyView(w, v); err != nil {
+			return Layout{}, err
+		}
+	}
+	g := FromWorld(viewed)
+	pins := world.ViewPins(w, view)
+	for i, n := range g.Nodes {
+		if pin, ok := itemPin(pins, n.Id); ok {
+			g.Nodes[i].Width, g.Nodes[i].Height = pin.Width, pin.Height
+		}
+	}
+	l, err := Arrange(g, opts)
+	if err != nil {
+		return Layout{}, err
+	}
+	return pinned(l, pins, opts), nil
+}
+
+// pinned returns the Layout with the Pin applied to it.
+func pinned(l Layout, pins []world.Pin, opts Options) Layout {
+	index := make(map[string]int, len(l.Boxes))
+	for i, b := range l.Boxes {
+		index[b.Id] = i
+	}
+	before := slices.Clone(l.Boxes)
+	depth := func(id string) int {
+		d := 0
+		for i, ok := index[id]; ok && l.Boxes[i].Parent != ""; i, ok = index[l.Boxes[i].Parent] {
+			d++
+		}
+		return d
+	}
+
+	// Clusters move first, so a pinned component lands where it is pinned, wherever its cluster went.
+	items := slices.DeleteFunc(slices.Clone(pins), func(p world.Pin) bool { _, ok := index[p.Id]; return p.Rel() || !ok })
+	slices.SortStableFunc(items, func(a, b world.Pin) int { return depth(a.Id) - depth(b.Id) })
+	for _, pin := range items {
+		b := l.Boxes[index[pin.Id]]
+		dx, dy := pin.X-b.X, pin.Y-b.Y
+		for i, other := range l.Boxes {
+			if other.Id == b.Id || within(l, index, other.Id, b.Id) {
+				l.Boxes[i].X += dx
+				l.Boxes[i].Y += dy
+			}
+		}
+		if pin.Width != 0 {
+			l.Boxes[index[pin.Id]].Width = pin.Width
+		}
+		if pin.Height != 0 {
+			l.Boxes[index[pin.Id]].Height = pin.Height
+		}
+	}
+
+	// The deepest clusters grow first, so their own clusters grow around them.
+	clusters := make([]int, 0)
+	for i, b := range l.Boxes {
+		if b.Cluster {
+			clusters = append(clusters, i)
+		}
+	}
+	slices.SortStableFunc(clusters, func(a, b int) int { return depth(l.Boxes[b].Id) - depth(l.Boxes[a].Id) })
+	for _, i := range clusters {
+		c := l.Boxes[i]
+		left, top, right, bottom := c.X, c.Y, c.X+c.Width, c.Y+c.Height
+		for _, b := range l.Boxes {
+			if b.Parent != c.Id {
+				continue
+			}
+			left = math.Min(left, b.X-opts.ClusterPadding)
+			top = math.Min(top, b.Y-opts.ClusterPadding-opts.ClusterLabel)
+			right = math.Max(right, b.X+b.Width+opts.ClusterPadding)
+			bottom = math.Max(bottom, b.Y+b.Height+opts.ClusterPadding)
+		}
+		l.Boxes[i].X, l.Boxes[i].Y, l.Boxes[i].Width, l.Boxes[i].Height = left, top, right-left, bottom-top
+	}
+
+	for i, r := range l.Routes {
+		fromIndex, fromOk := index[r.From]
+		toIndex, toOk := index[r.To]
+		if !fromOk || !toOk {
+			continue
+		}
+		from, to := l.Boxes[fromIndex], l.Boxes[toIndex]
+		if pin, ok := relPin(pins, r.From, r.To); ok {
+			l.Routes[i].Points = through(from, to, pin.Waypoints)
+			continue
+		}
+		if from == before[fromIndex] && to == before[toIndex] {
+			continue
+		}
+		if r.From == r.To {
+			dx, dy := from.X-before[fromIndex].X, from.Y-before[fromIndex].Y
+			for k, p := range r.Points {
+				r.Points[k] = Point{X: p.X + dx, Y: p.Y + dy}
+			}
+			continue
+		}
+		l.Routes[i].Points = direct(l, index, from, to)
+	}
+
+	l.Width, l.Height = 0, 0
+	for _, b := range l.Boxes {
+		l.Width, l.Height = math.Max(l.Width, b.X+b.Width), math.Max(l.Height, b.Y+b.Height)
+	}
+	for _, r := range l.Routes {
+		for _, p := range r.Points {
+			l.Width, l.Height = math.Max(l.Width, p.X), math.Max(l.Height, p.Y)
+		}
+	}
+	return l
+}
+
+// itemPin returns the Pin of the Item with the given ID, from the Pin of a View.
+func itemPin(pins []world.Pin, id string) (world.Pin, bool) {
+	i := slices.IndexFunc(pins, func(p world.Pin) bool { return !p.Rel() && p.Id == id })
+	if i < 0 {
+		return world.Pin{}, false
+	}
+	return pins[i], true
+}
+
+// relPin returns the Pin of the Rel between the Items with the given IDs, from the Pin of a View.
+func relPin(pins []world.Pin, fromId, toId string) (world.Pin, bool) {
+	i := slices.IndexFunc(pins, func(p world.Pin) bool { return p.Id == fromId && p.To == toId })
+	if i < 0 {
+		return world.Pin{}, false
+	}
+	return pins[i], true
+}
+
+// within indicates whether the Box with the given ID is in the cluster with the other ID, at any depth.
+func within(l Layout, index map[string]int, id, clusterId string) bool {
+	for i, ok := index[id]; ok; i, ok = index[l.Boxes[i].Parent] {
+		if l.Boxes[i].Parent == clusterId {
+			return true
+		}
+	}
+	return false
+}
+
+// through returns the points of a Route through the Waypoints, from the border of one Box to the border of the other.
+func through(from, to Box, waypoints []world.Waypoint) []Point {
+	points := make([]Point, 0, len(waypoints)+2)
+	for _, w := range waypoints {
+		points = append(points, Point{X: w.X, Y: w.Y})
+	}
+	start := border(from, points[0])
+	end := border(to, points[len(points)-1])
+	return simplify(append(append([]Point{start}, points...), end))
+}
+
+// direct returns the points of a straight Route between the borders of two Box.
+// If one Box is in the other, it runs from the top of the inner Box to the top of the outer one, as Arrange does.
+func direct(l Layout, index map[string]int, from, to Box) []Point {
+	inner, outer := from, to
+	if within(l, index, to.Id, from.Id) {
+		inner, outer = to, from
+	}
+	if within(l, index, inner.Id, outer.Id) {
+		x := inner.Center().X
+		points := []Point{{X: x, Y: inner.Y}, {X: x, Y: outer.Y}}
+		if inner.Id != from.Id {
+			slices.Reverse(points)
+		}
+		return points
+	}
+	return []Point{border(from, to.Center()), border(to, from.Center())}
+}
+
+// border returns where the line from the center of the Box towards the Point leaves the Box.
+// A Point inside the Box gives the middle of its nearest edge.
+func border(b Box, towards Point) Point {
+	center := b.Center()
+	dx, dy := towards.X-center.X, towards.Y-center.Y
+	if math.Abs(dx) <= b.Width/2 && math.Abs(dy) <= b.Height/2 {
+		switch {
+		case b.Width/2-math.Abs(dx) < b.Height/2-math.Abs(dy):
+			return Point{X: center.X + math.Copysign(b.Width/2, dx), Y: center.Y}
+		default:
+			return Point{X: center.X, Y: center.Y + math.Copysign(b.Height/2, dy)}
+		}
+	}
+	scale := math.Min(b.Width/2/math.Abs(dx), b.Height/2/math.Abs(dy))
+	return Point{X: center.X + dx*scale, Y: center.Y + dy*scale}
+}
@@ -0,0 +1,63 @@
+package layout
+
+import (
+	"github.com/williamflynt/topolith/pkg/world"
+	"testing"
+)
+
+func TestArrangeView(t *testing.T) {
+	w := layoutWorld()
+	w.ViewCreate("slides", world.ViewParams{Expand: strPtr("")})
+	w.PinSet("", "payments", "", world.PinParams{X: strPtr("600"), Y: strPtr("400")})
+	w.PinSet("", "db", "", world.PinParams{X: strPtr("1000"), Y: strPtr("900"), Width: strPtr("200"), Height: strPtr("50")})
+	w.PinSet("", "user", "audit", world.PinParams{Waypoints: strPtr("-40,20 -40,300")})
+	w.PinSet("slides", "user", "", world.PinParams{X: strPtr("-10"), Y: strPtr("-20")})
+
+	opts := DefaultOptions()
+	l, err := ArrangeView(w, "", opts)
+	if err != nil {
+		t.Fatalf("error arranging: %v", err)
+	}
+	db, _ := l.Box("db")
+	if db.X != 1000 || db.Y != 900 || db.Width != 200 || db.Height != 50 {
+		t.Fatalf("expected db where it is pinned, got %v", db)
+	}
+	payments, _ := l.Box("payments")
+	api, _ := l.Box("api")
+	if payments.X != 600 || payments.Y != 400 {
+		t.Fatalf("expected payments where it is pinned, got %v", payments)
+	}
+	for _, b := range []Box{api, db} {
+		if b.X < payments.X || b.Y < payments.Y || b.X+b.Width > payments.X+payments.Width || b.Y+b.Height > payments.Y+payments.Height {
+			t.Fatalf("expected payments to grow around %s, got %v in %v", b.Id, b, payments)
+		}
+	}
+	if l.Width < db.X+db.Width || l.Height < payments.Y+payments.Height {
+		t.Fatalf("expected the layout to fit the pinned boxes, got %v by %v", l.Width, l.Height)
+	}
+	for _, r := range l.Routes {
+		from, _ := l.Box(r.From)
+		to, _ := l.Box(r.To)
+		if !onBorder(from, r.Points[0]) || !onBorder(to, r.Points[len(r.Points)-1]) {
+			t.Errorf("expected the route from %s to %s to run between their borders, got %v", r.From, r.To, r.Points)
+		}
+		if r.From == "user" && r.To == "audit" && (len(r.Points) != 4 || r.Points[1] != (Point{X: -40, Y: 20}) || r.Points[2] != (Point{X: -40, Y: 300})) {
+			t.Errorf("expected the route from user to audit through its waypoints, got %v", r.Points)
+		}
+	}
+
+	// Pins are per View: the slides only move the user.
+	slides, err := ArrangeView(w, "slides", opts)
+	if err != nil {
+		t.Fatalf("error arranging the view: %v", err)
+	}
+	if user, _ := slides.Box("user"); user.X != -10 || user.Y != -20 || user.Width != opts.NodeWidth {
+		t.Fatalf("expected the user where it is pinned in the view, got %v", user)
+	}
+	if _, ok := slides.Box("api"); ok {
+		t.Fatalf("expected the view to collapse payments")
+	}
+	if _, err := ArrangeView(w, "nowhere", opts); err == nil {
+		t.Fatalf("expected an error for a view that isn't there")
+	}
+}
@@ -0,0 +1,192 @@
+package layout
+
+import (
+	"slices"
+)
+
+// splineSamples is how many segments of polyline stand for each curve of a Spline Route.
+const splineSamples = 8
+
+// path is an Edge on its way to a Route: from the Box above to the Box below, through the gates between.
+type path struct {
+	upper    Box
+	lower    Box
+	gates    []gate // gates are where the path passes through the layers between, from where the Layout starts.
+	lowerTop float64
+	reversed bool
+	start    Point
+	end      Point
+}
+
+// routes returns a Route for each Edge, in order.
+func (a *arranger) routes(edges []Edge) []Route {
+	routes := make([]Route, len(edges))
+	paths := make(map[int]*path)
+	for i, e := range edges {
+		routes[i] = Route{From: e.From, To: e.To}
+		from, to := a.boxes[e.From], a.boxes[e.To]
+		level, fromMember, toMember, ok := a.meet(e.From, e.To)
+		switch {
+		case e.From == e.To:
+			routes[i].Points = a.loop(from)
+		case !ok:
+			routes[i].Points = a.contained(from, to)
+		default:
+			p := a.levels[level]
+			key := [2]string{fromMember, toMember}
+			origin := a.origins[level]
+			pth := &path{upper: from, lower: to, lowerTop: origin.Y + p.tops[toMember], reversed: p.reversed[key]}
+			if pth.reversed {
+				pth.upper, pth.lower, pth.lowerTop = to, from, origin.Y+p.tops[fromMember]
+			}
+			for _, g := range p.gates[key] {
+				pth.gates = append(pth.gates, gate{x: origin.X + g.x, top: origin.Y + g.top, bottom: origin.Y + g.bottom})
+			}
+			paths[i] = pth
+		}
+	}
+	a.ports(paths)
+
+	for i, pth := range paths {
+		var points []Point
+		if a.opts.Routing == Spline {
+			points = spline(pth)
+		} else {
+			points = a.orthogonal(pth)
+		}
+		if pth.reversed {
+			slices.Reverse(points)
+		}
+		routes[i].Points = points
+	}
+	return routes
+}
+
+// ports spreads the paths that leave the bottom of a Box, and those that reach its top, across it.
+// They go in the order of where they head next, so they don't cross as they leave.
+func (a *arranger) ports(paths map[int]*path) {
+	type port struct {
+		index   int
+		towards float64
+	}
+	bottoms := make(map[string][]port)
+	tops := make(map[string][]port)
+	for i, pth := range paths {
+		next, previous := pth.lower.Center().X, pth.upper.Center().X
+		if len(pth.gates) > 0 {
+			next, previous = pth.gates[0].x, pth.gates[len(pth.gates)-1].x
+		}
+		bottoms[pth.upper.Id] = append(bottoms[pth.upper.Id], port{i, next})
+		tops[pth.lower.Id] = append(tops[pth.lower.Id], port{i, previous})
+	}
+	spread := func(ports []port, b Box, set func(p *path, x float64)) {
+		slices.SortFunc(ports, func(a, b port) int {
+			switch {
+			case a.towards < b.towards:
+				return -1
+			case a.towards > b.towards:
+				return 1
+			}
+			return a.index - b.index
+		})
+		for k, p := range ports {
+			set(paths[p.index], b.X+b.Width*float64(k+1)/float64(len(ports)+1))
+		}
+	}
+	for _, ports := range bottoms {
+		b := paths[ports[0].index].upper
+		spread(ports, b, func(p *path, x float64) { p.start = Point{X: x, Y: b.Y + b.Height} })
+	}
+	for _, ports := range tops {
+		b := paths[ports[0].index].lower
+		spread(ports, b, func(p *path, x float64) { p.end = Point{X: x, Y: b.Y} })
+	}
+}
+
+// orthogonal returns the points of a path that turns in the middle of the gap above each layer it passes through.
+func (a *arranger) orthogonal(pth *path) []Point {
+	points := []Point{pth.start}
+	at := pth.start
+	for _, g := range pth.gates {
+		turn := g.top - a.opts.LayerGap/2
+		points = append(points, Point{X: at.X, Y: turn}, Point{X: g.x, Y: turn}, Point{X: g.x, Y: g.bottom})
+		at = Point{X: g.x, Y: g.bottom}
+	}
+	turn := pth.lowerTop - a.opts.LayerGap/2
+	points = append(points, Point{X: at.X, Y: turn}, Point{X: pth.end.X, Y: turn}, pth.end)
+	return simplify(points)
+}
+
+// spline returns the points of a path that curves between the layers, and runs straight through each layer it passes through.
+func spline(pth *path) []Point {
+	through := []Point{pth.start}
+	for _, g := range pth.gates {
+		through = append(through, Point{X: g.x, Y: g.top}, Point{X: g.x, Y: g.bottom})
+	}
+	through = append(through, pth.end)
+
+	points := []Point{pth.start}
+	for i := 1; i < len(through); i++ {
+		from, to := through[i-1], through[i]
+		if from.X == to.X {
+			points = append(points, to)
+			continue
+		}
+		// A cubic Bézier that leaves and arrives straight down.
+		middle := (from.Y + to.Y) / 2
+		c1, c2 := Point{X: from.X, Y: middle}, Point{X: to.X, Y: middle}
+		for s := 1; s <= splineSamples; s++ {
+			t := float64(s) / splineSamples
+			u := 1 - t
+			points = append(points, Point{
+				X: u*u*u*from.X + 3*u*u*t*c1.X + 3*u*t*t*c2.X + t*t*t*to.X,
+				Y: u*u*u*from.Y + 3*u*u*t*c1.Y + 3*u*t*t*c2.Y + t*t*t*to.Y,
+			})
+		}
+	}
+	return points
+}
+
+// loop returns the points of an Edge from a Box to itself, out of its right side and back.
+func (a *arranger) loop(b Box) []Point {
+	center, reach := b.Center(), a.opts.NodeGap/2
+	return []Point{
+		{X: b.X + b.Width, Y: center.Y - b.Height/4},
+		{X: b.X + b.Width + reach, Y: center.Y - b.Height/4},
+		{X: b.X + b.Width + reach, Y: center.Y + b.Height/4},
+		{X: b.X + b.Width, Y: center.Y + b.Height/4},
+	}
+}
+
+// contained returns the points of an Edge between a cluster and a Box in it, from the top of the Box to the top of the cluster.
+func (a *arranger) contained(from, to Box) []Point {
+	inner, outer := from, to
+	if slices.Contains(a.ancestry(to.Id), from.Id) {
+		inner, outer = to, from
+	}
+	x := inner.Center().X
+	points := []Point{{X: x, Y: inner.Y}, {X: x, Y: outer.Y}}
+	if inner.Id != from.Id {
+		slices.Reverse(points)
+	}
+	return points
+}
+
+// simplify drops points that repeat the one before, or that are in a straight line between their neighbors.
+func simplify(points []Point) []Point {
+	kept := make([]Point, 0, len(points))
+	for _, p := range points {
+		if n := len(kept); n > 0 && kept[n-1] == p {
+			continue
+		}
+		if n := len(kept); n > 1 {
+			a, b := kept[n-2], kept[n-1]
+			if (a.X == b.X && b.X == p.X) || (a.Y == b.Y && b.Y == p.Y) {
+				kept[n-1] = p
+				continue
+			}
+		}
+		kept = append(kept, p)
+	}
+	return kept
+}
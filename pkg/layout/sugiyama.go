@@ -0,0 +1,480 @@
+package layout
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+type size struct {
+	width  float64
+	height float64
+}
+
+// gate is where a long Edge passes through a layer, in the coordinates of its level.
+type gate struct {
+	x      float64
+	top    float64
+	bottom float64
+}
+
+// placement is the layout of the components of one cluster: its level.
+type placement struct {
+	edges     [][2]string          // edges are between the components, as lifted from the Graph.
+	sizes     map[string]size      // sizes are the size of each component.
+	positions map[string]Point     // positions are the top left of each component, from where the components start.
+	reversed  map[[2]string]bool   // reversed are the edges that point up the layers, to break a cycle.
+	gates     map[[2]string][]gate // gates are where each edge passes through the layers between its ends, down the layers.
+	tops      map[string]float64   // tops are the top of the layer of each component.
+	width     float64              // width is how wide the components are together.
+	height    float64              // height is how tall the components are together.
+}
+
+// vertex is a component in the layered graph of a level, or a dummy where a long edge passes through a layer.
+type vertex struct {
+	size
+	rank  int
+	x     float64 // x is the center of the vertex.
+	up    []string
+	down  []string
+	dummy bool
+}
+
+// layer lays out the members of one level, with the edges between them, in the four steps of Sugiyama:
+// break cycles, assign layers, order each layer to take out crossings, and place the members.
+func layer(members []string, sizes map[string]size, edges [][2]string, prev map[string]float64, opts Options) placement {
+	p := placement{
+		edges:     edges,
+		sizes:     sizes,
+		positions: make(map[string]Point, len(members)),
+		reversed:  acyclic(members, edges),
+		gates:     make(map[[2]string][]gate),
+		tops:      make(map[string]float64, len(members)),
+	}
+	if len(members) == 0 {
+		return p
+	}
+
+	// The edges all point down the layers now, and a pair of edges between the same members is one.
+	arcs := make([][2]string, 0, len(edges))
+	for _, e := range edges {
+		arc := e
+		if p.reversed[e] {
+			arc = [2]string{e[1], e[0]}
+		}
+		if !slices.Contains(arcs, arc) {
+			arcs = append(arcs, arc)
+		}
+	}
+	slices.SortFunc(arcs, compareArcs)
+
+	vertices := make(map[string]*vertex, len(members))
+	for _, m := range members {
+		vertices[m] = &vertex{size: sizes[m]}
+	}
+	rank(members, arcs, vertices)
+
+	// A long edge passes through the layers between its ends as a chain of dummies, so it takes part in ordering.
+	chains := make(map[[2]string][]string)
+	for _, arc := range arcs {
+		from, to := vertices[arc[0]], vertices[arc[1]]
+		previous := arc[0]
+		for r := from.rank + 1; r < to.rank; r++ {
+			id := fmt.Sprintf("\x00%s\x00%s\x00%d", arc[0], arc[1], r)
+			vertices[id] = &vertex{rank: r, dummy: true}
+			chains[arc] = append(chains[arc], id)
+			connect(vertices, previous, id)
+			previous = id
+		}
+		connect(vertices, previous, arc[1])
+	}
+
+	layers := order(vertices, prev, opts.Sweeps)
+	layerY, layerHeight := coordinates(layers, vertices, opts)
+
+	for _, m := range members {
+		v := vertices[m]
+		p.positions[m] = Point{X: v.x - v.width/2, Y: layerY[v.rank] + (layerHeight[v.rank]-v.height)/2}
+		p.tops[m] = layerY[v.rank]
+		p.width = math.Max(p.width, v.x+v.width/2)
+	}
+	p.height = layerY[len(layers)-1] + layerHeight[len(layers)-1]
+	for _, e := range edges {
+		arc := e
+		if p.reversed[e] {
+			arc = [2]string{e[1], e[0]}
+		}
+		gates := make([]gate, 0, len(chains[arc]))
+		for _, id := range chains[arc] {
+			v := vertices[id]
+			gates = append(gates, gate{x: v.x, top: layerY[v.rank], bottom: layerY[v.rank] + layerHeight[v.rank]})
+		}
+		p.gates[e] = gates
+	}
+	return p
+}
+
+// acyclic returns the edges to reverse so the graph has no cycles: those that point back up a depth-first search.
+// We search from each member, and along edges, in ID order, so the same graph always breaks the same way.
+func acyclic(members []string, edges [][2]string) map[[2]string]bool {
+	out := make(map[string][]string)
+	for _, e := range edges {
+		out[e[0]] = append(out[e[0]], e[1])
+	}
+	for _, targets := range out {
+		slices.Sort(targets)
+	}
+	reversed := make(map[[2]string]bool)
+	state := make(map[string]int) // 0 is unvisited, 1 is on the search path, 2 is done.
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = 1
+		for _, to := range out[id] {
+			switch state[to] {
+			case 0:
+				visit(to)
+			case 1:
+				reversed[[2]string{id, to}] = true
+			}
+		}
+		state[id] = 2
+	}
+	for _, m := range members {
+		if state[m] == 0 {
+			visit(m)
+		}
+	}
+	return reversed
+}
+
+// rank puts each member in a layer: one below the lowest layer of what points to it.
+// A member that nothing points to then moves down to just above the highest layer it points to, to keep its edges short.
+func rank(members []string, arcs [][2]string, vertices map[string]*vertex) {
+	in := make(map[string]int)
+	out := make(map[string][]string)
+	for _, arc := range arcs {
+		in[arc[1]]++
+		out[arc[0]] = append(out[arc[0]], arc[1])
+	}
+	queue := make([]string, 0)
+	for _, m := range members {
+		if in[m] == 0 {
+			queue = append(queue, m)
+		}
+	}
+	sorted := make([]string, 0, len(members))
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		sorted = append(sorted, id)
+		for _, to := range out[id] {
+			vertices[to].rank = max(vertices[to].rank, vertices[id].rank+1)
+			if in[to]--; in[to] == 0 {
+				queue = append(queue, to)
+			}
+		}
+	}
+	for i := len(sorted) - 1; i >= 0; i-- {
+		id := sorted[i]
+		pointedTo := slices.ContainsFunc(arcs, func(arc [2]string) bool { return arc[1] == id })
+		if pointedTo || len(out[id]) == 0 {
+			continue
+		}
+		lowest := math.MaxInt
+		for _, to := range out[id] {
+			lowest = min(lowest, vertices[to].rank)
+		}
+		vertices[id].rank = lowest - 1
+	}
+}
+
+func connect(vertices map[string]*vertex, from, to string) {
+	vertices[from].down = append(vertices[from].down, to)
+	vertices[to].up = append(vertices[to].up, from)
+}
+
+// order returns the vertices in each layer, ordered to take out crossings.
+//
+// The first order follows Options.Previous where it can, and is otherwise by ID and the order of the layer above.
+// Then each sweep orders a layer by the mean position of its neighbors in the layer before, and swaps neighbors that cross less the other way.
+// We keep the order with the fewest crossings, and the earliest of those, so an order with nothing to gain stays as it was.
+func order(vertices map[string]*vertex, prev map[string]float64, sweeps int) [][]string {
+	depth := 0
+	for _, v := range vertices {
+		depth = max(depth, v.rank+1)
+	}
+	layers := make([][]string, depth)
+	ids := make([]string, 0, len(vertices))
+	for id := range vertices {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		layers[vertices[id].rank] = append(layers[vertices[id].rank], id)
+	}
+
+	positions := make(map[string]float64)
+	for _, ids := range layers {
+		keys := make(map[string]float64, len(ids))
+		for i, id := range ids {
+			if x, ok := prev[id]; ok && !vertices[id].dummy {
+				keys[id] = x
+			} else if mean, ok := meanOf(vertices[id].up, positions); ok {
+				keys[id] = mean
+			} else if len(prev) == 0 {
+				keys[id] = float64(i)
+			} else {
+				keys[id] = math.Inf(1)
+			}
+		}
+		sortByKeys(ids, keys)
+		// With Options.Previous, positions are its coordinates, so a new vertex in the next layer lands among them by its neighbors.
+		for i, id := range ids {
+			if len(prev) == 0 {
+				positions[id] = float64(i)
+			} else {
+				positions[id] = keys[id]
+			}
+		}
+	}
+
+	best := cloneLayers(layers)
+	fewest := crossings(layers, vertices)
+	for sweep := 0; sweep < sweeps && fewest > 0; sweep++ {
+		down := sweep%2 == 0
+		for i := 1; i < len(layers); i++ {
+			r, neighborRank := i, i-1
+			if !down {
+				r, neighborRank = len(layers)-1-i, len(layers)-i
+			}
+			index := indexOf(layers[neighborRank])
+			keys := make(map[string]float64, len(layers[r]))
+			for j, id := range layers[r] {
+				neighbors := vertices[id].up
+				if !down {
+					neighbors = vertices[id].down
+				}
+				if mean, ok := meanOf(neighbors, index); ok {
+					keys[id] = mean
+				} else {
+					keys[id] = float64(j)
+				}
+			}
+			sortByKeys(layers[r], keys)
+		}
+		transposeNeighbors(layers, vertices)
+		if n := crossings(layers, vertices); n < fewest {
+			best, fewest = cloneLayers(layers), n
+		}
+	}
+	return best
+}
+
+// transposeNeighbors swaps vertices next to each other in a layer while that takes out crossings.
+func transposeNeighbors(layers [][]string, vertices map[string]*vertex) {
+	for improved, rounds := true, 0; improved && rounds < len(layers)*4; rounds++ {
+		improved = false
+		for r, ids := range layers {
+			var above, below map[string]float64
+			if r > 0 {
+				above = indexOf(layers[r-1])
+			}
+			if r < len(layers)-1 {
+				below = indexOf(layers[r+1])
+			}
+			for i := 0; i+1 < len(ids); i++ {
+				u, v := vertices[ids[i]], vertices[ids[i+1]]
+				kept := pairCrossings(u.up, v.up, above) + pairCrossings(u.down, v.down, below)
+				swapped := pairCrossings(v.up, u.up, above) + pairCrossings(v.down, u.down, below)
+				if swapped < kept {
+					ids[i], ids[i+1] = ids[i+1], ids[i]
+					improved = true
+				}
+			}
+		}
+	}
+}
+
+// pairCrossings returns how many edges from a left vertex to the neighbors cross those from the vertex to its right.
+func pairCrossings(left, right []string, index map[string]float64) int {
+	n := 0
+	for _, l := range left {
+		for _, r := range right {
+			if index[l] > index[r] {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// crossings returns how many pairs of edges cross between each layer and the next.
+func crossings(layers [][]string, vertices map[string]*vertex) int {
+	n := 0
+	for r := 0; r+1 < len(layers); r++ {
+		above, below := indexOf(layers[r]), indexOf(layers[r+1])
+		segments := make([][2]float64, 0)
+		for _, id := range layers[r] {
+			for _, to := range vertices[id].down {
+				segments = append(segments, [2]float64{above[id], below[to]})
+			}
+		}
+		for i, a := range segments {
+			for _, b := range segments[i+1:] {
+				if (a[0] < b[0] && a[1] > b[1]) || (a[0] > b[0] && a[1] < b[1]) {
+					n++
+				}
+			}
+		}
+	}
+	return n
+}
+
+// coordinates sets the center of each vertex across the layers, and returns the top and height of each layer.
+//
+// Each vertex is pulled to the mean center of its neighbors, alternately above and below.
+// A layer keeps its order and gaps, and lands as close to where its vertices are pulled as it can.
+func coordinates(layers [][]string, vertices map[string]*vertex, opts Options) ([]float64, []float64) {
+	gapBetween := func(a, b *vertex) float64 {
+		gap := opts.NodeGap
+		if a.dummy || b.dummy {
+			gap /= 2
+		}
+		return (a.width+b.width)/2 + gap
+	}
+	spread := func(ids []string, desired []float64) {
+		gaps := make([]float64, max(len(ids)-1, 0))
+		for i := range gaps {
+			gaps[i] = gapBetween(vertices[ids[i]], vertices[ids[i+1]])
+		}
+		for i, x := range pack(desired, gaps) {
+			vertices[ids[i]].x = x
+		}
+	}
+	for _, ids := range layers {
+		spread(ids, make([]float64, len(ids)))
+	}
+	for pass := 0; pass < 4; pass++ {
+		for i := range layers {
+			r := i
+			if pass%2 == 1 {
+				r = len(layers) - 1 - i
+			}
+			desired := make([]float64, len(layers[r]))
+			for j, id := range layers[r] {
+				v := vertices[id]
+				neighbors := v.up
+				if pass%2 == 1 {
+					neighbors = v.down
+				}
+				desired[j] = v.x
+				if len(neighbors) > 0 {
+					sum := 0.0
+					for _, n := range neighbors {
+						sum += vertices[n].x
+					}
+					desired[j] = sum / float64(len(neighbors))
+				}
+			}
+			spread(layers[r], desired)
+		}
+	}
+
+	left := math.Inf(1)
+	for _, v := range vertices {
+		left = math.Min(left, v.x-v.width/2)
+	}
+	for _, v := range vertices {
+		v.x -= left
+	}
+	layerY, layerHeight := make([]float64, len(layers)), make([]float64, len(layers))
+	y := 0.0
+	for r, ids := range layers {
+		for _, id := range ids {
+			layerHeight[r] = math.Max(layerHeight[r], vertices[id].height)
+		}
+		layerY[r] = y
+		y += layerHeight[r] + opts.LayerGap
+	}
+	return layerY, layerHeight
+}
+
+// pack returns the positions closest to the desired ones, by least squares, that keep their order with at least the given gaps between them.
+// Taking away the gaps before each position makes it isotonic regression, which we solve by pooling adjacent violators.
+func pack(desired []float64, gaps []float64) []float64 {
+	offsets := make([]float64, len(desired))
+	for i := 1; i < len(desired); i++ {
+		offsets[i] = offsets[i-1] + gaps[i-1]
+	}
+	type pool struct {
+		sum   float64
+		count int
+	}
+	mean := func(p pool) float64 { return p.sum / float64(p.count) }
+	pools := make([]pool, 0, len(desired))
+	for i, d := range desired {
+		pools = append(pools, pool{sum: d - offsets[i], count: 1})
+		for len(pools) > 1 && mean(pools[len(pools)-2]) > mean(pools[len(pools)-1]) {
+			last, before := pools[len(pools)-1], pools[len(pools)-2]
+			pools = append(pools[:len(pools)-2], pool{sum: last.sum + before.sum, count: last.count + before.count})
+		}
+	}
+	positions := make([]float64, 0, len(desired))
+	for _, p := range pools {
+		for j := 0; j < p.count; j++ {
+			positions = append(positions, mean(p)+offsets[len(positions)])
+		}
+	}
+	return positions
+}
+
+func meanOf(ids []string, positions map[string]float64) (float64, bool) {
+	sum, n := 0.0, 0
+	for _, id := range ids {
+		if x, ok := positions[id]; ok && !math.IsInf(x, 0) {
+			sum += x
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return sum / float64(n), true
+}
+
+// sortByKeys sorts the IDs by their keys, keeping the order of equal keys.
+func sortByKeys(ids []string, keys map[string]float64) {
+	slices.SortStableFunc(ids, func(a, b string) int {
+		switch {
+		case keys[a] < keys[b]:
+			return -1
+		case keys[a] > keys[b]:
+			return 1
+		}
+		return 0
+	})
+}
+
+func indexOf(ids []string) map[string]float64 {
+	index := make(map[string]float64, len(ids))
+	for i, id := range ids {
+		index[id] = float64(i)
+	}
+	return index
+}
+
+func cloneLayers(layers [][]string) [][]string {
+	cloned := make([][]string, len(layers))
+	for i, ids := range layers {
+		cloned[i] = slices.Clone(ids)
+	}
+	return cloned
+}
+
+func compareArcs(a, b [2]string) int {
+	if c := strings.Compare(a[0], b[0]); c != 0 {
+		return c
+	}
+	return strings.Compare(a[1], b[1])
+}
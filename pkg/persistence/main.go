@@ -1,6 +1,8 @@
 package persistence
 
 import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
 	"github.com/williamflynt/topolith/pkg/world"
 	"os"
 	"path/filepath"
@@ -10,6 +12,7 @@ import (
 // Persistence defines the interface for saving, loading, and managing worlds.
 type Persistence interface {
 	Save(world world.World) error
+	SaveAs(world world.World, nameOrPath string) (string, error) // SaveAs saves a world under the given name, or to the given path if it has a `.world` extension. Return the path of the saved file.
 	Load(name string) (world.World, error)
 	ListWorlds() ([]string, error)
 	SetSourcePath(pathOrUrl string)
@@ -40,37 +43,47 @@ func getDefaultDirectory() string {
 
 // Save saves a world to a file.
 func (fp *filePersistence) Save(world world.World) error {
-	if err := os.MkdirAll(fp.directory, 0755); err != nil {
-		return err
+	_, err := fp.SaveAs(world, world.Name())
+	return err
+}
+
+// SaveAs saves a world to a file, named for the world or at the given path.
+func (fp *filePersistence) SaveAs(world world.World, nameOrPath string) (string, error) {
+	filePath := fp.filePath(nameOrPath)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return "", err
 	}
 
-	filePath := filepath.Join(fp.directory, world.Name()+".world")
 	data := world.String()
-	return os.WriteFile(filePath, []byte(data), 0644)
+	return filePath, os.WriteFile(filePath, []byte(data), 0644)
 }
 
 // Load loads a world from a file.
 func (fp *filePersistence) Load(name string) (world.World, error) {
-	filePath := name
-	if filepath.Ext(name) != ".world" {
-		filePath = filepath.Join(fp.directory, name+".world")
-	}
-	data, err := os.ReadFile(filePath)
+	path := fp.filePath(name)
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
-	println(string(data))
-	return world.FromString(string(data))
+	w, err := world.FromString(string(data))
+	if te, ok := err.(errors.TopolithError); ok {
+		return nil, te.WithDescription(fmt.Sprintf("error loading %s", path)).WithData(errors.KvPair{Key: "path", Value: path})
+	}
+	return w, err
 }
 
 // ListWorlds scans the directory for world files and returns their names.
 func (fp *filePersistence) ListWorlds() ([]string, error) {
 	files, err := os.ReadDir(fp.directory)
+	if os.IsNotExist(err) {
+		// Nothing saved yet.
+		return []string{}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	var worlds []string
+	worlds := make([]string, 0)
 	for _, file := range files {
 		if filepath.Ext(file.Name()) == ".world" {
 			worlds = append(worlds, file.Name()[:len(file.Name())-len(filepath.Ext(file.Name()))])
@@ -83,3 +96,11 @@ func (fp *filePersistence) ListWorlds() ([]string, error) {
 func (fp *filePersistence) SetSourcePath(dir string) {
 	fp.directory = dir
 }
+
+// filePath returns the path of the file for a world name, or the path itself if it has a `.world` extension.
+func (fp *filePersistence) filePath(nameOrPath string) string {
+	if filepath.Ext(nameOrPath) == ".world" {
+		return nameOrPath
+	}
+	return filepath.Join(fp.directory, nameOrPath+".world")
+}
@@ -2,7 +2,11 @@ package persistence
 
 import (
 	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
 	"github.com/williamflynt/topolith/pkg/world"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -27,6 +31,10 @@ func TestEndToEndFileSaveLoad(t *testing.T) {
 		w.Nest(fmt.Sprintf("item-%d", i), fmt.Sprintf("item-%d", i+5))
 	}
 
+	// Save a view
+	exec, filter := "", "status:as-is"
+	_ = w.ViewCreate("exec-summary", world.ViewParams{Expand: &exec, Filter: &filter})
+
 	// Test
 	err := fp.Save(w)
 	if err != nil {
@@ -49,3 +57,25 @@ func TestEndToEndFileSaveLoad(t *testing.T) {
 		fmt.Printf("\tLoaded:\n\n%s", w2.String())
 	}
 }
+
+func TestLoadParseError(t *testing.T) {
+	dir := t.TempDir()
+	fp := &filePersistence{directory: dir}
+
+	path := filepath.Join(dir, "broken.world")
+	if err := os.WriteFile(path, []byte("$$world\nversion=1\nid=\"1\"\nname=\"broken\"\nexpanded=\"\"\ntree{nil::[]}\nrel \"a\" =\nendworld$$"), 0644); err != nil {
+		t.Fatalf("error writing file: %v", err)
+	}
+
+	_, err := fp.Load("broken")
+	te, ok := err.(errors.TopolithError)
+	if !ok {
+		t.Fatalf("expected TopolithError, got: %v", err)
+	}
+	if !strings.HasPrefix(te.Message, "line 7 col ") {
+		t.Fatalf("expected message with the line of the error, got: %s", te.Message)
+	}
+	if te.Description != "error loading "+path {
+		t.Fatalf("expected description with the path, got: %s", te.Description)
+	}
+}
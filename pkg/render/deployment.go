@@ -0,0 +1,187 @@
+package render
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/world"
+	"slices"
+	"strings"
+)
+
+// DeploymentRenderer renders a C4 deployment diagram of an environment as PlantUML.
+// Each Item deployed to a DeploymentNode in the environment is an instance in it, and Rel between Items connect their instances.
+// Each distinct Look from the Stylesheet of the World is a C4 tag, and the legend lists the Style that the instances and Rel use.
+// Instances and Rel link to the first world.Link of their Item or Rel.
+type DeploymentRenderer struct {
+	Environment string // Environment is the ID of the DeploymentNode to render. It is usually an environment, but any DeploymentNode works.
+
+	hooks
+}
+
+func NewDeploymentRenderer(environment string) *DeploymentRenderer {
+	return &DeploymentRenderer{Environment: environment}
+}
+
+func (r *DeploymentRenderer) Render(w world.World) ([]byte, RenderedReturnType, error) {
+	env, ok := w.NodeFetch(r.Environment)
+	if !ok {
+		return nil, PlantUml, errors.New("node not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: r.Environment})
+	}
+	sheet := NewStylesheet(w)
+	tags := &c4Tags{}
+	instances := make(map[string][]string) // instances are the aliases of each Item's instances, by Item ID.
+	nodeLines := r.nodeLines(w, env, 0, instances, sheet, tags, newAliases())
+
+	relLines := make([]string, 0)
+	rels := w.RelList(0)
+	slices.SortFunc(rels, func(a, b world.Rel) int {
+		return strings.Compare(a.From.Id+"\x00"+a.To.Id, b.From.Id+"\x00"+b.To.Id)
+	})
+	for _, rel := range rels {
+		if len(instances[rel.From.Id]) == 0 || len(instances[rel.To.Id]) == 0 {
+			continue
+		}
+		tag := tags.rel(sheet.Rel(rel))
+		for _, from := range instances[rel.From.Id] {
+			for _, to := range instances[rel.To.Id] {
+				relLines = append(relLines, fmt.Sprintf(`Rel(%s, %s, "%s", "%s", $tags="%s"%s)`, from, to, escape(rel.Verb), escape(rel.Mechanism), tag, c4Link(rel.Links)))
+			}
+		}
+	}
+
+	lines := []string{"@startuml", "!include <C4/C4_Deployment>", ""}
+	lines = append(lines, fmt.Sprintf("skinparam backgroundColor %s", sheet.Theme.Background))
+	lines = append(lines, fmt.Sprintf(`UpdateElementStyle("node", $bgColor="%s", $fontColor="%s", $borderColor="%s")`, sheet.Theme.Background, sheet.Theme.Text, sheet.Theme.Text))
+	lines = append(append(lines, tags.lines...), "")
+	if title := w.Name(); title != "" {
+		lines = append(lines, fmt.Sprintf("title %s - %s", title, nodeLabel(env)), "")
+	}
+	lines = append(lines, nodeLines...)
+	if len(relLines) > 0 {
+		lines = append(append(lines, ""), relLines...)
+	}
+	if legend := plantUmlLegend(sheet.Legend()); len(legend) > 0 {
+		lines = append(append(lines, ""), legend...)
+	}
+	lines = append(lines, "@enduml", "")
+
+	r.run(w)
+	return []byte(strings.Join(lines, "\n")), PlantUml, nil
+}
+
+// nodeLines returns the Deployment_Node block for the DeploymentNode, with the nodes in it and the instances deployed to it.
+// It records the alias of each instance in instances, and the tag of its Look in tags. The aliases of nodes and instances come from as.
+func (r *DeploymentRenderer) nodeLines(w world.World, node world.DeploymentNode, depth int, instances map[string][]string, sheet *Stylesheet, tags *c4Tags, as *aliases) []string {
+	indent := strings.Repeat("    ", depth)
+	lines := []string{fmt.Sprintf(`%sDeployment_Node(%s, "%s", "%s") {`, indent, as.of(node.Id), escape(nodeLabel(node)), escape(node.Mechanism))}
+	for _, child := range world.NodeComponents(w, node.Id) {
+		lines = append(lines, r.nodeLines(w, child, depth+1, instances, sheet, tags, as)...)
+	}
+	for _, itemId := range node.Items {
+		item, ok := w.ItemFetch(itemId)
+		if !ok || w.Archived(itemId) {
+			continue
+		}
+		a := as.of(itemId, node.Id)
+		instances[itemId] = append(instances[itemId], a)
+		name := item.Name
+		if name == "" {
+			name = item.Id
+		}
+		look := sheet.Item(item)
+		tag := tags.item(look)
+		if look.Shape == "person" {
+			lines = append(lines, fmt.Sprintf(`%s    %s(%s, "%s", "%s", $tags="%s"%s)`, indent, instanceMacro(item, look), a, escape(name), escape(item.Expanded), tag, c4Link(item.Links)))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf(`%s    %s(%s, "%s", "%s", "%s", $tags="%s"%s)`, indent, instanceMacro(item, look), a, escape(name), escape(item.Mechanism), escape(item.Expanded), tag, c4Link(item.Links)))
+	}
+	return append(lines, indent+"}")
+}
+
+// nodeLabel returns the display name of the DeploymentNode, or its ID when it has no Name.
+func nodeLabel(node world.DeploymentNode) string {
+	if node.Name != "" {
+		return node.Name
+	}
+	return node.Id
+}
+
+// c4Link returns the $link argument of a C4-PlantUML macro for the first Link, or nothing without a Link.
+func c4Link(links []world.Link) string {
+	if target, ok := firstLink(links); ok {
+		return fmt.Sprintf(`, $link="%s"`, escape(target))
+	}
+	return ""
+}
+
+// instanceMacro returns the C4-PlantUML macro for an instance of the Item, by the shape of its Look.
+func instanceMacro(item world.Item, look Look) string {
+	macro := "Container"
+	switch look.Shape {
+	case "cylinder":
+		macro = "ContainerDb"
+	case "queue":
+		macro = "ContainerQueue"
+	case "person":
+		macro = "Person"
+	}
+	if item.External {
+		macro += "_Ext"
+	}
+	return macro
+}
+
+// c4Tags are the C4-PlantUML tags for each distinct Look in a render, named in the order they first appear.
+type c4Tags struct {
+	items []Look
+	rels  []Look
+	lines []string // lines are the AddElementTag and AddRelTag lines that define the tags.
+}
+
+// item returns the tag for the Look of an Item, and defines it the first time.
+func (t *c4Tags) item(look Look) string {
+	i := slices.Index(t.items, look)
+	if i < 0 {
+		t.items, i = append(t.items, look), len(t.items)
+		args := []string{fmt.Sprintf(`$bgColor="%s"`, look.Color), fmt.Sprintf(`$fontColor="%s"`, look.Text), fmt.Sprintf(`$borderColor="%s"`, borderColor(look))}
+		switch look.Shape {
+		case "rounded":
+			args = append(args, "$shape=RoundedBoxShape()")
+		case "hexagon":
+			args = append(args, "$shape=EightSidedShape()")
+		}
+		if style := c4LineStyle(look.Border); style != "" {
+			args = append(args, "$borderStyle="+style)
+		}
+		t.lines = append(t.lines, fmt.Sprintf(`AddElementTag("look_%d", %s)`, i+1, strings.Join(args, ", ")))
+	}
+	return fmt.Sprintf("look_%d", i+1)
+}
+
+// rel returns the tag for the Look of a Rel, and defines it the first time.
+func (t *c4Tags) rel(look Look) string {
+	i := slices.Index(t.rels, look)
+	if i < 0 {
+		t.rels, i = append(t.rels, look), len(t.rels)
+		args := []string{fmt.Sprintf(`$textColor="%s"`, look.Text), fmt.Sprintf(`$lineColor="%s"`, look.Color)}
+		if style := c4LineStyle(look.Line); style != "" {
+			args = append(args, "$lineStyle="+style)
+		}
+		t.lines = append(t.lines, fmt.Sprintf(`AddRelTag("line_%d", %s)`, i+1, strings.Join(args, ", ")))
+	}
+	return fmt.Sprintf("line_%d", i+1)
+}
+
+// c4LineStyle returns the C4-PlantUML line style for one of world.LineStyles, or nothing for a solid line.
+func c4LineStyle(style string) string {
+	switch style {
+	case "dashed":
+		return "DashedLine()"
+	case "dotted":
+		return "DottedLine()"
+	case "bold":
+		return "BoldLine()"
+	}
+	return ""
+}
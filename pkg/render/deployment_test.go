@@ -0,0 +1,148 @@
+package render
+
+import (
+	"github.com/williamflynt/topolith/pkg/world"
+	"strings"
+	"testing"
+)
+
+func TestDeploymentRenderer(t *testing.T) {
+	w := world.CreateWorld("test-world")
+	w.ItemCreate("web", world.ItemParams{})
+	w.ItemCreate("events", world.ItemParams{Type: strPtr("queue")})
+	w.ItemCreate("pay", world.ItemParams{External: boolPtr(true)})
+	w.RelCreate("web", "events", world.RelParams{Verb: strPtr("publishes")})
+	w.RelCreate("web", "pay", world.RelParams{Verb: strPtr(`says "hi"`)})
+	w.NodeCreate("prod", world.NodeParams{Name: strPtr("Production")})
+	w.NodeCreate("prod.k8s", world.NodeParams{Kind: strPtr("cluster"), Parent: strPtr("prod"), Mechanism: strPtr("Kubernetes")})
+	w.NodeCreate("staging", world.NodeParams{})
+	for _, d := range []world.Deployment{{ItemId: "web", NodeId: "prod.k8s"}, {ItemId: "events", NodeId: "prod"}, {ItemId: "pay", NodeId: "prod"}, {ItemId: "web", NodeId: "staging"}} {
+		if err := w.Deploy(d.ItemId, d.NodeId).Err(); err != nil {
+			t.Fatalf("error deploying %s: %v", d, err)
+		}
+	}
+
+	r := NewDeploymentRenderer("prod")
+	rendered := 0
+	unhook := r.OnRender(func(world.World) { rendered++ })
+	b, returnType, err := r.Render(w)
+	if err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	if returnType != PlantUml {
+		t.Fatalf("expected %s, got %s", PlantUml, returnType)
+	}
+	expected := strings.Join([]string{
+		"@startuml",
+		"!include <C4/C4_Deployment>",
+		"",
+		"skinparam backgroundColor #FFFFFF",
+		`UpdateElementStyle("node", $bgColor="#FFFFFF", $fontColor="#000000", $borderColor="#000000")`,
+		`AddElementTag("look_1", $bgColor="#438DD5", $fontColor="#FFFFFF", $borderColor="#438DD5")`,
+		`AddElementTag("look_2", $bgColor="#438DD5", $fontColor="#FFFFFF", $borderColor="#438DD5")`,
+		`AddElementTag("look_3", $bgColor="#999999", $fontColor="#FFFFFF", $borderColor="#999999")`,
+		`AddRelTag("line_1", $textColor="#000000", $lineColor="#707070")`,
+		"",
+		"title test-world - Production",
+		"",
+		`Deployment_Node(prod, "Production", "") {`,
+		`    Deployment_Node(prod_k8s, "prod.k8s", "Kubernetes") {`,
+		`        Container(web__prod_k8s, "web", "", "", $tags="look_1")`,
+		`    }`,
+		`    ContainerQueue(events__prod, "events", "", "", $tags="look_2")`,
+		`    Container_Ext(pay__prod, "pay", "", "", $tags="look_3")`,
+		`}`,
+		"",
+		`Rel(web__prod_k8s, events__prod, "publishes", "", $tags="line_1")`,
+		`Rel(web__prod_k8s, pay__prod, "says 'hi'", "", $tags="line_1")`,
+		"",
+		"legend right",
+		"|= Style |= Look |",
+		"| <back:#999999><color:#FFFFFF> External </color></back> | #999999 |",
+		"| <back:#438DD5><color:#FFFFFF> Queue </color></back> | queue |",
+		"endlegend",
+		"@enduml",
+		"",
+	}, "\n")
+	if string(b) != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, b)
+	}
+
+	unhook()
+	if _, _, err := r.Render(w); err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	if rendered != 1 {
+		t.Fatalf("expected the hook to run once before unhooking, got %d", rendered)
+	}
+	if _, _, err := NewDeploymentRenderer("nowhere").Render(w); err == nil {
+		t.Fatalf("expected an error rendering a missing node")
+	}
+
+	// Styles of the World apply on the theme, and the legend only has those in use.
+	w.SetTheme(world.ThemeDark)
+	w.StyleCreate("events", world.StyleParams{Name: strPtr("Events"), Match: strPtr("type:queue"), Shape: strPtr("hexagon"), Color: strPtr("#F5DEB3"), Border: strPtr("dotted")})
+	w.StyleCreate("pci", world.StyleParams{Match: strPtr("tag:pci"), Color: strPtr("#FF0000")})
+	b, _, err = r.Render(w)
+	if err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	for _, line := range []string{
+		"skinparam backgroundColor #1E1E1E",
+		`AddElementTag("look_2", $bgColor="#F5DEB3", $fontColor="#000000", $borderColor="#000000", $shape=EightSidedShape(), $borderStyle=DottedLine())`,
+		`    Container(events__prod, "events", "", "", $tags="look_2")`,
+		"| <back:#F5DEB3><color:#000000> Events </color></back> | #F5DEB3, hexagon, dotted border |",
+	} {
+		if !strings.Contains(string(b), line+"\n") {
+			t.Fatalf("expected the line %s, got:\n%s", line, b)
+		}
+	}
+	if strings.Contains(string(b), "tag:pci") || strings.Contains(string(b), "Queue") {
+		t.Fatalf("expected the legend to leave out styles that aren't used, got:\n%s", b)
+	}
+}
+
+func TestDeploymentRendererAliases(t *testing.T) {
+	w := world.CreateWorld("test-world")
+	w.ItemCreate("a", world.ItemParams{})
+	w.ItemCreate("a__b", world.ItemParams{})
+	w.NodeCreate("prod", world.NodeParams{})
+	w.NodeCreate("b__c", world.NodeParams{Parent: strPtr("prod")})
+	w.NodeCreate("c", world.NodeParams{Parent: strPtr("prod")})
+	w.Deploy("a", "b__c")
+	w.Deploy("a__b", "c")
+
+	b, _, err := NewDeploymentRenderer("prod").Render(w)
+	if err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	for _, alias := range []string{"Container(a__b__c,", "Container(a__b__c_2,"} {
+		if !strings.Contains(string(b), alias) {
+			t.Fatalf("expected an instance as %s, got:\n%s", alias, b)
+		}
+	}
+}
+
+func TestDeploymentRendererLinks(t *testing.T) {
+	w := world.CreateWorld("test-world")
+	w.ItemCreate("web", world.ItemParams{Links: &[]world.Link{{Kind: world.Dashboard, Target: "https://grafana/web"}, {Kind: world.Runbook, Target: "https://wiki/web"}}})
+	w.ItemCreate("db", world.ItemParams{})
+	w.RelCreate("web", "db", world.RelParams{Links: &[]world.Link{{Kind: world.Adr, Target: "docs/adr/1.md"}}})
+	w.NodeCreate("prod", world.NodeParams{})
+	w.Deploy("web", "prod")
+	w.Deploy("db", "prod")
+
+	b, _, err := NewDeploymentRenderer("prod").Render(w)
+	if err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	for _, line := range []string{
+		`    Container(web__prod, "web", "", "", $tags="look_1", $link="https://wiki/web")`,
+		`    Container(db__prod, "db", "", "", $tags="look_1")`,
+		`Rel(web__prod, db__prod, "", "", $tags="line_1", $link="docs/adr/1.md")`,
+	} {
+		if !strings.Contains(string(b), line+"\n") {
+			t.Fatalf("expected the line %s, got:\n%s", line, b)
+		}
+	}
+}
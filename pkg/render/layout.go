@@ -0,0 +1,76 @@
+package render
+
+import (
+	"encoding/json"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/layout"
+	"github.com/williamflynt/topolith/pkg/world"
+)
+
+// LayoutRenderer renders the layout.Layout of a saved world.View as JSON, for frontends that draw it themselves.
+// Pinned Items and Rel keep the positions in their world.Pin, and the rest are laid out around them.
+// Each render passes the last Layout as layout.Options.Previous, so small edits make small changes.
+// Along with the Layout, it renders the Look of each Box and Route, and the legend of the Style they use.
+type LayoutRenderer struct {
+	View    string         // View is the ID of the world.View to lay out, or empty for the whole World.
+	Options layout.Options // Options are the sizes and choices for the layout.
+	last    *layout.Layout
+
+	hooks
+}
+
+// StyledLayout is the layout.Layout of a LayoutRenderer, with the Look of everything in it.
+type StyledLayout struct {
+	layout.Layout
+	Background string          `json:"background"` // Background is the color behind the Layout.
+	Text       string          `json:"text"`       // Text is the color of labels on the Background.
+	Looks      map[string]Look `json:"looks"`      // Looks are the Look of each Box, by its ID.
+	RouteLooks []Look          `json:"routeLooks"` // RouteLooks are the Look of each Route, in the same order.
+	Legend     []LegendEntry   `json:"legend"`     // Legend are the Style that the Looks use.
+}
+
+func NewLayoutRenderer(view string) *LayoutRenderer {
+	return &LayoutRenderer{View: view, Options: layout.DefaultOptions()}
+}
+
+func (r *LayoutRenderer) Render(w world.World) ([]byte, RenderedReturnType, error) {
+	opts := r.Options
+	if opts.Previous == nil {
+		opts.Previous = r.last
+	}
+	l, err := layout.ArrangeView(w, r.View, opts)
+	if err != nil {
+		return nil, Json, err
+	}
+	viewed := w
+	if v, ok := w.ViewFetch(r.View); ok {
+		if viewed, err = world.ApplyView(w, v); err != nil {
+			return nil, Json, err
+		}
+	}
+	b, err := json.Marshal(styled(viewed, l))
+	if err != nil {
+		return nil, Json, errors.New("could not render layout").UseCode(errors.TopolithErrorInternal).WithError(err)
+	}
+	r.last = &l
+	r.run(viewed)
+	return b, Json, nil
+}
+
+// styled returns the Layout of the World with the Look of each Box and Route.
+func styled(w world.World, l layout.Layout) StyledLayout {
+	sheet := NewStylesheet(w)
+	s := StyledLayout{Layout: l, Background: sheet.Theme.Background, Text: sheet.Theme.Text, Looks: make(map[string]Look, len(l.Boxes)), RouteLooks: make([]Look, len(l.Routes))}
+	for _, b := range l.Boxes {
+		item, _ := w.ItemFetch(b.Id)
+		s.Looks[b.Id] = sheet.Item(item)
+	}
+	for i, route := range l.Routes {
+		s.RouteLooks[i] = sheet.Theme.Rel
+		if rels := w.RelFetch(route.From, route.To, true); len(rels) > 0 {
+			s.RouteLooks[i] = sheet.Rel(rels[0])
+		}
+	}
+	s.Legend = sheet.Legend()
+	return s
+}
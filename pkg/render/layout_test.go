@@ -0,0 +1,84 @@
+package render
+
+import (
+	"encoding/json"
+	"github.com/williamflynt/topolith/pkg/layout"
+	"github.com/williamflynt/topolith/pkg/world"
+	"slices"
+	"testing"
+)
+
+func TestLayoutRenderer(t *testing.T) {
+	w := world.CreateWorld("test-world")
+	w.ItemCreate("web", world.ItemParams{})
+	w.ItemCreate("api", world.ItemParams{Type: strPtr("database")})
+	w.RelCreate("web", "api", world.RelParams{Async: boolPtr(true)})
+	w.ViewCreate("slides", world.ViewParams{})
+	w.PinSet("slides", "api", "", world.PinParams{X: strPtr("500"), Y: strPtr("20")})
+
+	r := NewLayoutRenderer("slides")
+	rendered := 0
+	unhook := r.OnRender(func(world.World) { rendered++ })
+	b, returnType, err := r.Render(w)
+	if err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	if returnType != Json {
+		t.Fatalf("expected %s, got %s", Json, returnType)
+	}
+	var l layout.Layout
+	if err := json.Unmarshal(b, &l); err != nil {
+		t.Fatalf("error reading the layout: %v", err)
+	}
+	if api, _ := l.Box("api"); api.X != 500 || api.Y != 20 {
+		t.Fatalf("expected api where it is pinned, got %v", api)
+	}
+	if len(l.Routes) != 1 || len(l.Routes[0].Points) < 2 {
+		t.Fatalf("expected a route from web to api, got %v", l.Routes)
+	}
+	var s StyledLayout
+	if err := json.Unmarshal(b, &s); err != nil {
+		t.Fatalf("error reading the styled layout: %v", err)
+	}
+	if s.Looks["api"].Shape != "cylinder" || s.Looks["web"].Shape != "box" || len(s.RouteLooks) != 1 || s.RouteLooks[0].Line != "dashed" {
+		t.Fatalf("expected the looks of the theme, got %v and %v", s.Looks, s.RouteLooks)
+	}
+	legend := make([]string, 0)
+	for _, e := range s.Legend {
+		legend = append(legend, e.Kind+":"+e.Style.Label())
+	}
+	if expected := []string{"item:Database", "rel:Async"}; !slices.Equal(legend, expected) {
+		t.Fatalf("expected the legend %v, got %v", expected, legend)
+	}
+	unhook()
+	if _, _, err := NewLayoutRenderer("nowhere").Render(w); err == nil {
+		t.Fatalf("expected an error for a view that isn't there")
+	}
+	if _, _, err := r.Render(w); err != nil || rendered != 1 {
+		t.Fatalf("expected one render hook call and no error, got %d and %v", rendered, err)
+	}
+}
+
+func TestLayoutRendererHookSeesView(t *testing.T) {
+	w := world.CreateWorld("test-world")
+	w.ItemCreate("web", world.ItemParams{})
+	w.ItemCreate("api", world.ItemParams{})
+	w.ItemCreate("batch", world.ItemParams{})
+	w.RelCreate("web", "api", world.RelParams{})
+	w.ViewCreate("edge", world.ViewParams{Focus: strPtr("web"), Hops: strPtr("1")})
+
+	seen := make([]string, 0)
+	r := NewLayoutRenderer("edge")
+	r.OnRender(func(viewed world.World) {
+		for _, item := range world.AllItems(viewed) {
+			seen = append(seen, item.Id)
+		}
+	})
+	if _, _, err := r.Render(w); err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	slices.Sort(seen)
+	if expected := []string{"api", "web"}; !slices.Equal(seen, expected) {
+		t.Fatalf("expected the hook to see %v, got %v", expected, seen)
+	}
+}
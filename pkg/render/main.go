@@ -1,9 +1,22 @@
 package render
 
-import "github.com/williamflynt/topolith/pkg/world"
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/world"
+	"regexp"
+	"strings"
+	"sync"
+)
 
 type RenderedReturnType string
 
+const (
+	PlantUml RenderedReturnType = "text/x-plantuml"
+	Mermaid  RenderedReturnType = "text/vnd.mermaid"
+	Json     RenderedReturnType = "application/json"
+)
+
 type OnRenderFunction = func(world.World)
 type UnhookFunction = func()
 
@@ -12,4 +25,108 @@ type Renderer interface {
 	OnRender(f OnRenderFunction) UnhookFunction
 }
 
+// ViewRenderer renders the World as a saved world.View shows it, with another Renderer.
+// Its OnRenderFunction are those of the other Renderer, so they get the World as the View shows it.
+type ViewRenderer struct {
+	Renderer        // Renderer renders the World once the View is applied.
+	View     string // View is the ID of the world.View to apply.
+}
+
+func NewViewRenderer(r Renderer, view string) *ViewRenderer {
+	return &ViewRenderer{Renderer: r, View: view}
+}
+
+func (r *ViewRenderer) Render(w world.World) ([]byte, RenderedReturnType, error) {
+	view, ok := w.ViewFetch(r.View)
+	if !ok {
+		return nil, "", errors.New("view not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: r.View})
+	}
+	viewed, err := world.ApplyView(w, view)
+	if err != nil {
+		return nil, "", err
+	}
+	return r.Renderer.Render(viewed)
+}
+
 // TODO: Implement a renderer.
+
+// hooks are the OnRenderFunction of a Renderer. The zero value is ready to use.
+type hooks struct {
+	mu    sync.Mutex
+	funcs map[int]OnRenderFunction
+	next  int
+}
+
+func (h *hooks) OnRender(f OnRenderFunction) UnhookFunction {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.funcs == nil {
+		h.funcs = make(map[int]OnRenderFunction)
+	}
+	i := h.next
+	h.funcs[i] = f
+	h.next++
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.funcs, i)
+	}
+}
+
+// run calls each OnRenderFunction with the rendered World, in the order they were added.
+func (h *hooks) run(w world.World) {
+	h.mu.Lock()
+	funcs := make([]OnRenderFunction, 0, len(h.funcs))
+	for i := 0; i < h.next; i++ {
+		if f, ok := h.funcs[i]; ok {
+			funcs = append(funcs, f)
+		}
+	}
+	h.mu.Unlock()
+	for _, f := range funcs {
+		f(w)
+	}
+}
+
+var nonAlias = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// aliases are the diagram aliases in a render, with only letters, digits and underscores.
+// IDs that only differ in other characters, like `payments.api` and `payments-api`, get a suffix to tell them apart.
+type aliases struct {
+	byKey map[string]string
+	taken map[string]bool
+}
+
+func newAliases() *aliases {
+	return &aliases{byKey: make(map[string]string), taken: make(map[string]bool)}
+}
+
+// of returns the alias for the ID made of the parts, such as an Item ID and the ID of a DeploymentNode it is deployed to.
+// The same parts always get the same alias in a render.
+func (a *aliases) of(parts ...string) string {
+	key := strings.Join(parts, "\x00")
+	if s, ok := a.byKey[key]; ok {
+		return s
+	}
+	base := nonAlias.ReplaceAllString(strings.Join(parts, "__"), "_")
+	s := base
+	for i := 2; a.taken[s]; i++ {
+		s = fmt.Sprintf("%s_%d", base, i)
+	}
+	a.byKey[key], a.taken[s] = s, true
+	return s
+}
+
+// firstLink returns the target of the first Link, which is the one a diagram that takes a single URL links to.
+// Link are sorted by kind, so that is a runbook if there is one.
+func firstLink(links []world.Link) (string, bool) {
+	if len(links) == 0 {
+		return "", false
+	}
+	return links[0].Target, true
+}
+
+// escape returns the text safe to put in a quoted diagram argument.
+func escape(s string) string {
+	return strings.ReplaceAll(s, `"`, `'`)
+}
@@ -0,0 +1,35 @@
+package render
+
+import (
+	"github.com/williamflynt/topolith/pkg/world"
+	"strings"
+	"testing"
+)
+
+func TestViewRenderer(t *testing.T) {
+	w := sequenceWorld(t)
+	w.ViewCreate("no-queues", world.ViewParams{Filter: strPtr("type:person,type:server")})
+	w.ItemSet("web-app", world.ItemParams{Type: strPtr("server")})
+
+	rendered := make([]world.World, 0)
+	r := NewViewRenderer(NewSequenceRenderer("checkout", Mermaid), "no-queues")
+	r.OnRender(func(w world.World) { rendered = append(rendered, w) })
+	b, _, err := r.Render(w)
+	if err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	if strings.Contains(string(b), "orders") || !strings.Contains(string(b), "shopper->>web_app: checks out") {
+		t.Fatalf("expected the view to leave out the queue, got:\n%s", b)
+	}
+	if len(rendered) != 1 || len(rendered[0].ItemList(0)) != 2 || len(w.ItemList(0)) != 3 {
+		t.Fatalf("expected hooks to get the World as the view shows it, and the World to be left alone")
+	}
+
+	if _, _, err := NewViewRenderer(NewSequenceRenderer("checkout", Mermaid), "missing").Render(w); err == nil {
+		t.Fatalf("expected an error rendering a missing view")
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+func boolPtr(b bool) *bool { return &b }
@@ -0,0 +1,205 @@
+package render
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/world"
+	"strings"
+)
+
+// SequenceRenderer renders a sequence diagram of a Scenario, as PlantUML or Mermaid.
+// Each Item in the Scenario is a participant, in the order it first appears, and each step is a message.
+// A step without a label takes the verb of its Rel. A step against the direction of its Rel is a reply.
+// Participants and messages take their Look from the Stylesheet of the World, and a legend lists the Style they use.
+// A participant links to the world.Link of its Item: all of them in Mermaid, and the first in PlantUML, which takes one URL.
+type SequenceRenderer struct {
+	Scenario string             // Scenario is the ID of the Scenario to render.
+	Format   RenderedReturnType // Format is PlantUml or Mermaid.
+
+	hooks
+}
+
+func NewSequenceRenderer(scenario string, format RenderedReturnType) *SequenceRenderer {
+	return &SequenceRenderer{Scenario: scenario, Format: format}
+}
+
+func (r *SequenceRenderer) Render(w world.World) ([]byte, RenderedReturnType, error) {
+	scenario, ok := w.ScenarioFetch(r.Scenario)
+	if !ok {
+		return nil, r.Format, errors.New("scenario not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: r.Scenario})
+	}
+	var lines []string
+	switch r.Format {
+	case PlantUml:
+		lines = plantUmlSequence(w, scenario, NewStylesheet(w))
+	case Mermaid:
+		lines = mermaidSequence(w, scenario, NewStylesheet(w))
+	default:
+		return nil, r.Format, errors.New("invalid render format").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "format", Value: string(r.Format)})
+	}
+	r.run(w)
+	return []byte(strings.Join(lines, "\n")), r.Format, nil
+}
+
+func plantUmlSequence(w world.World, scenario world.Scenario, sheet *Stylesheet) []string {
+	as := newAliases()
+	lines := []string{"@startuml", fmt.Sprintf("title %s", scenarioTitle(scenario))}
+	lines = append(lines,
+		fmt.Sprintf("skinparam backgroundColor %s", sheet.Theme.Background),
+		fmt.Sprintf("skinparam defaultFontColor %s", sheet.Theme.Text),
+		fmt.Sprintf("skinparam ArrowColor %s", sheet.Theme.Rel.Color),
+		"",
+	)
+	for _, id := range world.Participants(scenario) {
+		item, _ := w.ItemFetch(id)
+		look := sheet.Item(item)
+		kind := "participant"
+		switch look.Shape {
+		case "person":
+			kind = "actor"
+		case "cylinder":
+			kind = "database"
+		case "queue":
+			kind = "queue"
+		}
+		participant := fmt.Sprintf(`%s "%s" as %s`, kind, escape(participantName(item, id)), as.of(id))
+		if target, ok := firstLink(item.Links); ok {
+			participant += fmt.Sprintf(" [[%s]]", target)
+		}
+		lines = append(lines, participant+" "+look.Color)
+	}
+	lines = append(lines, "")
+	for _, step := range scenario.Steps {
+		arrow := "->"
+		if world.Reply(w, step) {
+			arrow = "-->"
+		} else if step.Async {
+			arrow = "->>"
+		}
+		// Only a message with a Rel in another color than the rest says so.
+		if rel, ok := stepRel(w, step); ok {
+			if color := sheet.Rel(rel).Color; color != sheet.Theme.Rel.Color {
+				arrow = arrow[:1] + "[" + color + "]" + arrow[1:]
+			}
+		}
+		lines = append(lines, message(as.of(step.From), arrow, as.of(step.To), stepLabel(w, step)))
+	}
+	lines = append(lines, plantUmlLegend(sheet.Legend())...)
+	return append(lines, "@enduml", "")
+}
+
+// mermaidSequence returns a Mermaid sequence diagram. Mermaid has no colors for a single participant or message,
+// so the Theme sets them all, and a participant in another color than the rest is in a box of its color.
+func mermaidSequence(w world.World, scenario world.Scenario, sheet *Stylesheet) []string {
+	as := newAliases()
+	lines := []string{
+		fmt.Sprintf(`%%%%{init: {"theme": "base", "themeVariables": {"background": "%s", "actorBkg": "%s", "actorBorder": "%s", "actorTextColor": "%s", "signalColor": "%s", "signalTextColor": "%s"}}}%%%%`,
+			sheet.Theme.Background, sheet.Theme.Item.Color, borderColor(sheet.Theme.Item), sheet.Theme.Item.Text, sheet.Theme.Rel.Color, sheet.Theme.Text),
+		"sequenceDiagram",
+		fmt.Sprintf("    title %s", scenarioTitle(scenario)),
+	}
+	participants := world.Participants(scenario)
+	for _, id := range participants {
+		item, _ := w.ItemFetch(id)
+		look := sheet.Item(item)
+		kind := "participant"
+		if look.Shape == "person" {
+			kind = "actor"
+		}
+		participant := fmt.Sprintf(`    %s %s as %s`, kind, as.of(id), escape(participantName(item, id)))
+		if look.Color == sheet.Theme.Item.Color {
+			lines = append(lines, participant)
+			continue
+		}
+		r, g, b := rgb(look.Color)
+		lines = append(lines, fmt.Sprintf("    box rgb(%d,%d,%d)", r, g, b), participant, "    end")
+	}
+	for _, id := range participants {
+		if item, _ := w.ItemFetch(id); len(item.Links) > 0 {
+			lines = append(lines, fmt.Sprintf("    links %s: %s", as.of(id), mermaidLinks(item.Links)))
+		}
+	}
+	for _, step := range scenario.Steps {
+		arrow := "->>"
+		if world.Reply(w, step) {
+			arrow = "-->>"
+		} else if step.Async {
+			arrow = "-)"
+		}
+		if rel, ok := stepRel(w, step); ok {
+			sheet.Rel(rel)
+		}
+		lines = append(lines, "    "+message(as.of(step.From), arrow, as.of(step.To), stepLabel(w, step)))
+	}
+	if legend := sheet.Legend(); len(legend) > 0 {
+		notes := make([]string, len(legend))
+		for i, e := range legend {
+			notes[i] = fmt.Sprintf("%s: %s", escape(e.Style.Label()), legendNote(e))
+		}
+		over := as.of(participants[0])
+		if len(participants) > 1 {
+			over += "," + as.of(participants[len(participants)-1])
+		}
+		lines = append(lines, fmt.Sprintf("    Note over %s: %s", over, strings.Join(notes, "<br/>")))
+	}
+	return append(lines, "")
+}
+
+// mermaidLinks returns the Link as the JSON object of a Mermaid links line, keyed by kind.
+// A kind that repeats takes a number, like `runbook 2`, so each Link has its own key.
+func mermaidLinks(links []world.Link) string {
+	entries := make([]string, len(links))
+	seen := make(map[world.LinkKind]int)
+	for i, l := range links {
+		seen[l.Kind]++
+		key := world.StringFromLinkKind(l.Kind)
+		if seen[l.Kind] > 1 {
+			key += fmt.Sprintf(" %d", seen[l.Kind])
+		}
+		entries[i] = fmt.Sprintf("%q: %q", key, l.Target)
+	}
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+// message returns a message line of a sequence diagram, which is the same in PlantUML and Mermaid apart from the arrow.
+func message(from, arrow, to, label string) string {
+	if label == "" {
+		return fmt.Sprintf("%s%s%s", from, arrow, to)
+	}
+	return fmt.Sprintf("%s%s%s: %s", from, arrow, to, label)
+}
+
+// stepLabel returns the label of the step, or the verb of the Rel it follows if it has none.
+func stepLabel(w world.World, step world.ScenarioStep) string {
+	if step.Label != "" {
+		return escape(step.Label)
+	}
+	if rel, ok := stepRel(w, step); ok {
+		return escape(rel.Verb)
+	}
+	return ""
+}
+
+// stepRel returns the Rel the step follows, in its direction or against it for a reply.
+func stepRel(w world.World, step world.ScenarioStep) (world.Rel, bool) {
+	if rels := append(w.RelFetch(step.From, step.To, true), w.RelFetch(step.To, step.From, true)...); len(rels) > 0 {
+		return rels[0], true
+	}
+	return world.Rel{}, false
+}
+
+// scenarioTitle returns the display name of the Scenario, or its ID when it has no Name.
+func scenarioTitle(scenario world.Scenario) string {
+	if scenario.Name != "" {
+		return escape(scenario.Name)
+	}
+	return scenario.Id
+}
+
+// participantName returns the display name of the Item, or its ID when it has no Name.
+func participantName(item world.Item, id string) string {
+	if item.Name != "" {
+		return item.Name
+	}
+	return id
+}
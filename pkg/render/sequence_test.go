@@ -0,0 +1,175 @@
+package render
+
+import (
+	"github.com/williamflynt/topolith/pkg/world"
+	"strings"
+	"testing"
+)
+
+func sequenceWorld(t *testing.T) world.World {
+	w := world.CreateWorld("test-world")
+	w.ItemCreate("shopper", world.ItemParams{Type: strPtr("person")})
+	w.ItemCreate("web-app", world.ItemParams{Name: strPtr("Web App")})
+	w.ItemCreate("orders", world.ItemParams{Type: strPtr("queue")})
+	w.RelCreate("shopper", "web-app", world.RelParams{Verb: strPtr("checks out")})
+	w.RelCreate("web-app", "orders", world.RelParams{})
+	w.ScenarioCreate("checkout", world.ScenarioParams{Name: strPtr("Checkout")})
+	for _, step := range []world.ScenarioStep{
+		{From: "shopper", To: "web-app"},
+		{From: "web-app", To: "orders", Label: "order placed", Async: true},
+		{From: "web-app", To: "shopper", Label: "'thanks'"},
+	} {
+		if err := w.ScenarioStep("checkout", step, 0).Err(); err != nil {
+			t.Fatalf("error adding step %v: %v", step, err)
+		}
+	}
+	return w
+}
+
+func TestSequenceRenderer(t *testing.T) {
+	w := sequenceWorld(t)
+	for _, c := range []struct {
+		Format   RenderedReturnType
+		Expected []string
+	}{
+		{PlantUml, []string{
+			"@startuml",
+			"title Checkout",
+			"skinparam backgroundColor #FFFFFF",
+			"skinparam defaultFontColor #000000",
+			"skinparam ArrowColor #707070",
+			"",
+			`actor "shopper" as shopper #08427B`,
+			`participant "Web App" as web_app #438DD5`,
+			`queue "orders" as orders #438DD5`,
+			"",
+			"shopper->web_app: checks out",
+			"web_app->>orders: order placed",
+			"web_app-->shopper: 'thanks'",
+			"legend right",
+			"|= Style |= Look |",
+			"| <back:#08427B><color:#FFFFFF> Person </color></back> | #08427B, person |",
+			"| <back:#438DD5><color:#FFFFFF> Queue </color></back> | queue |",
+			"endlegend",
+			"@enduml",
+			"",
+		}},
+		{Mermaid, []string{
+			`%%{init: {"theme": "base", "themeVariables": {"background": "#FFFFFF", "actorBkg": "#438DD5", "actorBorder": "#438DD5", "actorTextColor": "#FFFFFF", "signalColor": "#707070", "signalTextColor": "#000000"}}}%%`,
+			"sequenceDiagram",
+			"    title Checkout",
+			"    box rgb(8,66,123)",
+			"    actor shopper as shopper",
+			"    end",
+			"    participant web_app as Web App",
+			"    participant orders as orders",
+			"    shopper->>web_app: checks out",
+			"    web_app-)orders: order placed",
+			"    web_app-->>shopper: 'thanks'",
+			"    Note over shopper,orders: Person: #08427B, person<br/>Queue: queue",
+			"",
+		}},
+	} {
+		t.Run(string(c.Format), func(t *testing.T) {
+			b, returnType, err := NewSequenceRenderer("checkout", c.Format).Render(w)
+			if err != nil {
+				t.Fatalf("error rendering: %v", err)
+			}
+			if returnType != c.Format {
+				t.Fatalf("expected %s, got %s", c.Format, returnType)
+			}
+			if expected := strings.Join(c.Expected, "\n"); string(b) != expected {
+				t.Fatalf("expected:\n%s\ngot:\n%s", expected, b)
+			}
+		})
+	}
+
+	if _, _, err := NewSequenceRenderer("refund", PlantUml).Render(w); err == nil {
+		t.Fatalf("expected an error rendering a missing scenario")
+	}
+	if _, _, err := NewSequenceRenderer("checkout", "image/png").Render(w); err == nil {
+		t.Fatalf("expected an error rendering an unknown format")
+	}
+}
+
+func TestSequenceRendererStyles(t *testing.T) {
+	w := sequenceWorld(t)
+	w.SetTheme(world.ThemePrint)
+	w.StyleCreate("person", world.StyleParams{Name: strPtr("Customer"), Match: strPtr("type:person"), Shape: strPtr("box")})
+	w.StyleCreate("checkout", world.StyleParams{Name: strPtr("Checkout"), Match: strPtr("mechanism:HTTPS"), Color: strPtr("#C00")})
+	w.StyleCreate("unused", world.StyleParams{Match: strPtr("type:device"), Color: strPtr("#00C")})
+	w.RelSet("shopper", "web-app", world.RelParams{Mechanism: strPtr("https")})
+
+	b, _, err := NewSequenceRenderer("checkout", PlantUml).Render(w)
+	if err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	for _, line := range []string{
+		"skinparam backgroundColor #FFFFFF",
+		"skinparam ArrowColor #000000",
+		`participant "shopper" as shopper #FFFFFF`,
+		"shopper-[#C00]>web_app: checks out",
+		"web_app-[#C00]->shopper: 'thanks'",
+		"| <back:#FFFFFF><color:#000000> Customer </color></back> | box |",
+		"| <color:#C00> Checkout </color> | #C00 |",
+	} {
+		if !strings.Contains(string(b), line+"\n") {
+			t.Fatalf("expected the line %s, got:\n%s", line, b)
+		}
+	}
+	if strings.Contains(string(b), "#00C") || strings.Contains(string(b), "Person") {
+		t.Fatalf("expected the legend to leave out styles that aren't used, got:\n%s", b)
+	}
+}
+
+func TestSequenceRendererAliases(t *testing.T) {
+	w := world.CreateWorld("test-world")
+	w.ItemCreate("payments.api", world.ItemParams{})
+	w.ItemCreate("payments-api", world.ItemParams{})
+	w.RelCreate("payments.api", "payments-api", world.RelParams{})
+	w.ScenarioCreate("pay", world.ScenarioParams{})
+	if err := w.ScenarioStep("pay", world.ScenarioStep{From: "payments.api", To: "payments-api", Label: "pays"}, 0).Err(); err != nil {
+		t.Fatalf("error adding the step: %v", err)
+	}
+
+	b, _, err := NewSequenceRenderer("pay", PlantUml).Render(w)
+	if err != nil {
+		t.Fatalf("error rendering: %v", err)
+	}
+	for _, line := range []string{
+		`participant "payments.api" as payments_api #438DD5`,
+		`participant "payments-api" as payments_api_2 #438DD5`,
+		"payments_api->payments_api_2: pays",
+	} {
+		if !strings.Contains(string(b), line+"\n") {
+			t.Fatalf("expected the line %s, got:\n%s", line, b)
+		}
+	}
+}
+
+func TestSequenceRendererLinks(t *testing.T) {
+	w := world.CreateWorld("test-world")
+	w.ItemCreate("web", world.ItemParams{Links: &[]world.Link{{Kind: world.Runbook, Target: "https://wiki/web"}, {Kind: world.Runbook, Target: "https://wiki/web-2"}, {Kind: world.Repo, Target: "https://git/web"}}})
+	w.ItemCreate("api", world.ItemParams{})
+	w.RelCreate("web", "api", world.RelParams{})
+	w.ScenarioCreate("call", world.ScenarioParams{})
+	if err := w.ScenarioStep("call", world.ScenarioStep{From: "web", To: "api"}, 0).Err(); err != nil {
+		t.Fatalf("error adding the step: %v", err)
+	}
+
+	for _, c := range []struct {
+		Format RenderedReturnType
+		Line   string
+	}{
+		{PlantUml, `participant "web" as web [[https://wiki/web]] #438DD5`},
+		{Mermaid, `    links web: {"runbook": "https://wiki/web", "runbook 2": "https://wiki/web-2", "repo": "https://git/web"}`},
+	} {
+		b, _, err := NewSequenceRenderer("call", c.Format).Render(w)
+		if err != nil {
+			t.Fatalf("error rendering: %v", err)
+		}
+		if !strings.Contains(string(b), c.Line+"\n") || strings.Count(string(b), "https://") != strings.Count(c.Line, "https://") {
+			t.Fatalf("expected the line %s, and no other links, got:\n%s", c.Line, b)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package render
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/world"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Look is how a Renderer draws an Item or a Rel, once every world.Style that matches it applies.
+type Look struct {
+	Shape  string `json:"shape"`  // Shape is the shape of an Item, one of world.Shapes.
+	Color  string `json:"color"`  // Color is the fill of an Item, or the line of a Rel.
+	Text   string `json:"text"`   // Text is the color of the label, which contrasts with the fill of an Item.
+	Border string `json:"border"` // Border is the style of the border of an Item, one of world.LineStyles.
+	Line   string `json:"line"`   // Line is the style of the line of a Rel, one of world.LineStyles.
+}
+
+// Theme is a preset of how to draw a World: the Look of everything, then its own Rules for kinds of Items and Rel.
+type Theme struct {
+	Name       string
+	Background string        // Background is the color behind the diagram.
+	Text       string        // Text is the color of labels on the Background, such as titles and the verbs of Rel.
+	Item       Look          // Item is the Look of an Item that no Style matches.
+	Rel        Look          // Rel is the Look of a Rel that no Style matches.
+	Rules      []world.Style // Rules are the Style of the Theme, which apply before those of the World.
+}
+
+// Themes are the theme presets, by name. A World with a theme that isn't here renders with world.ThemeC4.
+var Themes = map[string]Theme{
+	world.ThemeC4: {
+		Name:       world.ThemeC4,
+		Background: "#FFFFFF",
+		Text:       "#000000",
+		Item:       Look{Shape: "box", Color: "#438DD5", Text: "#FFFFFF", Border: "solid"},
+		Rel:        Look{Color: "#707070", Text: "#000000", Line: "solid"},
+		Rules: append([]world.Style{
+			{Id: "person", Name: "Person", Match: "type:person", Shape: "person", Color: "#08427B"},
+			{Id: "external", Name: "External", Match: "external:true", Color: "#999999"},
+		}, themeRules("dashed", "dashed")...),
+	},
+	world.ThemeDark: {
+		Name:       world.ThemeDark,
+		Background: "#1E1E1E",
+		Text:       "#E0E0E0",
+		Item:       Look{Shape: "box", Color: "#2E5A88", Text: "#FFFFFF", Border: "solid"},
+		Rel:        Look{Color: "#A0A0A0", Text: "#E0E0E0", Line: "solid"},
+		Rules: append([]world.Style{
+			{Id: "person", Name: "Person", Match: "type:person", Shape: "person", Color: "#1B3A5C"},
+			{Id: "external", Name: "External", Match: "external:true", Color: "#4A4A4A"},
+		}, themeRules("dashed", "dashed")...),
+	},
+	world.ThemePrint: {
+		Name:       world.ThemePrint,
+		Background: "#FFFFFF",
+		Text:       "#000000",
+		Item:       Look{Shape: "box", Color: "#FFFFFF", Text: "#000000", Border: "solid"},
+		Rel:        Look{Color: "#000000", Text: "#000000", Line: "solid"},
+		Rules: append([]world.Style{
+			{Id: "person", Name: "Person", Match: "type:person", Shape: "person"},
+			{Id: "external", Name: "External", Match: "external:true", Border: "dashed"},
+		}, themeRules("dotted", "dotted")...),
+	},
+}
+
+// themeRules returns the Rules every Theme has: shapes by world.ItemType, and line styles for what isn't there yet or isn't waited on.
+func themeRules(planned, async string) []world.Style {
+	return []world.Style{
+		{Id: "database", Name: "Database", Match: "type:database,type:blobstore", Shape: "cylinder"},
+		{Id: "queue", Name: "Queue", Match: "type:queue", Shape: "queue"},
+		{Id: "planned", Name: "Planned", Match: "status:planned", Border: planned},
+		{Id: "async", Name: "Async", Match: "async:true", Line: async},
+	}
+}
+
+// LegendEntry is a Style that a render used, with how it looks on its own.
+type LegendEntry struct {
+	Style world.Style `json:"style"`
+	Kind  string      `json:"kind"` // Kind is "item" or "rel", for what the Style matched.
+	Look  Look        `json:"look"` // Look is how an Item or Rel looks with only the Style on the Theme.
+}
+
+// Stylesheet evaluates the Rules of the Theme of a World, then its world.Style, for every Renderer.
+// It remembers which Style show in what it drew, so a render can have a legend of only those.
+// A world.Style with the ID of one of the Rules of the Theme takes its place.
+type Stylesheet struct {
+	Theme Theme
+	rules []world.Style
+	used  map[string]bool // used are the Kind and ID of each Style that set an attribute of an Item or Rel, which no later Style overrode.
+}
+
+func NewStylesheet(w world.World) *Stylesheet {
+	theme, ok := Themes[w.Theme()]
+	if !ok {
+		theme = Themes[world.ThemeC4]
+	}
+	rules := slices.Clone(theme.Rules)
+	for _, style := range world.SortedStyles(w) {
+		if i := slices.IndexFunc(rules, func(rule world.Style) bool { return rule.Id == style.Id }); i >= 0 {
+			rules[i] = style
+			continue
+		}
+		rules = append(rules, style)
+	}
+	return &Stylesheet{Theme: theme, rules: rules, used: make(map[string]bool)}
+}
+
+// Item returns the Look of the Item.
+func (s *Stylesheet) Item(item world.Item) Look {
+	look := s.Theme.Item
+	owners := make(map[string]string) // owners are the ID of the last Style to set each attribute, which is the one that shows.
+	for _, rule := range s.rules {
+		if (rule.Shape == "" && rule.Color == "" && rule.Border == "") || !rule.MatchesItem(item) {
+			continue
+		}
+		look = withItemStyle(look, rule)
+		for attribute, value := range map[string]string{"shape": rule.Shape, "color": rule.Color, "border": rule.Border} {
+			if value != "" {
+				owners[attribute] = rule.Id
+			}
+		}
+	}
+	for _, id := range owners {
+		s.used["item\x00"+id] = true
+	}
+	return look
+}
+
+// Rel returns the Look of the Rel.
+func (s *Stylesheet) Rel(rel world.Rel) Look {
+	look := s.Theme.Rel
+	owners := make(map[string]string) // owners are the ID of the last Style to set each attribute, which is the one that shows.
+	for _, rule := range s.rules {
+		if (rule.Color == "" && rule.Line == "") || !rule.MatchesRel(rel) {
+			continue
+		}
+		look = withRelStyle(look, rule)
+		for attribute, value := range map[string]string{"color": rule.Color, "line": rule.Line} {
+			if value != "" {
+				owners[attribute] = rule.Id
+			}
+		}
+	}
+	for _, id := range owners {
+		s.used["rel\x00"+id] = true
+	}
+	return look
+}
+
+// Legend returns the Style used since the Stylesheet was made, in the order they apply, with Items before Rel.
+func (s *Stylesheet) Legend() []LegendEntry {
+	entries := make([]LegendEntry, 0)
+	for _, kind := range []string{"item", "rel"} {
+		for _, rule := range s.rules {
+			if !s.used[kind+"\x00"+rule.Id] {
+				continue
+			}
+			look := withItemStyle(s.Theme.Item, rule)
+			if kind == "rel" {
+				look = withRelStyle(s.Theme.Rel, rule)
+			}
+			entries = append(entries, LegendEntry{Style: rule, Kind: kind, Look: look})
+		}
+	}
+	return entries
+}
+
+func withItemStyle(look Look, style world.Style) Look {
+	look.Shape = cmp.Or(style.Shape, look.Shape)
+	look.Border = cmp.Or(style.Border, look.Border)
+	if style.Color != "" {
+		look.Color, look.Text = style.Color, contrast(style.Color)
+	}
+	return look
+}
+
+func withRelStyle(look Look, style world.Style) Look {
+	look.Color = cmp.Or(style.Color, look.Color)
+	look.Line = cmp.Or(style.Line, look.Line)
+	return look
+}
+
+// contrast returns black or white, whichever is easier to read on the hex color.
+func contrast(color string) string {
+	r, g, b := rgb(color)
+	if 0.299*float64(r)+0.587*float64(g)+0.114*float64(b) > 0.6*255 {
+		return "#000000"
+	}
+	return "#FFFFFF"
+}
+
+// rgb returns the red, green and blue of a `#rgb` or `#rrggbb` hex color.
+func rgb(color string) (int64, int64, int64) {
+	hex := strings.TrimPrefix(color, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	n, _ := strconv.ParseInt(hex, 16, 64)
+	return n >> 16 & 0xFF, n >> 8 & 0xFF, n & 0xFF
+}
+
+// legendNote returns the attributes the Style of the LegendEntry sets, in words (ex: `#438DD5, cylinder, dashed border`).
+func legendNote(e LegendEntry) string {
+	notes := make([]string, 0)
+	if e.Style.Color != "" {
+		notes = append(notes, e.Style.Color)
+	}
+	if e.Kind == "rel" {
+		if e.Style.Line != "" {
+			notes = append(notes, e.Style.Line+" line")
+		}
+		return strings.Join(notes, ", ")
+	}
+	if e.Style.Shape != "" {
+		notes = append(notes, e.Style.Shape)
+	}
+	if e.Style.Border != "" {
+		notes = append(notes, e.Style.Border+" border")
+	}
+	return strings.Join(notes, ", ")
+}
+
+// borderColor returns the color of the border of an Item with the Look: its fill, or its text on a light fill.
+func borderColor(look Look) string {
+	if contrast(look.Color) == "#000000" {
+		return look.Text
+	}
+	return look.Color
+}
+
+// plantUmlLegend returns a PlantUML legend with a row for each LegendEntry, or nothing without any.
+// An Item row has its label on its fill, and a Rel row has its label in the color of its line.
+func plantUmlLegend(entries []LegendEntry) []string {
+	if len(entries) == 0 {
+		return []string{}
+	}
+	lines := []string{"legend right", "|= Style |= Look |"}
+	for _, e := range entries {
+		swatch := fmt.Sprintf("<back:%s><color:%s> %s </color></back>", e.Look.Color, e.Look.Text, escape(e.Style.Label()))
+		if e.Kind == "rel" {
+			swatch = fmt.Sprintf("<color:%s> %s </color>", e.Look.Color, escape(e.Style.Label()))
+		}
+		lines = append(lines, fmt.Sprintf("| %s | %s |", swatch, legendNote(e)))
+	}
+	return append(lines, "endlegend")
+}
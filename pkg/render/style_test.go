@@ -0,0 +1,62 @@
+package render
+
+import (
+	"github.com/williamflynt/topolith/pkg/world"
+	"slices"
+	"testing"
+)
+
+func TestStylesheet(t *testing.T) {
+	w := world.CreateWorld("test-world")
+	w.ItemCreate("shopper", world.ItemParams{Type: strPtr("person")})
+	w.ItemCreate("db", world.ItemParams{Type: strPtr("database"), Tags: strPtr("pci")})
+	w.ItemCreate("mail", world.ItemParams{External: boolPtr(true)})
+	w.RelCreate("shopper", "db", world.RelParams{Async: boolPtr(true)})
+	w.StyleCreate("database", world.StyleParams{Name: strPtr("Stores"), Match: strPtr("type:database"), Shape: strPtr("rounded")})
+	w.StyleCreate("pci", world.StyleParams{Name: strPtr("PCI"), Match: strPtr("tag:pci"), Color: strPtr("#FFCC00"), Border: strPtr("bold")})
+	w.StyleCreate("zz-async", world.StyleParams{Match: strPtr("async:true"), Line: strPtr("dotted")})
+
+	for _, c := range []struct {
+		Name   string
+		Theme  string
+		Id     string
+		Look   Look
+		Legend []string
+	}{
+		{"theme rule", world.ThemeC4, "shopper", Look{Shape: "person", Color: "#08427B", Text: "#FFFFFF", Border: "solid"}, []string{"item:Person"}},
+		{"world style in place of a theme rule", world.ThemeC4, "db", Look{Shape: "rounded", Color: "#FFCC00", Text: "#000000", Border: "bold"}, []string{"item:Stores", "item:PCI"}},
+		{"dark", world.ThemeDark, "mail", Look{Shape: "box", Color: "#4A4A4A", Text: "#FFFFFF", Border: "solid"}, []string{"item:External"}},
+		{"print", world.ThemePrint, "mail", Look{Shape: "box", Color: "#FFFFFF", Text: "#000000", Border: "dashed"}, []string{"item:External"}},
+		{"unknown theme", "neon", "mail", Look{Shape: "box", Color: "#999999", Text: "#FFFFFF", Border: "solid"}, []string{"item:External"}},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			w.SetTheme(c.Theme)
+			sheet := NewStylesheet(w)
+			item, _ := w.ItemFetch(c.Id)
+			if look := sheet.Item(item); look != c.Look {
+				t.Fatalf("expected %v, got %v", c.Look, look)
+			}
+			if legend := legendLabels(sheet.Legend()); !slices.Equal(legend, c.Legend) {
+				t.Fatalf("expected the legend %v, got %v", c.Legend, legend)
+			}
+		})
+	}
+
+	// A later Style overrides the line of the theme, so only it is in the legend.
+	w.SetTheme(world.ThemeC4)
+	sheet := NewStylesheet(w)
+	if look := sheet.Rel(w.RelFetch("shopper", "db", true)[0]); look.Line != "dotted" || look.Color != "#707070" {
+		t.Fatalf("expected the dotted line of the world style, got %v", look)
+	}
+	if legend := legendLabels(sheet.Legend()); !slices.Equal(legend, []string{"rel:async:true"}) {
+		t.Fatalf("expected only the world style in the legend, got %v", legend)
+	}
+}
+
+func legendLabels(entries []LegendEntry) []string {
+	labels := make([]string, len(entries))
+	for i, e := range entries {
+		labels[i] = e.Kind + ":" + e.Style.Label()
+	}
+	return labels
+}
@@ -0,0 +1,117 @@
+package threat
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/world"
+	"slices"
+	"strings"
+)
+
+// Category is a STRIDE threat category.
+type Category string
+
+const (
+	Spoofing              Category = "spoofing"
+	Tampering             Category = "tampering"
+	Repudiation           Category = "repudiation"
+	InformationDisclosure Category = "information-disclosure"
+	DenialOfService       Category = "denial-of-service"
+	ElevationOfPrivilege  Category = "elevation-of-privilege"
+)
+
+// Threat is an entry in a threat model checklist: a question to answer about a world.Item or world.Rel.
+type Threat struct {
+	Category Category `json:"category"`
+	Question string   `json:"question"`
+}
+
+// Crossing is a world.Rel between Items in different trust boundaries, and the Threat to check where it crosses.
+type Crossing struct {
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	FromBoundary string   `json:"fromBoundary"`
+	ToBoundary   string   `json:"toBoundary"`
+	Threats      []Threat `json:"threats"`
+}
+
+// ItemThreats is a world.Item at either end of a Crossing, and the Threat to check for its type.
+type ItemThreats struct {
+	Id       string   `json:"id"`
+	Type     string   `json:"type"` // Type is the world.ItemType of the Item, or empty if it has none.
+	Boundary string   `json:"boundary"`
+	Threats  []Threat `json:"threats"`
+}
+
+// Model is a STRIDE threat model of a world.World, built from its trust boundaries.
+type Model struct {
+	World     string        `json:"world"` // World is the name of the world.World.
+	Crossings []Crossing    `json:"crossings"`
+	Items     []ItemThreats `json:"items"`
+}
+
+// Build returns the threat Model of the World: a Crossing for each world.Rel across a trust boundary, and an ItemThreats for each Item at either end of one.
+func Build(w world.World) Model {
+	model := Model{World: w.Name(), Crossings: make([]Crossing, 0), Items: make([]ItemThreats, 0)}
+	itemIds := make([]string, 0)
+	for _, rel := range world.BoundaryCrossings(w) {
+		// The Rel holds a copy of its Items, so we fetch them for the current type.
+		from, _ := w.ItemFetch(rel.From.Id)
+		to, _ := w.ItemFetch(rel.To.Id)
+		model.Crossings = append(model.Crossings, Crossing{
+			From:         from.Id,
+			To:           to.Id,
+			FromBoundary: world.EffectiveBoundary(w, from.Id),
+			ToBoundary:   world.EffectiveBoundary(w, to.Id),
+			Threats:      crossingThreats(rel, from, to),
+		})
+		itemIds = append(itemIds, from.Id, to.Id)
+	}
+	slices.Sort(itemIds)
+	for _, id := range slices.Compact(itemIds) {
+		item, _ := w.ItemFetch(id)
+		model.Items = append(model.Items, ItemThreats{
+			Id:       id,
+			Type:     world.StringFromItemType(item.Type),
+			Boundary: world.EffectiveBoundary(w, id),
+			Threats:  itemThreats(item),
+		})
+	}
+	return model
+}
+
+// JSON returns the Model as indented JSON.
+func (m Model) JSON() ([]byte, error) {
+	return json.MarshalIndent(m, "", "  ")
+}
+
+// Markdown returns the Model as a Markdown document, with a checklist for each Crossing and Item.
+func (m Model) Markdown() []byte {
+	b := strings.Builder{}
+	b.WriteString(fmt.Sprintf("# Threat Model: %s\n\n", m.World))
+	b.WriteString("## Boundary Crossings\n")
+	if len(m.Crossings) == 0 {
+		b.WriteString("\nNo relationships cross a trust boundary.\n")
+	}
+	for _, c := range m.Crossings {
+		b.WriteString(fmt.Sprintf("\n### %s → %s (%s → %s)\n\n", c.From, c.To, c.FromBoundary, c.ToBoundary))
+		writeChecklist(&b, c.Threats)
+	}
+	b.WriteString("\n## Items\n")
+	for _, i := range m.Items {
+		kind := i.Type
+		if kind == "" {
+			kind = "untyped"
+		}
+		b.WriteString(fmt.Sprintf("\n### %s (%s, %s)\n\n", i.Id, kind, i.Boundary))
+		writeChecklist(&b, i.Threats)
+	}
+	return []byte(b.String())
+}
+
+// writeChecklist writes the Threat as unchecked Markdown task list entries.
+func writeChecklist(b *strings.Builder, threats []Threat) {
+	for _, t := range threats {
+		b.WriteString(fmt.Sprintf("- [ ] **%s**: %s\n", t.Category, t.Question))
+	}
+}
@@ -0,0 +1,113 @@
+package threat
+
+import (
+	"encoding/json"
+	"github.com/williamflynt/topolith/pkg/world"
+	"strings"
+	"testing"
+)
+
+func threatWorld() world.World {
+	w := world.CreateWorld("shop")
+	w.ItemCreate("customer", world.ItemParams{Type: strPtr("person"), Boundary: strPtr("public")})
+	w.ItemCreate("browser", world.ItemParams{Type: strPtr("browser"), External: boolPtr(true)})
+	w.ItemCreate("api", world.ItemParams{Type: strPtr("server")})
+	w.ItemCreate("events", world.ItemParams{Type: strPtr("queue"), Boundary: strPtr("data")})
+	w.ItemCreate("worker", world.ItemParams{Type: strPtr("server")})
+	w.RelCreate("customer", "browser", world.RelParams{})
+	w.RelCreate("browser", "api", world.RelParams{Mechanism: strPtr("HTTPS"), Classification: strPtr("pii")})
+	w.RelCreate("api", "events", world.RelParams{Async: boolPtr(true)})
+	w.RelCreate("api", "worker", world.RelParams{})
+	return w
+}
+
+func TestBuild(t *testing.T) {
+	model := Build(threatWorld())
+	if model.World != "shop" {
+		t.Errorf("expected world shop, got %s", model.World)
+	}
+
+	for _, c := range []struct {
+		Name     string
+		From     string
+		To       string
+		Boundary string // Boundary is the from and to boundaries, joined by an arrow.
+		Expected Threat
+	}{
+		{"person to browser", "customer", "browser", "public->external", Threat{Spoofing, "Can someone other than customer use browser, such as on a shared or stolen device?"}},
+		{"external caller", "browser", "api", "external->internal", Threat{Spoofing, "browser is external: is its identity verified, such as with mTLS or signed requests?"}},
+		{"mechanism", "browser", "api", "external->internal", Threat{Tampering, "Is the HTTPS connection from browser to api integrity protected, such as with TLS?"}},
+		{"classified", "browser", "api", "external->internal", Threat{InformationDisclosure, "It carries pii data: is only what's needed sent, and is it encrypted end to end?"}},
+		{"queue", "api", "events", "internal->data", Threat{Tampering, "Can messages between api and events be forged, replayed, or reordered?"}},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			var crossing *Crossing
+			for i := range model.Crossings {
+				if model.Crossings[i].From == c.From && model.Crossings[i].To == c.To {
+					crossing = &model.Crossings[i]
+				}
+			}
+			if crossing == nil {
+				t.Fatalf("expected a crossing from %s to %s", c.From, c.To)
+			}
+			if boundary := crossing.FromBoundary + "->" + crossing.ToBoundary; boundary != c.Boundary {
+				t.Errorf("expected boundaries %s, got %s", c.Boundary, boundary)
+			}
+			found := false
+			for _, threat := range crossing.Threats {
+				found = found || threat == c.Expected
+			}
+			if !found {
+				t.Errorf("expected threat %v, got %v", c.Expected, crossing.Threats)
+			}
+		})
+	}
+
+	if len(model.Crossings) != 3 {
+		t.Errorf("expected 3 crossings, got %d: api to worker doesn't cross a boundary", len(model.Crossings))
+	}
+	ids := make([]string, len(model.Items))
+	for i, item := range model.Items {
+		ids[i] = item.Id
+	}
+	if strings.Join(ids, ",") != "api,browser,customer,events" {
+		t.Errorf("expected items api,browser,customer,events, got %v", ids)
+	}
+	for _, item := range model.Items {
+		if item.Id == "events" && (item.Type != "queue" || item.Threats[0] != itemChecklists[world.Queue][0]) {
+			t.Errorf("expected the queue checklist for events, got %v", item)
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	model := Build(threatWorld())
+
+	md := string(model.Markdown())
+	for _, expected := range []string{
+		"# Threat Model: shop\n",
+		"### customer → browser (public → external)\n",
+		"### events (queue, data)\n",
+		"- [ ] **tampering**: Can messages be modified, injected, or replayed on the queue?\n",
+	} {
+		if !strings.Contains(md, expected) {
+			t.Errorf("expected markdown to contain %q, got:\n%s", expected, md)
+		}
+	}
+
+	b, err := model.JSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded Model
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(decoded.Crossings) != len(model.Crossings) || len(decoded.Items) != len(model.Items) {
+		t.Errorf("expected JSON to round trip, got %s", b)
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+func boolPtr(b bool) *bool { return &b }
@@ -0,0 +1,116 @@
+package threat
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/world"
+	"slices"
+)
+
+// categoryOrder is the order of the STRIDE categories in a checklist.
+var categoryOrder = []Category{Spoofing, Tampering, Repudiation, InformationDisclosure, DenialOfService, ElevationOfPrivilege}
+
+// itemChecklists are the Threat to check for each world.ItemType at a trust boundary.
+var itemChecklists = map[world.ItemType][]Threat{
+	world.Person: {
+		{Spoofing, "Can someone impersonate this person, such as with stolen credentials or a phished session?"},
+		{Repudiation, "Can this person deny an action they took? Are their actions logged and attributable?"},
+	},
+	world.Database: {
+		{Tampering, "Can data be modified without authorization, such as through injection?"},
+		{Repudiation, "Are changes to the data audited?"},
+		{InformationDisclosure, "Is the data encrypted at rest, and access restricted to the services that need it?"},
+		{DenialOfService, "Can expensive queries or connection exhaustion make the database unavailable?"},
+	},
+	world.Queue: {
+		{Tampering, "Can messages be modified, injected, or replayed on the queue?"},
+		{Repudiation, "Can the producer of each message be identified?"},
+		{InformationDisclosure, "Can unauthorized consumers read the messages?"},
+		{DenialOfService, "Can a flood of messages or a poison message stall the consumers?"},
+	},
+	world.Blobstore: {
+		{Tampering, "Can objects be overwritten or deleted without authorization?"},
+		{InformationDisclosure, "Are buckets private, and objects encrypted at rest?"},
+		{DenialOfService, "Can uploads exhaust storage or run up cost?"},
+	},
+	world.Browser: {
+		{Spoofing, "Can a malicious page or extension act as this client, such as with CSRF or clickjacking?"},
+		{Tampering, "Can requests or client-side state be modified before they leave the browser?"},
+		{InformationDisclosure, "Is sensitive data cached, stored, or logged in the browser?"},
+	},
+	world.Mobile: {
+		{Spoofing, "Can a repackaged or emulated app pose as this client?"},
+		{Tampering, "Can the app binary or its local storage be modified?"},
+		{InformationDisclosure, "Are secrets or personal data stored unencrypted on the device?"},
+	},
+	world.Server: {
+		{Spoofing, "Does the server authenticate every caller from across the boundary?"},
+		{Tampering, "Is input from across the boundary validated before use?"},
+		{Repudiation, "Are requests from across the boundary logged with the caller's identity?"},
+		{InformationDisclosure, "Do errors, logs, or responses leak internal details or data?"},
+		{DenialOfService, "Are requests from across the boundary rate limited and bounded in size?"},
+		{ElevationOfPrivilege, "Does the server run with least privilege, and authorize each request?"},
+	},
+	world.Device: {
+		{Spoofing, "Can another device pose as this one? Does it have a unique identity?"},
+		{Tampering, "Can the device or its firmware be modified, physically or remotely?"},
+		{DenialOfService, "Can the device be disabled or disconnected?"},
+	},
+	world.Code: {
+		{Tampering, "Can this code or its dependencies be modified in the supply chain?"},
+		{ElevationOfPrivilege, "Can this code be made to run with more privilege than it needs?"},
+	},
+}
+
+// untypedChecklist is the Threat to check for an Item without a world.ItemType.
+var untypedChecklist = []Threat{
+	{Spoofing, "Can something else pretend to be this item?"},
+	{Tampering, "Can this item, or the data it holds, be modified without authorization?"},
+	{Repudiation, "Are actions on this item logged and attributable?"},
+	{InformationDisclosure, "Can data leak from this item to someone not authorized to see it?"},
+	{DenialOfService, "Can this item be made unavailable?"},
+	{ElevationOfPrivilege, "Can a caller gain more privilege on this item than it was granted?"},
+}
+
+// itemThreats returns the checklist for the type of the Item.
+func itemThreats(item world.Item) []Threat {
+	if threats, ok := itemChecklists[item.Type]; ok {
+		return slices.Clone(threats)
+	}
+	return slices.Clone(untypedChecklist)
+}
+
+// crossingThreats returns the checklist for a Rel across a trust boundary: the general STRIDE questions for data in transit,
+// with more for the types of the Items at either end, whether it is async, and what data it carries.
+func crossingThreats(rel world.Rel, from, to world.Item) []Threat {
+	channel := "the connection"
+	if rel.Mechanism != "" {
+		channel = fmt.Sprintf("the %s connection", rel.Mechanism)
+	}
+	threats := []Threat{
+		{Spoofing, fmt.Sprintf("Does %s authenticate %s?", to.Id, from.Id)},
+		{Tampering, fmt.Sprintf("Is %s from %s to %s integrity protected, such as with TLS?", channel, from.Id, to.Id)},
+		{Repudiation, fmt.Sprintf("Does %s log what %s asked for, and who asked?", to.Id, from.Id)},
+		{InformationDisclosure, fmt.Sprintf("Is data between %s and %s encrypted in transit?", from.Id, to.Id)},
+		{DenialOfService, fmt.Sprintf("Can %s overwhelm %s? Is it rate limited?", from.Id, to.Id)},
+		{ElevationOfPrivilege, fmt.Sprintf("Does %s authorize what %s can do, rather than trust where it is?", to.Id, from.Id)},
+	}
+	if from.External {
+		threats = append(threats, Threat{Spoofing, fmt.Sprintf("%s is external: is its identity verified, such as with mTLS or signed requests?", from.Id)})
+	}
+	if from.Type == world.Person && (to.Type == world.Browser || to.Type == world.Mobile) {
+		threats = append(threats, Threat{Spoofing, fmt.Sprintf("Can someone other than %s use %s, such as on a shared or stolen device?", from.Id, to.Id)})
+	}
+	if rel.Async || to.Type == world.Queue || from.Type == world.Queue {
+		threats = append(threats, Threat{Tampering, fmt.Sprintf("Can messages between %s and %s be forged, replayed, or reordered?", from.Id, to.Id)})
+	}
+	if to.Type == world.Database || to.Type == world.Blobstore {
+		threats = append(threats, Threat{Tampering, fmt.Sprintf("Is the access %s has to %s limited to what it needs?", from.Id, to.Id)})
+	}
+	if rel.Classification != "" {
+		threats = append(threats, Threat{InformationDisclosure, fmt.Sprintf("It carries %s data: is only what's needed sent, and is it encrypted end to end?", rel.Classification)})
+	}
+	slices.SortStableFunc(threats, func(a, b Threat) int {
+		return slices.Index(categoryOrder, a.Category) - slices.Index(categoryOrder, b.Category)
+	})
+	return threats
+}
@@ -0,0 +1,45 @@
+package world
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	InternalBoundary = "internal" // InternalBoundary is the trust boundary of Items outside any declared boundary.
+	ExternalBoundary = "external" // ExternalBoundary is the trust boundary of External Items outside any declared boundary.
+)
+
+// EffectiveBoundary returns the trust boundary of the Item with the given ID: its own Boundary, or else the nearest ancestor's.
+// Outside any declared boundary, an External Item is in ExternalBoundary, and any other Item is in InternalBoundary.
+func EffectiveBoundary(w World, id string) string {
+	item, ok := w.ItemFetch(id)
+	if !ok {
+		return ""
+	}
+	for ancestorId := id; ancestorId != ""; ancestorId, _ = w.Parent(ancestorId) {
+		ancestor, ok := w.ItemFetch(ancestorId)
+		if !ok {
+			break
+		}
+		if ancestor.Boundary != "" {
+			return ancestor.Boundary
+		}
+	}
+	if item.External {
+		return ExternalBoundary
+	}
+	return InternalBoundary
+}
+
+// BoundaryCrossings returns the Rel between Items in different trust boundaries, sorted by ID. Archived Items and Rel are left out.
+func BoundaryCrossings(w World) []Rel {
+	crossings := make([]Rel, 0)
+	for _, rel := range w.RelList(0) {
+		if EffectiveBoundary(w, rel.From.Id) != EffectiveBoundary(w, rel.To.Id) {
+			crossings = append(crossings, rel)
+		}
+	}
+	slices.SortFunc(crossings, func(a, b Rel) int { return strings.Compare(a.id(), b.id()) })
+	return crossings
+}
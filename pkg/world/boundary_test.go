@@ -0,0 +1,53 @@
+package world
+
+import (
+	"testing"
+)
+
+func TestBoundaryCrossings(t *testing.T) {
+	w := CreateWorld("boundary-world")
+	w.ItemCreate("user", ItemParams{Type: strPtr("person")})
+	w.ItemCreate("browser", ItemParams{Type: strPtr("browser"), External: boolPtr(true)})
+	w.ItemCreate("dmz", ItemParams{Boundary: strPtr("dmz")})
+	w.ItemCreate("gateway", ItemParams{})
+	w.ItemCreate("api", ItemParams{})
+	w.ItemCreate("db", ItemParams{Boundary: strPtr("data")})
+	w.ItemCreate("crm", ItemParams{External: boolPtr(true), Boundary: strPtr("partner")})
+	w.Nest("gateway", "dmz")
+	w.RelCreate("user", "browser", RelParams{})
+	w.RelCreate("browser", "gateway", RelParams{})
+	w.RelCreate("gateway", "api", RelParams{})
+	w.RelCreate("api", "db", RelParams{})
+	w.RelCreate("api", "crm", RelParams{})
+
+	for _, c := range []struct {
+		Id       string
+		Boundary string
+	}{
+		{"user", InternalBoundary},
+		{"browser", ExternalBoundary},
+		{"dmz", "dmz"},
+		{"gateway", "dmz"},
+		{"api", InternalBoundary},
+		{"db", "data"},
+		{"crm", "partner"},
+		{"missing", ""},
+	} {
+		t.Run(c.Id, func(t *testing.T) {
+			if boundary := EffectiveBoundary(w, c.Id); boundary != c.Boundary {
+				t.Errorf("expected boundary %q, got %q", c.Boundary, boundary)
+			}
+		})
+	}
+
+	expected := []string{"api::crm", "api::db", "browser::gateway", "gateway::api", "user::browser"}
+	crossings := BoundaryCrossings(w)
+	if len(crossings) != len(expected) {
+		t.Fatalf("expected %d crossings, got %d", len(expected), len(crossings))
+	}
+	for i, rel := range crossings {
+		if rel.id() != expected[i] {
+			t.Errorf("expected crossing %d to be %s, got %s", i, expected[i], rel.id())
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package world
+
+import (
+	"slices"
+	"strings"
+)
+
+// Flow is where data of a classification can go in the World, from the Items that store it and the Rel that carry it.
+type Flow struct {
+	Class string     // Class is the classification that flows (ex: `pii`).
+	Steps []FlowStep // Steps are the Items that could receive the data, in the order we reach them. Sources come first.
+}
+
+// FlowStep is an Item that could receive classified data, and how the data gets there.
+type FlowStep struct {
+	Item   Item
+	Source bool  // Source is true if the Item stores the data, or is at either end of a Rel that carries it.
+	Path   []Rel // Path are the Rel the data takes from a source to the Item, in order. It is empty for a source, or an Item that holds a source.
+}
+
+// Crossing indicates whether the classified data crosses into an External Item at this FlowStep.
+func (s FlowStep) Crossing() bool {
+	return s.Item.External && !s.Source
+}
+
+// DataFlow returns where data of the classification can go in the World.
+//
+// The sources are the Items with the classification, and the Items at either end of a Rel with it.
+// Data moves along a Rel in its direction, as a request from the caller. An Item that holds the data returns it
+// to its callers too, against the direction of their Rel, and so does each caller that gets it that way.
+// An Item that only receives the data in a request returns it only to the caller that sent it, so it goes no further back.
+// An Item that receives data rolls it up to its ancestors in the Tree, and the data moves on along their Rel too.
+// Each Item is reached by the shortest Path from a source. Archived Items and Rel are left out.
+func DataFlow(w World, class string) Flow {
+	flow := Flow{Class: class, Steps: make([]FlowStep, 0)}
+	reached := make(map[string]bool)
+	responding := make(map[string]bool) // responding are the reached Items that return the data to their callers.
+	type visit struct {
+		step     FlowStep
+		responds bool
+	}
+	queue := make([]visit, 0)
+	var reach func(id string, source bool, path []Rel, responds bool)
+	reach = func(id string, source bool, path []Rel, responds bool) {
+		item, ok := w.ItemFetch(id)
+		if !ok || w.Archived(id) || (reached[id] && (responding[id] || !responds)) {
+			return
+		}
+		step := FlowStep{Item: item, Source: source, Path: path}
+		if !reached[id] {
+			flow.Steps = append(flow.Steps, step)
+		}
+		// An Item we reached by a request, and now reach by a response, goes on to return the data as well.
+		reached[id], responding[id] = true, responds
+		queue = append(queue, visit{step: step, responds: responds})
+		// The data is in every Item that holds this one.
+		if parentId, _ := w.Parent(id); parentId != "" {
+			reach(parentId, false, path, responds)
+		}
+	}
+
+	items := w.ItemList(0)
+	slices.SortFunc(items, func(a, b Item) int { return strings.Compare(a.Id, b.Id) })
+	for _, item := range items {
+		if hasClass(item.Classification, class) {
+			reach(item.Id, true, []Rel{}, true)
+		}
+	}
+	rels := w.RelList(0)
+	slices.SortFunc(rels, func(a, b Rel) int { return strings.Compare(a.id(), b.id()) })
+	for _, rel := range rels {
+		if hasClass(rel.Classification, class) {
+			reach(rel.From.Id, true, []Rel{}, true)
+			reach(rel.To.Id, true, []Rel{}, true)
+		}
+	}
+
+	byId := func(a, b Rel) int { return strings.Compare(a.id(), b.id()) }
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		id := v.step.Item.Id
+		outbound := w.RelFrom(id, true)
+		slices.SortFunc(outbound, byId)
+		for _, rel := range outbound {
+			reach(rel.To.Id, false, append(slices.Clone(v.step.Path), rel), false)
+		}
+		if !v.responds {
+			continue
+		}
+		inbound := w.RelTo(id, true)
+		slices.SortFunc(inbound, byId)
+		for _, rel := range inbound {
+			reach(rel.From.Id, false, append(slices.Clone(v.step.Path), rel), true)
+		}
+	}
+	return flow
+}
+
+// hasClass indicates whether the class is among the comma-separated classes of the classification, ignoring case.
+func hasClass(classification, class string) bool {
+	for _, c := range strings.Split(classification, ",") {
+		if c = strings.TrimSpace(c); c != "" && strings.EqualFold(c, strings.TrimSpace(class)) {
+			return true
+		}
+	}
+	return false
+}
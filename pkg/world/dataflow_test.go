@@ -0,0 +1,71 @@
+package world
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDataFlow(t *testing.T) {
+	w := CreateWorld("dataflow-world")
+	w.ItemCreate("payments", ItemParams{})
+	w.ItemCreate("payments.db", ItemParams{Classification: strPtr("pii, pci")})
+	w.ItemCreate("payments.api", ItemParams{})
+	w.ItemCreate("web", ItemParams{})
+	w.ItemCreate("crm", ItemParams{External: boolPtr(true)})
+	w.ItemCreate("mailer", ItemParams{External: boolPtr(true)})
+	w.ItemCreate("legacy", ItemParams{Archived: boolPtr(true)})
+	w.ItemCreate("metrics", ItemParams{})
+	w.ItemCreate("partner", ItemParams{})
+	w.ItemCreate("reports", ItemParams{})
+	w.Nest("payments.db", "payments")
+	w.Nest("payments.api", "payments")
+	w.RelCreate("payments.api", "payments.db", RelParams{})
+	w.RelCreate("web", "payments.api", RelParams{})
+	w.RelCreate("web", "crm", RelParams{})
+	w.RelCreate("payments", "legacy", RelParams{})
+	w.RelCreate("metrics", "mailer", RelParams{Classification: strPtr("PII")})
+	// crm only receives the data in requests from web, so it returns nothing to partner, but it can pass the data on to reports.
+	w.RelCreate("partner", "crm", RelParams{})
+	w.RelCreate("crm", "reports", RelParams{})
+
+	flow := DataFlow(w, "pii")
+	type step struct {
+		Id     string
+		Source bool
+		Path   []string
+	}
+	expected := []step{
+		{"payments.db", true, []string{}},
+		{"payments", false, []string{}},
+		{"metrics", true, []string{}},
+		{"mailer", true, []string{}},
+		{"payments.api", false, []string{"payments.api::payments.db"}},
+		{"web", false, []string{"payments.api::payments.db", "web::payments.api"}},
+		{"crm", false, []string{"payments.api::payments.db", "web::payments.api", "web::crm"}},
+		{"reports", false, []string{"payments.api::payments.db", "web::payments.api", "web::crm", "crm::reports"}},
+	}
+	if len(flow.Steps) != len(expected) {
+		t.Fatalf("expected %d steps, got %d: %v", len(expected), len(flow.Steps), flow.Steps)
+	}
+	for i, s := range flow.Steps {
+		path := make([]string, len(s.Path))
+		for j, rel := range s.Path {
+			path[j] = rel.id()
+		}
+		if s.Item.Id != expected[i].Id || s.Source != expected[i].Source || !slices.Equal(path, expected[i].Path) {
+			t.Errorf("expected step %d to be %v, got %s %t %v", i, expected[i], s.Item.Id, s.Source, path)
+		}
+	}
+	crossings := make([]string, 0)
+	for _, s := range flow.Steps {
+		if s.Crossing() {
+			crossings = append(crossings, s.Item.Id)
+		}
+	}
+	if !slices.Equal(crossings, []string{"crm"}) {
+		t.Errorf("expected a crossing into crm only, got %v", crossings)
+	}
+	if steps := DataFlow(w, "phi").Steps; len(steps) != 0 {
+		t.Errorf("expected no flow for an unused class, got %v", steps)
+	}
+}
@@ -0,0 +1,440 @@
+package world
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/grammar"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DeploymentKind is an iota that represents the level of a DeploymentNode in the deployment hierarchy.
+type DeploymentKind int
+
+const (
+	_ DeploymentKind = iota
+	Environment
+	Cluster
+	Node
+)
+
+func DeploymentKindFromString(s string) DeploymentKind {
+	switch s {
+	case "environment":
+		return Environment
+	case "cluster":
+		return Cluster
+	case "node":
+		return Node
+	default:
+		return 0
+	}
+}
+
+func StringFromDeploymentKind(k DeploymentKind) string {
+	switch k {
+	case Environment:
+		return "environment"
+	case Cluster:
+		return "cluster"
+	case Node:
+		return "node"
+	default:
+		return ""
+	}
+}
+
+// DeploymentNode is somewhere Items are deployed to: an environment, or a cluster or node in one.
+// DeploymentNode have a hierarchy of their own, apart from the Tree. Each environment is a root, with the clusters and nodes in it below.
+type DeploymentNode struct {
+	Id        string         `json:"id"`
+	Kind      DeploymentKind `json:"kind"`
+	Name      string         `json:"name"`      // Name is the display name of the DeploymentNode; meant for humans.
+	Mechanism string         `json:"mechanism"` // Mechanism is the technology of the DeploymentNode (ex: Kubernetes, EC2).
+	Parent    string         `json:"parent"`    // Parent is the ID of the DeploymentNode this one is in. It is empty for an environment.
+	Items     []string       `json:"items"`     // Items are the IDs of the Items deployed to the DeploymentNode, sorted.
+}
+
+func (n DeploymentNode) String() string {
+	paramRepr := []string{fmt.Sprintf(`node "%s"`, n.Id)}
+	if n.Kind != 0 {
+		paramRepr = append(paramRepr, "kind="+StringFromDeploymentKind(n.Kind))
+	}
+	if n.Name != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`name="%s"`, n.Name))
+	}
+	if n.Mechanism != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`mechanism="%s"`, n.Mechanism))
+	}
+	if n.Parent != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`parent="%s"`, n.Parent))
+	}
+	return strings.Join(paramRepr, " ")
+}
+
+// NodeFromString returns a DeploymentNode from a grammar-adherent string representation, without its Items.
+func NodeFromString(s string) (DeploymentNode, error) {
+	p, err := grammar.Parse(s)
+	if err != nil {
+		return DeploymentNode{}, errors.New("error parsing Node").UseCode(errors.TopolithErrorInvalid).WithError(err).WithDescription("error parsing Node").WithData(errors.KvPair{Key: "input", Value: s})
+	}
+	return nodeSet(DeploymentNode{Id: p.InputAttributes.ResourceId, Items: []string{}}, NodeParamsFromInput(p.InputAttributes))
+}
+
+func NodeEqual(n1, n2 DeploymentNode) bool {
+	return n1.Id == n2.Id && n1.Kind == n2.Kind && n1.Name == n2.Name && n1.Mechanism == n2.Mechanism && n1.Parent == n2.Parent && slices.Equal(n1.Items, n2.Items)
+}
+
+// NodeParams is a struct that represents the parameters that can be set on a DeploymentNode.
+type NodeParams struct {
+	Kind      *string `json:"kind"`
+	Name      *string `json:"name"`
+	Mechanism *string `json:"mechanism"`
+	Parent    *string `json:"parent"`
+}
+
+func NodeParamsFromInput(input grammar.InputAttributes) NodeParams {
+	params := NodeParams{}
+	if v, ok := input.Params["kind"]; ok {
+		params.Kind = strPtr(v)
+	}
+	if v, ok := input.Params["name"]; ok {
+		params.Name = strPtr(v)
+	}
+	if v, ok := input.Params["mechanism"]; ok {
+		params.Mechanism = strPtr(v)
+	}
+	if v, ok := input.Params["parent"]; ok {
+		params.Parent = strPtr(v)
+	}
+	return params
+}
+
+// Deployment is an Item deployed to a DeploymentNode.
+type Deployment struct {
+	ItemId string `json:"itemId"`
+	NodeId string `json:"nodeId"`
+}
+
+func (d Deployment) String() string {
+	return fmt.Sprintf(`deploy "%s" to "%s"`, d.ItemId, d.NodeId)
+}
+
+// AllDeployments returns every Deployment in the World, sorted by DeploymentNode ID, then Item ID.
+func AllDeployments(w World) []Deployment {
+	deployments := make([]Deployment, 0)
+	for _, node := range sortedNodes(w.NodeList(0)) {
+		for _, itemId := range node.Items {
+			deployments = append(deployments, Deployment{ItemId: itemId, NodeId: node.Id})
+		}
+	}
+	return deployments
+}
+
+// NodeEnvironment returns the ID of the environment the DeploymentNode with the given ID is in: the root of its hierarchy.
+func NodeEnvironment(w World, id string) string {
+	for {
+		node, ok := w.NodeFetch(id)
+		if !ok || node.Parent == "" {
+			return id
+		}
+		id = node.Parent
+	}
+}
+
+// NodeIn indicates whether the DeploymentNode with the given ID is the one with the ancestor ID, or anywhere in it.
+func NodeIn(w World, id, ancestorId string) bool {
+	for id != "" {
+		if id == ancestorId {
+			return true
+		}
+		node, ok := w.NodeFetch(id)
+		if !ok {
+			return false
+		}
+		id = node.Parent
+	}
+	return false
+}
+
+// Deployed returns the DeploymentNode the Item with the given ID is deployed to, sorted by ID.
+// With a non-empty in ID, only the DeploymentNode in that one count (ex: those in the `prod` environment).
+func Deployed(w World, itemId, in string) []DeploymentNode {
+	nodes := make([]DeploymentNode, 0)
+	for _, node := range sortedNodes(w.NodeList(0)) {
+		if slices.Contains(node.Items, itemId) && (in == "" || NodeIn(w, node.Id, in)) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// NodeComponents returns the DeploymentNode directly in the one with the given ID, sorted by ID.
+// An empty ID returns the environments.
+func NodeComponents(w World, id string) []DeploymentNode {
+	nodes := make([]DeploymentNode, 0)
+	for _, node := range sortedNodes(w.NodeList(0)) {
+		if node.Parent == id {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// HierarchyOrder returns the DeploymentNode depth first through their hierarchy, so each comes after the one it is in.
+// Siblings are sorted by ID. Any DeploymentNode in one that isn't among them comes last.
+func HierarchyOrder(nodes []DeploymentNode) []DeploymentNode {
+	byParent := make(map[string][]DeploymentNode)
+	ids := make(map[string]bool)
+	for _, node := range sortedNodes(slices.Clone(nodes)) {
+		byParent[node.Parent] = append(byParent[node.Parent], node)
+		ids[node.Id] = true
+	}
+	ordered := make([]DeploymentNode, 0, len(nodes))
+	var visit func(parentId string)
+	visit = func(parentId string) {
+		for _, node := range byParent[parentId] {
+			ordered = append(ordered, node)
+			visit(node.Id)
+		}
+	}
+	visit("")
+	for _, node := range sortedNodes(slices.Clone(nodes)) {
+		if node.Parent != "" && !ids[node.Parent] {
+			ordered = append(ordered, node)
+			visit(node.Id)
+		}
+	}
+	return ordered
+}
+
+// sortedNodes returns the DeploymentNode sorted by ID.
+func sortedNodes(nodes []DeploymentNode) []DeploymentNode {
+	slices.SortFunc(nodes, func(a, b DeploymentNode) int { return strings.Compare(a.Id, b.Id) })
+	return nodes
+}
+
+// --- WORLD OPERATIONS ---
+
+func (w *world) NodeCreate(id string, params NodeParams) WorldWithNode {
+	w.resetLatestTrackers()
+	if id == "" {
+		w.latestErr = errors.New("id cannot be empty").UseCode(errors.TopolithErrorInvalid)
+		return w
+	}
+	if existing, ok := w.Nodes[id]; ok {
+		w.latestNode = &existing
+		if err := equalNodeParams(existing, params); err != nil {
+			w.latestErr = err
+		}
+		return w
+	}
+	if params.Kind == nil {
+		// A DeploymentNode is an environment at the root, and a node anywhere else, unless we are told otherwise.
+		kind := "environment"
+		if params.Parent != nil && *params.Parent != "" {
+			kind = "node"
+		}
+		params.Kind = &kind
+	}
+	node, err := nodeSet(DeploymentNode{Id: id, Items: []string{}}, params)
+	if err == nil {
+		err = w.validateNode(node)
+	}
+	if err != nil {
+		w.latestErr = err
+		return w
+	}
+	w.Nodes[id] = node
+	w.latestNode = &node
+	return w
+}
+
+func (w *world) NodeDelete(id string) World {
+	w.resetLatestTrackers()
+	if _, ok := w.Nodes[id]; !ok {
+		return w
+	}
+	if components := NodeComponents(w, id); len(components) > 0 {
+		w.latestErr = errors.
+			New("node has other nodes in it").
+			UseCode(errors.TopolithErrorConflict).
+			WithData(errors.KvPair{Key: "id", Value: id}, errors.KvPair{Key: "component", Value: components[0].Id})
+		return w
+	}
+	delete(w.Nodes, id)
+	return w
+}
+
+func (w *world) NodeFetch(id string) (DeploymentNode, bool) {
+	node, ok := w.Nodes[id]
+	return node, ok
+}
+
+func (w *world) NodeList(limit int) []DeploymentNode {
+	nodes := make([]DeploymentNode, 0)
+	for _, node := range w.Nodes {
+		if limit > 0 && len(nodes) >= limit {
+			break
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func (w *world) NodeSet(id string, params NodeParams) WorldWithNode {
+	w.resetLatestTrackers()
+	node, ok := w.Nodes[id]
+	if !ok {
+		w.latestErr = nodeNotFound(id)
+		return w
+	}
+	node, err := nodeSet(node, params)
+	if err == nil {
+		err = w.validateNode(node)
+	}
+	if err != nil {
+		w.latestErr = err
+		return w
+	}
+	w.Nodes[id] = node
+	w.latestNode = &node
+	return w
+}
+
+func (w *world) Deploy(itemId, nodeId string) World {
+	w.resetLatestTrackers()
+	node, ok := w.Nodes[nodeId]
+	if !ok {
+		w.latestErr = nodeNotFound(nodeId)
+		return w
+	}
+	if _, ok := w.Items[itemId]; !ok {
+		w.latestErr = errors.New("item not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: itemId}).WithSuggestions(itemId, SuggestIds(w, itemId, SuggestionLimit)...)
+		return w
+	}
+	if slices.Contains(node.Items, itemId) {
+		w.latestErr = errors.New("item already deployed to node").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "id", Value: itemId}, errors.KvPair{Key: "node", Value: nodeId})
+		return w
+	}
+	node.Items = append(slices.Clone(node.Items), itemId)
+	slices.Sort(node.Items)
+	w.Nodes[nodeId] = node
+	w.latestNode = &node
+	return w
+}
+
+func (w *world) Undeploy(itemId, nodeId string) World {
+	w.resetLatestTrackers()
+	node, ok := w.Nodes[nodeId]
+	if !ok {
+		w.latestErr = nodeNotFound(nodeId)
+		return w
+	}
+	i := slices.Index(node.Items, itemId)
+	if i < 0 {
+		w.latestErr = errors.New("item not deployed to node").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: itemId}, errors.KvPair{Key: "node", Value: nodeId})
+		return w
+	}
+	node.Items = slices.Delete(slices.Clone(node.Items), i, i+1)
+	w.Nodes[nodeId] = node
+	w.latestNode = &node
+	return w
+}
+
+func (w *world) Node() (DeploymentNode, error) {
+	if w.latestNode == nil {
+		return DeploymentNode{}, w.latestErr
+	}
+	return *w.latestNode, w.latestErr
+}
+
+// undeployItem removes the Item with the given ID from every DeploymentNode, such as when it is deleted.
+func (w *world) undeployItem(itemId string) {
+	for id, node := range w.Nodes {
+		if i := slices.Index(node.Items, itemId); i >= 0 {
+			node.Items = slices.Delete(slices.Clone(node.Items), i, i+1)
+			w.Nodes[id] = node
+		}
+	}
+}
+
+// validateNode checks that the DeploymentNode fits in the hierarchy: environments are roots, anything else is in an existing DeploymentNode, and there are no cycles.
+func (w *world) validateNode(node DeploymentNode) error {
+	invalid := func(message string) error {
+		return errors.New(message).UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "id", Value: node.Id}, errors.KvPair{Key: "parent", Value: node.Parent})
+	}
+	if node.Kind == Environment {
+		if node.Parent != "" {
+			return invalid("an environment cannot be in another node")
+		}
+		return nil
+	}
+	if node.Parent == "" {
+		return invalid("a cluster or node must be in an environment")
+	}
+	for parentId := node.Parent; parentId != ""; {
+		if parentId == node.Id {
+			return invalid("a node cannot be in itself")
+		}
+		parent, ok := w.Nodes[parentId]
+		if !ok {
+			return nodeNotFound(parentId)
+		}
+		parentId = parent.Parent
+	}
+	return nil
+}
+
+func nodeSet(node DeploymentNode, params NodeParams) (DeploymentNode, error) {
+	if params.Kind != nil {
+		kind, err := deploymentKindFromParam(*params.Kind)
+		if err != nil {
+			return node, err
+		}
+		node.Kind = kind
+	}
+	if params.Name != nil {
+		node.Name = *params.Name
+	}
+	if params.Mechanism != nil {
+		node.Mechanism = *params.Mechanism
+	}
+	if params.Parent != nil {
+		node.Parent = *params.Parent
+	}
+	return node, nil
+}
+
+// deploymentKindFromParam returns the DeploymentKind for a NodeParams.Kind value.
+// It accepts the grammar name (ex: "cluster") or the integer representation.
+func deploymentKindFromParam(s string) (DeploymentKind, error) {
+	kind := DeploymentKindFromString(s)
+	if kind == 0 {
+		if i, err := strconv.Atoi(s); err == nil && StringFromDeploymentKind(DeploymentKind(i)) != "" {
+			kind = DeploymentKind(i)
+		}
+	}
+	if kind == 0 {
+		return 0, errors.New("invalid node kind").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "kind", Value: s})
+	}
+	return kind, nil
+}
+
+func equalNodeParams(existing DeploymentNode, params NodeParams) error {
+	if (params.Kind != nil && DeploymentKindFromString(*params.Kind) != existing.Kind) ||
+		(params.Name != nil && *params.Name != existing.Name) ||
+		(params.Mechanism != nil && *params.Mechanism != existing.Mechanism) ||
+		(params.Parent != nil && *params.Parent != existing.Parent) {
+		return errors.
+			New("parameter mismatch").
+			UseCode(errors.TopolithErrorConflict).
+			WithData(errors.KvPair{Key: "object", Value: "Node"}, errors.KvPair{Key: "existing", Value: existing.String()})
+	}
+	return nil
+}
+
+func nodeNotFound(id string) error {
+	return errors.New("node not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: id})
+}
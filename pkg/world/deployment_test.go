@@ -0,0 +1,143 @@
+package world
+
+import (
+	"testing"
+)
+
+func deploymentWorld(t *testing.T) World {
+	w := CreateWorld("deployment-world")
+	w.ItemCreate("api", ItemParams{})
+	w.ItemCreate("db", ItemParams{})
+	for _, c := range []struct {
+		Id     string
+		Params NodeParams
+	}{
+		{"prod", NodeParams{Name: strPtr("Production")}},
+		{"staging", NodeParams{Kind: strPtr("environment")}},
+		{"prod-eu", NodeParams{Kind: strPtr("cluster"), Mechanism: strPtr("EKS"), Parent: strPtr("prod")}},
+		{"prod-eu-1", NodeParams{Parent: strPtr("prod-eu")}},
+		{"prod-us-1", NodeParams{Parent: strPtr("prod")}},
+		{"staging-1", NodeParams{Parent: strPtr("staging")}},
+	} {
+		if _, err := w.NodeCreate(c.Id, c.Params).Node(); err != nil {
+			t.Fatalf("error creating node %s: %v", c.Id, err)
+		}
+	}
+	for _, d := range []Deployment{{"api", "prod-eu-1"}, {"api", "prod-us-1"}, {"api", "staging-1"}, {"db", "prod-eu"}} {
+		if err := w.Deploy(d.ItemId, d.NodeId).Err(); err != nil {
+			t.Fatalf("error deploying %s: %v", d, err)
+		}
+	}
+	return w
+}
+
+func TestNodeHierarchy(t *testing.T) {
+	w := deploymentWorld(t)
+
+	for _, c := range []struct {
+		Id          string
+		Kind        DeploymentKind
+		Environment string
+	}{
+		{"prod", Environment, "prod"},
+		{"prod-eu", Cluster, "prod"},
+		{"prod-eu-1", Node, "prod"},
+		{"staging-1", Node, "staging"},
+	} {
+		t.Run(c.Id, func(t *testing.T) {
+			node, ok := w.NodeFetch(c.Id)
+			if !ok {
+				t.Fatalf("expected node %s", c.Id)
+			}
+			if node.Kind != c.Kind {
+				t.Errorf("expected kind %s, got %s", StringFromDeploymentKind(c.Kind), StringFromDeploymentKind(node.Kind))
+			}
+			if env := NodeEnvironment(w, c.Id); env != c.Environment {
+				t.Errorf("expected environment %s, got %s", c.Environment, env)
+			}
+		})
+	}
+
+	for _, c := range []struct {
+		Name   string
+		Id     string
+		Params NodeParams
+	}{
+		{"environment in a node", "qa", NodeParams{Kind: strPtr("environment"), Parent: strPtr("prod")}},
+		{"cluster at the root", "loose", NodeParams{Kind: strPtr("cluster")}},
+		{"missing parent", "lost", NodeParams{Parent: strPtr("nowhere")}},
+		{"unknown kind", "odd", NodeParams{Kind: strPtr("rack"), Parent: strPtr("prod")}},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			if _, err := w.NodeCreate(c.Id, c.Params).Node(); err == nil {
+				t.Errorf("expected an error")
+			}
+			if _, ok := w.NodeFetch(c.Id); ok {
+				t.Errorf("expected no node %s", c.Id)
+			}
+		})
+	}
+	if err := w.NodeSet("prod-eu", NodeParams{Parent: strPtr("prod-eu-1")}).Err(); err == nil {
+		t.Errorf("expected an error moving a node into itself")
+	}
+	if err := w.NodeDelete("prod-eu").Err(); err == nil {
+		t.Errorf("expected an error deleting a node with nodes in it")
+	}
+}
+
+func TestDeployments(t *testing.T) {
+	w := deploymentWorld(t)
+
+	for _, c := range []struct {
+		In    string
+		Nodes []string
+	}{
+		{"", []string{"prod-eu-1", "prod-us-1", "staging-1"}},
+		{"prod", []string{"prod-eu-1", "prod-us-1"}},
+		{"prod-eu", []string{"prod-eu-1"}},
+		{"staging", []string{"staging-1"}},
+		{"missing", []string{}},
+	} {
+		t.Run("in "+c.In, func(t *testing.T) {
+			nodes := Deployed(w, "api", c.In)
+			if len(nodes) != len(c.Nodes) {
+				t.Fatalf("expected nodes %v, got %v", c.Nodes, nodes)
+			}
+			for i, node := range nodes {
+				if node.Id != c.Nodes[i] {
+					t.Fatalf("expected nodes %v, got %v", c.Nodes, nodes)
+				}
+			}
+		})
+	}
+
+	if err := w.Deploy("api", "prod-eu-1").Err(); err == nil {
+		t.Errorf("expected an error deploying twice")
+	}
+	if err := w.Deploy("missing", "prod-eu-1").Err(); err == nil {
+		t.Errorf("expected an error deploying a missing item")
+	}
+	if err := w.Undeploy("db", "prod-eu-1").Err(); err == nil {
+		t.Errorf("expected an error undeploying what isn't deployed")
+	}
+
+	before, err := Clone(w)
+	if err != nil {
+		t.Fatalf("error cloning world: %v", err)
+	}
+	if !WorldEqual(before, w) {
+		t.Fatalf("expected the clone to keep the deployment, got:\n%s", before)
+	}
+	w.ItemDelete("api")
+	w.NodeDelete("staging-1")
+	changes := Diff(before, w)
+	if len(changes.NodesRemoved) != 1 || changes.NodesRemoved[0].Id != "staging-1" {
+		t.Errorf("expected staging-1 removed, got %v", changes.NodesRemoved)
+	}
+	if len(changes.DeploymentsRemoved) != 3 || len(changes.DeploymentsCreated) != 0 {
+		t.Errorf("expected the api deployments removed, got %v", changes.DeploymentsRemoved)
+	}
+	if deployed := AllDeployments(w); len(deployed) != 1 || deployed[0] != (Deployment{"db", "prod-eu"}) {
+		t.Errorf("expected only db deployed, got %v", deployed)
+	}
+}
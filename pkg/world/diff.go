@@ -0,0 +1,238 @@
+package world
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// ChangeSet is the difference between two states of a World.
+// Items, Rel, DeploymentNode, Scenario, View and Style are sorted by ID, Deployment by DeploymentNode ID, then Item ID, and Pin by View, then the IDs they pin.
+type ChangeSet struct {
+	WorldChanged       []WorldChange    // WorldChanged are the attributes of the World itself with a different value after, as they are after.
+	ItemsCreated       []Item           // ItemsCreated are the Items that only exist after.
+	ItemsRemoved       []Item           // ItemsRemoved are the Items that only existed before.
+	ItemsChanged       []Item           // ItemsChanged are the Items with different attributes after, as they are after.
+	ItemsMoved         []Move           // ItemsMoved are the Items that exist before and after, with a different parent after.
+	RelsCreated        []Rel            // RelsCreated are the Rel that only exist after.
+	RelsRemoved        []Rel            // RelsRemoved are the Rel that only existed before, including those dropped with their Items.
+	RelsChanged        []Rel            // RelsChanged are the Rel with different attributes after, as they are after.
+	NodesCreated       []DeploymentNode // NodesCreated are the DeploymentNode that only exist after.
+	NodesRemoved       []DeploymentNode // NodesRemoved are the DeploymentNode that only existed before.
+	NodesChanged       []DeploymentNode // NodesChanged are the DeploymentNode with different attributes after, as they are after.
+	DeploymentsCreated []Deployment     // DeploymentsCreated are the Deployment that only exist after.
+	DeploymentsRemoved []Deployment     // DeploymentsRemoved are the Deployment that only existed before, including those dropped with their Items or DeploymentNode.
+	ScenariosCreated   []Scenario       // ScenariosCreated are the Scenario that only exist after.
+	ScenariosRemoved   []Scenario       // ScenariosRemoved are the Scenario that only existed before.
+	ScenariosChanged   []Scenario       // ScenariosChanged are the Scenario with a different name or steps after, as they are after.
+	ViewsCreated       []View           // ViewsCreated are the View that only exist after.
+	ViewsRemoved       []View           // ViewsRemoved are the View that only existed before.
+	ViewsChanged       []View           // ViewsChanged are the View with different attributes after, as they are after.
+	PinsCreated        []Pin            // PinsCreated are the Pin that only exist after.
+	PinsRemoved        []Pin            // PinsRemoved are the Pin that only existed before, including those dropped with their Items, Rel or View.
+	PinsChanged        []Pin            // PinsChanged are the Pin with a different position, size or waypoints after, as they are after.
+	StylesCreated      []Style          // StylesCreated are the Style that only exist after.
+	StylesRemoved      []Style          // StylesRemoved are the Style that only existed before.
+	StylesChanged      []Style          // StylesChanged are the Style with different attributes after, as they are after.
+}
+
+// WorldChange is an attribute of the World itself, such as its name, with a different value after.
+type WorldChange struct {
+	Name  string // Name is the attribute, as `world set` names it (ex: `name`, `theme`).
+	Value string // Value is the value after.
+}
+
+// Move is a change of parent for an Item in the Tree.
+type Move struct {
+	Id   string
+	From string // From is the ID of the parent before, or empty for the Tree root.
+	To   string // To is the ID of the parent after, or empty for the Tree root.
+}
+
+// Empty indicates whether nothing changed.
+func (c ChangeSet) Empty() bool {
+	return len(c.WorldChanged)+len(c.ItemsCreated)+len(c.ItemsRemoved)+len(c.ItemsChanged)+len(c.ItemsMoved)+len(c.RelsCreated)+len(c.RelsRemoved)+len(c.RelsChanged)+
+		len(c.NodesCreated)+len(c.NodesRemoved)+len(c.NodesChanged)+len(c.DeploymentsCreated)+len(c.DeploymentsRemoved)+
+		len(c.ScenariosCreated)+len(c.ScenariosRemoved)+len(c.ScenariosChanged)+len(c.ViewsCreated)+len(c.ViewsRemoved)+len(c.ViewsChanged)+
+		len(c.PinsCreated)+len(c.PinsRemoved)+len(c.PinsChanged)+len(c.StylesCreated)+len(c.StylesRemoved)+len(c.StylesChanged) == 0
+}
+
+// Clone returns a copy of the World, which shares no state with it.
+func Clone(w World) (World, error) {
+	return FromString(w.String())
+}
+
+// Diff returns the ChangeSet from the before World to the after World.
+func Diff(before, after World) ChangeSet {
+	c := ChangeSet{
+		WorldChanged:       make([]WorldChange, 0),
+		ItemsCreated:       make([]Item, 0),
+		ItemsRemoved:       make([]Item, 0),
+		ItemsChanged:       make([]Item, 0),
+		ItemsMoved:         make([]Move, 0),
+		RelsCreated:        make([]Rel, 0),
+		RelsRemoved:        make([]Rel, 0),
+		RelsChanged:        make([]Rel, 0),
+		NodesCreated:       make([]DeploymentNode, 0),
+		NodesRemoved:       make([]DeploymentNode, 0),
+		NodesChanged:       make([]DeploymentNode, 0),
+		DeploymentsCreated: make([]Deployment, 0),
+		DeploymentsRemoved: make([]Deployment, 0),
+		ScenariosCreated:   make([]Scenario, 0),
+		ScenariosRemoved:   make([]Scenario, 0),
+		ScenariosChanged:   make([]Scenario, 0),
+		ViewsCreated:       make([]View, 0),
+		ViewsRemoved:       make([]View, 0),
+		ViewsChanged:       make([]View, 0),
+		PinsCreated:        make([]Pin, 0),
+		PinsRemoved:        make([]Pin, 0),
+		PinsChanged:        make([]Pin, 0),
+		StylesCreated:      make([]Style, 0),
+		StylesRemoved:      make([]Style, 0),
+		StylesChanged:      make([]Style, 0),
+	}
+
+	for _, attribute := range []struct{ name, before, after string }{
+		{"name", before.Name(), after.Name()},
+		{"expanded", before.Expanded(), after.Expanded()},
+		{"theme", before.Theme(), after.Theme()},
+		{"id", before.Id(), after.Id()},
+		{"version", strconv.Itoa(before.Version()), strconv.Itoa(after.Version())},
+	} {
+		if attribute.before != attribute.after {
+			c.WorldChanged = append(c.WorldChanged, WorldChange{Name: attribute.name, Value: attribute.after})
+		}
+	}
+
+	for _, item := range AllItems(after) {
+		old, ok := before.ItemFetch(item.Id)
+		if !ok {
+			c.ItemsCreated = append(c.ItemsCreated, item)
+			continue
+		}
+		if !ItemEqual(old, item) {
+			c.ItemsChanged = append(c.ItemsChanged, item)
+		}
+		from, _ := before.Parent(item.Id)
+		to, _ := after.Parent(item.Id)
+		if from != to {
+			c.ItemsMoved = append(c.ItemsMoved, Move{Id: item.Id, From: from, To: to})
+		}
+	}
+	for _, item := range AllItems(before) {
+		if _, ok := after.ItemFetch(item.Id); !ok {
+			c.ItemsRemoved = append(c.ItemsRemoved, item)
+		}
+	}
+
+	for _, rel := range AllRels(after) {
+		old := before.RelFetch(rel.From.Id, rel.To.Id, true)
+		if len(old) == 0 {
+			c.RelsCreated = append(c.RelsCreated, rel)
+			continue
+		}
+		// The Items of a Rel are compared above, so only compare its own attributes.
+		if old[0].Verb != rel.Verb || old[0].Mechanism != rel.Mechanism || old[0].Async != rel.Async || old[0].Expanded != rel.Expanded || old[0].Status != rel.Status || !slices.Equal(old[0].Links, rel.Links) || old[0].Classification != rel.Classification {
+			c.RelsChanged = append(c.RelsChanged, rel)
+		}
+	}
+	for _, rel := range AllRels(before) {
+		if len(after.RelFetch(rel.From.Id, rel.To.Id, true)) == 0 {
+			c.RelsRemoved = append(c.RelsRemoved, rel)
+		}
+	}
+
+	for _, node := range sortedNodes(after.NodeList(0)) {
+		old, ok := before.NodeFetch(node.Id)
+		if !ok {
+			c.NodesCreated = append(c.NodesCreated, node)
+			continue
+		}
+		// What is deployed to a DeploymentNode is compared below, so only compare its own attributes.
+		old.Items = node.Items
+		if !NodeEqual(old, node) {
+			c.NodesChanged = append(c.NodesChanged, node)
+		}
+	}
+	for _, node := range sortedNodes(before.NodeList(0)) {
+		if _, ok := after.NodeFetch(node.Id); !ok {
+			c.NodesRemoved = append(c.NodesRemoved, node)
+		}
+	}
+	beforeDeployments, afterDeployments := AllDeployments(before), AllDeployments(after)
+	for _, d := range afterDeployments {
+		if !slices.Contains(beforeDeployments, d) {
+			c.DeploymentsCreated = append(c.DeploymentsCreated, d)
+		}
+	}
+	for _, d := range beforeDeployments {
+		if !slices.Contains(afterDeployments, d) {
+			c.DeploymentsRemoved = append(c.DeploymentsRemoved, d)
+		}
+	}
+
+	for _, scenario := range sortedScenarios(after.ScenarioList(0)) {
+		old, ok := before.ScenarioFetch(scenario.Id)
+		if !ok {
+			c.ScenariosCreated = append(c.ScenariosCreated, scenario)
+		} else if !ScenarioEqual(old, scenario) {
+			c.ScenariosChanged = append(c.ScenariosChanged, scenario)
+		}
+	}
+	for _, scenario := range sortedScenarios(before.ScenarioList(0)) {
+		if _, ok := after.ScenarioFetch(scenario.Id); !ok {
+			c.ScenariosRemoved = append(c.ScenariosRemoved, scenario)
+		}
+	}
+
+	for _, view := range sortedViews(after.ViewList(0)) {
+		old, ok := before.ViewFetch(view.Id)
+		if !ok {
+			c.ViewsCreated = append(c.ViewsCreated, view)
+		} else if !ViewEqual(old, view) {
+			c.ViewsChanged = append(c.ViewsChanged, view)
+		}
+	}
+	for _, view := range sortedViews(before.ViewList(0)) {
+		if _, ok := after.ViewFetch(view.Id); !ok {
+			c.ViewsRemoved = append(c.ViewsRemoved, view)
+		}
+	}
+
+	for _, pin := range sortedPins(after.PinList(0)) {
+		old, ok := before.PinFetch(pin.View, pin.Id, pin.To)
+		if !ok {
+			c.PinsCreated = append(c.PinsCreated, pin)
+		} else if !PinEqual(old, pin) {
+			c.PinsChanged = append(c.PinsChanged, pin)
+		}
+	}
+	for _, pin := range sortedPins(before.PinList(0)) {
+		if _, ok := after.PinFetch(pin.View, pin.Id, pin.To); !ok {
+			c.PinsRemoved = append(c.PinsRemoved, pin)
+		}
+	}
+
+	for _, style := range sortedStyles(after.StyleList(0)) {
+		old, ok := before.StyleFetch(style.Id)
+		if !ok {
+			c.StylesCreated = append(c.StylesCreated, style)
+		} else if !StyleEqual(old, style) {
+			c.StylesChanged = append(c.StylesChanged, style)
+		}
+	}
+	for _, style := range sortedStyles(before.StyleList(0)) {
+		if _, ok := after.StyleFetch(style.Id); !ok {
+			c.StylesRemoved = append(c.StylesRemoved, style)
+		}
+	}
+
+	for _, items := range [][]Item{c.ItemsCreated, c.ItemsRemoved, c.ItemsChanged} {
+		sort.Slice(items, func(i, j int) bool { return items[i].Id < items[j].Id })
+	}
+	sort.Slice(c.ItemsMoved, func(i, j int) bool { return c.ItemsMoved[i].Id < c.ItemsMoved[j].Id })
+	for _, rels := range [][]Rel{c.RelsCreated, c.RelsRemoved, c.RelsChanged} {
+		sort.Slice(rels, func(i, j int) bool { return rels[i].id() < rels[j].id() })
+	}
+	return c
+}
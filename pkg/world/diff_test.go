@@ -0,0 +1,48 @@
+package world
+
+import (
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	before := CreateWorld("diff-world")
+	before.ItemCreate("svc", ItemParams{}).ItemCreate("api", ItemParams{}).ItemCreate("db", ItemParams{}).ItemCreate("old", ItemParams{})
+	before.Nest("api", "svc")
+	before.RelCreate("api", "db", RelParams{Verb: strPtr("reads")})
+	before.RelCreate("old", "db", RelParams{})
+
+	after, err := Clone(before)
+	if err != nil {
+		t.Fatalf("error cloning world: %v", err)
+	}
+	if c := Diff(before, after); !c.Empty() {
+		t.Fatalf("expected no changes for a clone, got %+v", c)
+	}
+
+	after.ItemCreate("cache", ItemParams{})
+	after.ItemSet("db", ItemParams{Mechanism: strPtr("Postgres")})
+	after.ItemDelete("old")
+	after.Free("api")
+	after.RelSet("api", "db", RelParams{Verb: strPtr("writes")})
+	after.RelCreate("api", "cache", RelParams{})
+
+	c := Diff(before, after)
+	if len(c.ItemsCreated) != 1 || c.ItemsCreated[0].Id != "cache" {
+		t.Fatalf("expected cache created, got %v", c.ItemsCreated)
+	}
+	if len(c.ItemsRemoved) != 1 || c.ItemsRemoved[0].Id != "old" {
+		t.Fatalf("expected old removed, got %v", c.ItemsRemoved)
+	}
+	if len(c.ItemsChanged) != 1 || c.ItemsChanged[0].Mechanism != "Postgres" {
+		t.Fatalf("expected db changed, got %v", c.ItemsChanged)
+	}
+	if len(c.ItemsMoved) != 1 || c.ItemsMoved[0] != (Move{Id: "api", From: "svc", To: ""}) {
+		t.Fatalf("expected api moved to root, got %v", c.ItemsMoved)
+	}
+	if len(c.RelsCreated) != 1 || len(c.RelsRemoved) != 1 || len(c.RelsChanged) != 1 {
+		t.Fatalf("expected one Rel created, removed and changed, got %v, %v, %v", c.RelsCreated, c.RelsRemoved, c.RelsChanged)
+	}
+	if _, ok := before.ItemFetch("old"); !ok {
+		t.Fatalf("expected changes to the clone to leave the world alone")
+	}
+}
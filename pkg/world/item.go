@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/williamflynt/topolith/pkg/errors"
 	"github.com/williamflynt/topolith/pkg/grammar"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -73,14 +75,44 @@ func StringFromItemType(t ItemType) string {
 	}
 }
 
+// itemTypeFromParam returns the ItemType for an ItemParams.Type value.
+// It accepts the grammar name (ex: "database"), the integer representation, or an empty string to clear the type.
+func itemTypeFromParam(s string) (ItemType, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if t := ItemTypeFromString(s); t != 0 {
+		return t, nil
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.New("invalid Item type").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "type", Value: s})
+	}
+	return ItemType(i), nil
+}
+
+func equalItemType(t ItemType, param string) bool {
+	paramType, err := itemTypeFromParam(param)
+	return err == nil && paramType == t
+}
+
 // Item is a struct that represents a single entity in the world. If using the C4 diagrams methodology, an Item can be any of Person, Software System, Container, Component, or Code element.
 type Item struct {
-	Id        string   `json:"id"`        // Id is the unique identifier of the Item. Often the same as Name.
-	External  bool     `json:"external"`  // External is a boolean that represents whether the Item is external - a C4 diagrams concept.
-	Type      ItemType `json:"type"`      // Type is the type of the Item.
-	Name      string   `json:"name"`      // Name is the display name of the Item; meant for humans.
-	Mechanism string   `json:"mechanism"` // Mechanism is the method of implementation of the Item. This may not always be relevant for a strict C4 diagram.
-	Expanded  string   `json:"expanded"`  // Expanded is the expanded description of the Item. This may not always be relevant for a strict C4 diagram.
+	Id             string   `json:"id"`             // Id is the unique identifier of the Item. Often the same as Name.
+	External       bool     `json:"external"`       // External is a boolean that represents whether the Item is external - a C4 diagrams concept.
+	Type           ItemType `json:"type"`           // Type is the type of the Item.
+	Name           string   `json:"name"`           // Name is the display name of the Item; meant for humans.
+	Mechanism      string   `json:"mechanism"`      // Mechanism is the method of implementation of the Item. This may not always be relevant for a strict C4 diagram.
+	Expanded       string   `json:"expanded"`       // Expanded is the expanded description of the Item. This may not always be relevant for a strict C4 diagram.
+	Status         Status   `json:"status"`         // Status is the lifecycle of the Item, for planning migrations. An unset Status is Active.
+	Archived       bool     `json:"archived"`       // Archived is a boolean that represents whether the Item is decommissioned. It and its components are hidden, but kept in the World.
+	Owner          string   `json:"owner"`          // Owner is the team that owns the Item. Components without an Owner inherit it through the Tree.
+	Contacts       string   `json:"contacts"`       // Contacts are the people or channels to reach the Owner (ex: `@acme/payments,alice@acme.com`).
+	Source         string   `json:"source"`         // Source is the path to the code of the Item in its repository, for mapping Code Items to CODEOWNERS.
+	Links          []Link   `json:"links"`          // Links are to the runbooks, dashboards, etc. for the Item, sorted by kind and target.
+	Classification string   `json:"classification"` // Classification are the comma-separated classes of sensitive data that the Item stores (ex: `pii,pci`).
+	Boundary       string   `json:"boundary"`       // Boundary is the trust boundary of the Item (ex: `dmz`). Components without a Boundary inherit it through the Tree.
+	Tags           string   `json:"tags"`           // Tags are comma-separated labels of the Item, for filtering views (ex: `edge,pci`).
 }
 
 func (i Item) String() string {
@@ -101,6 +133,33 @@ func (i Item) String() string {
 	if i.External {
 		paramRepr = append(paramRepr, `external=true`)
 	}
+	if i.Status > 0 {
+		paramRepr = append(paramRepr, fmt.Sprintf(`status=%s`, StringFromStatus(i.Status)))
+	}
+	if i.Archived {
+		paramRepr = append(paramRepr, `archived=true`)
+	}
+	if i.Owner != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`owner="%s"`, i.Owner))
+	}
+	if i.Contacts != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`contacts="%s"`, i.Contacts))
+	}
+	if i.Source != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`source="%s"`, i.Source))
+	}
+	if len(i.Links) > 0 {
+		paramRepr = append(paramRepr, LinksString(i.Links))
+	}
+	if i.Classification != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`classification="%s"`, i.Classification))
+	}
+	if i.Boundary != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`boundary="%s"`, i.Boundary))
+	}
+	if i.Tags != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`tags="%s"`, i.Tags))
+	}
 	if len(paramRepr) > 0 {
 		item += " " + strings.Join(paramRepr, " ")
 	}
@@ -135,6 +194,33 @@ func ItemEqual(i1, i2 Item) bool {
 	if i1.Expanded != i2.Expanded {
 		return false
 	}
+	if i1.Status != i2.Status {
+		return false
+	}
+	if i1.Archived != i2.Archived {
+		return false
+	}
+	if i1.Owner != i2.Owner {
+		return false
+	}
+	if i1.Contacts != i2.Contacts {
+		return false
+	}
+	if i1.Source != i2.Source {
+		return false
+	}
+	if !slices.Equal(i1.Links, i2.Links) {
+		return false
+	}
+	if i1.Classification != i2.Classification {
+		return false
+	}
+	if i1.Boundary != i2.Boundary {
+		return false
+	}
+	if i1.Tags != i2.Tags {
+		return false
+	}
 	return true
 }
 
@@ -145,11 +231,20 @@ func (i Item) id() string {
 
 // ItemParams is a struct that represents the parameters that can be set on an Item.
 type ItemParams struct {
-	External  *bool   `json:"external"`
-	Type      *string `json:"type"`
-	Name      *string `json:"name"`
-	Mechanism *string `json:"mechanism"`
-	Expanded  *string `json:"expanded"`
+	External       *bool   `json:"external"`
+	Type           *string `json:"type"`
+	Name           *string `json:"name"`
+	Mechanism      *string `json:"mechanism"`
+	Expanded       *string `json:"expanded"`
+	Status         *string `json:"status"`
+	Archived       *bool   `json:"archived"`
+	Owner          *string `json:"owner"`
+	Contacts       *string `json:"contacts"`
+	Source         *string `json:"source"`
+	Links          *[]Link `json:"links"` // Links replace all the Link of the Item.
+	Classification *string `json:"classification"`
+	Boundary       *string `json:"boundary"`
+	Tags           *string `json:"tags"`
 }
 
 func ItemParamsFromInput(input grammar.InputAttributes) ItemParams {
@@ -169,5 +264,33 @@ func ItemParamsFromInput(input grammar.InputAttributes) ItemParams {
 	if v, ok := input.Params["expanded"]; ok {
 		params.Expanded = strPtr(v)
 	}
+	if v, ok := input.Params["status"]; ok {
+		params.Status = strPtr(v)
+	}
+	if v, ok := input.Params["archived"]; ok {
+		params.Archived = boolPtr(v == "true")
+	}
+	if v, ok := input.Params["owner"]; ok {
+		params.Owner = strPtr(v)
+	}
+	if v, ok := input.Params["contacts"]; ok {
+		params.Contacts = strPtr(v)
+	}
+	if v, ok := input.Params["source"]; ok {
+		params.Source = strPtr(v)
+	}
+	if _, ok := input.Params["links"]; ok || len(input.Links) > 0 {
+		links := LinksFromInput(input)
+		params.Links = &links
+	}
+	if v, ok := input.Params["classification"]; ok {
+		params.Classification = strPtr(v)
+	}
+	if v, ok := input.Params["boundary"]; ok {
+		params.Boundary = strPtr(v)
+	}
+	if v, ok := input.Params["tags"]; ok {
+		params.Tags = strPtr(v)
+	}
 	return params
 }
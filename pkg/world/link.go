@@ -0,0 +1,167 @@
+package world
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/grammar"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// LinkKind is an iota that represents what a Link is to.
+type LinkKind int
+
+const (
+	_ LinkKind = iota
+	Runbook
+	Dashboard
+	Repo
+	Adr
+	ApiSpec
+)
+
+func LinkKindFromString(s string) LinkKind {
+	switch s {
+	case "runbook":
+		return Runbook
+	case "dashboard":
+		return Dashboard
+	case "repo":
+		return Repo
+	case "adr":
+		return Adr
+	case "api-spec":
+		return ApiSpec
+	default:
+		return 0
+	}
+}
+
+func StringFromLinkKind(k LinkKind) string {
+	switch k {
+	case Runbook:
+		return "runbook"
+	case Dashboard:
+		return "dashboard"
+	case Repo:
+		return "repo"
+	case Adr:
+		return "adr"
+	case ApiSpec:
+		return "api-spec"
+	default:
+		return ""
+	}
+}
+
+// Link is a link from an Item or Rel to something about it outside the World, such as its runbook or dashboard.
+type Link struct {
+	Kind   LinkKind `json:"kind"`   // Kind is what the Link is to.
+	Target string   `json:"target"` // Target is an http(s) or file URL, or a local path.
+}
+
+func (l Link) String() string {
+	return fmt.Sprintf(`%s="%s"`, StringFromLinkKind(l.Kind), l.Target)
+}
+
+// LinksFromInput returns the Link in the input, in order.
+func LinksFromInput(input grammar.InputAttributes) []Link {
+	links := make([]Link, len(input.Links))
+	for i, l := range input.Links {
+		links[i] = Link{Kind: LinkKindFromString(l.Kind), Target: l.Target}
+	}
+	return links
+}
+
+// AddLinks returns the Link with the added ones. We error if one is already there.
+func AddLinks(links []Link, added []Link) ([]Link, error) {
+	result := slices.Clone(links)
+	for _, l := range added {
+		if slices.Contains(result, l) {
+			return links, errors.New("duplicate link").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "link", Value: l.String()})
+		}
+		result = append(result, l)
+	}
+	return result, nil
+}
+
+// RemoveLinks returns the Link without the removed ones. We error if one isn't there.
+func RemoveLinks(links []Link, removed []Link) ([]Link, error) {
+	result := slices.Clone(links)
+	for _, l := range removed {
+		i := slices.Index(result, l)
+		if i < 0 {
+			return links, errors.New("could not find link").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "link", Value: l.String()})
+		}
+		result = slices.Delete(result, i, i+1)
+	}
+	return result, nil
+}
+
+// LinksString returns the Link as grammar params, separated by spaces (ex: `runbook="https://wiki/pay" adr="docs/adr/1.md"`).
+func LinksString(links []Link) string {
+	repr := make([]string, len(links))
+	for i, l := range links {
+		repr[i] = l.String()
+	}
+	return strings.Join(repr, " ")
+}
+
+// linksFromParam returns a sorted copy of the Link for an ItemParams.Links or RelParams.Links value.
+// We error for an unknown kind, a malformed target, or a duplicate.
+func linksFromParam(links []Link) ([]Link, error) {
+	sorted := slices.Clone(links)
+	slices.SortFunc(sorted, func(a, b Link) int {
+		if a.Kind != b.Kind {
+			return int(a.Kind) - int(b.Kind)
+		}
+		return strings.Compare(a.Target, b.Target)
+	})
+	for i, l := range sorted {
+		if err := validateLink(l); err != nil {
+			return nil, err
+		}
+		if i > 0 && l == sorted[i-1] {
+			return nil, errors.New("duplicate link").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "link", Value: l.String()})
+		}
+	}
+	return sorted, nil
+}
+
+// equalLinks indicates whether the Link are the same as the param Link, which may not be sorted.
+func equalLinks(links []Link, param []Link) bool {
+	paramLinks, err := linksFromParam(param)
+	return err == nil && slices.Equal(links, paramLinks)
+}
+
+// validateLink checks that the Link has a known kind, and a target that is a well-formed http(s) or file URL, or a local path.
+func validateLink(l Link) error {
+	if StringFromLinkKind(l.Kind) == "" {
+		return errors.New("invalid link kind").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "kind", Value: fmt.Sprint(int(l.Kind))})
+	}
+	malformed := errors.New("malformed link").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "link", Value: l.String()})
+	if strings.TrimSpace(l.Target) == "" {
+		return malformed
+	}
+	u, err := url.Parse(l.Target)
+	if err != nil {
+		return malformed.WithError(err)
+	}
+	switch u.Scheme {
+	case "":
+		// A local path, relative or absolute.
+		return nil
+	case "http", "https":
+		if u.Host == "" {
+			return malformed
+		}
+	case "file":
+		if u.Path == "" {
+			return malformed
+		}
+	default:
+		return malformed
+	}
+	return nil
+}
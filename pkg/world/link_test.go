@@ -0,0 +1,50 @@
+package world
+
+import (
+	"testing"
+)
+
+func TestValidateLink(t *testing.T) {
+	for _, c := range []struct {
+		Link  Link
+		Valid bool
+	}{
+		{Link{Runbook, "https://wiki.acme.com/pay"}, true},
+		{Link{Dashboard, "http://grafana:3000/d/abc?from=now-1h"}, true},
+		{Link{Repo, "file:///src/payments"}, true},
+		{Link{Adr, "docs/adr/0001-use-postgres.md"}, true},
+		{Link{ApiSpec, "/srv/specs/payments.yaml"}, true},
+		{Link{Runbook, ""}, false},
+		{Link{Runbook, "https://"}, false},
+		{Link{Runbook, "https:/wiki"}, false},
+		{Link{Runbook, "ftp://files.acme.com/runbook"}, false},
+		{Link{Runbook, "javascript:alert(1)"}, false},
+		{Link{Runbook, "%zz"}, false},
+		{Link{0, "docs/runbook.md"}, false},
+	} {
+		t.Run(c.Link.String(), func(t *testing.T) {
+			if err := validateLink(c.Link); (err == nil) != c.Valid {
+				t.Errorf("expected valid=%t, got error %v", c.Valid, err)
+			}
+		})
+	}
+}
+
+func TestLinksFromParam(t *testing.T) {
+	links, err := linksFromParam([]Link{{Adr, "docs/adr/2.md"}, {Runbook, "docs/runbook.md"}, {Adr, "docs/adr/1.md"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repr := LinksString(links); repr != `runbook="docs/runbook.md" adr="docs/adr/1.md" adr="docs/adr/2.md"` {
+		t.Errorf("expected links sorted by kind and target, got %s", repr)
+	}
+	if _, err := linksFromParam([]Link{{Adr, "docs/adr/1.md"}, {Adr, "docs/adr/1.md"}}); err == nil {
+		t.Errorf("expected error for duplicate links")
+	}
+	if _, err := AddLinks(links, []Link{{Runbook, "docs/runbook.md"}}); err == nil {
+		t.Errorf("expected error adding an existing link")
+	}
+	if _, err := RemoveLinks(links, []Link{{Repo, "docs/runbook.md"}}); err == nil {
+		t.Errorf("expected error removing a missing link")
+	}
+}
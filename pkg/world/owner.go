@@ -0,0 +1,28 @@
+package world
+
+// Owners returns the Items that the Item with the given ID inherits ownership from, nearest first.
+// The Item itself comes first if it has an Owner, then each ancestor with an Owner, up to the Tree root.
+// The first is the effective owner; the rest are who to escalate to.
+func Owners(w World, id string) []Item {
+	owners := make([]Item, 0)
+	for id != "" {
+		item, ok := w.ItemFetch(id)
+		if !ok {
+			break
+		}
+		if item.Owner != "" {
+			owners = append(owners, item)
+		}
+		id, _ = w.Parent(id)
+	}
+	return owners
+}
+
+// EffectiveOwner returns the Owner of the Item with the given ID, inherited from its nearest owned ancestor if it has none.
+// An empty string means nothing above the Item in the Tree has an Owner.
+func EffectiveOwner(w World, id string) string {
+	if owners := Owners(w, id); len(owners) > 0 {
+		return owners[0].Owner
+	}
+	return ""
+}
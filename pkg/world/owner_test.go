@@ -0,0 +1,46 @@
+package world
+
+import (
+	"testing"
+)
+
+func TestOwners(t *testing.T) {
+	w := CreateWorld("owner-world")
+	w.ItemCreate("payments", ItemParams{Owner: strPtr("payments"), Contacts: strPtr("@acme/payments")})
+	w.ItemCreate("payments.api", ItemParams{})
+	w.ItemCreate("payments.api.ledger", ItemParams{Owner: strPtr("ledger")})
+	w.ItemCreate("web", ItemParams{})
+	w.Nest("payments.api", "payments")
+	w.Nest("payments.api.ledger", "payments.api")
+
+	for _, c := range []struct {
+		Id     string
+		Owners []string
+		Owner  string
+	}{
+		{"payments", []string{"payments"}, "payments"},
+		{"payments.api", []string{"payments"}, "payments"},
+		{"payments.api.ledger", []string{"payments.api.ledger", "payments"}, "ledger"},
+		{"web", []string{}, ""},
+		{"missing", []string{}, ""},
+	} {
+		t.Run(c.Id, func(t *testing.T) {
+			owners := Owners(w, c.Id)
+			ids := make([]string, len(owners))
+			for i, item := range owners {
+				ids[i] = item.Id
+			}
+			if len(ids) != len(c.Owners) {
+				t.Fatalf("expected owners %v, got %v", c.Owners, ids)
+			}
+			for i := range ids {
+				if ids[i] != c.Owners[i] {
+					t.Fatalf("expected owners %v, got %v", c.Owners, ids)
+				}
+			}
+			if owner := EffectiveOwner(w, c.Id); owner != c.Owner {
+				t.Errorf("expected effective owner %q, got %q", c.Owner, owner)
+			}
+		})
+	}
+}
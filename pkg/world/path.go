@@ -0,0 +1,56 @@
+package world
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// PathSeparator separates the local IDs in a path through the Tree (ex: `payments.api.db`).
+const PathSeparator = "."
+
+// LocalId returns the last segment of the ID, which must be unique among the components of its parent.
+// An ID without a PathSeparator is its own local ID.
+func LocalId(id string) string {
+	return id[strings.LastIndex(id, PathSeparator)+1:]
+}
+
+// SplitPath splits the path into the path of its parent and its local ID.
+// The parent path is empty if the path has no PathSeparator.
+func SplitPath(path string) (string, string) {
+	i := strings.LastIndex(path, PathSeparator)
+	if i < 0 {
+		return "", path
+	}
+	return path[:i], path[i+1:]
+}
+
+// JoinPath returns the ID for an Item with the given local ID under the parent ID.
+func JoinPath(parentId, localId string) string {
+	if parentId == "" {
+		return localId
+	}
+	return parentId + PathSeparator + localId
+}
+
+func (w *world) Resolve(path string) []string {
+	w.resetLatestTrackers()
+	if _, ok := w.Items[path]; ok {
+		return []string{path}
+	}
+	parentPath, localId := SplitPath(path)
+	if parentPath == "" || localId == "" {
+		return []string{}
+	}
+	ids := make([]string, 0)
+	for _, parentId := range w.Resolve(parentPath) {
+		components, _ := w.Components(parentId)
+		for _, id := range components {
+			if LocalId(id) == localId && !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,76 @@
+package world
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+var resolveCases = []struct {
+	Path     string
+	Expected []string
+}{
+	{"payments", []string{"payments"}},
+	{"payments.db", []string{"payments.db"}},
+	{"payments.api.handler", []string{"payments.api.handler"}},
+	{"orders.db", []string{"orders.db"}},
+	{"orders.cache", []string{"payments.cache"}},
+	{"payments.cache", []string{"payments.cache"}},
+	{"orders.db.replica", []string{"orders.db.replica"}},
+	{"payments.nope", []string{}},
+	{"nope.db", []string{}},
+	{"db", []string{}},
+}
+
+func TestResolve(t *testing.T) {
+	w := CreateWorld("path-world")
+	for _, id := range []string{"payments", "payments.db", "payments.api", "payments.api.handler", "payments.cache", "orders", "orders.db", "orders.db.replica"} {
+		w.ItemCreate(id, ItemParams{})
+	}
+	for _, nest := range [][2]string{
+		{"payments.db", "payments"}, {"payments.api", "payments"}, {"payments.api.handler", "payments.api"},
+		{"payments.cache", "orders"}, {"orders.db", "orders"}, {"orders.db.replica", "orders.db"},
+	} {
+		if err := w.Nest(nest[0], nest[1]).Err(); err != nil {
+			t.Fatalf("error nesting %s in %s: %v", nest[0], nest[1], err)
+		}
+	}
+	for i, c := range resolveCases {
+		t.Run(fmt.Sprintf("TestResolve-%d", i), func(t *testing.T) {
+			if ids := w.Resolve(c.Path); !reflect.DeepEqual(ids, c.Expected) {
+				t.Fatalf("expected %v for %q, got %v", c.Expected, c.Path, ids)
+			}
+		})
+	}
+}
+
+func TestNestLocalIdConflict(t *testing.T) {
+	w := CreateWorld("path-world")
+	for _, id := range []string{"payments", "orders", "payments.db", "orders.db"} {
+		w.ItemCreate(id, ItemParams{})
+	}
+	if err := w.Nest("payments.db", "payments").Err(); err != nil {
+		t.Fatalf("error nesting: %v", err)
+	}
+	if err := w.Nest("orders.db", "payments").Err(); err == nil {
+		t.Fatalf("expected conflict nesting a second `db` in payments")
+	}
+	if err := w.Nest("orders.db", "orders").Err(); err != nil {
+		t.Fatalf("error nesting: %v", err)
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	for _, c := range []struct{ Path, Parent, Local string }{
+		{"db", "", "db"},
+		{"payments.db", "payments", "db"},
+		{"payments.api.db", "payments.api", "db"},
+	} {
+		if parent, local := SplitPath(c.Path); parent != c.Parent || local != c.Local {
+			t.Fatalf("expected %q and %q for %q, got %q and %q", c.Parent, c.Local, c.Path, parent, local)
+		}
+		if LocalId(c.Path) != c.Local || JoinPath(c.Parent, c.Local) != c.Path {
+			t.Fatalf("expected local ID %q and path %q", c.Local, c.Path)
+		}
+	}
+}
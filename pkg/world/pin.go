@@ -0,0 +1,270 @@
+package world
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/grammar"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Pin is a manual position in the layout of a View: where an Item goes and how big it is, or where a Rel runs.
+// Renderers keep pinned Items and Rel where they are, and lay out the rest around them.
+type Pin struct {
+	View      string     `json:"view"`      // View is the ID of the View the Pin is in, or empty for the whole World.
+	Id        string     `json:"id"`        // Id is the ID of the pinned Item, or of the Item the pinned Rel is from.
+	To        string     `json:"to"`        // To is the ID of the Item the pinned Rel is to, or empty for a pinned Item.
+	X         float64    `json:"x"`         // X is the left of the pinned Item.
+	Y         float64    `json:"y"`         // Y is the top of the pinned Item.
+	Width     float64    `json:"width"`     // Width is the width of the pinned Item, or 0 to size it automatically.
+	Height    float64    `json:"height"`    // Height is the height of the pinned Item, or 0 to size it automatically.
+	Waypoints []Waypoint `json:"waypoints"` // Waypoints are the points the pinned Rel runs through, in order from its From Item.
+}
+
+// Waypoint is a point a pinned Rel runs through.
+type Waypoint struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Rel indicates whether the Pin is for a Rel, rather than an Item.
+func (p Pin) Rel() bool {
+	return p.To != ""
+}
+
+func (p Pin) String() string {
+	paramRepr := []string{fmt.Sprintf(`pin "%s"`, p.Id)}
+	if p.Rel() {
+		paramRepr = append(paramRepr, fmt.Sprintf(`"%s"`, p.To), fmt.Sprintf(`waypoints="%s"`, WaypointsString(p.Waypoints)))
+	} else {
+		paramRepr = append(paramRepr, fmt.Sprintf("x=%s", formatCoordinate(p.X)), fmt.Sprintf("y=%s", formatCoordinate(p.Y)))
+		if p.Width != 0 {
+			paramRepr = append(paramRepr, fmt.Sprintf("width=%s", formatCoordinate(p.Width)))
+		}
+		if p.Height != 0 {
+			paramRepr = append(paramRepr, fmt.Sprintf("height=%s", formatCoordinate(p.Height)))
+		}
+	}
+	if p.View != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`view="%s"`, p.View))
+	}
+	return strings.Join(paramRepr, " ")
+}
+
+func PinEqual(p1, p2 Pin) bool {
+	return p1.View == p2.View && p1.Id == p2.Id && p1.To == p2.To && p1.X == p2.X && p1.Y == p2.Y && p1.Width == p2.Width && p1.Height == p2.Height && slices.Equal(p1.Waypoints, p2.Waypoints)
+}
+
+// PinParams is a struct that represents the parameters that can be set on a Pin.
+type PinParams struct {
+	X         *string `json:"x"`
+	Y         *string `json:"y"`
+	Width     *string `json:"width"`
+	Height    *string `json:"height"`
+	Waypoints *string `json:"waypoints"` // Waypoints are space-separated `x,y` pairs (ex: `120,40 200,80`).
+}
+
+func PinParamsFromInput(input grammar.InputAttributes) PinParams {
+	params := PinParams{}
+	if v, ok := input.Params["x"]; ok {
+		params.X = strPtr(v)
+	}
+	if v, ok := input.Params["y"]; ok {
+		params.Y = strPtr(v)
+	}
+	if v, ok := input.Params["width"]; ok {
+		params.Width = strPtr(v)
+	}
+	if v, ok := input.Params["height"]; ok {
+		params.Height = strPtr(v)
+	}
+	if v, ok := input.Params["waypoints"]; ok {
+		params.Waypoints = strPtr(v)
+	}
+	return params
+}
+
+// PinTargetFromInput returns the ID of the View, the Item, and the Item at the other end of a Rel, from the input of a pin or unpin command.
+func PinTargetFromInput(input grammar.InputAttributes) (string, string, string) {
+	toId := ""
+	if len(input.SecondaryIds) > 0 {
+		toId = input.SecondaryIds[0]
+	}
+	return input.Params["layout-view"], input.ResourceId, toId
+}
+
+// ViewPins returns the Pin in the View with the given ID, or in the whole World for an empty ID, sorted by the IDs they pin.
+func ViewPins(w World, view string) []Pin {
+	pins := slices.DeleteFunc(w.PinList(0), func(p Pin) bool { return p.View != view })
+	return sortedPins(pins)
+}
+
+// WaypointsString returns the Waypoints as space-separated `x,y` pairs.
+func WaypointsString(waypoints []Waypoint) string {
+	pairs := make([]string, len(waypoints))
+	for i, p := range waypoints {
+		pairs[i] = formatCoordinate(p.X) + "," + formatCoordinate(p.Y)
+	}
+	return strings.Join(pairs, " ")
+}
+
+// sortedPins returns the Pin sorted by View, then the IDs they pin.
+func sortedPins(pins []Pin) []Pin {
+	slices.SortFunc(pins, func(a, b Pin) int { return strings.Compare(pinKey(a.View, a.Id, a.To), pinKey(b.View, b.Id, b.To)) })
+	return pins
+}
+
+// --- WORLD OPERATIONS ---
+
+func (w *world) PinSet(view, id, toId string, params PinParams) WorldWithPin {
+	w.resetLatestTrackers()
+	if view != "" {
+		if _, ok := w.Views[view]; !ok {
+			w.latestErr = errors.New("view not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: view})
+			return w
+		}
+	}
+	if id == "" {
+		w.latestErr = errors.New("id cannot be empty").UseCode(errors.TopolithErrorInvalid)
+		return w
+	}
+	for _, itemId := range []string{id, toId} {
+		if _, ok := w.Items[itemId]; itemId != "" && !ok {
+			w.latestErr = errors.New("item not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: itemId}).WithSuggestions(itemId, SuggestIds(w, itemId, SuggestionLimit)...)
+			return w
+		}
+	}
+	key := pinKey(view, id, toId)
+	pin, existed := w.Pins[key]
+	if !existed {
+		pin = Pin{View: view, Id: id, To: toId}
+	}
+	pin, err := pinSet(pin, params)
+	if err != nil {
+		w.latestErr = err
+		return w
+	}
+	if !existed && !pin.Rel() && (params.X == nil || params.Y == nil) {
+		w.latestErr = errors.New("a pinned item needs x and y").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "id", Value: id})
+		return w
+	}
+	if pin.Rel() && len(pin.Waypoints) == 0 {
+		w.latestErr = errors.New("a pinned rel needs waypoints").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "from", Value: id}, errors.KvPair{Key: "to", Value: toId})
+		return w
+	}
+	w.Pins[key] = pin
+	w.latestPin = &pin
+	return w
+}
+
+func (w *world) PinDelete(view, id, toId string) World {
+	w.resetLatestTrackers()
+	delete(w.Pins, pinKey(view, id, toId))
+	return w
+}
+
+func (w *world) PinFetch(view, id, toId string) (Pin, bool) {
+	pin, ok := w.Pins[pinKey(view, id, toId)]
+	return pin, ok
+}
+
+func (w *world) PinList(limit int) []Pin {
+	pins := make([]Pin, 0)
+	for _, pin := range w.Pins {
+		if limit > 0 && len(pins) >= limit {
+			break
+		}
+		pins = append(pins, pin)
+	}
+	return pins
+}
+
+func (w *world) Pin() (Pin, error) {
+	if w.latestPin == nil {
+		return Pin{}, w.latestErr
+	}
+	return *w.latestPin, w.latestErr
+}
+
+// dropBrokenPins removes the Pin for Items that no longer exist, and for Rel between them.
+func (w *world) dropBrokenPins() {
+	for key, pin := range w.Pins {
+		_, from := w.Items[pin.Id]
+		_, to := w.Items[pin.To]
+		if !from || (pin.Rel() && !to) {
+			delete(w.Pins, key)
+		}
+	}
+}
+
+// dropPins removes the Pin that match.
+func (w *world) dropPins(match func(Pin) bool) {
+	for key, pin := range w.Pins {
+		if match(pin) {
+			delete(w.Pins, key)
+		}
+	}
+}
+
+// pinKey returns the key of a Pin in the World: no two Pin in a View are for the same Item or Rel.
+func pinKey(view, id, toId string) string {
+	return view + "\x00" + id + "\x00" + toId
+}
+
+func pinSet(pin Pin, params PinParams) (Pin, error) {
+	if pin.Rel() && (params.X != nil || params.Y != nil || params.Width != nil || params.Height != nil) {
+		return pin, errors.New("a pinned rel only has waypoints").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "from", Value: pin.Id}, errors.KvPair{Key: "to", Value: pin.To})
+	}
+	if !pin.Rel() && params.Waypoints != nil {
+		return pin, errors.New("a pinned item has no waypoints").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "id", Value: pin.Id})
+	}
+	for _, c := range []struct {
+		key   string
+		value *string
+		field *float64
+		size  bool
+	}{
+		{"x", params.X, &pin.X, false},
+		{"y", params.Y, &pin.Y, false},
+		{"width", params.Width, &pin.Width, true},
+		{"height", params.Height, &pin.Height, true},
+	} {
+		if c.value == nil {
+			continue
+		}
+		v, err := strconv.ParseFloat(*c.value, 64)
+		if err != nil || (c.size && v < 0) {
+			return pin, errors.New("invalid " + c.key).UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: c.key, Value: *c.value})
+		}
+		*c.field = v
+	}
+	if params.Waypoints != nil {
+		waypoints, err := parseWaypoints(*params.Waypoints)
+		if err != nil {
+			return pin, err
+		}
+		pin.Waypoints = waypoints
+	}
+	return pin, nil
+}
+
+// parseWaypoints returns the Waypoints from space-separated `x,y` pairs.
+func parseWaypoints(s string) ([]Waypoint, error) {
+	waypoints := make([]Waypoint, 0)
+	for _, pair := range strings.Fields(s) {
+		xs, ys, ok := strings.Cut(pair, ",")
+		x, xErr := strconv.ParseFloat(xs, 64)
+		y, yErr := strconv.ParseFloat(ys, 64)
+		if !ok || xErr != nil || yErr != nil {
+			return nil, errors.New("invalid waypoint").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "waypoint", Value: pair})
+		}
+		waypoints = append(waypoints, Waypoint{X: x, Y: y})
+	}
+	return waypoints, nil
+}
+
+// formatCoordinate returns the shortest decimal for the coordinate, so it reads back the same.
+func formatCoordinate(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
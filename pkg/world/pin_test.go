@@ -0,0 +1,91 @@
+package world
+
+import (
+	"slices"
+	"testing"
+)
+
+func pinWorld() World {
+	w := viewWorld()
+	w.ViewCreate("slides", ViewParams{Expand: strPtr("")})
+	w.PinSet("", "api", "", PinParams{X: strPtr("120"), Y: strPtr("40")})
+	w.PinSet("slides", "payments", "", PinParams{X: strPtr("0"), Y: strPtr("0"), Width: strPtr("400"), Height: strPtr("300.5")})
+	w.PinSet("", "api", "db", PinParams{Waypoints: strPtr("200,120 200,300")})
+	return w
+}
+
+func TestPinSet(t *testing.T) {
+	w := pinWorld()
+
+	for _, c := range []struct {
+		Name   string
+		View   string
+		Id     string
+		ToId   string
+		Params PinParams
+		Err    bool
+	}{
+		{"move", "", "api", "", PinParams{X: strPtr("150.25")}, false},
+		{"new item", "slides", "user", "", PinParams{X: strPtr("-10"), Y: strPtr("20")}, false},
+		{"new item without y", "", "user", "", PinParams{X: strPtr("10")}, true},
+		{"unknown view", "nowhere", "user", "", PinParams{X: strPtr("10"), Y: strPtr("20")}, true},
+		{"unknown item", "", "nowhere", "", PinParams{X: strPtr("10"), Y: strPtr("20")}, true},
+		{"negative width", "", "api", "", PinParams{Width: strPtr("-1")}, true},
+		{"not a number", "", "api", "", PinParams{X: strPtr("left")}, true},
+		{"waypoints on an item", "", "api", "", PinParams{Waypoints: strPtr("1,2")}, true},
+		{"rel", "slides", "user", "payments", PinParams{Waypoints: strPtr("1,2 3.5,-4")}, false},
+		{"rel without waypoints", "", "user", "api", PinParams{Waypoints: strPtr("")}, true},
+		{"rel with x", "", "api", "db", PinParams{X: strPtr("1")}, true},
+		{"bad waypoint", "", "api", "db", PinParams{Waypoints: strPtr("1,2 3")}, true},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			before, _ := w.PinFetch(c.View, c.Id, c.ToId)
+			pin, err := w.PinSet(c.View, c.Id, c.ToId, c.Params).Pin()
+			if c.Err != (err != nil) {
+				t.Fatalf("expected error %v, got %v", c.Err, err)
+			}
+			if after, _ := w.PinFetch(c.View, c.Id, c.ToId); c.Err && !PinEqual(before, after) {
+				t.Fatalf("expected a failed pin to change nothing, got %v", after)
+			} else if !c.Err && !PinEqual(pin, after) {
+				t.Fatalf("expected %v to be stored, got %v", pin, after)
+			}
+		})
+	}
+	if pin, _ := w.PinFetch("", "api", ""); pin.X != 150.25 || pin.Y != 40 {
+		t.Fatalf("expected a move to keep the other coordinates, got %v", pin)
+	}
+}
+
+func TestPinRoundTrip(t *testing.T) {
+	w := pinWorld()
+	copied, err := FromString(w.String())
+	if err != nil {
+		t.Fatalf("error reading back the world: %v", err)
+	}
+	if !WorldEqual(w, copied) {
+		t.Fatalf("expected the pins to survive a round trip, got:\n%s", copied.String())
+	}
+	pins := make([]string, 0)
+	for _, pin := range ViewPins(copied, "slides") {
+		pins = append(pins, pin.String())
+	}
+	if expected := []string{`pin "payments" x=0 y=0 width=400 height=300.5 view="slides"`}; !slices.Equal(pins, expected) {
+		t.Fatalf("expected %v, got %v", expected, pins)
+	}
+}
+
+func TestPinCascade(t *testing.T) {
+	w := pinWorld()
+	w.RelDelete("api", "db")
+	if _, ok := w.PinFetch("", "api", "db"); ok {
+		t.Fatalf("expected deleting the rel to drop its pin")
+	}
+	w.ViewDelete("slides")
+	if _, ok := w.PinFetch("slides", "payments", ""); ok {
+		t.Fatalf("expected deleting the view to drop its pins")
+	}
+	w.ItemDelete("api")
+	if pins := w.PinList(0); len(pins) != 0 {
+		t.Fatalf("expected deleting the item to drop its pins, got %v", pins)
+	}
+}
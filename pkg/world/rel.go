@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/williamflynt/topolith/pkg/errors"
 	"github.com/williamflynt/topolith/pkg/grammar"
+	"slices"
 	"strings"
 )
 
@@ -11,12 +12,15 @@ const RelIdSeparator = "::"
 
 // Rel is a struct that represents a relationship between two Item. It might be represented in diagrams as an arrow between two Item renderings.
 type Rel struct {
-	From      Item   `json:"from"`      // From is the source Item.
-	To        Item   `json:"to"`        // To is the destination Item.
-	Verb      string `json:"verb"`      // Verb is the action that the relationship represents (ex: reads, uses).
-	Mechanism string `json:"mechanism"` // Mechanism is the method of implementation of the relationship (ex: HTTPS, JSON).
-	Async     bool   `json:"async"`     // Async is a boolean that represents whether the relationship is asynchronous.
-	Expanded  string `json:"expanded"`  // Expanded is expanded information on the relationship.
+	From           Item   `json:"from"`           // From is the source Item.
+	To             Item   `json:"to"`             // To is the destination Item.
+	Verb           string `json:"verb"`           // Verb is the action that the relationship represents (ex: reads, uses).
+	Mechanism      string `json:"mechanism"`      // Mechanism is the method of implementation of the relationship (ex: HTTPS, JSON).
+	Async          bool   `json:"async"`          // Async is a boolean that represents whether the relationship is asynchronous.
+	Expanded       string `json:"expanded"`       // Expanded is expanded information on the relationship.
+	Status         Status `json:"status"`         // Status is the lifecycle of the relationship, for planning migrations. An unset Status is Active.
+	Links          []Link `json:"links"`          // Links are to the runbooks, dashboards, etc. for the relationship, sorted by kind and target.
+	Classification string `json:"classification"` // Classification are the comma-separated classes of sensitive data that the relationship carries (ex: `pii,pci`).
 }
 
 func (r Rel) String() string {
@@ -34,6 +38,15 @@ func (r Rel) String() string {
 	if r.Expanded != "" {
 		paramRepr = append(paramRepr, fmt.Sprintf(`expanded="%s"`, r.Expanded))
 	}
+	if r.Status > 0 {
+		paramRepr = append(paramRepr, fmt.Sprintf(`status=%s`, StringFromStatus(r.Status)))
+	}
+	if len(r.Links) > 0 {
+		paramRepr = append(paramRepr, LinksString(r.Links))
+	}
+	if r.Classification != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`classification="%s"`, r.Classification))
+	}
 	if len(paramRepr) > 0 {
 		rel += " " + strings.Join(paramRepr, " ")
 	}
@@ -78,6 +91,15 @@ func RelEqual(r1, r2 Rel) bool {
 	if r1.Expanded != r2.Expanded {
 		return false
 	}
+	if r1.Status != r2.Status {
+		return false
+	}
+	if !slices.Equal(r1.Links, r2.Links) {
+		return false
+	}
+	if r1.Classification != r2.Classification {
+		return false
+	}
 	return true
 }
 
@@ -92,10 +114,13 @@ func relIdFromIds(fromId, toId string) string {
 
 // RelParams is a struct that represents the parameters that can be set on a Rel.
 type RelParams struct {
-	Verb      *string `json:"verb"`
-	Mechanism *string `json:"mechanism"`
-	Async     *bool   `json:"async"`
-	Expanded  *string `json:"expanded"`
+	Verb           *string `json:"verb"`
+	Mechanism      *string `json:"mechanism"`
+	Async          *bool   `json:"async"`
+	Expanded       *string `json:"expanded"`
+	Status         *string `json:"status"`
+	Links          *[]Link `json:"links"` // Links replace all the Link of the Rel.
+	Classification *string `json:"classification"`
 }
 
 func RelParamsFromInput(input grammar.InputAttributes) RelParams {
@@ -112,5 +137,15 @@ func RelParamsFromInput(input grammar.InputAttributes) RelParams {
 	if v, ok := input.Params["expanded"]; ok {
 		params.Expanded = strPtr(v)
 	}
+	if v, ok := input.Params["status"]; ok {
+		params.Status = strPtr(v)
+	}
+	if _, ok := input.Params["links"]; ok || len(input.Links) > 0 {
+		links := LinksFromInput(input)
+		params.Links = &links
+	}
+	if v, ok := input.Params["classification"]; ok {
+		params.Classification = strPtr(v)
+	}
 	return params
 }
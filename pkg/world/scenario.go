@@ -0,0 +1,286 @@
+package world
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/grammar"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Scenario is a named, ordered sequence of calls between Items, such as a checkout flow.
+// Each ScenarioStep follows a Rel, so a Scenario is a dynamic view of the static Rel, for sequence diagrams.
+type Scenario struct {
+	Id    string         `json:"id"`
+	Name  string         `json:"name"`  // Name is the display name of the Scenario; meant for humans.
+	Steps []ScenarioStep `json:"steps"` // Steps are the calls in the Scenario, in order.
+}
+
+func (s Scenario) String() string {
+	if s.Name == "" {
+		return fmt.Sprintf(`scenario "%s"`, s.Id)
+	}
+	return fmt.Sprintf(`scenario "%s" name="%s"`, s.Id, s.Name)
+}
+
+// StepStrings returns the grammar-adherent string representation of each ScenarioStep, in order.
+func (s Scenario) StepStrings() []string {
+	steps := make([]string, len(s.Steps))
+	for i, step := range s.Steps {
+		steps[i] = step.Line(s.Id)
+	}
+	return steps
+}
+
+// ScenarioFromString returns a Scenario from a grammar-adherent string representation, without its Steps.
+func ScenarioFromString(s string) (Scenario, error) {
+	p, err := grammar.Parse(s)
+	if err != nil {
+		return Scenario{}, errors.New("error parsing Scenario").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "input", Value: s})
+	}
+	return scenarioSet(Scenario{Id: p.InputAttributes.ResourceId, Steps: []ScenarioStep{}}, ScenarioParamsFromInput(p.InputAttributes)), nil
+}
+
+func ScenarioEqual(s1, s2 Scenario) bool {
+	return s1.Id == s2.Id && s1.Name == s2.Name && slices.Equal(s1.Steps, s2.Steps)
+}
+
+// ScenarioParams is a struct that represents the parameters that can be set on a Scenario.
+type ScenarioParams struct {
+	Name *string `json:"name"`
+}
+
+func ScenarioParamsFromInput(input grammar.InputAttributes) ScenarioParams {
+	params := ScenarioParams{}
+	if v, ok := input.Params["name"]; ok {
+		params.Name = strPtr(v)
+	}
+	return params
+}
+
+// ScenarioStep is a call from one Item to another in a Scenario.
+// It follows the Rel between them: forward for a request, or backward for a reply.
+type ScenarioStep struct {
+	From  string `json:"from"`  // From is the ID of the Item that calls.
+	To    string `json:"to"`    // To is the ID of the Item that is called.
+	Label string `json:"label"` // Label is what happens in the call (ex: `POST /orders`).
+	Async bool   `json:"async"` // Async is a boolean that represents whether the caller goes on without waiting.
+}
+
+// Line returns the grammar-adherent string representation of the ScenarioStep in the Scenario with the given ID.
+func (s ScenarioStep) Line(scenarioId string) string {
+	paramRepr := []string{fmt.Sprintf(`step "%s" "%s" "%s"`, scenarioId, s.From, s.To)}
+	if s.Label != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`label="%s"`, s.Label))
+	}
+	if s.Async {
+		paramRepr = append(paramRepr, "async=true")
+	}
+	return strings.Join(paramRepr, " ")
+}
+
+// StepFromInput returns the ScenarioStep from the input of a step command, and its position (1 for the first), or 0 to append it.
+func StepFromInput(input grammar.InputAttributes) (ScenarioStep, int, error) {
+	if len(input.SecondaryIds) != 2 {
+		return ScenarioStep{}, 0, errors.New("a step needs the items it is from and to").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "scenario", Value: input.ResourceId})
+	}
+	step := ScenarioStep{From: input.SecondaryIds[0], To: input.SecondaryIds[1], Label: input.Params["label"]}
+	if v, ok := input.Params["async"]; ok {
+		async, err := strconv.ParseBool(v)
+		if err != nil {
+			return ScenarioStep{}, 0, errors.New("invalid async value").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "async", Value: v})
+		}
+		step.Async = async
+	}
+	at, err := stepPosition(input.Params["at"])
+	return step, at, err
+}
+
+// StepPosition returns the position of a step (1 for the first) from the input, or 0 if there isn't one.
+func StepPosition(input grammar.InputAttributes) (int, error) {
+	return stepPosition(input.Params["at"])
+}
+
+// Reply indicates whether the ScenarioStep answers a call: there is a Rel from its To to its From, and none the other way.
+func Reply(w World, step ScenarioStep) bool {
+	return len(w.RelFetch(step.From, step.To, true)) == 0 && len(w.RelFetch(step.To, step.From, true)) > 0
+}
+
+// Participants returns the IDs of the Items in the Scenario, in the order they first appear.
+func Participants(s Scenario) []string {
+	ids := make([]string, 0)
+	for _, step := range s.Steps {
+		for _, id := range []string{step.From, step.To} {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// sortedScenarios returns the Scenario sorted by ID.
+func sortedScenarios(scenarios []Scenario) []Scenario {
+	slices.SortFunc(scenarios, func(a, b Scenario) int { return strings.Compare(a.Id, b.Id) })
+	return scenarios
+}
+
+// --- WORLD OPERATIONS ---
+
+func (w *world) ScenarioCreate(id string, params ScenarioParams) WorldWithScenario {
+	w.resetLatestTrackers()
+	if id == "" {
+		w.latestErr = errors.New("id cannot be empty").UseCode(errors.TopolithErrorInvalid)
+		return w
+	}
+	if existing, ok := w.Scenarios[id]; ok {
+		w.latestScenario = &existing
+		if params.Name != nil && *params.Name != existing.Name {
+			w.latestErr = errors.
+				New("parameter mismatch").
+				UseCode(errors.TopolithErrorConflict).
+				WithData(errors.KvPair{Key: "object", Value: "Scenario"}, errors.KvPair{Key: "existing", Value: existing.String()})
+		}
+		return w
+	}
+	scenario := scenarioSet(Scenario{Id: id, Steps: []ScenarioStep{}}, params)
+	w.Scenarios[id] = scenario
+	w.latestScenario = &scenario
+	return w
+}
+
+func (w *world) ScenarioDelete(id string) World {
+	w.resetLatestTrackers()
+	delete(w.Scenarios, id)
+	return w
+}
+
+func (w *world) ScenarioFetch(id string) (Scenario, bool) {
+	scenario, ok := w.Scenarios[id]
+	return scenario, ok
+}
+
+func (w *world) ScenarioList(limit int) []Scenario {
+	scenarios := make([]Scenario, 0)
+	for _, scenario := range w.Scenarios {
+		if limit > 0 && len(scenarios) >= limit {
+			break
+		}
+		scenarios = append(scenarios, scenario)
+	}
+	return scenarios
+}
+
+func (w *world) ScenarioSet(id string, params ScenarioParams) WorldWithScenario {
+	w.resetLatestTrackers()
+	scenario, ok := w.Scenarios[id]
+	if !ok {
+		w.latestErr = scenarioNotFound(id)
+		return w
+	}
+	scenario = scenarioSet(scenario, params)
+	w.Scenarios[id] = scenario
+	w.latestScenario = &scenario
+	return w
+}
+
+func (w *world) ScenarioStep(id string, step ScenarioStep, at int) WorldWithScenario {
+	w.resetLatestTrackers()
+	scenario, ok := w.Scenarios[id]
+	if !ok {
+		w.latestErr = scenarioNotFound(id)
+		return w
+	}
+	if at == 0 {
+		at = len(scenario.Steps) + 1
+	}
+	if at < 1 || at > len(scenario.Steps)+1 {
+		w.latestErr = errors.New("step position out of range").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "scenario", Value: id}, errors.KvPair{Key: "at", Value: strconv.Itoa(at)})
+		return w
+	}
+	if strings.Contains(step.Label, `"`) {
+		// The label is quoted in the World, and a quote can't be in a quoted value.
+		w.latestErr = errors.New("step label cannot contain a quote").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "label", Value: step.Label})
+		return w
+	}
+	for _, itemId := range []string{step.From, step.To} {
+		if _, ok := w.Items[itemId]; !ok {
+			w.latestErr = errors.New("item not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: itemId}).WithSuggestions(itemId, SuggestIds(w, itemId, SuggestionLimit)...)
+			return w
+		}
+	}
+	if !w.follows(step) {
+		w.latestErr = errors.New("step does not follow a rel").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "from", Value: step.From}, errors.KvPair{Key: "to", Value: step.To})
+		return w
+	}
+	scenario.Steps = slices.Insert(slices.Clone(scenario.Steps), at-1, step)
+	w.Scenarios[id] = scenario
+	w.latestScenario = &scenario
+	return w
+}
+
+func (w *world) ScenarioUnstep(id string, at int) WorldWithScenario {
+	w.resetLatestTrackers()
+	scenario, ok := w.Scenarios[id]
+	if !ok {
+		w.latestErr = scenarioNotFound(id)
+		return w
+	}
+	if at < 1 || at > len(scenario.Steps) {
+		w.latestErr = errors.New("step not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "scenario", Value: id}, errors.KvPair{Key: "at", Value: strconv.Itoa(at)})
+		return w
+	}
+	scenario.Steps = slices.Delete(slices.Clone(scenario.Steps), at-1, at)
+	w.Scenarios[id] = scenario
+	w.latestScenario = &scenario
+	return w
+}
+
+func (w *world) Scenario() (Scenario, error) {
+	if w.latestScenario == nil {
+		return Scenario{}, w.latestErr
+	}
+	return *w.latestScenario, w.latestErr
+}
+
+// follows indicates whether there is a Rel between the Items of the ScenarioStep, either way.
+func (w *world) follows(step ScenarioStep) bool {
+	_, forward := w.Rels[relIdFromIds(step.From, step.To)]
+	_, backward := w.Rels[relIdFromIds(step.To, step.From)]
+	return forward || backward
+}
+
+// dropBrokenSteps removes the ScenarioStep that no longer follow a Rel, such as when the Rel or its Items are deleted.
+func (w *world) dropBrokenSteps() {
+	for id, scenario := range w.Scenarios {
+		steps := slices.DeleteFunc(slices.Clone(scenario.Steps), func(step ScenarioStep) bool { return !w.follows(step) })
+		if len(steps) != len(scenario.Steps) {
+			scenario.Steps = steps
+			w.Scenarios[id] = scenario
+		}
+	}
+}
+
+func scenarioSet(scenario Scenario, params ScenarioParams) Scenario {
+	if params.Name != nil {
+		scenario.Name = *params.Name
+	}
+	return scenario
+}
+
+// stepPosition returns the position of a step from a parameter value, or 0 if the value is empty.
+func stepPosition(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	at, err := strconv.Atoi(s)
+	if err != nil || at < 1 {
+		return 0, errors.New("invalid step position").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "at", Value: s})
+	}
+	return at, nil
+}
+
+func scenarioNotFound(id string) error {
+	return errors.New("scenario not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: id})
+}
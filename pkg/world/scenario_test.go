@@ -0,0 +1,109 @@
+package world
+
+import (
+	"slices"
+	"testing"
+)
+
+func scenarioWorld(t *testing.T) World {
+	w := CreateWorld("scenario-world")
+	for _, id := range []string{"web", "api", "db", "queue"} {
+		w.ItemCreate(id, ItemParams{})
+	}
+	w.RelCreate("web", "api", RelParams{Verb: strPtr("calls")})
+	w.RelCreate("api", "db", RelParams{})
+	w.RelCreate("api", "queue", RelParams{Async: boolPtr(true)})
+	w.ScenarioCreate("checkout", ScenarioParams{Name: strPtr("Checkout")})
+	for _, step := range []ScenarioStep{
+		{From: "web", To: "api", Label: "POST /orders"},
+		{From: "api", To: "db", Label: "insert"},
+		{From: "api", To: "queue", Async: true},
+		{From: "api", To: "web", Label: "201"},
+	} {
+		if err := w.ScenarioStep("checkout", step, 0).Err(); err != nil {
+			t.Fatalf("error adding step %v: %v", step, err)
+		}
+	}
+	return w
+}
+
+func TestScenarioSteps(t *testing.T) {
+	w := scenarioWorld(t)
+
+	for _, c := range []struct {
+		Name string
+		Step ScenarioStep
+		At   int
+		Err  bool
+	}{
+		{"forward", ScenarioStep{From: "web", To: "api"}, 1, false},
+		{"reply", ScenarioStep{From: "db", To: "api"}, 0, false},
+		{"no rel", ScenarioStep{From: "web", To: "db"}, 0, true},
+		{"missing item", ScenarioStep{From: "web", To: "cdn"}, 0, true},
+		{"out of range", ScenarioStep{From: "web", To: "api"}, 9, true},
+		{"quoted label", ScenarioStep{From: "web", To: "api", Label: `say "hi"`}, 0, true},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			err := w.ScenarioStep("checkout", c.Step, c.At).Err()
+			if c.Err != (err != nil) {
+				t.Fatalf("expected error %v, got %v", c.Err, err)
+			}
+		})
+	}
+	scenario, _ := w.ScenarioFetch("checkout")
+	if len(scenario.Steps) != 6 || scenario.Steps[0] != (ScenarioStep{From: "web", To: "api"}) {
+		t.Fatalf("expected the step at 1 to come first, got %v", scenario.Steps)
+	}
+	if !Reply(w, ScenarioStep{From: "api", To: "web"}) || Reply(w, ScenarioStep{From: "web", To: "api"}) {
+		t.Fatalf("expected only a step against its Rel to be a reply")
+	}
+	if p := Participants(scenario); !slices.Equal(p, []string{"web", "api", "db", "queue"}) {
+		t.Fatalf("expected participants in order of appearance, got %v", p)
+	}
+
+	if err := w.ScenarioUnstep("checkout", 1).Err(); err != nil {
+		t.Fatalf("error removing step: %v", err)
+	}
+	if err := w.ScenarioUnstep("checkout", 6).Err(); err == nil {
+		t.Fatalf("expected an error removing a step that isn't there")
+	}
+}
+
+func TestScenarioDroppedSteps(t *testing.T) {
+	w := scenarioWorld(t)
+
+	w.RelDelete("api", "db")
+	scenario, _ := w.ScenarioFetch("checkout")
+	if len(scenario.Steps) != 3 || slices.Contains(scenario.Steps, ScenarioStep{From: "api", To: "db", Label: "insert"}) {
+		t.Fatalf("expected the step along the deleted rel to be dropped, got %v", scenario.Steps)
+	}
+
+	// Both the call and the reply follow the Rel between web and api.
+	w.ItemDelete("web")
+	scenario, _ = w.ScenarioFetch("checkout")
+	if !slices.Equal(scenario.Steps, []ScenarioStep{{From: "api", To: "queue", Async: true}}) {
+		t.Fatalf("expected the steps to or from the deleted item to be dropped, got %v", scenario.Steps)
+	}
+}
+
+func TestScenarioRoundTrip(t *testing.T) {
+	w := scenarioWorld(t)
+	w.ScenarioCreate("empty", ScenarioParams{})
+
+	restored, err := FromString(w.String())
+	if err != nil {
+		t.Fatalf("error restoring world: %v\n%s", err, w.String())
+	}
+	if !WorldEqual(w, restored) {
+		t.Fatalf("expected the restored world to equal the original:\n%s\ngot:\n%s", w.String(), restored.String())
+	}
+
+	w.ScenarioUnstep("checkout", 2)
+	if WorldEqual(w, restored) {
+		t.Fatalf("expected worlds with different steps to differ")
+	}
+	c := Diff(restored, w)
+	if len(c.ScenariosChanged) != 1 || c.ScenariosChanged[0].Id != "checkout" {
+		t.Fatalf("expected the checkout scenario to change, got %v", c.ScenariosChanged)
+	}
+}
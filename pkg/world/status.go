@@ -0,0 +1,118 @@
+package world
+
+import (
+	"github.com/williamflynt/topolith/pkg/errors"
+	"slices"
+	"strconv"
+)
+
+// Status is an iota that represents the lifecycle of an Item or Rel, so one World can describe a migration.
+// An unset Status is Active.
+type Status int
+
+const (
+	_ Status = iota
+	Planned
+	Active
+	Deprecated
+	Retired
+)
+
+const (
+	AsIs = "as-is" // AsIs is the view of the World as it runs today: Active and Deprecated.
+	ToBe = "to-be" // ToBe is the view of the World after the migration: Planned and Active.
+)
+
+func StatusFromString(s string) Status {
+	switch s {
+	case "planned":
+		return Planned
+	case "active":
+		return Active
+	case "deprecated":
+		return Deprecated
+	case "retired":
+		return Retired
+	default:
+		return 0
+	}
+}
+
+func StringFromStatus(s Status) string {
+	switch s {
+	case Planned:
+		return "planned"
+	case Active:
+		return "active"
+	case Deprecated:
+		return "deprecated"
+	case Retired:
+		return "retired"
+	default:
+		return ""
+	}
+}
+
+// StatusesFromView returns the Status included in the view with the given name.
+// A view is AsIs, ToBe, or the name of a single Status. The okay boolean is false if the name isn't a view.
+func StatusesFromView(name string) ([]Status, bool) {
+	switch name {
+	case AsIs:
+		return []Status{Active, Deprecated}, true
+	case ToBe:
+		return []Status{Planned, Active}, true
+	}
+	if s := StatusFromString(name); s != 0 {
+		return []Status{s}, true
+	}
+	return nil, false
+}
+
+// StatusView returns a copy of the World with only the Items and Rel that have one of the given Status.
+// Components of a removed Item are hoisted to its parent, and Rel to or from a removed Item are removed with it.
+func StatusView(w World, statuses ...Status) (World, error) {
+	view, err := Clone(w)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range AllItems(view) {
+		if !slices.Contains(statuses, effectiveStatus(item.Status)) {
+			view.ItemDelete(item.Id)
+		}
+	}
+	for _, rel := range AllRels(view) {
+		if !slices.Contains(statuses, effectiveStatus(rel.Status)) {
+			view.RelDelete(rel.From.Id, rel.To.Id)
+		}
+	}
+	return view, nil
+}
+
+// effectiveStatus returns the Status, or Active if it is unset.
+func effectiveStatus(s Status) Status {
+	if s == 0 {
+		return Active
+	}
+	return s
+}
+
+// statusFromParam returns the Status for an ItemParams.Status or RelParams.Status value.
+// It accepts the grammar name (ex: "planned"), the integer representation, or an empty string to clear the Status.
+func statusFromParam(s string) (Status, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if status := StatusFromString(s); status != 0 {
+		return status, nil
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil || i < 0 || i > int(Retired) {
+		return 0, errors.New("invalid status").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "status", Value: s})
+	}
+	return Status(i), nil
+}
+
+func equalStatus(s Status, param string) bool {
+	paramStatus, err := statusFromParam(param)
+	return err == nil && paramStatus == s
+}
@@ -0,0 +1,66 @@
+package world
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStatusView(t *testing.T) {
+	w := CreateWorld("migration-world")
+	w.ItemCreate("monolith", ItemParams{Status: strPtr("deprecated")})
+	w.ItemCreate("orders", ItemParams{Status: strPtr("planned")})
+	w.ItemCreate("orders.db", ItemParams{})
+	w.ItemCreate("web", ItemParams{Status: strPtr("active")})
+	w.Nest("orders.db", "orders")
+	w.RelCreate("web", "monolith", RelParams{})
+	w.RelCreate("web", "orders", RelParams{Status: strPtr("planned")})
+	w.RelCreate("orders", "orders.db", RelParams{Status: strPtr("retired")})
+
+	for _, c := range []struct {
+		View  string
+		Items []string
+		Rels  []string
+	}{
+		{AsIs, []string{"orders.db", "web", "monolith"}, []string{"web::monolith"}},
+		{ToBe, []string{"orders", "orders.db", "web"}, []string{"web::orders"}},
+		{"retired", []string{}, []string{}},
+	} {
+		t.Run(c.View, func(t *testing.T) {
+			statuses, ok := StatusesFromView(c.View)
+			if !ok {
+				t.Fatalf("expected %s to be a view", c.View)
+			}
+			view, err := StatusView(w, statuses...)
+			if err != nil {
+				t.Fatalf("error building view: %v", err)
+			}
+			items := make([]string, 0)
+			for _, item := range view.ItemList(0) {
+				items = append(items, item.Id)
+			}
+			rels := make([]string, 0)
+			for _, rel := range view.RelList(0) {
+				rels = append(rels, rel.id())
+			}
+			slices.Sort(items)
+			slices.Sort(c.Items)
+			if !slices.Equal(items, c.Items) || !slices.Equal(rels, c.Rels) {
+				t.Fatalf("expected %v and %v in %s, got %v and %v", c.Items, c.Rels, c.View, items, rels)
+			}
+		})
+	}
+	if _, ok := StatusesFromView("someday"); ok {
+		t.Fatalf("expected someday not to be a view")
+	}
+	if len(w.ItemList(0)) != 4 || len(w.RelList(0)) != 3 {
+		t.Fatalf("expected the World untouched by its views")
+	}
+
+	w2, err := FromString(w.String())
+	if err != nil || !WorldEqual(w, w2) {
+		t.Fatalf("expected Status to survive serialization: %v", err)
+	}
+	if err := w.ItemSet("web", ItemParams{Status: strPtr("gone")}).Err(); err == nil {
+		t.Fatalf("expected error setting an invalid Status")
+	}
+}
@@ -0,0 +1,293 @@
+package world
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/grammar"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	ThemeC4    = "c4"    // ThemeC4 is the classic C4 palette of blues and greys. It is the default.
+	ThemeDark  = "dark"  // ThemeDark is light lines and labels on a dark background, for dark slides.
+	ThemePrint = "print" // ThemePrint is black and white, for paper.
+)
+
+// Themes are the names of the theme presets a World can render with.
+var Themes = []string{ThemeC4, ThemeDark, ThemePrint}
+
+var (
+	// Shapes are the shapes an Item can take.
+	Shapes = []string{"box", "rounded", "cylinder", "person", "queue", "hexagon"}
+	// LineStyles are the styles of a border, or of the line of a Rel.
+	LineStyles = []string{"solid", "dashed", "dotted", "bold"}
+)
+
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Style is a declarative rule for how renderers draw the Items and Rel it matches.
+// Every renderer evaluates the same Style, on top of the rules of the theme of the World, so a diagram looks the same however it's rendered.
+// Where several Style match, they apply in order of ID, and a later one overrides the attributes it sets.
+type Style struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`   // Name is what the legend calls the Style; meant for humans. The legend uses the Match without one.
+	Match  string `json:"match"`  // Match are the comma-separated terms an Item or Rel must match (ex: `type:database,tag:pci`). An empty Match matches everything.
+	Shape  string `json:"shape"`  // Shape is the shape of a matched Item, one of Shapes.
+	Color  string `json:"color"`  // Color is the fill of a matched Item, or the line color of a matched Rel, as a `#rgb` or `#rrggbb` hex color.
+	Border string `json:"border"` // Border is the style of the border of a matched Item, one of LineStyles.
+	Line   string `json:"line"`   // Line is the style of the line of a matched Rel, one of LineStyles.
+}
+
+func (s Style) String() string {
+	paramRepr := []string{fmt.Sprintf(`style "%s"`, s.Id)}
+	for _, kv := range [][2]string{{"name", s.Name}, {"match", s.Match}, {"shape", s.Shape}, {"color", s.Color}, {"border", s.Border}, {"line", s.Line}} {
+		if kv[1] != "" {
+			paramRepr = append(paramRepr, fmt.Sprintf(`%s="%s"`, kv[0], kv[1]))
+		}
+	}
+	return strings.Join(paramRepr, " ")
+}
+
+// Label returns the Name of the Style, or its Match when it has no Name.
+func (s Style) Label() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	if s.Match != "" {
+		return s.Match
+	}
+	return s.Id
+}
+
+// MatchesItem indicates whether the Style applies to the Item: it matches one of the values for each key in the Match, and none of those keys is only for Rel.
+func (s Style) MatchesItem(item Item) bool {
+	m, err := parseStyleMatch(s.Match)
+	return err == nil && m.matchesItem(item)
+}
+
+// MatchesRel indicates whether the Style applies to the Rel: it matches one of the values for each key in the Match, and none of those keys is only for Items.
+func (s Style) MatchesRel(rel Rel) bool {
+	m, err := parseStyleMatch(s.Match)
+	return err == nil && m.matchesRel(rel)
+}
+
+func StyleEqual(s1, s2 Style) bool {
+	return s1 == s2
+}
+
+// StyleParams is a struct that represents the parameters that can be set on a Style.
+type StyleParams struct {
+	Name   *string `json:"name"`
+	Match  *string `json:"match"`
+	Shape  *string `json:"shape"`
+	Color  *string `json:"color"`
+	Border *string `json:"border"`
+	Line   *string `json:"line"`
+}
+
+func StyleParamsFromInput(input grammar.InputAttributes) StyleParams {
+	params := StyleParams{}
+	for key, field := range map[string]**string{"name": &params.Name, "match": &params.Match, "shape": &params.Shape, "color": &params.Color, "border": &params.Border, "line": &params.Line} {
+		if v, ok := input.Params[key]; ok {
+			*field = strPtr(v)
+		}
+	}
+	return params
+}
+
+// sortedStyles returns the Style sorted by ID, which is the order they apply in.
+func sortedStyles(styles []Style) []Style {
+	slices.SortFunc(styles, func(a, b Style) int { return strings.Compare(a.Id, b.Id) })
+	return styles
+}
+
+// SortedStyles returns the Style in the World, in the order they apply.
+func SortedStyles(w World) []Style {
+	return sortedStyles(w.StyleList(0))
+}
+
+// styleMatch is a parsed Style.Match. It matches one of the values for each key that has any.
+type styleMatch struct {
+	viewFilter
+	external  []bool
+	async     []bool
+	mechanism []string
+}
+
+func (m styleMatch) matchesItem(item Item) bool {
+	if len(m.async) > 0 {
+		return false
+	}
+	if !m.viewFilter.matches(item) {
+		return false
+	}
+	if len(m.external) > 0 && !slices.Contains(m.external, item.External) {
+		return false
+	}
+	return len(m.mechanism) == 0 || slices.ContainsFunc(m.mechanism, func(mechanism string) bool { return strings.EqualFold(mechanism, item.Mechanism) })
+}
+
+func (m styleMatch) matchesRel(rel Rel) bool {
+	if len(m.types) > 0 || len(m.tags) > 0 || len(m.external) > 0 {
+		return false
+	}
+	if len(m.statuses) > 0 && !slices.Contains(m.statuses, effectiveStatus(rel.Status)) {
+		return false
+	}
+	if len(m.async) > 0 && !slices.Contains(m.async, rel.Async) {
+		return false
+	}
+	return len(m.mechanism) == 0 || slices.ContainsFunc(m.mechanism, func(mechanism string) bool { return strings.EqualFold(mechanism, rel.Mechanism) })
+}
+
+// parseStyleMatch parses comma-separated `key:value` terms.
+// The keys of a View.Filter match Items, `external` matches Items, `async` matches Rel, and `status` and `mechanism` match both.
+func parseStyleMatch(s string) (styleMatch, error) {
+	m := styleMatch{}
+	filterTerms := make([]string, 0)
+	for _, term := range strings.Split(s, ",") {
+		if term = strings.TrimSpace(term); term == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(term, ":")
+		switch key {
+		case "type", "tag", "status":
+			filterTerms = append(filterTerms, term)
+			continue
+		case "external", "async":
+			if value == "true" || value == "false" {
+				if key == "external" {
+					m.external = append(m.external, value == "true")
+				} else {
+					m.async = append(m.async, value == "true")
+				}
+				continue
+			}
+		case "mechanism":
+			if value != "" {
+				m.mechanism = append(m.mechanism, value)
+				continue
+			}
+		}
+		return styleMatch{}, errors.New("invalid style match").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "match", Value: term})
+	}
+	filter, err := parseViewFilter(strings.Join(filterTerms, ","))
+	if err != nil {
+		return styleMatch{}, errors.New("invalid style match").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "match", Value: s})
+	}
+	m.viewFilter = filter
+	return m, nil
+}
+
+// --- WORLD OPERATIONS ---
+
+func (w *world) StyleCreate(id string, params StyleParams) WorldWithStyle {
+	w.resetLatestTrackers()
+	if id == "" {
+		w.latestErr = errors.New("id cannot be empty").UseCode(errors.TopolithErrorInvalid)
+		return w
+	}
+	if existing, ok := w.Styles[id]; ok {
+		w.latestStyle = &existing
+		if set, err := styleSet(existing, params); err != nil || !StyleEqual(set, existing) {
+			w.latestErr = errors.
+				New("parameter mismatch").
+				UseCode(errors.TopolithErrorConflict).
+				WithData(errors.KvPair{Key: "object", Value: "Style"}, errors.KvPair{Key: "existing", Value: existing.String()})
+		}
+		return w
+	}
+	style, err := styleSet(Style{Id: id}, params)
+	if err != nil {
+		w.latestErr = err
+		return w
+	}
+	w.Styles[id] = style
+	w.latestStyle = &style
+	return w
+}
+
+func (w *world) StyleDelete(id string) World {
+	w.resetLatestTrackers()
+	delete(w.Styles, id)
+	return w
+}
+
+func (w *world) StyleFetch(id string) (Style, bool) {
+	style, ok := w.Styles[id]
+	return style, ok
+}
+
+func (w *world) StyleList(limit int) []Style {
+	styles := make([]Style, 0)
+	for _, style := range w.Styles {
+		if limit > 0 && len(styles) >= limit {
+			break
+		}
+		styles = append(styles, style)
+	}
+	return styles
+}
+
+func (w *world) StyleSet(id string, params StyleParams) WorldWithStyle {
+	w.resetLatestTrackers()
+	style, ok := w.Styles[id]
+	if !ok {
+		w.latestErr = errors.New("style not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: id})
+		return w
+	}
+	style, err := styleSet(style, params)
+	if err != nil {
+		w.latestErr = err
+		return w
+	}
+	w.Styles[id] = style
+	w.latestStyle = &style
+	return w
+}
+
+func (w *world) Style() (Style, error) {
+	if w.latestStyle == nil {
+		return Style{}, w.latestErr
+	}
+	return *w.latestStyle, w.latestErr
+}
+
+func styleSet(style Style, params StyleParams) (Style, error) {
+	if params.Name != nil {
+		style.Name = *params.Name
+	}
+	if params.Match != nil {
+		if _, err := parseStyleMatch(*params.Match); err != nil {
+			return style, err
+		}
+		style.Match = *params.Match
+	}
+	for _, c := range []struct {
+		key    string
+		value  *string
+		field  *string
+		values []string
+	}{
+		{"shape", params.Shape, &style.Shape, Shapes},
+		{"border", params.Border, &style.Border, LineStyles},
+		{"line", params.Line, &style.Line, LineStyles},
+	} {
+		if c.value == nil {
+			continue
+		}
+		if *c.value != "" && !slices.Contains(c.values, *c.value) {
+			return style, errors.New("invalid "+c.key).UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: c.key, Value: *c.value}, errors.KvPair{Key: "expected", Value: strings.Join(c.values, ", ")})
+		}
+		*c.field = *c.value
+	}
+	if params.Color != nil {
+		if *params.Color != "" && !hexColor.MatchString(*params.Color) {
+			return style, errors.New("invalid color").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "color", Value: *params.Color})
+		}
+		style.Color = *params.Color
+	}
+	return style, nil
+}
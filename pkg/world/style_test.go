@@ -0,0 +1,117 @@
+package world
+
+import (
+	"testing"
+)
+
+func TestStyleMatches(t *testing.T) {
+	w := viewWorld()
+	api, _ := w.ItemFetch("api")
+	mail, _ := w.ItemFetch("mail")
+	legacy, _ := w.ItemFetch("legacy")
+	calls := w.RelFetch("api", "db", true)[0]
+	sends := w.RelFetch("api", "mail", true)[0]
+
+	for _, c := range []struct {
+		Name  string
+		Match string
+		Items []Item
+		Rels  []Rel
+	}{
+		{"everything", "", []Item{api, mail, legacy}, []Rel{calls, sends}},
+		{"type", "type:server", []Item{api, legacy}, []Rel{}},
+		{"tag or type", "tag:edge,type:person", []Item{}, []Rel{}},
+		{"tag and type", "tag:edge,type:server", []Item{api}, []Rel{}},
+		{"external", "external:true", []Item{mail}, []Rel{}},
+		{"status", "status:retired", []Item{legacy}, []Rel{}},
+		{"status of both", "status:active", []Item{api, mail}, []Rel{calls, sends}},
+		{"async", "async:true", []Item{}, []Rel{sends}},
+		{"sync", "async:false", []Item{}, []Rel{calls}},
+		{"invalid", "async:maybe", []Item{}, []Rel{}},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			s := Style{Id: "s", Match: c.Match}
+			for _, item := range []Item{api, mail, legacy} {
+				expected := false
+				for _, i := range c.Items {
+					expected = expected || i.Id == item.Id
+				}
+				if s.MatchesItem(item) != expected {
+					t.Errorf("expected %q to match %s: %v", c.Match, item.Id, expected)
+				}
+			}
+			for _, rel := range []Rel{calls, sends} {
+				expected := false
+				for _, r := range c.Rels {
+					expected = expected || r.id() == rel.id()
+				}
+				if s.MatchesRel(rel) != expected {
+					t.Errorf("expected %q to match %s: %v", c.Match, rel.id(), expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStyleCreate(t *testing.T) {
+	w := CreateWorld("style-world")
+
+	for _, c := range []struct {
+		Name   string
+		Id     string
+		Params StyleParams
+		Err    bool
+	}{
+		{"valid", "db", StyleParams{Match: strPtr("type:database"), Shape: strPtr("cylinder"), Color: strPtr("#438DD5")}, false},
+		{"same again", "db", StyleParams{Shape: strPtr("cylinder")}, false},
+		{"mismatch", "db", StyleParams{Shape: strPtr("box")}, true},
+		{"empty id", "", StyleParams{}, true},
+		{"bad match", "bad", StyleParams{Match: strPtr("color:red")}, true},
+		{"bad shape", "bad", StyleParams{Shape: strPtr("star")}, true},
+		{"bad color", "bad", StyleParams{Color: strPtr("red")}, true},
+		{"bad line", "bad", StyleParams{Line: strPtr("wavy")}, true},
+		{"short color", "async", StyleParams{Match: strPtr("async:true"), Color: strPtr("#abc"), Line: strPtr("dashed")}, false},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			_, err := w.StyleCreate(c.Id, c.Params).Style()
+			if c.Err != (err != nil) {
+				t.Fatalf("expected error %v, got %v", c.Err, err)
+			}
+		})
+	}
+	if _, ok := w.StyleFetch("bad"); ok {
+		t.Fatalf("expected an invalid style not to be created")
+	}
+
+	// An empty value clears an attribute.
+	style, err := w.StyleSet("db", StyleParams{Color: strPtr("")}).Style()
+	if err != nil || style.Color != "" || style.Shape != "cylinder" {
+		t.Fatalf("expected the color cleared and the shape kept, got %v, %v", style, err)
+	}
+	if err := w.StyleSet("nowhere", StyleParams{}).Err(); err == nil {
+		t.Fatalf("expected an error setting a style that isn't there")
+	}
+}
+
+func TestStyleRoundTrip(t *testing.T) {
+	w := CreateWorld("style-world")
+	w.SetTheme(ThemeDark)
+	w.StyleCreate("db", StyleParams{Name: strPtr("Databases"), Match: strPtr("type:database"), Shape: strPtr("cylinder")})
+	w.StyleCreate("async", StyleParams{Match: strPtr("async:true"), Line: strPtr("dotted"), Color: strPtr("#999999")})
+	copied, err := FromString(w.String())
+	if err != nil {
+		t.Fatalf("error reading back the world: %v", err)
+	}
+	if !WorldEqual(w, copied) {
+		t.Fatalf("expected the theme and styles to survive a round trip, got:\n%s", copied.String())
+	}
+	if copied.Theme() != ThemeDark {
+		t.Fatalf("expected the dark theme, got %q", copied.Theme())
+	}
+
+	// The default theme isn't written, so it reads back as the default.
+	plain, err := FromString(CreateWorld("plain").String())
+	if err != nil || plain.Theme() != ThemeC4 {
+		t.Fatalf("expected the default theme, got %q, %v", plain.Theme(), err)
+	}
+}
@@ -0,0 +1,76 @@
+package world
+
+import (
+	"sort"
+	"strings"
+)
+
+// SuggestionLimit is how many IDs we suggest when an Item isn't found.
+const SuggestionLimit = 3
+
+// SuggestIds returns up to limit IDs of Items in the World that are close to the given ID, to suggest when it isn't found.
+// IDs that start with the given ID (or that it starts with) rank first, then by edit distance.
+// IDs that are too far from the given ID aren't suggested, so the result may be empty.
+func SuggestIds(w World, id string, limit int) []string {
+	type candidate struct {
+		id       string
+		prefix   bool
+		distance int
+	}
+	lower := strings.ToLower(id)
+	maxDistance := len([]rune(id))/3 + 1
+	candidates := make([]candidate, 0)
+	for _, item := range w.ItemList(0) {
+		if item.Id == id {
+			continue
+		}
+		other := strings.ToLower(item.Id)
+		c := candidate{
+			id:       item.Id,
+			prefix:   lower != "" && (strings.HasPrefix(other, lower) || strings.HasPrefix(lower, other)),
+			distance: editDistance(lower, other),
+		}
+		if c.prefix || c.distance <= maxDistance {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].prefix != candidates[j].prefix {
+			return candidates[i].prefix
+		}
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].id < candidates[j].id
+	})
+	ids := make([]string, 0, limit)
+	for _, c := range candidates {
+		if limit > 0 && len(ids) >= limit {
+			break
+		}
+		ids = append(ids, c.id)
+	}
+	return ids
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,47 @@
+package world
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"reflect"
+	"testing"
+)
+
+var suggestIdsCases = []struct {
+	Id       string
+	Expected []string
+}{
+	{"apx", []string{"api", "app"}},
+	{"API", []string{"api", "app"}},
+	{"pay", []string{"payments", "payments-db"}},
+	{"payments-bd", []string{"payments", "payments-db"}},
+	{"zzz", []string{}},
+	{"api", []string{"app"}},
+}
+
+func TestSuggestIds(t *testing.T) {
+	w := CreateWorld("suggest-world")
+	for _, id := range []string{"api", "app", "payments", "payments-db", "queue"} {
+		w.ItemCreate(id, ItemParams{})
+	}
+	for i, c := range suggestIdsCases {
+		t.Run(fmt.Sprintf("TestSuggestIds-%d", i), func(t *testing.T) {
+			if ids := SuggestIds(w, c.Id, SuggestionLimit); !reflect.DeepEqual(ids, c.Expected) {
+				t.Fatalf("expected suggestions %v for %q, got %v", c.Expected, c.Id, ids)
+			}
+		})
+	}
+}
+
+func TestNotFoundSuggestions(t *testing.T) {
+	w := CreateWorld("suggest-world")
+	w.ItemCreate("api", ItemParams{})
+	w.ItemCreate("db", ItemParams{})
+
+	for _, err := range []error{w.ItemSet("apj", ItemParams{}).Err(), w.RelCreate("api", "dc", RelParams{}).Err(), w.Nest("ap", "db").Err()} {
+		missing, suggestions := errors.Suggestions(err)
+		if missing == "" || len(suggestions) == 0 {
+			t.Fatalf("expected suggestions in error: %v", err)
+		}
+	}
+}
@@ -154,6 +154,7 @@ func (t *tree) AddOrMove(item *Item) error {
 		// Already in a different Tree.
 		// Just move the node to this Tree.
 		node.Parent().Components().Remove(node)
+		node.(*tree).parent = t
 		t.components.Add(node)
 		return nil
 	}
@@ -204,9 +205,11 @@ func (t *tree) Delete(id string) {
 		return
 	}
 	foundComponents := found.Components().ToSlice()
-	found.Parent().Components().Remove(found)
+	parent := found.(*tree).parent
+	parent.components.Remove(found)
 	for _, c := range foundComponents {
-		found.Parent().Components().Add(c)
+		c.(*tree).parent = parent
+		parent.components.Add(c)
 	}
 }
 
@@ -0,0 +1,387 @@
+package world
+
+import (
+	"fmt"
+	"github.com/williamflynt/topolith/pkg/errors"
+	"github.com/williamflynt/topolith/pkg/grammar"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	ExpandAll   = "*" // ExpandAll is the Expand of a View that shows the components of every Item. It is the default.
+	defaultHops = 1   // defaultHops is how far from its Focus a View reaches, unless it says otherwise.
+)
+
+// View is a named combination of expansion, filters and focus, saved in the World so every render of it is the same.
+// A View is applied with ApplyView: first the Filter, then the Focus, then the Expand.
+type View struct {
+	Id     string   `json:"id"`
+	Name   string   `json:"name"`   // Name is the display name of the View; meant for humans.
+	Expand []string `json:"expand"` // Expand are the IDs of the Items whose components show. Root Items always show. ExpandAll shows every Item.
+	Filter string   `json:"filter"` // Filter are the comma-separated terms an Item must match (ex: `type:server,tag:pci,status:to-be`).
+	Focus  string   `json:"focus"`  // Focus is the ID of the Item the View centers on. Only Items within Hops of it show.
+	Hops   int      `json:"hops"`   // Hops is how many Rel away from the Focus an Item may be, in either direction.
+}
+
+func (v View) String() string {
+	paramRepr := []string{fmt.Sprintf(`view "%s"`, v.Id)}
+	if v.Name != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`name="%s"`, v.Name))
+	}
+	if !slices.Equal(v.Expand, []string{ExpandAll}) {
+		paramRepr = append(paramRepr, fmt.Sprintf(`expand="%s"`, strings.Join(v.Expand, ",")))
+	}
+	if v.Filter != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`filter="%s"`, v.Filter))
+	}
+	if v.Focus != "" {
+		paramRepr = append(paramRepr, fmt.Sprintf(`focus="%s"`, v.Focus))
+	}
+	if v.Hops != defaultHops {
+		paramRepr = append(paramRepr, fmt.Sprintf(`hops=%d`, v.Hops))
+	}
+	return strings.Join(paramRepr, " ")
+}
+
+// ViewFromString returns a View from a grammar-adherent string representation.
+func ViewFromString(s string) (View, error) {
+	p, err := grammar.Parse(s)
+	if err != nil {
+		return View{}, errors.New("error parsing View").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "input", Value: s})
+	}
+	return viewSet(newView(p.InputAttributes.ResourceId), ViewParamsFromInput(p.InputAttributes))
+}
+
+func ViewEqual(v1, v2 View) bool {
+	return v1.Id == v2.Id && v1.Name == v2.Name && slices.Equal(v1.Expand, v2.Expand) && v1.Filter == v2.Filter && v1.Focus == v2.Focus && v1.Hops == v2.Hops
+}
+
+// ViewParams is a struct that represents the parameters that can be set on a View.
+type ViewParams struct {
+	Name   *string `json:"name"`
+	Expand *string `json:"expand"` // Expand are comma-separated Item IDs, or ExpandAll.
+	Filter *string `json:"filter"`
+	Focus  *string `json:"focus"`
+	Hops   *string `json:"hops"`
+}
+
+func ViewParamsFromInput(input grammar.InputAttributes) ViewParams {
+	params := ViewParams{}
+	if v, ok := input.Params["name"]; ok {
+		params.Name = strPtr(v)
+	}
+	if v, ok := input.Params["expand"]; ok {
+		params.Expand = strPtr(v)
+	}
+	if v, ok := input.Params["filter"]; ok {
+		params.Filter = strPtr(v)
+	}
+	if v, ok := input.Params["focus"]; ok {
+		params.Focus = strPtr(v)
+	}
+	if v, ok := input.Params["hops"]; ok {
+		params.Hops = strPtr(v)
+	}
+	return params
+}
+
+// ApplyView returns a copy of the World as the View shows it.
+//
+// Items that don't match the Filter are removed, and so are Rel when it filters on status, as with StatusView.
+// With a Focus, only the Items within Hops of it, and their ancestors, are left.
+// Components of an Item that isn't expanded are removed, and their Rel are lifted to the nearest Item that shows.
+func ApplyView(w World, v View) (World, error) {
+	filter, err := parseViewFilter(v.Filter)
+	if err != nil {
+		return nil, err
+	}
+	view, err := Clone(w)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range AllItems(view) {
+		if !filter.matches(item) {
+			view.ItemDelete(item.Id)
+		}
+	}
+	if len(filter.statuses) > 0 {
+		for _, rel := range AllRels(view) {
+			if !slices.Contains(filter.statuses, effectiveStatus(rel.Status)) {
+				view.RelDelete(rel.From.Id, rel.To.Id)
+			}
+		}
+	}
+	if v.Focus != "" {
+		if err := focus(view, v.Focus, v.Hops); err != nil {
+			return nil, err
+		}
+	}
+	if !slices.Contains(v.Expand, ExpandAll) {
+		collapse(view, v.Expand)
+	}
+	return view, nil
+}
+
+// viewFilter is a parsed View.Filter. An Item matches one of the values for each key that has any.
+type viewFilter struct {
+	types    []ItemType
+	tags     []string
+	statuses []Status
+}
+
+func (f viewFilter) matches(item Item) bool {
+	if len(f.types) > 0 && !slices.Contains(f.types, item.Type) {
+		return false
+	}
+	if len(f.tags) > 0 && !slices.ContainsFunc(f.tags, func(tag string) bool { return hasClass(item.Tags, tag) }) {
+		return false
+	}
+	if len(f.statuses) > 0 && !slices.Contains(f.statuses, effectiveStatus(item.Status)) {
+		return false
+	}
+	return true
+}
+
+// parseViewFilter parses comma-separated `key:value` terms, where the key is type, tag, or status.
+// A status is a Status name, or a lifecycle view like AsIs.
+func parseViewFilter(s string) (viewFilter, error) {
+	filter := viewFilter{}
+	for _, term := range strings.Split(s, ",") {
+		if term = strings.TrimSpace(term); term == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(term, ":")
+		switch key {
+		case "type":
+			if t := ItemTypeFromString(value); t != 0 {
+				filter.types = append(filter.types, t)
+				continue
+			}
+		case "tag":
+			if value != "" {
+				filter.tags = append(filter.tags, value)
+				continue
+			}
+		case "status":
+			if statuses, ok := StatusesFromView(value); ok {
+				filter.statuses = append(filter.statuses, statuses...)
+				continue
+			}
+		}
+		return viewFilter{}, errors.New("invalid view filter").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "filter", Value: term})
+	}
+	return filter, nil
+}
+
+// focus removes the Items that are more than the given hops from the focus Item, unless they are an ancestor of one that isn't.
+// A Rel to or from a component counts as one to or from the Item, so the components of the focus stay too.
+func focus(w World, id string, hops int) error {
+	if _, ok := w.ItemFetch(id); !ok {
+		return errors.New("focus not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "focus", Value: id}).WithSuggestions(id, SuggestIds(w, id, SuggestionLimit)...)
+	}
+	reached := map[string]bool{id: true}
+	for _, item := range AllItems(w) {
+		if w.In(item.Id, id, false) {
+			reached[item.Id] = true
+		}
+	}
+	frontier := []string{id}
+	for hop := 0; hop < hops && len(frontier) > 0; hop++ {
+		next := make([]string, 0)
+		for _, current := range frontier {
+			for _, rel := range append(w.RelFrom(current, false), w.RelTo(current, false)...) {
+				for _, neighbor := range []string{rel.From.Id, rel.To.Id} {
+					if !reached[neighbor] {
+						reached[neighbor] = true
+						next = append(next, neighbor)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+	keep := make(map[string]bool)
+	for reachedId := range reached {
+		for ancestorId := reachedId; ancestorId != ""; ancestorId, _ = w.Parent(ancestorId) {
+			keep[ancestorId] = true
+		}
+	}
+	for _, item := range AllItems(w) {
+		if !keep[item.Id] {
+			w.ItemDelete(item.Id)
+		}
+	}
+	return nil
+}
+
+// collapse removes the components of the Items that aren't expanded, and lifts their Rel to the nearest Item that shows.
+// A lifted Rel takes the attributes of the first Rel it stands for, by ID, unless there is a Rel between those Items already.
+func collapse(w World, expand []string) {
+	shown := func(id string) string {
+		ancestors := make([]string, 0)
+		for ancestorId, _ := w.Parent(id); ancestorId != ""; ancestorId, _ = w.Parent(ancestorId) {
+			ancestors = append(ancestors, ancestorId)
+		}
+		for i := len(ancestors) - 1; i >= 0; i-- {
+			if !slices.Contains(expand, ancestors[i]) {
+				return ancestors[i]
+			}
+		}
+		return id
+	}
+	rels := AllRels(w)
+	slices.SortFunc(rels, func(a, b Rel) int { return strings.Compare(a.id(), b.id()) })
+	lifted := make([]Rel, 0)
+	for _, rel := range rels {
+		from, to := shown(rel.From.Id), shown(rel.To.Id)
+		if (from == rel.From.Id && to == rel.To.Id) || from == to {
+			continue
+		}
+		if len(w.RelFetch(from, to, true)) > 0 || slices.ContainsFunc(lifted, func(r Rel) bool { return r.From.Id == from && r.To.Id == to }) {
+			continue
+		}
+		rel.From, rel.To = Item{Id: from}, Item{Id: to}
+		lifted = append(lifted, rel)
+	}
+	hidden := make([]string, 0)
+	for _, item := range AllItems(w) {
+		if shown(item.Id) != item.Id {
+			hidden = append(hidden, item.Id)
+		}
+	}
+	for _, id := range hidden {
+		w.ItemDelete(id)
+	}
+	for _, rel := range lifted {
+		w.RelCreate(rel.From.Id, rel.To.Id, RelParams{
+			Verb:      strPtr(rel.Verb),
+			Mechanism: strPtr(rel.Mechanism),
+			Async:     boolPtr(rel.Async),
+			Status:    strPtr(strconv.Itoa(int(rel.Status))),
+		})
+	}
+}
+
+// sortedViews returns the View sorted by ID.
+func sortedViews(views []View) []View {
+	slices.SortFunc(views, func(a, b View) int { return strings.Compare(a.Id, b.Id) })
+	return views
+}
+
+// --- WORLD OPERATIONS ---
+
+func (w *world) ViewCreate(id string, params ViewParams) WorldWithView {
+	w.resetLatestTrackers()
+	if id == "" {
+		w.latestErr = errors.New("id cannot be empty").UseCode(errors.TopolithErrorInvalid)
+		return w
+	}
+	if _, ok := StatusesFromView(id); ok {
+		// A lifecycle view like AsIs always wins over a saved View, so one with its name could never be used.
+		w.latestErr = errors.New("view name is reserved").UseCode(errors.TopolithErrorConflict).WithData(errors.KvPair{Key: "id", Value: id})
+		return w
+	}
+	if existing, ok := w.Views[id]; ok {
+		w.latestView = &existing
+		if set, err := viewSet(existing, params); err != nil || !ViewEqual(set, existing) {
+			w.latestErr = errors.
+				New("parameter mismatch").
+				UseCode(errors.TopolithErrorConflict).
+				WithData(errors.KvPair{Key: "object", Value: "View"}, errors.KvPair{Key: "existing", Value: existing.String()})
+		}
+		return w
+	}
+	view, err := viewSet(newView(id), params)
+	if err != nil {
+		w.latestErr = err
+		return w
+	}
+	w.Views[id] = view
+	w.latestView = &view
+	return w
+}
+
+func (w *world) ViewDelete(id string) World {
+	w.resetLatestTrackers()
+	delete(w.Views, id)
+	w.dropPins(func(p Pin) bool { return p.View == id })
+	return w
+}
+
+func (w *world) ViewFetch(id string) (View, bool) {
+	view, ok := w.Views[id]
+	return view, ok
+}
+
+func (w *world) ViewList(limit int) []View {
+	views := make([]View, 0)
+	for _, view := range w.Views {
+		if limit > 0 && len(views) >= limit {
+			break
+		}
+		views = append(views, view)
+	}
+	return views
+}
+
+func (w *world) ViewSet(id string, params ViewParams) WorldWithView {
+	w.resetLatestTrackers()
+	view, ok := w.Views[id]
+	if !ok {
+		w.latestErr = errors.New("view not found").UseCode(errors.TopolithErrorNotFound).WithData(errors.KvPair{Key: "id", Value: id})
+		return w
+	}
+	view, err := viewSet(view, params)
+	if err != nil {
+		w.latestErr = err
+		return w
+	}
+	w.Views[id] = view
+	w.latestView = &view
+	return w
+}
+
+func (w *world) View() (View, error) {
+	if w.latestView == nil {
+		return View{}, w.latestErr
+	}
+	return *w.latestView, w.latestErr
+}
+
+// newView returns a View with the given ID that shows the whole World.
+func newView(id string) View {
+	return View{Id: id, Expand: []string{ExpandAll}, Hops: defaultHops}
+}
+
+func viewSet(view View, params ViewParams) (View, error) {
+	if params.Name != nil {
+		view.Name = *params.Name
+	}
+	if params.Expand != nil {
+		view.Expand = make([]string, 0)
+		for _, id := range strings.Split(*params.Expand, ",") {
+			if id = strings.TrimSpace(id); id != "" && !slices.Contains(view.Expand, id) {
+				view.Expand = append(view.Expand, id)
+			}
+		}
+	}
+	if params.Filter != nil {
+		if _, err := parseViewFilter(*params.Filter); err != nil {
+			return view, err
+		}
+		view.Filter = *params.Filter
+	}
+	if params.Focus != nil {
+		view.Focus = *params.Focus
+	}
+	if params.Hops != nil {
+		hops, err := strconv.Atoi(*params.Hops)
+		if err != nil || hops < 0 {
+			return view, errors.New("invalid hops").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "hops", Value: *params.Hops})
+		}
+		view.Hops = hops
+	}
+	return view, nil
+}
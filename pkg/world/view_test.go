@@ -0,0 +1,132 @@
+package world
+
+import (
+	"slices"
+	"testing"
+)
+
+func viewWorld() World {
+	w := CreateWorld("view-world")
+	w.ItemCreate("user", ItemParams{Type: strPtr("person")})
+	w.ItemCreate("payments", ItemParams{Tags: strPtr("pci")})
+	w.ItemCreate("api", ItemParams{Type: strPtr("server"), Tags: strPtr("pci,edge")})
+	w.ItemCreate("db", ItemParams{Type: strPtr("database"), Tags: strPtr("pci")})
+	w.ItemCreate("legacy", ItemParams{Type: strPtr("server"), Status: strPtr("retired")})
+	w.ItemCreate("mail", ItemParams{External: boolPtr(true)})
+	w.Nest("api", "payments")
+	w.Nest("db", "payments")
+	w.RelCreate("user", "api", RelParams{Verb: strPtr("uses")})
+	w.RelCreate("api", "db", RelParams{})
+	w.RelCreate("api", "mail", RelParams{Verb: strPtr("sends"), Async: boolPtr(true)})
+	w.RelCreate("legacy", "db", RelParams{})
+	return w
+}
+
+func TestApplyView(t *testing.T) {
+	w := viewWorld()
+
+	for _, c := range []struct {
+		Name   string
+		Params ViewParams
+		Items  []string
+		Rels   []string
+	}{
+		{"whole world", ViewParams{}, []string{"api", "db", "legacy", "mail", "payments", "user"}, []string{"api->db", "api->mail", "legacy->db", "user->api"}},
+		{"filter type", ViewParams{Filter: strPtr("type:server,type:person")}, []string{"api", "legacy", "user"}, []string{"user->api"}},
+		{"filter tag", ViewParams{Filter: strPtr("tag:pci")}, []string{"api", "db", "payments"}, []string{"api->db"}},
+		{"filter status", ViewParams{Filter: strPtr("status:as-is")}, []string{"api", "db", "mail", "payments", "user"}, []string{"api->db", "api->mail", "user->api"}},
+		{"focus", ViewParams{Focus: strPtr("mail")}, []string{"api", "mail", "payments"}, []string{"api->mail"}},
+		{"focus hops", ViewParams{Focus: strPtr("mail"), Hops: strPtr("2")}, []string{"api", "db", "mail", "payments", "user"}, []string{"api->db", "api->mail", "user->api"}},
+		{"collapse", ViewParams{Expand: strPtr("")}, []string{"legacy", "mail", "payments", "user"}, []string{"legacy->payments", "payments->mail", "user->payments"}},
+		{"expand", ViewParams{Expand: strPtr("payments")}, []string{"api", "db", "legacy", "mail", "payments", "user"}, []string{"api->db", "api->mail", "legacy->db", "user->api"}},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			w.ViewDelete("v")
+			v, err := w.ViewCreate("v", c.Params).View()
+			if err != nil {
+				t.Fatalf("error creating view: %v", err)
+			}
+			viewed, err := ApplyView(w, v)
+			if err != nil {
+				t.Fatalf("error applying view: %v", err)
+			}
+			items := make([]string, 0)
+			for _, item := range viewed.ItemList(0) {
+				items = append(items, item.Id)
+			}
+			slices.Sort(items)
+			rels := make([]string, 0)
+			for _, rel := range viewed.RelList(0) {
+				rels = append(rels, rel.From.Id+"->"+rel.To.Id)
+			}
+			slices.Sort(rels)
+			if !slices.Equal(items, c.Items) {
+				t.Fatalf("expected items %v, got %v", c.Items, items)
+			}
+			if !slices.Equal(rels, c.Rels) {
+				t.Fatalf("expected rels %v, got %v", c.Rels, rels)
+			}
+		})
+	}
+
+	// A lifted Rel takes the attributes of the first Rel it stands for.
+	v, _ := w.ViewSet("v", ViewParams{Expand: strPtr("")}).View()
+	viewed, _ := ApplyView(w, v)
+	if rels := viewed.RelFetch("payments", "mail", true); len(rels) != 1 || rels[0].Verb != "sends" || !rels[0].Async {
+		t.Fatalf("expected the lifted rel to keep its verb and async, got %v", rels)
+	}
+	if _, err := ApplyView(w, View{Id: "lost", Expand: []string{ExpandAll}, Focus: "nowhere"}); err == nil {
+		t.Fatalf("expected an error for a focus that isn't there")
+	}
+}
+
+func TestViewParams(t *testing.T) {
+	w := CreateWorld("view-world")
+	w.ItemCreate("api", ItemParams{})
+
+	for _, c := range []struct {
+		Name   string
+		Params ViewParams
+		Err    bool
+	}{
+		{"valid", ViewParams{Filter: strPtr("type:server, tag:pci, status:to-be"), Hops: strPtr("0")}, false},
+		{"unknown key", ViewParams{Filter: strPtr("owner:payments")}, true},
+		{"unknown type", ViewParams{Filter: strPtr("type:robot")}, true},
+		{"unknown status", ViewParams{Filter: strPtr("status:soon")}, true},
+		{"bad hops", ViewParams{Hops: strPtr("-1")}, true},
+	} {
+		t.Run(c.Name, func(t *testing.T) {
+			err := w.ViewCreate(c.Name, c.Params).Err()
+			if c.Err != (err != nil) {
+				t.Fatalf("expected error %v, got %v", c.Err, err)
+			}
+		})
+	}
+	if err := w.ViewCreate("valid", ViewParams{Focus: strPtr("api")}).Err(); err == nil {
+		t.Fatalf("expected a conflict creating a view that exists with other params")
+	}
+}
+
+func TestViewRoundTrip(t *testing.T) {
+	w := CreateWorld("view-world")
+	w.ItemCreate("payments", ItemParams{})
+	w.ItemCreate("api", ItemParams{})
+	w.Nest("api", "payments")
+	w.ViewCreate("exec-summary", ViewParams{Name: strPtr("Exec summary"), Expand: strPtr(""), Filter: strPtr("status:as-is")})
+	w.ViewCreate("around-api", ViewParams{Expand: strPtr("payments"), Focus: strPtr("api"), Hops: strPtr("2")})
+	w.ViewCreate("all", ViewParams{})
+
+	copied, err := FromString(w.String())
+	if err != nil {
+		t.Fatalf("error reading back the world: %v", err)
+	}
+	if !WorldEqual(w, copied) {
+		t.Fatalf("expected the views to survive a round trip, got:\n%s", copied.String())
+	}
+	if v, _ := copied.ViewFetch("exec-summary"); v.String() != `view "exec-summary" name="Exec summary" expand="" filter="status:as-is"` {
+		t.Fatalf("unexpected view %s", v.String())
+	}
+	if v, _ := copied.ViewFetch("all"); !slices.Equal(v.Expand, []string{ExpandAll}) || v.Hops != 1 {
+		t.Fatalf("expected a view without params to show the whole world, got %v", v)
+	}
+}
@@ -19,27 +19,31 @@ type Info interface {
 	Id() string       // Id is the unique identifier of the World.
 	Name() string     // Name is the display name of the World; meant for humans.
 	Expanded() string // Expanded is the expanded description of the World.
+	Theme() string    // Theme is the name of the theme preset the World renders with, one of Themes.
 
 	SetVersion(version int) World      // SetVersion sets the version of the World.
 	SetId(id string) World             // SetId sets the unique identifier of the World.
 	SetName(name string) World         // SetName sets the display name of the World; meant for humans.
 	SetExpanded(expanded string) World // SetExpanded sets the expanded description of the World.
+	SetTheme(theme string) World       // SetTheme sets the theme preset the World renders with.
 }
 
 // Operations is an interface that represents operations over the state of the World.
 type Operations interface {
 	ItemCreate(id string, params ItemParams) WorldWithItem // ItemCreate creates a new Item in the World, or retrieves it if already exists.
-	ItemDelete(id string) World                            // ItemDelete deletes an Item from the World. If the item doesn't exist, noop.
+	ItemDelete(id string) World                            // ItemDelete deletes an Item from the World, and the Scenario steps to or from it. If the item doesn't exist, noop.
 	ItemFetch(id string) (Item, bool)                      // ItemFetch fetches an Item from the World. Returns an "okay" boolean, which is true only if the Item exists.
-	ItemList(limit int) []Item                             // ItemList returns a list of Items in the World, up to the given limit. A 0 indicates no limit.
+	ItemList(limit int) []Item                             // ItemList returns a list of Items in the World, up to the given limit. A 0 indicates no limit. Archived Items are hidden.
+	ItemListArchived(limit int) []Item                     // ItemListArchived returns a list of the archived Items in the World, up to the given limit. A 0 indicates no limit.
 	ItemSet(id string, params ItemParams) WorldWithItem    // ItemSet sets the not-nil attributes from ItemParams on Item that has the given ID.
 
 	RelCreate(fromId, toId string, params RelParams) WorldWithRel // RelCreate creates a new Rel in the World, or retrieves it if already exists. Returns the empty Rel if either Item doesn't exist.
-	RelDelete(fromId, toId string) World                          // RelDelete deletes a Rel from the World. If the Rel doesn't exist, noop.
-	RelFetch(fromId, toId string, strict bool) []Rel              // RelFetch fetches a Rel from the World. It will traverse the internal World Tree to find the first Rel that matches the fromId OR any descendent of the associated Item, and the toId or any descendent of the associated Item. If strict is true, it will only return the Rel if the fromId and toId match exactly.
-	RelTo(toId string, strict bool) []Rel                         // RelTo fetches a Rel from the World. It will traverse the internal World Tree to find the first Rel that matches the fromId OR any descendent of the associated Item, and the toId or any descendent of the associated Item. If strict is true, it will only return the Rel if the fromId and toId match exactly.
-	RelFrom(fromId string, strict bool) []Rel                     // RelFrom fetches a Rel from the World. It will traverse the internal World Tree to find the first Rel that matches the fromId OR any descendent of the associated Item, and the toId or any descendent of the associated Item. If strict is true, it will only return the Rel if the fromId and toId match exactly.
-	RelList(limit int) []Rel                                      // RelList returns a list of Rels in the World, up to the given limit. A 0 indicates no limit.
+	RelDelete(fromId, toId string) World                          // RelDelete deletes a Rel from the World, and the Scenario steps that only followed it. If the Rel doesn't exist, noop.
+	RelFetch(fromId, toId string, strict bool) []Rel              // RelFetch fetches a Rel from the World. It will traverse the internal World Tree to find the first Rel that matches the fromId OR any descendent of the associated Item, and the toId or any descendent of the associated Item. If strict is true, it will only return the Rel if the fromId and toId match exactly. Otherwise, archived Rel are hidden, except the Rel between the given Items.
+	RelTo(toId string, strict bool) []Rel                         // RelTo fetches the Rel to the Item with the given toId, or to any descendent of the Item. If strict is true, it will only return the Rel to the Item itself. Otherwise, archived Rel are hidden.
+	RelFrom(fromId string, strict bool) []Rel                     // RelFrom fetches the Rel from the Item with the given fromId, or from any descendent of the Item. If strict is true, it will only return the Rel from the Item itself. Otherwise, archived Rel are hidden.
+	RelList(limit int) []Rel                                      // RelList returns a list of Rels in the World, up to the given limit. A 0 indicates no limit. Archived Rel are hidden.
+	RelListArchived(limit int) []Rel                              // RelListArchived returns a list of the archived Rels in the World, up to the given limit. A 0 indicates no limit.
 	RelSet(fromId, toId string, params RelParams) WorldWithRel    // RelSet sets the not-nil attributes from RelParams on Rel that has the given fromId and toId.
 
 	In(childId, parentId string, strict bool) bool // In checks if a child Item is nested anywhere under a parent Item. If strict is true, it will only return true if the childId and parentId match exactly.
@@ -49,6 +53,41 @@ type Operations interface {
 	ItemComponents(id string) ([]Item, bool)       // ItemComponents returns the IDs of the child Items of the given parent Item. An empty slice is returned if the parent Item has no children. The okay boolean is false if the parent Item isn't found.
 	Nest(childId, parentId string) WorldWithItem   // Nest nests a child Item under a parent Item. If the parent doesn't exist, noop.
 	Free(childId string) WorldWithItem             // Free removes an Item from its parent to the root. If the Item doesn't exist, noop.
+	Archived(id string) bool                       // Archived checks if the Item is archived, or nested anywhere under an archived Item. A Rel is archived if either of its Items is.
+	Resolve(path string) []string                  // Resolve returns the IDs of the Items at the given ID or path through the Tree (ex: `payments.api.db`), sorted. An exact ID wins. More than one ID means the path is ambiguous.
+
+	NodeCreate(id string, params NodeParams) WorldWithNode // NodeCreate creates a new DeploymentNode in the World, or retrieves it if already exists. Without a kind, it is an environment at the root, and a node anywhere else.
+	NodeDelete(id string) World                            // NodeDelete deletes a DeploymentNode and what is deployed to it from the World. We error if other DeploymentNode are in it. If it doesn't exist, noop.
+	NodeFetch(id string) (DeploymentNode, bool)            // NodeFetch fetches a DeploymentNode from the World. Returns an "okay" boolean, which is true only if the DeploymentNode exists.
+	NodeList(limit int) []DeploymentNode                   // NodeList returns a list of DeploymentNode in the World, up to the given limit. A 0 indicates no limit.
+	NodeSet(id string, params NodeParams) WorldWithNode    // NodeSet sets the not-nil attributes from NodeParams on the DeploymentNode that has the given ID.
+	Deploy(itemId, nodeId string) World                    // Deploy deploys an Item to a DeploymentNode. We error if either doesn't exist, or the Item is already deployed there.
+	Undeploy(itemId, nodeId string) World                  // Undeploy removes an Item from a DeploymentNode. We error if it isn't deployed there.
+
+	ScenarioCreate(id string, params ScenarioParams) WorldWithScenario   // ScenarioCreate creates a new Scenario in the World without steps, or retrieves it if already exists.
+	ScenarioDelete(id string) World                                      // ScenarioDelete deletes a Scenario and its steps from the World. If it doesn't exist, noop.
+	ScenarioFetch(id string) (Scenario, bool)                            // ScenarioFetch fetches a Scenario from the World. Returns an "okay" boolean, which is true only if the Scenario exists.
+	ScenarioList(limit int) []Scenario                                   // ScenarioList returns a list of Scenario in the World, up to the given limit. A 0 indicates no limit.
+	ScenarioSet(id string, params ScenarioParams) WorldWithScenario      // ScenarioSet sets the not-nil attributes from ScenarioParams on the Scenario that has the given ID.
+	ScenarioStep(id string, step ScenarioStep, at int) WorldWithScenario // ScenarioStep adds a step to a Scenario at a position, where 1 is the first, or at the end for 0. We error if the step doesn't follow a Rel, either way, or its label has a quote.
+	ScenarioUnstep(id string, at int) WorldWithScenario                  // ScenarioUnstep removes the step at a position from a Scenario, where 1 is the first.
+
+	ViewCreate(id string, params ViewParams) WorldWithView // ViewCreate creates a new View in the World, or retrieves it if already exists. Without params, it shows the whole World. We error if the ID is the name of a lifecycle view, like AsIs.
+	ViewDelete(id string) World                            // ViewDelete deletes a View from the World. If it doesn't exist, noop.
+	ViewFetch(id string) (View, bool)                      // ViewFetch fetches a View from the World. Returns an "okay" boolean, which is true only if the View exists.
+	ViewList(limit int) []View                             // ViewList returns a list of View in the World, up to the given limit. A 0 indicates no limit.
+	ViewSet(id string, params ViewParams) WorldWithView    // ViewSet sets the not-nil attributes from ViewParams on the View that has the given ID.
+
+	PinSet(view, id, toId string, params PinParams) WorldWithPin // PinSet pins an Item, or the Rel to the Item with toId, in the View with the given ID, or in the whole World for an empty one. It creates the Pin, or sets the not-nil attributes from PinParams if it exists.
+	PinDelete(view, id, toId string) World                       // PinDelete deletes the Pin for an Item, or a Rel, in the View. If it doesn't exist, noop.
+	PinFetch(view, id, toId string) (Pin, bool)                  // PinFetch fetches the Pin for an Item, or a Rel, in the View. Returns an "okay" boolean, which is true only if the Pin exists.
+	PinList(limit int) []Pin                                     // PinList returns a list of the Pin in every View, up to the given limit. A 0 indicates no limit.
+
+	StyleCreate(id string, params StyleParams) WorldWithStyle // StyleCreate creates a new Style in the World, or retrieves it if already exists.
+	StyleDelete(id string) World                              // StyleDelete deletes a Style from the World. If it doesn't exist, noop.
+	StyleFetch(id string) (Style, bool)                       // StyleFetch fetches a Style from the World. Returns an "okay" boolean, which is true only if the Style exists.
+	StyleList(limit int) []Style                              // StyleList returns a list of Style in the World, up to the given limit. A 0 indicates no limit.
+	StyleSet(id string, params StyleParams) WorldWithStyle    // StyleSet sets the not-nil attributes from StyleParams on the Style that has the given ID.
 
 	Err() error // Err returns an error if the last operation failed, or nil if it succeeded.
 }
@@ -72,6 +111,36 @@ type WorldWithRel interface {
 	Rel() (Rel, error)
 }
 
+// WorldWithNode is an interface that represents a World with a possible DeploymentNode from the last operation.
+type WorldWithNode interface {
+	World
+	Node() (DeploymentNode, error)
+}
+
+// WorldWithScenario is an interface that represents a World with a possible Scenario from the last operation.
+type WorldWithScenario interface {
+	World
+	Scenario() (Scenario, error)
+}
+
+// WorldWithView is an interface that represents a World with a possible View from the last operation.
+type WorldWithView interface {
+	World
+	View() (View, error)
+}
+
+// WorldWithPin is an interface that represents a World with a possible Pin from the last operation.
+type WorldWithPin interface {
+	World
+	Pin() (Pin, error)
+}
+
+// WorldWithStyle is an interface that represents a World with a possible Style from the last operation.
+type WorldWithStyle interface {
+	World
+	Style() (Style, error)
+}
+
 // WorldWithBoth is an interface that represents a World with a possible Item and Rel from the last operation.
 type WorldWithBoth interface {
 	WorldWithItem
@@ -93,11 +162,15 @@ type WorldWithBoth interface {
 // ```
 func FromString(s string) (World, error) {
 	p, err := grammar.Parse(s)
+	if pe, ok := err.(errors.TopolithError); ok {
+		// The grammar tells us where parsing failed, which is more useful than the whole input.
+		return nil, pe.WithDescription("error parsing World")
+	}
 	if err != nil {
 		return nil, errors.New("error parsing World").UseCode(errors.TopolithErrorInvalid).WithError(err).WithDescription("error parsing World").WithData(errors.KvPair{Key: "input", Value: s})
 	}
 
-	w := CreateWorld(p.WorldParams["name"])
+	w := CreateWorld(strings.Trim(p.WorldParams["name"], `"`))
 
 	if version, err := strconv.Atoi(p.WorldParams["version"]); err == nil && version != 0 {
 		w.SetVersion(version)
@@ -105,8 +178,11 @@ func FromString(s string) (World, error) {
 	if p.WorldParams["id"] != "" {
 		w.SetId(p.WorldParams["id"])
 	}
-	if p.WorldParams["expanded"] != "" {
-		w.SetExpanded(p.WorldParams["expanded"])
+	if expanded := strings.Trim(p.WorldParams["expanded"], `"`); expanded != "" {
+		w.SetExpanded(expanded)
+	}
+	if theme := p.WorldParams["theme"]; theme != "" {
+		w.SetTheme(theme)
 	}
 
 	for _, itemStr := range p.ItemStrings {
@@ -115,11 +191,20 @@ func FromString(s string) (World, error) {
 			return nil, err
 		}
 		w.ItemCreate(item.Id, ItemParams{
-			Name:      strPtr(item.Name),
-			Expanded:  strPtr(item.Expanded),
-			External:  boolPtr(item.External),
-			Type:      strPtr(strconv.Itoa(int(item.Type))),
-			Mechanism: strPtr(item.Mechanism),
+			Name:           strPtr(item.Name),
+			Expanded:       strPtr(item.Expanded),
+			External:       boolPtr(item.External),
+			Type:           strPtr(strconv.Itoa(int(item.Type))),
+			Mechanism:      strPtr(item.Mechanism),
+			Status:         strPtr(strconv.Itoa(int(item.Status))),
+			Archived:       boolPtr(item.Archived),
+			Owner:          strPtr(item.Owner),
+			Contacts:       strPtr(item.Contacts),
+			Source:         strPtr(item.Source),
+			Links:          &item.Links,
+			Classification: strPtr(item.Classification),
+			Boundary:       strPtr(item.Boundary),
+			Tags:           strPtr(item.Tags),
 		})
 	}
 
@@ -147,13 +232,92 @@ func FromString(s string) (World, error) {
 			return nil, err
 		}
 		w.RelCreate(rel.From.Id, rel.To.Id, RelParams{
-			Verb:      strPtr(rel.Verb),
-			Mechanism: strPtr(rel.Mechanism),
-			Async:     boolPtr(rel.Async),
-			Expanded:  strPtr(rel.Expanded),
+			Verb:           strPtr(rel.Verb),
+			Mechanism:      strPtr(rel.Mechanism),
+			Async:          boolPtr(rel.Async),
+			Expanded:       strPtr(rel.Expanded),
+			Status:         strPtr(strconv.Itoa(int(rel.Status))),
+			Links:          &rel.Links,
+			Classification: strPtr(rel.Classification),
 		})
 	}
 
+	nodes := make([]DeploymentNode, len(p.NodeStrings))
+	for i, nodeStr := range p.NodeStrings {
+		node, err := NodeFromString(nodeStr)
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = node
+	}
+	for _, node := range HierarchyOrder(nodes) {
+		kind := StringFromDeploymentKind(node.Kind)
+		if _, err := w.NodeCreate(node.Id, NodeParams{Kind: &kind, Name: strPtr(node.Name), Mechanism: strPtr(node.Mechanism), Parent: strPtr(node.Parent)}).Node(); err != nil {
+			return nil, err
+		}
+	}
+	for _, deployStr := range p.DeployStrings {
+		dp, err := grammar.Parse(deployStr)
+		if err != nil {
+			return nil, errors.New("error parsing Deployment").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "input", Value: deployStr})
+		}
+		if err := w.Deploy(dp.InputAttributes.ResourceId, dp.InputAttributes.SecondaryIds[0]).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, scenarioStr := range p.ScenarioStrings {
+		scenario, err := ScenarioFromString(scenarioStr)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.ScenarioCreate(scenario.Id, ScenarioParams{Name: strPtr(scenario.Name)}).Err(); err != nil {
+			return nil, err
+		}
+	}
+	for _, stepStr := range p.StepStrings {
+		sp, err := grammar.Parse(stepStr)
+		if err != nil {
+			return nil, errors.New("error parsing Step").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "input", Value: stepStr})
+		}
+		step, at, err := StepFromInput(sp.InputAttributes)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.ScenarioStep(sp.InputAttributes.ResourceId, step, at).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, viewStr := range p.ViewStrings {
+		vp, err := grammar.Parse(viewStr)
+		if err != nil {
+			return nil, errors.New("error parsing View").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "input", Value: viewStr})
+		}
+		if err := w.ViewCreate(vp.InputAttributes.ResourceId, ViewParamsFromInput(vp.InputAttributes)).Err(); err != nil {
+			return nil, err
+		}
+	}
+	for _, pinStr := range p.PinStrings {
+		pp, err := grammar.Parse(pinStr)
+		if err != nil {
+			return nil, errors.New("error parsing Pin").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "input", Value: pinStr})
+		}
+		view, id, toId := PinTargetFromInput(pp.InputAttributes)
+		if err := w.PinSet(view, id, toId, PinParamsFromInput(pp.InputAttributes)).Err(); err != nil {
+			return nil, err
+		}
+	}
+	for _, styleStr := range p.StyleStrings {
+		sp, err := grammar.Parse(styleStr)
+		if err != nil {
+			return nil, errors.New("error parsing Style").UseCode(errors.TopolithErrorInvalid).WithError(err).WithData(errors.KvPair{Key: "input", Value: styleStr})
+		}
+		if err := w.StyleCreate(sp.InputAttributes.ResourceId, StyleParamsFromInput(sp.InputAttributes)).Err(); err != nil {
+			return nil, err
+		}
+	}
+
 	return w, nil
 }
 
@@ -171,10 +335,13 @@ func WorldEqual(w1, w2 World) bool {
 	if w1.Expanded() != w2.Expanded() {
 		return false
 	}
+	if w1.Theme() != w2.Theme() {
+		return false
+	}
 
 	// Compare items.
-	items1 := w1.ItemList(0)
-	items2 := w2.ItemList(0)
+	items1 := AllItems(w1)
+	items2 := AllItems(w2)
 	if len(items1) != len(items2) {
 		return false
 	}
@@ -208,23 +375,124 @@ func WorldEqual(w1, w2 World) bool {
 	}
 
 	// Compare relationships.
-	rels1 := w1.RelList(0)
-	rels2 := w2.RelList(0)
+	rels1 := AllRels(w1)
+	rels2 := AllRels(w2)
 	if len(rels1) != len(rels2) {
 		return false
 	}
 	sort.Slice(rels1, func(i, j int) bool {
-		return rels1[i].From.Id < rels1[j].From.Id
+		return rels1[i].id() < rels1[j].id()
 	})
 	sort.Slice(rels2, func(i, j int) bool {
-		return rels2[i].From.Id < rels2[j].From.Id
+		return rels2[i].id() < rels2[j].id()
 	})
 	for i, rel1 := range rels1 {
 		if !RelEqual(rel1, rels2[i]) {
 			return false
 		}
 	}
-	return true
+
+	// Compare deployment.
+	nodes1 := sortedNodes(w1.NodeList(0))
+	nodes2 := sortedNodes(w2.NodeList(0))
+	if !slices.EqualFunc(nodes1, nodes2, NodeEqual) {
+		return false
+	}
+
+	// Compare scenarios.
+	scenarios1 := sortedScenarios(w1.ScenarioList(0))
+	scenarios2 := sortedScenarios(w2.ScenarioList(0))
+	if !slices.EqualFunc(scenarios1, scenarios2, ScenarioEqual) {
+		return false
+	}
+
+	// Compare views.
+	if !slices.EqualFunc(sortedViews(w1.ViewList(0)), sortedViews(w2.ViewList(0)), ViewEqual) {
+		return false
+	}
+
+	// Compare pins.
+	if !slices.EqualFunc(sortedPins(w1.PinList(0)), sortedPins(w2.PinList(0)), PinEqual) {
+		return false
+	}
+
+	// Compare styles.
+	return slices.EqualFunc(SortedStyles(w1), SortedStyles(w2), StyleEqual)
+}
+
+// AllItems returns every Item in the World, archived or not.
+func AllItems(w World) []Item {
+	return append(w.ItemList(0), w.ItemListArchived(0)...)
+}
+
+// AllRels returns every Rel in the World, archived or not.
+func AllRels(w World) []Rel {
+	return append(w.RelList(0), w.RelListArchived(0)...)
+}
+
+// ReplaceWorld replaces the state of dst with the state of src, in place.
+// Anything holding a reference to dst will see the new state. The src World should not be used afterward.
+func ReplaceWorld(dst, src World) error {
+	d, ok := dst.(*world)
+	if !ok {
+		return errors.New("cannot replace unknown World implementation").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "type", Value: fmt.Sprintf("%T", dst)})
+	}
+	s, ok := src.(*world)
+	if !ok {
+		return errors.New("cannot replace with unknown World implementation").UseCode(errors.TopolithErrorInvalid).WithData(errors.KvPair{Key: "type", Value: fmt.Sprintf("%T", src)})
+	}
+	*d = *s
+	d.resetLatestTrackers()
+	return nil
+}
+
+// InfoParams is a struct that represents the parameters that can be set on the Info of a World.
+type InfoParams struct {
+	Id       *string `json:"id"`
+	Name     *string `json:"name"`
+	Expanded *string `json:"expanded"`
+	Theme    *string `json:"theme"`
+	Version  *int    `json:"version"`
+}
+
+func InfoParamsFromInput(input grammar.InputAttributes) InfoParams {
+	params := InfoParams{}
+	if v, ok := input.Params["id"]; ok {
+		params.Id = strPtr(v)
+	}
+	if v, ok := input.Params["name"]; ok {
+		params.Name = strPtr(v)
+	}
+	if v, ok := input.Params["expanded"]; ok {
+		params.Expanded = strPtr(v)
+	}
+	if v, ok := input.Params["theme"]; ok {
+		params.Theme = strPtr(v)
+	}
+	if v, err := strconv.Atoi(input.Params["version"]); err == nil {
+		params.Version = &v
+	}
+	return params
+}
+
+// InfoSet sets the not-nil attributes from InfoParams on the given World.
+func InfoSet(w World, params InfoParams) World {
+	if params.Id != nil {
+		w.SetId(*params.Id)
+	}
+	if params.Name != nil {
+		w.SetName(*params.Name)
+	}
+	if params.Expanded != nil {
+		w.SetExpanded(*params.Expanded)
+	}
+	if params.Theme != nil {
+		w.SetTheme(*params.Theme)
+	}
+	if params.Version != nil {
+		w.SetVersion(*params.Version)
+	}
+	return w
 }
 
 // world is the internal implementation of the WorldWithBoth interface.
@@ -234,14 +502,26 @@ type world struct {
 	Id_       string `json:"id"`       // Id_ is the unique identifier of the World.
 	Name_     string `json:"name"`     // Name_ is the display name of the World; meant for humans.
 	Expanded_ string `json:"expanded"` // Expanded_ is the expanded description of the World.
+	Theme_    string `json:"theme"`    // Theme_ is the name of the theme preset the World renders with.
 
 	Items map[string]Item `json:"items"` // Items is a map of ID string to related Item.
 	Rels  map[string]Rel  `json:"rels"`  // Rels is a map of `Rel.From.Id` to related Rel.
 	Tree  Tree            `json:"tree"`  // Tree is a tree representation of the World.
 
-	latestItem *Item // latestItem is the last Item that was created or modified. This will be returned by the Item() method.
-	latestRel  *Rel  // latestRel is the last Rel that was created or modified. This will be returned by the Rel() method.
-	latestErr  error // latestErr is any error that occurred during the most recent operation.
+	Nodes     map[string]DeploymentNode `json:"nodes"`     // Nodes is a map of ID string to related DeploymentNode, with the Items deployed to each.
+	Scenarios map[string]Scenario       `json:"scenarios"` // Scenarios is a map of ID string to related Scenario, with its steps.
+	Views     map[string]View           `json:"views"`     // Views is a map of ID string to related View.
+	Pins      map[string]Pin            `json:"pins"`      // Pins is a map of the View, Item and Rel they pin to related Pin.
+	Styles    map[string]Style          `json:"styles"`    // Styles is a map of ID string to related Style.
+
+	latestItem     *Item           // latestItem is the last Item that was created or modified. This will be returned by the Item() method.
+	latestRel      *Rel            // latestRel is the last Rel that was created or modified. This will be returned by the Rel() method.
+	latestNode     *DeploymentNode // latestNode is the last DeploymentNode that was created or modified. This will be returned by the Node() method.
+	latestScenario *Scenario       // latestScenario is the last Scenario that was created or modified. This will be returned by the Scenario() method.
+	latestView     *View           // latestView is the last View that was created or modified. This will be returned by the View() method.
+	latestPin      *Pin            // latestPin is the last Pin that was created or modified. This will be returned by the Pin() method.
+	latestStyle    *Style          // latestStyle is the last Style that was created or modified. This will be returned by the Style() method.
+	latestErr      error           // latestErr is any error that occurred during the most recent operation.
 }
 
 func CreateWorld(name string) World {
@@ -250,12 +530,23 @@ func CreateWorld(name string) World {
 		Id_:       name,
 		Name_:     name,
 		Expanded_: "",
+		Theme_:    ThemeC4,
 		Items:     make(map[string]Item),
 		Rels:      make(map[string]Rel),
 		Tree:      newTree(nil, nil),
-
-		latestItem: &Item{},
-		latestRel:  &Rel{},
+		Nodes:     make(map[string]DeploymentNode),
+		Scenarios: make(map[string]Scenario),
+		Views:     make(map[string]View),
+		Pins:      make(map[string]Pin),
+		Styles:    make(map[string]Style),
+
+		latestItem:     &Item{},
+		latestRel:      &Rel{},
+		latestNode:     &DeploymentNode{},
+		latestScenario: &Scenario{},
+		latestView:     &View{},
+		latestPin:      &Pin{},
+		latestStyle:    &Style{},
 	}
 }
 
@@ -265,11 +556,41 @@ func (w *world) String() string {
 	for _, rel := range w.Rels {
 		allRels = append(allRels, rel.String())
 	}
-	return fmt.Sprintf("$$world\nversion=%d\nid=%s\nname=%s\nexpanded=%s\n%s\n%s\nendworld$$",
+	// Parents come before the DeploymentNode in them, so the World reads back in order.
+	for _, node := range HierarchyOrder(w.NodeList(0)) {
+		allRels = append(allRels, node.String())
+	}
+	for _, deployment := range AllDeployments(w) {
+		allRels = append(allRels, deployment.String())
+	}
+	// Scenarios come before their steps, and steps keep their order.
+	scenarios := sortedScenarios(w.ScenarioList(0))
+	for _, scenario := range scenarios {
+		allRels = append(allRels, scenario.String())
+	}
+	for _, scenario := range scenarios {
+		allRels = append(allRels, scenario.StepStrings()...)
+	}
+	for _, view := range sortedViews(w.ViewList(0)) {
+		allRels = append(allRels, view.String())
+	}
+	for _, pin := range sortedPins(w.PinList(0)) {
+		allRels = append(allRels, pin.String())
+	}
+	for _, style := range SortedStyles(w) {
+		allRels = append(allRels, style.String())
+	}
+	// The default theme is left out, so Worlds from before themes read back the same.
+	theme := ""
+	if w.Theme_ != ThemeC4 {
+		theme = fmt.Sprintf("theme=%s\n", w.Theme_)
+	}
+	return fmt.Sprintf("$$world\nversion=%d\nid=\"%s\"\nname=\"%s\"\nexpanded=\"%s\"\n%s%s\n%s\nendworld$$",
 		w.Version_,
 		w.Id_,
 		w.Name_,
 		w.Expanded_,
+		theme,
 		treeString,
 		strings.Join(allRels, "\n"),
 	)
@@ -291,6 +612,10 @@ func (w *world) Expanded() string {
 	return w.Expanded_
 }
 
+func (w *world) Theme() string {
+	return w.Theme_
+}
+
 func (w *world) SetVersion(version int) World {
 	w.Version_ = version
 	return w
@@ -311,6 +636,11 @@ func (w *world) SetExpanded(expanded string) World {
 	return w
 }
 
+func (w *world) SetTheme(theme string) World {
+	w.Theme_ = theme
+	return w
+}
+
 func (w *world) ItemCreate(id string, params ItemParams) WorldWithItem {
 	w.resetLatestTrackers()
 	if id == "" {
@@ -329,12 +659,12 @@ func (w *world) ItemCreate(id string, params ItemParams) WorldWithItem {
 		Id: id,
 	}
 	w.Items[id] = item
-	w.ItemSet(id, params) // After we set in the tracking map on World.
-	w.latestItem = &item
 	if err := w.Tree.AddOrMove(&item); err != nil {
 		// This shouldn't happen if we're properly syncing the Items map with Tree...
 		w.latestErr = err
+		return w
 	}
+	w.ItemSet(id, params) // After we set in the tracking map on World and Tree.
 	return w
 }
 
@@ -346,11 +676,14 @@ func (w *world) ItemDelete(id string) World {
 	}
 	delete(w.Items, id)
 	w.Tree.Delete(id)
+	w.undeployItem(id)
 	for k, rel := range w.Rels {
 		if rel.From.Id == id || rel.To.Id == id {
 			delete(w.Rels, k)
 		}
 	}
+	w.dropBrokenSteps()
+	w.dropBrokenPins()
 	return w
 }
 
@@ -360,12 +693,23 @@ func (w *world) ItemFetch(id string) (Item, bool) {
 }
 
 func (w *world) ItemList(limit int) []Item {
+	return w.itemList(limit, false)
+}
+
+func (w *world) ItemListArchived(limit int) []Item {
+	return w.itemList(limit, true)
+}
+
+// itemList returns the Items that are archived, or not, up to the given limit. A 0 indicates no limit.
+func (w *world) itemList(limit int, archived bool) []Item {
 	items := make([]Item, 0)
 	for _, item := range w.Items {
 		if limit > 0 && len(items) >= limit {
 			break
 		}
-		items = append(items, item)
+		if w.Archived(item.Id) == archived {
+			items = append(items, item)
+		}
 	}
 	return items
 }
@@ -377,7 +721,8 @@ func (w *world) ItemSet(id string, params ItemParams) WorldWithItem {
 		w.latestErr = errors.
 			New("item not found").
 			UseCode(errors.TopolithErrorNotFound).
-			WithData(errors.KvPair{Key: "id", Value: id})
+			WithData(errors.KvPair{Key: "id", Value: id}).
+			WithSuggestions(id, SuggestIds(w, id, SuggestionLimit)...)
 		return w
 	}
 	item, err := itemSet(item, params)
@@ -386,6 +731,19 @@ func (w *world) ItemSet(id string, params ItemParams) WorldWithItem {
 	}
 	w.Items[id] = item
 	w.latestItem = &item
+	if node, ok := w.Tree.Find(id); ok {
+		node.(*tree).item = &item // Keep the Tree in sync with the Items map.
+	}
+	for k, rel := range w.Rels {
+		// Keep the Rels in sync with the Items map, too.
+		if rel.From.Id == id {
+			rel.From = item
+		}
+		if rel.To.Id == id {
+			rel.To = item
+		}
+		w.Rels[k] = rel
+	}
 	return w
 }
 
@@ -401,8 +759,8 @@ func itemSet(item Item, params ItemParams) (Item, error) {
 		item.External = *params.External
 	}
 	if params.Type != nil {
-		if iotaType, err := strconv.Atoi(*params.Type); err == nil {
-			item.Type = ItemType(iotaType)
+		if itemType, err := itemTypeFromParam(*params.Type); err == nil {
+			item.Type = itemType
 		} else {
 			errs = append(errs, err)
 		}
@@ -410,6 +768,41 @@ func itemSet(item Item, params ItemParams) (Item, error) {
 	if params.Mechanism != nil {
 		item.Mechanism = *params.Mechanism
 	}
+	if params.Status != nil {
+		if status, err := statusFromParam(*params.Status); err == nil {
+			item.Status = status
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	if params.Archived != nil {
+		item.Archived = *params.Archived
+	}
+	if params.Owner != nil {
+		item.Owner = *params.Owner
+	}
+	if params.Contacts != nil {
+		item.Contacts = *params.Contacts
+	}
+	if params.Source != nil {
+		item.Source = *params.Source
+	}
+	if params.Links != nil {
+		if links, err := linksFromParam(*params.Links); err == nil {
+			item.Links = links
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	if params.Classification != nil {
+		item.Classification = *params.Classification
+	}
+	if params.Boundary != nil {
+		item.Boundary = *params.Boundary
+	}
+	if params.Tags != nil {
+		item.Tags = *params.Tags
+	}
 	if len(errs) > 0 {
 		return item, errors.New("error setting Item params").UseCode(errors.TopolithErrorConflict).WithError(errs...)
 	}
@@ -447,15 +840,17 @@ func (w *world) RelCreate(fromId, toId string, params RelParams) WorldWithRel {
 		w.latestErr = errors.
 			New("fromId for Rel not found").
 			UseCode(errors.TopolithErrorNotFound).
-			WithData(errors.KvPair{Key: "fromId", Value: fromId})
+			WithData(errors.KvPair{Key: "fromId", Value: fromId}).
+			WithSuggestions(fromId, SuggestIds(w, fromId, SuggestionLimit)...)
 		return w
 	}
 	toItem, ok := w.ItemFetch(toId)
 	if !ok {
 		w.latestErr = errors.
-			New("fromId for Rel not found").
+			New("toId for Rel not found").
 			UseCode(errors.TopolithErrorNotFound).
-			WithData(errors.KvPair{Key: "fromId", Value: fromId})
+			WithData(errors.KvPair{Key: "toId", Value: toId}).
+			WithSuggestions(toId, SuggestIds(w, toId, SuggestionLimit)...)
 		return w
 	}
 	existing, ok := w.Rels[relIdFromIds(fromId, toId)]
@@ -473,13 +868,14 @@ func (w *world) RelCreate(fromId, toId string, params RelParams) WorldWithRel {
 	}
 	w.Rels[rel.id()] = rel
 	w.RelSet(fromId, toId, params) // After we set in the tracking map on World.
-	w.latestRel = &rel
 	return w
 }
 
 func (w *world) RelDelete(fromId, toId string) World {
 	w.resetLatestTrackers()
 	delete(w.Rels, relIdFromIds(fromId, toId))
+	w.dropBrokenSteps()
+	w.dropPins(func(p Pin) bool { return p.Id == fromId && p.To == toId })
 	return w
 }
 
@@ -495,22 +891,34 @@ func (w *world) RelFetch(fromId, toId string, strict bool) []Rel {
 	rels := make([]Rel, 0)
 	leftIds := append(w.Tree.GetDescendantIds(fromId), fromId)
 	rightIds := append(w.Tree.GetDescendantIds(toId), toId)
+	exactId := relIdFromIds(fromId, toId)
 	for _, rel := range w.Rels {
-		if slices.Contains(leftIds, rel.From.Id) && slices.Contains(rightIds, rel.To.Id) {
+		// An archived Rel can still be fetched by its own Items, like an archived Item by its ID.
+		if slices.Contains(leftIds, rel.From.Id) && slices.Contains(rightIds, rel.To.Id) && (!w.relArchived(rel) || rel.id() == exactId) {
 			rels = append(rels, rel)
 		}
 	}
+	// The Rel between the given Items comes first, if it exists.
+	slices.SortFunc(rels, func(a, b Rel) int {
+		if (a.id() == exactId) != (b.id() == exactId) {
+			if a.id() == exactId {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.id(), b.id())
+	})
 	return rels
 }
 
 func (w *world) RelTo(toId string, strict bool) []Rel {
 	rels := make([]Rel, 0)
 	rightIds := []string{toId}
-	if strict {
+	if !strict {
 		rightIds = append(w.Tree.GetDescendantIds(toId), toId)
 	}
 	for _, rel := range w.Rels {
-		if slices.Contains(rightIds, rel.To.Id) {
+		if slices.Contains(rightIds, rel.To.Id) && (strict || !w.relArchived(rel)) {
 			rels = append(rels, rel)
 		}
 	}
@@ -520,11 +928,11 @@ func (w *world) RelTo(toId string, strict bool) []Rel {
 func (w *world) RelFrom(fromId string, strict bool) []Rel {
 	rels := make([]Rel, 0)
 	leftIds := []string{fromId}
-	if strict {
+	if !strict {
 		leftIds = append(w.Tree.GetDescendantIds(fromId), fromId)
 	}
-	for k, rel := range w.Rels {
-		if slices.Contains(leftIds, k) {
+	for _, rel := range w.Rels {
+		if slices.Contains(leftIds, rel.From.Id) && (strict || !w.relArchived(rel)) {
 			rels = append(rels, rel)
 		}
 	}
@@ -532,16 +940,32 @@ func (w *world) RelFrom(fromId string, strict bool) []Rel {
 }
 
 func (w *world) RelList(limit int) []Rel {
+	return w.relList(limit, false)
+}
+
+func (w *world) RelListArchived(limit int) []Rel {
+	return w.relList(limit, true)
+}
+
+// relList returns the Rels that are archived, or not, up to the given limit. A 0 indicates no limit.
+func (w *world) relList(limit int, archived bool) []Rel {
 	rels := make([]Rel, 0)
 	for _, rel := range w.Rels {
 		if limit > 0 && len(rels) >= limit {
 			break
 		}
-		rels = append(rels, rel)
+		if w.relArchived(rel) == archived {
+			rels = append(rels, rel)
+		}
 	}
 	return rels
 }
 
+// relArchived checks if either Item of the Rel is archived.
+func (w *world) relArchived(rel Rel) bool {
+	return w.Archived(rel.From.Id) || w.Archived(rel.To.Id)
+}
+
 func (w *world) RelSet(fromId, toId string, params RelParams) WorldWithRel {
 	w.resetLatestTrackers()
 	rel, ok := w.Rels[relIdFromIds(fromId, toId)]
@@ -562,6 +986,20 @@ func (w *world) RelSet(fromId, toId string, params RelParams) WorldWithRel {
 }
 
 func relSet(rel Rel, params RelParams) (Rel, error) {
+	if params.Status != nil {
+		status, err := statusFromParam(*params.Status)
+		if err != nil {
+			return rel, errors.New("error setting Rel params").UseCode(errors.TopolithErrorConflict).WithError(err)
+		}
+		rel.Status = status
+	}
+	if params.Links != nil {
+		links, err := linksFromParam(*params.Links)
+		if err != nil {
+			return rel, errors.New("error setting Rel params").UseCode(errors.TopolithErrorConflict).WithError(err)
+		}
+		rel.Links = links
+	}
 	if params.Verb != nil {
 		rel.Verb = *params.Verb
 	}
@@ -574,6 +1012,9 @@ func relSet(rel Rel, params RelParams) (Rel, error) {
 	if params.Expanded != nil {
 		rel.Expanded = *params.Expanded
 	}
+	if params.Classification != nil {
+		rel.Classification = *params.Classification
+	}
 	return rel, nil
 }
 
@@ -586,6 +1027,18 @@ func (w *world) In(childId, parentId string, strict bool) bool {
 	return tree.Has(childId, strict)
 }
 
+func (w *world) Archived(id string) bool {
+	// We walk the Tree rather than use Parent, so the latest trackers stay untouched for lists and queries.
+	node, ok := w.Tree.Find(id)
+	for ok && node.Item().Id != "" {
+		if w.Items[node.Item().Id].Archived {
+			return true
+		}
+		node = node.Parent()
+	}
+	return false
+}
+
 func (w *world) Parent(childId string) (string, bool) {
 	w.resetLatestTrackers()
 	tree, ok := w.Tree.Find(childId)
@@ -616,7 +1069,8 @@ func (w *world) Nest(childId, parentId string) WorldWithItem {
 		w.latestErr = errors.
 			New("childId for Nest not found").
 			UseCode(errors.TopolithErrorNotFound).
-			WithData(errors.KvPair{Key: "childId", Value: childId})
+			WithData(errors.KvPair{Key: "childId", Value: childId}).
+			WithSuggestions(childId, SuggestIds(w, childId, SuggestionLimit)...)
 		return w
 	}
 	w.latestItem = &item
@@ -624,7 +1078,8 @@ func (w *world) Nest(childId, parentId string) WorldWithItem {
 		w.latestErr = errors.
 			New("parentId for Nest not found").
 			UseCode(errors.TopolithErrorNotFound).
-			WithData(errors.KvPair{Key: "parentId", Value: parentId})
+			WithData(errors.KvPair{Key: "parentId", Value: parentId}).
+			WithSuggestions(parentId, SuggestIds(w, parentId, SuggestionLimit)...)
 		return w
 	}
 	tree, ok := w.Tree.Find(parentId)
@@ -638,6 +1093,16 @@ func (w *world) Nest(childId, parentId string) WorldWithItem {
 			WithData(errors.KvPair{Key: "parentId", Value: parentId})
 		return w
 	}
+	// Local IDs are unique among the components of a parent, so paths through the Tree stay unambiguous.
+	for _, c := range tree.Components().ToSlice() {
+		if c.Item().Id != childId && LocalId(c.Item().Id) == LocalId(childId) {
+			w.latestErr = errors.
+				New("parent already has a component with the same local ID").
+				UseCode(errors.TopolithErrorConflict).
+				WithData(errors.KvPair{Key: "childId", Value: childId}, errors.KvPair{Key: "parentId", Value: parentId}, errors.KvPair{Key: "componentId", Value: c.Item().Id})
+			return w
+		}
+	}
 	w.latestErr = tree.AddOrMove(&item)
 	return w
 }
@@ -649,7 +1114,8 @@ func (w *world) Free(childId string) WorldWithItem {
 		w.latestErr = errors.
 			New("childId for Free not found").
 			UseCode(errors.TopolithErrorNotFound).
-			WithData(errors.KvPair{Key: "childId", Value: childId})
+			WithData(errors.KvPair{Key: "childId", Value: childId}).
+			WithSuggestions(childId, SuggestIds(w, childId, SuggestionLimit)...)
 		return w
 	}
 	w.latestItem = &item
@@ -681,8 +1147,17 @@ func equalItemParams(existing Item, params ItemParams) error {
 	if (params.Name != nil && *params.Name != existing.Name) ||
 		(params.Expanded != nil && *params.Expanded != existing.Expanded) ||
 		(params.External != nil && *params.External != existing.External) ||
-		(params.Type != nil && strconv.Itoa(int(existing.Type)) != *params.Type) ||
-		(params.Mechanism != nil && *params.Mechanism != existing.Mechanism) {
+		(params.Type != nil && !equalItemType(existing.Type, *params.Type)) ||
+		(params.Mechanism != nil && *params.Mechanism != existing.Mechanism) ||
+		(params.Status != nil && !equalStatus(existing.Status, *params.Status)) ||
+		(params.Archived != nil && *params.Archived != existing.Archived) ||
+		(params.Owner != nil && *params.Owner != existing.Owner) ||
+		(params.Contacts != nil && *params.Contacts != existing.Contacts) ||
+		(params.Source != nil && *params.Source != existing.Source) ||
+		(params.Links != nil && !equalLinks(existing.Links, *params.Links)) ||
+		(params.Classification != nil && *params.Classification != existing.Classification) ||
+		(params.Boundary != nil && *params.Boundary != existing.Boundary) ||
+		(params.Tags != nil && *params.Tags != existing.Tags) {
 		existingJson, _ := json.Marshal(existing)
 		paramsJson, _ := json.Marshal(params)
 		return errors.
@@ -701,7 +1176,10 @@ func equalRelParams(existing Rel, params RelParams) error {
 	if (params.Verb != nil && *params.Verb != existing.Verb) ||
 		(params.Mechanism != nil && *params.Mechanism != existing.Mechanism) ||
 		(params.Async != nil && *params.Async != existing.Async) ||
-		(params.Expanded != nil && *params.Expanded != existing.Expanded) {
+		(params.Expanded != nil && *params.Expanded != existing.Expanded) ||
+		(params.Status != nil && !equalStatus(existing.Status, *params.Status)) ||
+		(params.Links != nil && !equalLinks(existing.Links, *params.Links)) ||
+		(params.Classification != nil && *params.Classification != existing.Classification) {
 		existingJson, _ := json.Marshal(existing)
 		paramsJson, _ := json.Marshal(params)
 		return errors.
@@ -722,6 +1200,11 @@ func equalRelParams(existing Rel, params RelParams) error {
 func (w *world) resetLatestTrackers() {
 	w.latestItem = &Item{}
 	w.latestRel = &Rel{}
+	w.latestNode = &DeploymentNode{}
+	w.latestScenario = &Scenario{}
+	w.latestView = &View{}
+	w.latestPin = &Pin{}
+	w.latestStyle = &Style{}
 	w.latestErr = nil
 }
 
@@ -47,6 +47,37 @@ func TestEmptyWorldParses(t *testing.T) {
 	}
 }
 
+func TestArchived(t *testing.T) {
+	w := CreateWorld("archive-world")
+	for _, id := range []string{"legacy", "legacy.db", "api"} {
+		w.ItemCreate(id, ItemParams{})
+	}
+	w.Nest("legacy.db", "legacy")
+	w.RelCreate("api", "legacy.db", RelParams{})
+	w.ItemSet("legacy", ItemParams{Archived: boolPtr(true)})
+
+	if !w.Archived("legacy") || !w.Archived("legacy.db") || w.Archived("api") {
+		t.Fatalf("expected legacy and its components archived")
+	}
+	if items := w.ItemList(0); len(items) != 1 || items[0].Id != "api" {
+		t.Fatalf("expected only api listed, got %v", items)
+	}
+	if len(w.ItemListArchived(0)) != 2 || len(w.RelList(0)) != 0 || len(w.RelListArchived(0)) != 1 {
+		t.Fatalf("expected archived Items and Rel listed apart")
+	}
+	if len(w.RelFrom("api", false)) != 0 || len(w.RelFrom("api", true)) != 1 {
+		t.Fatalf("expected archived Rel hidden, unless strict")
+	}
+
+	w2, err := FromString(w.String())
+	if err != nil {
+		t.Fatalf("FromString failed: %v", err)
+	}
+	if !WorldEqual(w, w2) || !w2.Archived("legacy.db") {
+		t.Fatalf("expected the archive to survive serialization")
+	}
+}
+
 // --- HELPERS ---
 
 func printDiff(a, b string) {